@@ -875,6 +875,28 @@ func CheckResourceAttrHasSuffix(name, key, suffix string) resource.TestCheckFunc
 	})
 }
 
+// CheckStateNotContains ensures that no attribute of any resource in the Terraform state contains the specified value,
+// e.g. the value of a write-only attribute.
+func CheckStateNotContains(value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, m := range s.Modules {
+			for name, rs := range m.Resources {
+				if rs.Primary == nil {
+					continue
+				}
+
+				for k, v := range rs.Primary.Attributes {
+					if strings.Contains(v, value) {
+						return fmt.Errorf("%s: Attribute '%s' contains a value that must not be persisted in state", name, k)
+					}
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
 // Copied and inlined from the SDK testing code
 func PrimaryInstanceState(s *terraform.State, name string) (*terraform.InstanceState, error) {
 	rs, ok := s.RootModule().Resources[name]
@@ -1738,6 +1760,12 @@ func RandomDomain() domainName {
 	return domainNameTestTopLevelDomain.RandomSubdomain()
 }
 
+// RandomPassword generates a random password that satisfies the complexity requirements of most AWS services:
+// at least one uppercase letter, lowercase letter, digit and special character, and no quotes, slashes, spaces or "@".
+func RandomPassword() string {
+	return fmt.Sprintf("Tf1!%s", sdkacctest.RandStringFromCharSet(24, sdkacctest.CharSetAlphaNum)) //nolint:mnd // 28 characters in total
+}
+
 // DefaultEmailAddress is the default email address to set as a
 // resource or data source parameter for acceptance tests.
 const DefaultEmailAddress = "no-reply@hashicorp.com"
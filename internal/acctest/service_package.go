@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+// CheckServicePackageResourceDisappears destroys an existing resource out of band.
+// The resource's implementation is looked up by type name in the specified service package,
+// so this check can be used without access to the resource's (unexported) factory function.
+func CheckServicePackageResourceDisappears(ctx context.Context, sp conns.ServicePackage, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", n)
+		}
+
+		for _, v := range sp.SDKResources(ctx) {
+			if v.TypeName == rs.Type {
+				return CheckResourceDisappears(ctx, Provider, v.Factory(), n)(s)
+			}
+		}
+
+		for _, v := range sp.FrameworkResources(ctx) {
+			if v.TypeName == rs.Type {
+				return CheckFrameworkResourceDisappears(ctx, Provider, v.Factory, n)(s)
+			}
+		}
+
+		return fmt.Errorf("resource type %s not found in service package %s", rs.Type, sp.ServicePackageName())
+	}
+}
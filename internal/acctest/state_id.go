@@ -5,6 +5,7 @@ package acctest
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -21,3 +22,25 @@ func AttrImportStateIdFunc(resourceName, attrName string) resource.ImportStateId
 		return rs.Primary.Attributes[attrName], nil
 	}
 }
+
+// AttrsImportStateIdFunc is a resource.ImportStateIdFunc that returns the values of the specified attributes
+// joined with the specified separator
+func AttrsImportStateIdFunc(resourceName, sep string, attrNames ...string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		attrValues := make([]string, 0, len(attrNames))
+		for _, attrName := range attrNames {
+			attrValue, ok := rs.Primary.Attributes[attrName]
+			if !ok {
+				return "", fmt.Errorf("attribute %q not found in resource %s", attrName, resourceName)
+			}
+			attrValues = append(attrValues, attrValue)
+		}
+
+		return strings.Join(attrValues, sep), nil
+	}
+}
@@ -27,24 +27,25 @@ import (
 )
 
 type AWSClient struct {
-	accountID                 string
-	awsConfig                 *aws.Config
-	clients                   map[string]any
-	conns                     map[string]any
-	defaultTagsConfig         *tftags.DefaultConfig
-	endpoints                 map[string]string // From provider configuration.
-	httpClient                *http.Client
-	ignoreTagsConfig          *tftags.IgnoreConfig
-	lock                      sync.Mutex
-	logger                    baselogging.Logger
-	partition                 endpoints.Partition
-	region                    string
-	servicePackages           map[string]ServicePackage
-	session                   *session_sdkv1.Session
-	s3ExpressClient           *s3.Client
-	s3UsePathStyle            bool   // From provider configuration.
-	s3USEast1RegionalEndpoint string // From provider configuration.
-	stsRegion                 string // From provider configuration.
+	accountID                     string
+	awsConfig                     *aws.Config
+	clients                       map[string]any
+	conns                         map[string]any
+	defaultTagsConfig             *tftags.DefaultConfig
+	endpoints                     map[string]string // From provider configuration.
+	httpClient                    *http.Client
+	ignoreTagsConfig              *tftags.IgnoreConfig
+	lock                          sync.Mutex
+	logger                        baselogging.Logger
+	partition                     endpoints.Partition
+	region                        string
+	servicePackages               map[string]ServicePackage
+	session                       *session_sdkv1.Session
+	s3ExpressClient               *s3.Client
+	s3UsePathStyle                bool   // From provider configuration.
+	s3USEast1RegionalEndpoint     string // From provider configuration.
+	sfnDefinitionWarningsAsErrors bool   // From provider configuration.
+	stsRegion                     string // From provider configuration.
 }
 
 func (c *AWSClient) SetServicePackages(_ context.Context, servicePackages map[string]ServicePackage) {
@@ -180,6 +181,11 @@ func (c *AWSClient) S3UsePathStyle(context.Context) bool {
 	return c.s3UsePathStyle
 }
 
+// SFNDefinitionWarningsAsErrors returns the sfn_definition_warnings_as_errors provider configuration value.
+func (c *AWSClient) SFNDefinitionWarningsAsErrors(context.Context) bool {
+	return c.sfnDefinitionWarningsAsErrors
+}
+
 // SetHTTPClient sets the http.Client used for AWS API calls.
 // To have effect it must be called before the AWS SDK v1 Session is created.
 func (c *AWSClient) SetHTTPClient(_ context.Context, httpClient *http.Client) {
@@ -50,6 +50,7 @@ type Config struct {
 	S3UsePathStyle                 bool
 	S3USEast1RegionalEndpoint      string
 	SecretKey                      string
+	SFNDefinitionWarningsAsErrors  bool
 	SharedConfigFiles              []string
 	SharedCredentialsFiles         []string
 	SkipCredsValidation            bool
@@ -222,6 +223,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.logger = logger
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
+	client.sfnDefinitionWarningsAsErrors = c.SFNDefinitionWarningsAsErrors
 	client.stsRegion = c.STSRegion
 
 	return client, diags
@@ -33,6 +33,45 @@ func GetWriteOnlyStringValue(d writeOnlyAttrGetter, path cty.Path) (string, diag
 	return value, diags
 }
 
+// GetWriteOnlyStringListValue returns the string values of the list-typed write-only attribute from the config.
+func GetWriteOnlyStringListValue(d writeOnlyAttrGetter, path cty.Path) ([]string, diag.Diagnostics) {
+	valueWO, diags := GetWriteOnlyValue(d, path, cty.List(cty.String))
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var values []string
+	if !valueWO.IsNull() && valueWO.IsKnown() {
+		for _, v := range valueWO.AsValueSlice() {
+			if !v.IsNull() {
+				values = append(values, v.AsString())
+			}
+		}
+	}
+
+	return values, diags
+}
+
+// GetWriteOnlyStringMapValue returns the string values of the map-typed write-only attribute from the config.
+func GetWriteOnlyStringMapValue(d writeOnlyAttrGetter, path cty.Path) (map[string]string, diag.Diagnostics) {
+	valueWO, diags := GetWriteOnlyValue(d, path, cty.Map(cty.String))
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var values map[string]string
+	if !valueWO.IsNull() && valueWO.IsKnown() {
+		values = make(map[string]string, valueWO.LengthInt())
+		for k, v := range valueWO.AsValueMap() {
+			if !v.IsNull() {
+				values[k] = v.AsString()
+			}
+		}
+	}
+
+	return values, diags
+}
+
 // GetWriteOnlyValue returns the value of the write-only attribute from the config.
 func GetWriteOnlyValue(d writeOnlyAttrGetter, path cty.Path, attrType cty.Type) (cty.Value, diag.Diagnostics) {
 	var diags diag.Diagnostics
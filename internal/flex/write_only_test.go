@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
@@ -137,3 +138,101 @@ func TestGetWriteOnlyStringValue(t *testing.T) {
 		})
 	}
 }
+
+func TestGetWriteOnlyStringListValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value          cty.Value
+		expectedValues []string
+		expectError    bool
+	}{
+		"valid value": {
+			value:          cty.ListVal([]cty.Value{cty.StringVal("value1"), cty.StringVal("value2")}),
+			expectedValues: []string{"value1", "value2"},
+		},
+		"null value": {
+			value: cty.NullVal(cty.List(cty.String)),
+		},
+		"invalid value type": {
+			value:       cty.StringVal("value1"),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			m := mockWriteOnlyAttrGetter{
+				path:  cty.GetAttrPath("test_path"),
+				value: testCase.value,
+			}
+			values, diags := flex.GetWriteOnlyStringListValue(&m, cty.GetAttrPath("test_path"))
+
+			if testCase.expectError {
+				if !diags.HasError() {
+					t.Fatalf("expected error, got none")
+				}
+				return
+			}
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if diff := cmp.Diff(values, testCase.expectedValues); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestGetWriteOnlyStringMapValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value          cty.Value
+		expectedValues map[string]string
+		expectError    bool
+	}{
+		"valid value": {
+			value:          cty.MapVal(map[string]cty.Value{"key1": cty.StringVal("value1"), "key2": cty.StringVal("value2")}),
+			expectedValues: map[string]string{"key1": "value1", "key2": "value2"},
+		},
+		"null value": {
+			value: cty.NullVal(cty.Map(cty.String)),
+		},
+		"invalid value type": {
+			value:       cty.ListVal([]cty.Value{cty.StringVal("value1")}),
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			m := mockWriteOnlyAttrGetter{
+				path:  cty.GetAttrPath("test_path"),
+				value: testCase.value,
+			}
+			values, diags := flex.GetWriteOnlyStringMapValue(&m, cty.GetAttrPath("test_path"))
+
+			if testCase.expectError {
+				if !diags.HasError() {
+					t.Fatalf("expected error, got none")
+				}
+				return
+			}
+
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if diff := cmp.Diff(values, testCase.expectedValues); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -8,23 +8,13 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-go/tftypes"
-)
-
-const (
-	// Valid time format is "ddd:hh24:mi".
-	validTimeFormat             = "(sun|mon|tue|wed|thu|fri|sat):([0-1][0-9]|2[0-3]):([0-5][0-9])"
-	validTimeFormatConsolidated = "^(" + validTimeFormat + "-" + validTimeFormat + "|)$"
-)
-
-var (
-	validTimeFormatConsolidatedRegex = regexache.MustCompile(validTimeFormatConsolidated)
+	"github.com/hashicorp/terraform-provider-aws/internal/types/timestamp"
 )
 
 var (
@@ -132,7 +122,7 @@ func (v OnceAWeekWindow) ValidateAttribute(ctx context.Context, req xattr.Valida
 		return
 	}
 
-	if vs := strings.ToLower(v.ValueString()); !validTimeFormatConsolidatedRegex.MatchString(vs) {
+	if vs := strings.ToLower(v.ValueString()); timestamp.New(vs).ValidateOnceAWeekWindowFormat() != nil {
 		resp.Diagnostics.AddAttributeError(
 			req.Path,
 			"Invalid Once A Week Window Value",
@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/schedule"
+)
+
+// scheduleExpressionValidator validates that a string Attribute's value is a valid schedule expression.
+type scheduleExpressionValidator struct {
+	allowed []schedule.Type
+}
+
+// Description describes the validation in plain text formatting.
+func (validator scheduleExpressionValidator) Description(_ context.Context) string {
+	expected := make([]string, len(validator.allowed))
+	for i, t := range validator.allowed {
+		expected[i] = string(t) + "()"
+	}
+
+	return fmt.Sprintf("value must be a valid %s schedule expression", strings.Join(expected, " or "))
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator scheduleExpressionValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+// Validate performs the validation.
+func (validator scheduleExpressionValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	configValue := request.ConfigValue
+
+	if configValue.IsNull() || configValue.IsUnknown() {
+		return
+	}
+
+	if _, err := schedule.ParseAllowed(configValue.ValueString(), validator.allowed...); err != nil {
+		response.Diagnostics.Append(diag.NewAttributeErrorDiagnostic(
+			request.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got error: %s", request.Path, validator.Description(ctx), err),
+		))
+		return
+	}
+}
+
+// ScheduleExpression returns a string validator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, which represents a valid schedule expression of one of the allowed types,
+//     e.g. `cron(0 12 * * ? *)`, `rate(5 minutes)` or `at(2025-11-20T13:00:00)`.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func ScheduleExpression(allowed ...schedule.Type) validator.String {
+	return scheduleExpressionValidator{
+		allowed: allowed,
+	}
+}
+
+// unixCronExpressionValidator validates that a string Attribute's value is a valid five-field Unix cron expression.
+type unixCronExpressionValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (validator unixCronExpressionValidator) Description(_ context.Context) string {
+	return "value must be a valid Unix cron expression"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator unixCronExpressionValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+// Validate performs the validation.
+func (validator unixCronExpressionValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	configValue := request.ConfigValue
+
+	if configValue.IsNull() || configValue.IsUnknown() {
+		return
+	}
+
+	if _, err := schedule.ParseUnixCron(configValue.ValueString()); err != nil {
+		response.Diagnostics.Append(diag.NewAttributeErrorDiagnostic(
+			request.Path,
+			"Invalid Attribute Value",
+			fmt.Sprintf("Attribute %s %s, got error: %s", request.Path, validator.Description(ctx), err),
+		))
+		return
+	}
+}
+
+// UnixCronExpression returns a string validator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, which represents a valid five-field Unix cron expression, e.g. `0 12 * * MON-FRI`.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func UnixCronExpression() validator.String {
+	return unixCronExpressionValidator{}
+}
@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/duration"
+)
+
+var durationParseResultAttrTypes = map[string]attr.Type{
+	"years":  types.Int64Type,
+	"months": types.Int64Type,
+	"days":   types.Int64Type,
+}
+
+var _ function.Function = durationParseFunction{}
+
+func NewDurationParseFunction() function.Function {
+	return &durationParseFunction{}
+}
+
+type durationParseFunction struct{}
+
+func (f durationParseFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "duration_parse"
+}
+
+func (f durationParseFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "duration_parse Function",
+		MarkdownDescription: "Parses an RFC 3339 duration into its constituent parts",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "duration",
+				MarkdownDescription: "Duration to parse, in the format `P[n]Y[n]M[n]D`",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: durationParseResultAttrTypes,
+		},
+	}
+}
+
+func (f durationParseFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg))
+	if resp.Error != nil {
+		return
+	}
+
+	d, err := duration.Parse(arg)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("invalid duration %q: %s", arg, err))
+		return
+	}
+
+	value := map[string]attr.Value{
+		"years":  types.Int64Value(int64(d.Years())),
+		"months": types.Int64Value(int64(d.Months())),
+		"days":   types.Int64Value(int64(d.Days())),
+	}
+
+	result, diags := types.ObjectValue(durationParseResultAttrTypes, value)
+	if diags.HasError() {
+		resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestDurationParseFunction_known(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testDurationParseFunctionConfig("P1Y2M3D"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("years", "1"),
+					resource.TestCheckOutput("months", "2"),
+					resource.TestCheckOutput("days", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestDurationParseFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testDurationParseFunctionConfig("PT1H"),
+				ExpectError: regexache.MustCompile("invalid duration"),
+			},
+		},
+	})
+}
+
+func testDurationParseFunctionConfig(arg string) string {
+	return fmt.Sprintf(`
+locals {
+  result = provider::aws::duration_parse(%[1]q)
+}
+
+output "years" {
+  value = local.result.years
+}
+
+output "months" {
+  value = local.result.months
+}
+
+output "days" {
+  value = local.result.days
+}
+`, arg)
+}
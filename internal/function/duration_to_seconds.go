@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/duration"
+)
+
+var _ function.Function = durationToSecondsFunction{}
+
+func NewDurationToSecondsFunction() function.Function {
+	return &durationToSecondsFunction{}
+}
+
+type durationToSecondsFunction struct{}
+
+func (f durationToSecondsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "duration_to_seconds"
+}
+
+func (f durationToSecondsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "duration_to_seconds Function",
+		MarkdownDescription: "Converts an RFC 3339 duration to a number of seconds. " +
+			"Durations containing years or months require a start timestamp",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "duration",
+				MarkdownDescription: "Duration to convert, in the format `P[n]Y[n]M[n]D`",
+			},
+		},
+		VariadicParameter: function.StringParameter{
+			Name:                "start",
+			MarkdownDescription: "Optional RFC 3339 timestamp from which calendar years and months are counted",
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f durationToSecondsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg string
+	var starts []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg, &starts))
+	if resp.Error != nil {
+		return
+	}
+
+	d, err := duration.Parse(arg)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("invalid duration %q: %s", arg, err))
+		return
+	}
+
+	var result int64
+	switch len(starts) {
+	case 0:
+		if d.Years() != 0 || d.Months() != 0 {
+			resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("duration %q contains years or months, a start timestamp is required", arg))
+			return
+		}
+		result = int64(d.Days()) * int64((24 * time.Hour).Seconds())
+	case 1:
+		start, err := time.Parse(time.RFC3339, starts[0])
+		if err != nil {
+			resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("invalid start timestamp %q: %s", starts[0], err))
+			return
+		}
+		result = int64(duration.Add(start, d).Sub(start).Seconds())
+	default:
+		resp.Error = function.NewArgumentFuncError(2, "at most one start timestamp may be specified")
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/eks/kubeconfig"
+)
+
+// eksKubeconfigClusterKeys are the supported keys of each cluster map.
+var eksKubeconfigClusterKeys = []string{
+	"alias",
+	"arn",
+	"certificate_authority_data",
+	"endpoint",
+	"exec_command",
+	"name",
+	"namespace",
+	"profile",
+	"region",
+	"role_arn",
+	"token",
+}
+
+var _ function.Function = eksKubeconfigFunction{}
+
+func NewEKSKubeconfigFunction() function.Function {
+	return &eksKubeconfigFunction{}
+}
+
+type eksKubeconfigFunction struct{}
+
+func (f eksKubeconfigFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "eks_kubeconfig"
+}
+
+func (f eksKubeconfigFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "eks_kubeconfig Function",
+		MarkdownDescription: "Renders a kubeconfig YAML document for one or more EKS clusters",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "clusters",
+				MarkdownDescription: "Clusters, each a map with `name`, `endpoint` and `certificate_authority_data` keys and optional `alias`, `arn`, `namespace`, `token`, `exec_command`, `region`, `role_arn` and `profile` keys",
+				ElementType:         types.MapType{ElemType: types.StringType},
+			},
+			function.StringParameter{
+				Name:                "current_context",
+				MarkdownDescription: "Name of the current context. An empty string means the first cluster's context",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f eksKubeconfigFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var clusters []map[string]string
+	var currentContext string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &clusters, &currentContext))
+	if resp.Error != nil {
+		return
+	}
+
+	config := kubeconfig.Config{
+		CurrentContext: currentContext,
+	}
+	for i, v := range clusters {
+		for k := range v {
+			if !slices.Contains(eksKubeconfigClusterKeys, k) {
+				resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("cluster %d: unsupported key %q", i, k))
+				return
+			}
+		}
+
+		config.Clusters = append(config.Clusters, kubeconfig.Cluster{
+			Alias:                    v["alias"],
+			ARN:                      v["arn"],
+			CertificateAuthorityData: v["certificate_authority_data"],
+			Endpoint:                 v["endpoint"],
+			Exec: kubeconfig.Exec{
+				Command: v["exec_command"],
+				Profile: v["profile"],
+				Region:  v["region"],
+				RoleARN: v["role_arn"],
+			},
+			Name:      v["name"],
+			Namespace: v["namespace"],
+			Token:     v["token"],
+		})
+	}
+
+	output, err := config.Marshal()
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, string(output)))
+}
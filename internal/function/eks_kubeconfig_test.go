@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestEKSKubeconfigFunction_token(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testEKSKubeconfigFunctionConfig(`[{
+    name                       = "example"
+    endpoint                   = "https://example.com"
+    certificate_authority_data = "Y2VydGlmaWNhdGU="
+    namespace                  = "apps"
+    token                      = "k8s-aws-v1.example"
+  }], ""`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `apiVersion: v1
+clusters:
+  - name: example
+    cluster:
+      certificate-authority-data: Y2VydGlmaWNhdGU=
+      server: https://example.com
+contexts:
+  - name: example
+    context:
+      cluster: example
+      namespace: apps
+      user: example
+current-context: example
+kind: Config
+preferences: {}
+users:
+  - name: example
+    user:
+      token: k8s-aws-v1.example
+`),
+				),
+			},
+		},
+	})
+}
+
+func TestEKSKubeconfigFunction_exec(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testEKSKubeconfigFunctionConfig(`[{
+    name                       = "example"
+    alias                      = "admin"
+    endpoint                   = "https://example.com"
+    certificate_authority_data = "Y2VydGlmaWNhdGU="
+    profile                    = "admin"
+  }], "admin"`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `apiVersion: v1
+clusters:
+  - name: example
+    cluster:
+      certificate-authority-data: Y2VydGlmaWNhdGU=
+      server: https://example.com
+contexts:
+  - name: admin
+    context:
+      cluster: example
+      user: admin
+current-context: admin
+kind: Config
+preferences: {}
+users:
+  - name: admin
+    user:
+      exec:
+        apiVersion: client.authentication.k8s.io/v1beta1
+        args:
+          - eks
+          - get-token
+          - --cluster-name
+          - example
+          - --output
+          - json
+        command: aws
+        env:
+          - name: AWS_PROFILE
+            value: admin
+        interactiveMode: IfAvailable
+        provideClusterInfo: false
+`),
+				),
+			},
+		},
+	})
+}
+
+func TestEKSKubeconfigFunction_unsupportedKey(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testEKSKubeconfigFunctionConfig(`[{
+    name                       = "example"
+    endpoint                   = "https://example.com"
+    certificate_authority_data = "Y2VydGlmaWNhdGU="
+    server                     = "https://example.com"
+  }], ""`),
+				ExpectError: regexache.MustCompile(`unsupported key "server"`),
+			},
+		},
+	})
+}
+
+func TestEKSKubeconfigFunction_missingEndpoint(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testEKSKubeconfigFunctionConfig(`[{
+    name                       = "example"
+    certificate_authority_data = "Y2VydGlmaWNhdGU="
+  }], ""`),
+				ExpectError: regexache.MustCompile(`endpoint is required`),
+			},
+		},
+	})
+}
+
+func testEKSKubeconfigFunctionConfig(args string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::eks_kubeconfig(%[1]s)
+}
+`, args)
+}
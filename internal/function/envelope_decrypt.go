@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"encoding/base64"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = envelopeDecryptFunction{}
+
+func NewEnvelopeDecryptFunction() function.Function {
+	return &envelopeDecryptFunction{}
+}
+
+type envelopeDecryptFunction struct{}
+
+func (f envelopeDecryptFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "envelope_decrypt"
+}
+
+func (f envelopeDecryptFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "envelope_decrypt Function",
+		MarkdownDescription: "Decrypts a ciphertext produced by envelope_encrypt locally with AES-GCM using a " +
+			"base64-encoded data key.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "key",
+				MarkdownDescription: "Base64-encoded 128, 192 or 256-bit data key",
+			},
+			function.StringParameter{
+				Name:                "ciphertext",
+				MarkdownDescription: "Base64-encoded nonce and ciphertext returned by envelope_encrypt",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f envelopeDecryptFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var key, ciphertext string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &key, &ciphertext))
+	if resp.Error != nil {
+		return
+	}
+
+	_, aead, err := newEnvelopeAEAD(key)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(ciphertext)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, "invalid base64 ciphertext: "+err.Error())
+		return
+	}
+
+	if len(raw) < aead.NonceSize()+aead.Overhead() {
+		resp.Error = function.NewArgumentFuncError(1, "ciphertext is too short")
+		return
+	}
+
+	nonce, sealed := raw[:aead.NonceSize()], raw[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, sealed, nil)
+	if err != nil {
+		resp.Error = function.NewFuncError("decryption failed: the key is wrong or the ciphertext has been modified")
+		return
+	}
+
+	if !utf8.Valid(plaintext) {
+		resp.Error = function.NewFuncError("decrypted plaintext is not valid UTF-8")
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, string(plaintext)))
+}
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestEnvelopeDecryptFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testEnvelopeDecryptFunctionConfig(testEnvelopeKey, testEnvelopeCiphertext),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "hello, world"),
+				),
+			},
+		},
+	})
+}
+
+func TestEnvelopeDecryptFunction_wrongKey(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testEnvelopeDecryptFunctionConfig(base64Key("fedcba9876543210fedcba9876543210"), testEnvelopeCiphertext),
+				ExpectError: regexache.MustCompile(`decryption failed`),
+			},
+		},
+	})
+}
+
+func TestEnvelopeDecryptFunction_invalidCiphertext(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testEnvelopeDecryptFunctionConfig(testEnvelopeKey, "dG9vIHNob3J0"),
+				ExpectError: regexache.MustCompile(`ciphertext is too short`),
+			},
+			{
+				Config:      testEnvelopeDecryptFunctionConfig(testEnvelopeKey, "not base64!"),
+				ExpectError: regexache.MustCompile(`invalid base64 ciphertext`),
+			},
+		},
+	})
+}
+
+func testEnvelopeDecryptFunctionConfig(key, ciphertext string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::envelope_decrypt("%[1]s", %[2]q)
+}
+`, key, ciphertext)
+}
@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = envelopeEncryptFunction{}
+
+func NewEnvelopeEncryptFunction() function.Function {
+	return &envelopeEncryptFunction{}
+}
+
+type envelopeEncryptFunction struct{}
+
+func (f envelopeEncryptFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "envelope_encrypt"
+}
+
+func (f envelopeEncryptFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "envelope_encrypt Function",
+		MarkdownDescription: "Encrypts a plaintext locally with AES-GCM using a base64-encoded data key, such as the " +
+			"plaintext data key of an aws_kms_data_key ephemeral resource. Returns the base64-encoded nonce and ciphertext.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "key",
+				MarkdownDescription: "Base64-encoded 128, 192 or 256-bit data key",
+			},
+			function.StringParameter{
+				Name:                "plaintext",
+				MarkdownDescription: "Plaintext to encrypt",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f envelopeEncryptFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var key, plaintext string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &key, &plaintext))
+	if resp.Error != nil {
+		return
+	}
+
+	rawKey, aead, err := newEnvelopeAEAD(key)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	// Functions must return the same result for the same arguments, so rather than a random nonce
+	// the nonce is derived from the key and plaintext. A nonce is therefore only reused for the
+	// same plaintext, which yields the same ciphertext.
+	mac := hmac.New(sha256.New, rawKey)
+	mac.Write([]byte(plaintext))
+	nonce := mac.Sum(nil)[:aead.NonceSize()]
+
+	result := base64.StdEncoding.EncodeToString(aead.Seal(nonce, nonce, []byte(plaintext), nil))
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+// newEnvelopeAEAD returns the AES-GCM cipher for a base64-encoded data key.
+func newEnvelopeAEAD(key string) ([]byte, cipher.AEAD, error) {
+	rawKey, err := base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid base64 key: %w", err)
+	}
+
+	block, err := aes.NewCipher(rawKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("key must be 16, 24 or 32 bytes, got %d", len(rawKey))
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return rawKey, aead, nil
+}
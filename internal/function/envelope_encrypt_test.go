@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+const (
+	testEnvelopeKey        = "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY=" // 0123456789abcdef0123456789abcdef
+	testEnvelopeCiphertext = "vXnwJlkhRwwHoy0EDodJ97YNRPPgkXzM01oZa9S8D8+RNzPHh+TMeA=="
+)
+
+func TestEnvelopeEncryptFunction_basic(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testEnvelopeEncryptFunctionConfig(testEnvelopeKey, "hello, world"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", testEnvelopeCiphertext),
+				),
+			},
+		},
+	})
+}
+
+func TestEnvelopeEncryptFunction_roundTrip(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testEnvelopeEncryptFunctionConfig_roundTrip(base64Key("0123456789abcdef"), `{"password":"s3cr3t"}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"password":"s3cr3t"}`),
+				),
+			},
+		},
+	})
+}
+
+func TestEnvelopeEncryptFunction_invalidKey(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testEnvelopeEncryptFunctionConfig(base64Key("short"), "hello, world"),
+				ExpectError: regexache.MustCompile(`key must be 16, 24 or 32 bytes, got 5`),
+			},
+			{
+				Config:      testEnvelopeEncryptFunctionConfig("not base64!", "hello, world"),
+				ExpectError: regexache.MustCompile(`invalid base64 key`),
+			},
+		},
+	})
+}
+
+func base64Key(s string) string {
+	return fmt.Sprintf("${base64encode(%q)}", s)
+}
+
+func testEnvelopeEncryptFunctionConfig(key, plaintext string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::envelope_encrypt("%[1]s", %[2]q)
+}
+`, key, plaintext)
+}
+
+func testEnvelopeEncryptFunctionConfig_roundTrip(key, plaintext string) string {
+	return fmt.Sprintf(`
+locals {
+  key = "%[1]s"
+}
+
+output "test" {
+  value = provider::aws::envelope_decrypt(local.key, provider::aws::envelope_encrypt(local.key, %[2]q))
+}
+`, key, plaintext)
+}
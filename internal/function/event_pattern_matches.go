@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/eventpattern"
+)
+
+var _ function.Function = eventPatternMatchesFunction{}
+
+func NewEventPatternMatchesFunction() function.Function {
+	return &eventPatternMatchesFunction{}
+}
+
+type eventPatternMatchesFunction struct{}
+
+func (f eventPatternMatchesFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "event_pattern_matches"
+}
+
+func (f eventPatternMatchesFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "event_pattern_matches Function",
+		MarkdownDescription: "Returns whether an event matches an EventBridge event pattern",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "pattern",
+				MarkdownDescription: "EventBridge event pattern JSON",
+			},
+			function.StringParameter{
+				Name:                "event",
+				MarkdownDescription: "Event JSON",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f eventPatternMatchesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var pattern, event string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &pattern, &event))
+	if resp.Error != nil {
+		return
+	}
+
+	p, err := eventpattern.Parse(pattern)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("invalid event pattern: %s", err))
+		return
+	}
+
+	matches, err := p.Matches(event)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("invalid event: %s", err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, matches))
+}
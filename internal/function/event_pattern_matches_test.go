@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+const testEventPatternMatchesFunctionEvent = `{
+  source      = "aws.ec2"
+  detail-type = "EC2 Instance State-change Notification"
+  detail = {
+    state = "running"
+    count = 3
+  }
+}`
+
+func TestEventPatternMatchesFunction_matches(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testEventPatternMatchesFunctionConfig(`{
+  source = ["aws.ec2"]
+  detail = {
+    state = [{ prefix = "run" }]
+    count = [{ numeric = [">", 0, "<=", 5] }]
+  }
+}`, testEventPatternMatchesFunctionEvent),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestEventPatternMatchesFunction_noMatch(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testEventPatternMatchesFunctionConfig(`{
+  "$or" = [
+    { source = ["aws.s3"] },
+    { detail = { state = [{ anything-but = ["running", "pending"] }] } },
+  ]
+}`, testEventPatternMatchesFunctionEvent),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestEventPatternMatchesFunction_invalidPattern(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testEventPatternMatchesFunctionConfig(`{ source = [{ contains = "ec2" }] }`, testEventPatternMatchesFunctionEvent),
+				ExpectError: regexache.MustCompile(`invalid event pattern: source\[0\]: unsupported operator "contains"`),
+			},
+		},
+	})
+}
+
+func TestEventPatternMatchesFunction_invalidEvent(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testEventPatternMatchesFunctionConfig(`{ source = ["aws.ec2"] }`, `["aws.ec2"]`),
+				ExpectError: regexache.MustCompile("invalid event: event must be a JSON object"),
+			},
+		},
+	})
+}
+
+func testEventPatternMatchesFunctionConfig(pattern, event string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::event_pattern_matches(jsonencode(%[1]s), jsonencode(%[2]s))
+}
+`, pattern, event)
+}
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/logs/filterpattern"
+)
+
+var _ function.Function = logFilterMatchesFunction{}
+
+func NewLogFilterMatchesFunction() function.Function {
+	return &logFilterMatchesFunction{}
+}
+
+type logFilterMatchesFunction struct{}
+
+func (f logFilterMatchesFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "log_filter_matches"
+}
+
+func (f logFilterMatchesFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "log_filter_matches Function",
+		MarkdownDescription: "Returns whether a log event matches a CloudWatch Logs filter pattern",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "pattern",
+				MarkdownDescription: "CloudWatch Logs filter pattern",
+			},
+			function.StringParameter{
+				Name:                "line",
+				MarkdownDescription: "Log event message",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f logFilterMatchesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var pattern, line string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &pattern, &line))
+	if resp.Error != nil {
+		return
+	}
+
+	p, err := filterpattern.Parse(pattern)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("invalid filter pattern: %s", err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, p.Matches(line)))
+}
@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/timestamp"
+)
+
+var _ function.Function = maintenanceWindowOverlapsFunction{}
+
+func NewMaintenanceWindowOverlapsFunction() function.Function {
+	return &maintenanceWindowOverlapsFunction{}
+}
+
+type maintenanceWindowOverlapsFunction struct{}
+
+func (f maintenanceWindowOverlapsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "maintenance_window_overlaps"
+}
+
+func (f maintenanceWindowOverlapsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "maintenance_window_overlaps Function",
+		MarkdownDescription: "Returns whether two weekly maintenance windows or daily backup windows are ever open at the same time. " +
+			"A daily window is treated as open on every day of the week",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "window",
+				MarkdownDescription: "Window in the format `ddd:hh24:mi-ddd:hh24:mi` or `hh24:mi-hh24:mi`",
+			},
+			function.StringParameter{
+				Name:                "other_window",
+				MarkdownDescription: "Window in the format `ddd:hh24:mi-ddd:hh24:mi` or `hh24:mi-hh24:mi`",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f maintenanceWindowOverlapsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var args [2]string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &args[0], &args[1]))
+	if resp.Error != nil {
+		return
+	}
+
+	var windows [2]timestamp.Window
+	for i, arg := range args {
+		w, err := timestamp.ParseWindow(arg)
+		if err != nil {
+			resp.Error = function.NewArgumentFuncError(int64(i), fmt.Sprintf("invalid window: %s", err))
+			return
+		}
+		windows[i] = w
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, timestamp.WindowsOverlap(windows[0], windows[1])))
+}
@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/timestamp"
+)
+
+var _ function.Function = maintenanceWindowShiftFunction{}
+
+func NewMaintenanceWindowShiftFunction() function.Function {
+	return &maintenanceWindowShiftFunction{}
+}
+
+type maintenanceWindowShiftFunction struct{}
+
+func (f maintenanceWindowShiftFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "maintenance_window_shift"
+}
+
+func (f maintenanceWindowShiftFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "maintenance_window_shift Function",
+		MarkdownDescription: "Shifts a weekly maintenance window or daily backup window by an offset, wrapping around the week or day",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "window",
+				MarkdownDescription: "Window in the format `ddd:hh24:mi-ddd:hh24:mi` or `hh24:mi-hh24:mi`",
+			},
+			function.StringParameter{
+				Name:                "offset",
+				MarkdownDescription: "Offset as a Go duration string, e.g. `-30m` or `1h30m`. Must be a whole number of minutes",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f maintenanceWindowShiftFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var arg, offset string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &arg, &offset))
+	if resp.Error != nil {
+		return
+	}
+
+	w, err := timestamp.ParseWindow(arg)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("invalid window: %s", err))
+		return
+	}
+
+	d, err := time.ParseDuration(offset)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("invalid offset: %s", err))
+		return
+	}
+
+	result, err := timestamp.ShiftWindow(w, d)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result.String()))
+}
@@ -0,0 +1,95 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestMaintenanceWindowShiftFunction_weekly(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testMaintenanceWindowShiftFunctionConfig("sun:00:30-sun:01:30", "-1h"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "sat:23:30-sun:00:30"),
+				),
+			},
+		},
+	})
+}
+
+func TestMaintenanceWindowShiftFunction_daily(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testMaintenanceWindowShiftFunctionConfig("23:00-23:30", "90m"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "00:30-01:00"),
+				),
+			},
+		},
+	})
+}
+
+func TestMaintenanceWindowShiftFunction_invalidWindow(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testMaintenanceWindowShiftFunctionConfig("sun:05:00", "1h"),
+				ExpectError: regexache.MustCompile("invalid window"),
+			},
+		},
+	})
+}
+
+func TestMaintenanceWindowShiftFunction_invalidOffset(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testMaintenanceWindowShiftFunctionConfig("sun:05:00-sun:06:00", "30s"),
+				ExpectError: regexache.MustCompile("not a whole number of minutes"),
+			},
+		},
+	})
+}
+
+func testMaintenanceWindowShiftFunctionConfig(window, offset string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::maintenance_window_shift(%[1]q, %[2]q)
+}
+`, window, offset)
+}
@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+)
+
+// crudPhases are the resource lifecycle phases recorded in the API lineage.
+var crudPhases = []string{"create", "read", "update", "delete"}
+
+var _ function.Function = requiredIAMPolicyFunction{}
+
+func NewRequiredIAMPolicyFunction() function.Function {
+	return &requiredIAMPolicyFunction{}
+}
+
+type requiredIAMPolicyFunction struct{}
+
+func (f requiredIAMPolicyFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "required_iam_policy"
+}
+
+func (f requiredIAMPolicyFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "required_iam_policy Function",
+		MarkdownDescription: "Generates an IAM policy document allowing the actions the provider calls to manage the specified " +
+			"resource types in the specified lifecycle phases.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "resource_types",
+				MarkdownDescription: "Resource types, e.g. `aws_s3_bucket`",
+				ElementType:         types.StringType,
+			},
+			function.ListParameter{
+				Name:                "phases",
+				MarkdownDescription: "Lifecycle phases, any of `create`, `read`, `update` and `delete`. An empty list means all phases",
+				ElementType:         types.StringType,
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f requiredIAMPolicyFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var typeNames, phases []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &typeNames, &phases))
+	if resp.Error != nil {
+		return
+	}
+
+	for _, phase := range phases {
+		if !slices.Contains(crudPhases, phase) {
+			resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("invalid phase %q, expected one of %q", phase, crudPhases))
+			return
+		}
+	}
+
+	lineage, err := data.ReadAPILineage()
+	if err != nil {
+		resp.Error = function.NewFuncError(err.Error())
+		return
+	}
+
+	result, err := requiredIAMPolicy(lineage, typeNames, phases)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
+
+type iamPolicyDocument struct {
+	Version   string
+	Statement []iamPolicyStatement
+}
+
+type iamPolicyStatement struct {
+	Effect   string
+	Action   []string
+	Resource string
+}
+
+// requiredIAMPolicy returns a JSON IAM policy document allowing all actions required by the specified resource types in the specified phases.
+func requiredIAMPolicy(lineage data.APILineage, typeNames, phases []string) (string, error) {
+	if len(phases) == 0 {
+		phases = crudPhases
+	}
+
+	var actions []string
+	for _, typeName := range typeNames {
+		v, ok := lineage[typeName]
+		if !ok {
+			return "", fmt.Errorf("unsupported resource type %q", typeName)
+		}
+		// The resource type is known, but no AWS API operations were detected in its implementation.
+		if len(v) == 0 {
+			return "", fmt.Errorf("no API lineage recorded for resource type %q", typeName)
+		}
+
+		for _, phase := range phases {
+			v, _ := lineage.IAMActions(typeName, phase)
+			actions = append(actions, v...)
+		}
+	}
+	slices.Sort(actions)
+	actions = slices.Compact(actions)
+
+	// A policy document must contain at least one statement.
+	if len(actions) == 0 {
+		return "", fmt.Errorf("no IAM actions are required by resource types %q in phases %q", typeNames, phases)
+	}
+
+	document := iamPolicyDocument{
+		Version: "2012-10-17",
+		Statement: []iamPolicyStatement{
+			{
+				Effect:   "Allow",
+				Action:   actions,
+				Resource: "*",
+			},
+		},
+	}
+
+	b, err := json.Marshal(document)
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestRequiredIAMPolicyFunction_known(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testRequiredIAMPolicyFunctionConfig(`["aws_cloudwatch_log_group"]`, `["delete"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["logs:DeleteLogGroup"],"Resource":"*"}]}`),
+				),
+			},
+		},
+	})
+}
+
+func TestRequiredIAMPolicyFunction_tags(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testRequiredIAMPolicyFunctionConfig(`["aws_iam_user"]`, `["create"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["iam:CreateUser","iam:GetUser","iam:TagUser","iam:UntagUser"],"Resource":"*"}]}`),
+				),
+			},
+		},
+	})
+}
+
+func TestRequiredIAMPolicyFunction_invalidResourceType(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testRequiredIAMPolicyFunctionConfig(`["aws_invalid"]`, `[]`),
+				ExpectError: regexache.MustCompile(`unsupported resource type "aws_invalid"`),
+			},
+		},
+	})
+}
+
+func TestRequiredIAMPolicyFunction_noActions(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testRequiredIAMPolicyFunctionConfig(`["aws_account_region"]`, `["delete"]`),
+				ExpectError: regexache.MustCompile(`no IAM actions are required by resource types`),
+			},
+		},
+	})
+}
+
+func TestRequiredIAMPolicyFunction_noResourceTypes(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testRequiredIAMPolicyFunctionConfig(`[]`, `[]`),
+				ExpectError: regexache.MustCompile(`no IAM actions are required by resource types`),
+			},
+		},
+	})
+}
+
+func TestRequiredIAMPolicyFunction_invalidPhase(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testRequiredIAMPolicyFunctionConfig(`["aws_cloudwatch_log_group"]`, `["import"]`),
+				ExpectError: regexache.MustCompile(`invalid phase "import"`),
+			},
+		},
+	})
+}
+
+func testRequiredIAMPolicyFunctionConfig(resourceTypes, phases string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = provider::aws::required_iam_policy(%[1]s, %[2]s)
+}
+`, resourceTypes, phases)
+}
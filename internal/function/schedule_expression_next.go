@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/schedule"
+)
+
+// scheduleExpressionNextMaxCount bounds the number of invocation times returned.
+const scheduleExpressionNextMaxCount = 1000
+
+var _ function.Function = scheduleExpressionNextFunction{}
+
+func NewScheduleExpressionNextFunction() function.Function {
+	return &scheduleExpressionNextFunction{}
+}
+
+type scheduleExpressionNextFunction struct{}
+
+func (f scheduleExpressionNextFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "schedule_expression_next"
+}
+
+func (f scheduleExpressionNextFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "schedule_expression_next Function",
+		MarkdownDescription: "Returns the next invocation times, in UTC, of a `cron()`, `rate()` or `at()` schedule expression " +
+			"strictly after a start timestamp. Fewer times are returned if the schedule stops firing",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "expression",
+				MarkdownDescription: "Schedule expression",
+			},
+			function.Int64Parameter{
+				Name:                "count",
+				MarkdownDescription: fmt.Sprintf("Number of invocation times to return, between 1 and %d", scheduleExpressionNextMaxCount),
+			},
+			function.StringParameter{
+				Name:                "start",
+				MarkdownDescription: "RFC 3339 timestamp after which invocation times are calculated",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f scheduleExpressionNextFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var expression, start string
+	var count int64
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &expression, &count, &start))
+	if resp.Error != nil {
+		return
+	}
+
+	expr, err := schedule.Parse(expression)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("invalid schedule expression: %s", err))
+		return
+	}
+
+	if count < 1 || count > scheduleExpressionNextMaxCount {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("count must be between 1 and %d, got %d", scheduleExpressionNextMaxCount, count))
+		return
+	}
+
+	t, err := time.Parse(time.RFC3339, start)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(2, fmt.Sprintf("invalid start timestamp %q: %s", start, err))
+		return
+	}
+	t = t.UTC()
+
+	result := make([]string, 0, count)
+	for range count {
+		next, ok := expr.Next(t)
+		if !ok {
+			break
+		}
+		result = append(result, next.Format(time.RFC3339))
+		t = next
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestScheduleExpressionNextFunction_cron(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testScheduleExpressionNextFunctionConfig("cron(0 12 ? * MON-FRI *)", 2, "2024-06-07T13:00:00Z"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "2024-06-10T12:00:00Z,2024-06-11T12:00:00Z"),
+				),
+			},
+		},
+	})
+}
+
+func TestScheduleExpressionNextFunction_rate(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testScheduleExpressionNextFunctionConfig("rate(15 minutes)", 2, "2024-06-07T13:00:00Z"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "2024-06-07T13:15:00Z,2024-06-07T13:30:00Z"),
+				),
+			},
+		},
+	})
+}
+
+func TestScheduleExpressionNextFunction_neverFires(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testScheduleExpressionNextFunctionConfig("cron(0 0 30 2 ? *)", 1, "2024-06-07T13:00:00Z"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestScheduleExpressionNextFunction_invalid(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config:      testScheduleExpressionNextFunctionConfig("cron(0 12 * * MON *)", 1, "2024-06-07T13:00:00Z"),
+				ExpectError: regexache.MustCompile("invalid schedule expression"),
+			},
+		},
+	})
+}
+
+func testScheduleExpressionNextFunctionConfig(expression string, count int, start string) string {
+	return fmt.Sprintf(`
+output "test" {
+  value = join(",", provider::aws::schedule_expression_next(%[1]q, %[2]d, %[3]q))
+}
+`, expression, count, start)
+}
@@ -0,0 +1,937 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+)
+
+const (
+	modulePath          = "github.com/hashicorp/terraform-provider-aws"
+	servicePackagesPath = modulePath + "/internal/service/"
+	sdkServicesPath     = "github.com/aws/aws-sdk-go-v2/service/"
+	sdkV1ServicesPath   = "github.com/aws/aws-sdk-go/service/"
+)
+
+func main() {
+	filename := `data/api_lineage.json`
+	rootDir := ".."
+
+	flag.Parse()
+	args := flag.Args()
+	if len(args) > 0 {
+		filename = args[0]
+	}
+
+	g := common.NewGenerator()
+
+	g.Infof("Generating %s", filename)
+
+	clients, err := readAWSClientMethods(filepath.Join(rootDir, "internal", "conns", "awsclient_gen.go"))
+	if err != nil {
+		g.Fatalf("reading AWS client methods: %s", err)
+	}
+
+	a := &analyzer{
+		clients:  clients,
+		funcs:    make(map[string]*funcInfo),
+		types:    make(map[string]bool),
+		analyzed: make(map[string]*funcFacts),
+	}
+
+	serviceDir := filepath.Join(rootDir, "internal", "service")
+	entries, err := os.ReadDir(serviceDir)
+	if err != nil {
+		g.Fatalf("reading %s: %s", serviceDir, err)
+	}
+
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		if err := a.loadPackage(filepath.Join(serviceDir, entry.Name()), servicePackagesPath+entry.Name()); err != nil {
+			g.Fatalf("loading service package %s: %s", entry.Name(), err)
+		}
+	}
+
+	lineage := make(map[string]map[string]map[string][]string)
+
+	for _, resource := range a.resources {
+		phases := make(map[string]map[string][]string)
+
+		for _, phase := range []string{phaseCreate, phaseRead, phaseUpdate, phaseDelete} {
+			operations := make(map[string][]string)
+
+			for op := range a.reachableOperations(resource.roots[phase], "") {
+				operations[op.String()] = op.iamActions()
+			}
+			for op := range a.reachableOperations(resource.tagsRoots[phase], resource.tagsResourceType) {
+				operations[op.String()] = op.iamActions()
+			}
+
+			if len(operations) > 0 {
+				phases[phase] = operations
+			}
+		}
+
+		// Resources with no detected operations are kept with an empty entry so that consumers can
+		// distinguish them from resource types that are unknown to the provider.
+		lineage[resource.typeName] = phases
+	}
+
+	b, err := json.MarshalIndent(lineage, "", "  ")
+	if err != nil {
+		g.Fatalf("encoding API lineage: %s", err)
+	}
+
+	d := g.NewUnformattedFileDestination(filename)
+
+	if err := d.BufferBytes(append(b, '\n')); err != nil {
+		g.Fatalf("buffering API lineage: %s", err)
+	}
+
+	if err := d.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+}
+
+const (
+	phaseCreate = "create"
+	phaseRead   = "read"
+	phaseUpdate = "update"
+	phaseDelete = "delete"
+)
+
+// sdkResourceHandlers maps Plugin SDK schema.Resource fields to CRUD phases.
+var sdkResourceHandlers = map[string]string{
+	"Create":               phaseCreate,
+	"CreateContext":        phaseCreate,
+	"CreateWithoutTimeout": phaseCreate,
+	"Read":                 phaseRead,
+	"ReadContext":          phaseRead,
+	"ReadWithoutTimeout":   phaseRead,
+	"Update":               phaseUpdate,
+	"UpdateContext":        phaseUpdate,
+	"UpdateWithoutTimeout": phaseUpdate,
+	"Delete":               phaseDelete,
+	"DeleteContext":        phaseDelete,
+	"DeleteWithoutTimeout": phaseDelete,
+}
+
+// frameworkResourceHandlers maps Plugin Framework resource methods to CRUD phases.
+var frameworkResourceHandlers = map[string]string{
+	"Create": phaseCreate,
+	"Read":   phaseRead,
+	"Update": phaseUpdate,
+	"Delete": phaseDelete,
+}
+
+// iamServicePrefixes maps AWS SDK for Go v2 service package names to IAM service prefixes where they differ.
+var iamServicePrefixes = map[string]string{
+	"accessanalyzer":                  "access-analyzer",
+	"acmpca":                          "acm-pca",
+	"amp":                             "aps",
+	"apigatewayv2":                    "apigateway",
+	"appintegrations":                 "app-integrations",
+	"applicationautoscaling":          "application-autoscaling",
+	"autoscalingplans":                "autoscaling-plans",
+	"bcmdataexports":                  "bcm-data-exports",
+	"bedrockagent":                    "bedrock",
+	"chimesdkmediapipelines":          "chime",
+	"chimesdkvoice":                   "chime",
+	"cloudcontrol":                    "cloudformation",
+	"cloudfrontkeyvaluestore":         "cloudfront-keyvaluestore",
+	"cloudhsmv2":                      "cloudhsm",
+	"cloudwatchlogs":                  "logs",
+	"codeguruprofiler":                "codeguru-profiler",
+	"codegurureviewer":                "codeguru-reviewer",
+	"codestarconnections":             "codestar-connections",
+	"codestarnotifications":           "codestar-notifications",
+	"cognitoidentity":                 "cognito-identity",
+	"cognitoidentityprovider":         "cognito-idp",
+	"computeoptimizer":                "compute-optimizer",
+	"configservice":                   "config",
+	"costandusagereportservice":       "cur",
+	"costexplorer":                    "ce",
+	"costoptimizationhub":             "cost-optimization-hub",
+	"customerprofiles":                "profile",
+	"databasemigrationservice":        "dms",
+	"directoryservice":                "ds",
+	"docdb":                           "rds",
+	"docdbelastic":                    "docdb-elastic",
+	"ecrpublic":                       "ecr-public",
+	"efs":                             "elasticfilesystem",
+	"elasticloadbalancingv2":          "elasticloadbalancing",
+	"elasticsearchservice":            "es",
+	"emr":                             "elasticmapreduce",
+	"emrcontainers":                   "emr-containers",
+	"emrserverless":                   "emr-serverless",
+	"eventbridge":                     "events",
+	"keyspaces":                       "cassandra",
+	"kinesisanalyticsv2":              "kinesisanalytics",
+	"lexmodelbuildingservice":         "lex",
+	"lexmodelsv2":                     "lex",
+	"licensemanager":                  "license-manager",
+	"location":                        "geo",
+	"mwaa":                            "airflow",
+	"neptune":                         "rds",
+	"networkfirewall":                 "network-firewall",
+	"opensearch":                      "es",
+	"opensearchserverless":            "aoss",
+	"paymentcryptography":             "payment-cryptography",
+	"pinpoint":                        "mobiletargeting",
+	"pinpointsmsvoicev2":              "sms-voice",
+	"redshiftdata":                    "redshift-data",
+	"redshiftserverless":              "redshift-serverless",
+	"resourceexplorer2":               "resource-explorer-2",
+	"resourcegroups":                  "resource-groups",
+	"resourcegroupstaggingapi":        "tag",
+	"route53recoverycontrolconfig":    "route53-recovery-control-config",
+	"route53recoveryreadiness":        "route53-recovery-readiness",
+	"s3control":                       "s3",
+	"s3outposts":                      "s3-outposts",
+	"serverlessapplicationrepository": "serverlessrepo",
+	"servicecatalogappregistry":       "servicecatalog",
+	"sesv2":                           "ses",
+	"simpledb":                        "sdb",
+	"sfn":                             "states",
+	"ssmcontacts":                     "ssm-contacts",
+	"ssmincidents":                    "ssm-incidents",
+	"ssmquicksetup":                   "ssm-quicksetup",
+	"ssoadmin":                        "sso",
+	"timestreaminfluxdb":              "timestream-influxdb",
+	"timestreamquery":                 "timestream",
+	"timestreamwrite":                 "timestream",
+	"vpclattice":                      "vpc-lattice",
+	"wafregional":                     "waf-regional",
+}
+
+// iamActionOverrides maps API operations to IAM actions where the action name differs from the operation name.
+var iamActionOverrides = map[string][]string{
+	"lambda.Invoke":                                  {"lambda:InvokeFunction"},
+	"s3.CompleteMultipartUpload":                     {"s3:PutObject"},
+	"s3.CopyObject":                                  {"s3:GetObject", "s3:PutObject"},
+	"s3.CreateMultipartUpload":                       {"s3:PutObject"},
+	"s3.DeleteBucketAnalyticsConfiguration":          {"s3:PutAnalyticsConfiguration"},
+	"s3.DeleteBucketCors":                            {"s3:PutBucketCORS"},
+	"s3.DeleteBucketEncryption":                      {"s3:PutEncryptionConfiguration"},
+	"s3.DeleteBucketIntelligentTieringConfiguration": {"s3:PutIntelligentTieringConfiguration"},
+	"s3.DeleteBucketInventoryConfiguration":          {"s3:PutInventoryConfiguration"},
+	"s3.DeleteBucketLifecycle":                       {"s3:PutLifecycleConfiguration"},
+	"s3.DeleteBucketMetricsConfiguration":            {"s3:PutMetricsConfiguration"},
+	"s3.DeleteBucketOwnershipControls":               {"s3:PutBucketOwnershipControls"},
+	"s3.DeleteBucketReplication":                     {"s3:PutReplicationConfiguration"},
+	"s3.DeleteBucketTagging":                         {"s3:PutBucketTagging"},
+	"s3.DeleteObjects":                               {"s3:DeleteObject"},
+	"s3.DeletePublicAccessBlock":                     {"s3:PutBucketPublicAccessBlock"},
+	"s3.GetBucketAccelerateConfiguration":            {"s3:GetAccelerateConfiguration"},
+	"s3.GetBucketAnalyticsConfiguration":             {"s3:GetAnalyticsConfiguration"},
+	"s3.GetBucketCors":                               {"s3:GetBucketCORS"},
+	"s3.GetBucketEncryption":                         {"s3:GetEncryptionConfiguration"},
+	"s3.GetBucketIntelligentTieringConfiguration":    {"s3:GetIntelligentTieringConfiguration"},
+	"s3.GetBucketInventoryConfiguration":             {"s3:GetInventoryConfiguration"},
+	"s3.GetBucketLifecycleConfiguration":             {"s3:GetLifecycleConfiguration"},
+	"s3.GetBucketMetricsConfiguration":               {"s3:GetMetricsConfiguration"},
+	"s3.GetBucketNotificationConfiguration":          {"s3:GetBucketNotification"},
+	"s3.GetBucketReplication":                        {"s3:GetReplicationConfiguration"},
+	"s3.GetObjectAttributes":                         {"s3:GetObject", "s3:GetObjectAttributes"},
+	"s3.GetObjectLockConfiguration":                  {"s3:GetBucketObjectLockConfiguration"},
+	"s3.GetPublicAccessBlock":                        {"s3:GetBucketPublicAccessBlock"},
+	"s3.HeadBucket":                                  {"s3:ListBucket"},
+	"s3.HeadObject":                                  {"s3:GetObject"},
+	"s3.ListBuckets":                                 {"s3:ListAllMyBuckets"},
+	"s3.ListObjectVersions":                          {"s3:ListBucketVersions"},
+	"s3.ListObjects":                                 {"s3:ListBucket"},
+	"s3.ListObjectsV2":                               {"s3:ListBucket"},
+	"s3.PutBucketAccelerateConfiguration":            {"s3:PutAccelerateConfiguration"},
+	"s3.PutBucketAnalyticsConfiguration":             {"s3:PutAnalyticsConfiguration"},
+	"s3.PutBucketCors":                               {"s3:PutBucketCORS"},
+	"s3.PutBucketEncryption":                         {"s3:PutEncryptionConfiguration"},
+	"s3.PutBucketIntelligentTieringConfiguration":    {"s3:PutIntelligentTieringConfiguration"},
+	"s3.PutBucketInventoryConfiguration":             {"s3:PutInventoryConfiguration"},
+	"s3.PutBucketLifecycleConfiguration":             {"s3:PutLifecycleConfiguration"},
+	"s3.PutBucketMetricsConfiguration":               {"s3:PutMetricsConfiguration"},
+	"s3.PutBucketNotificationConfiguration":          {"s3:PutBucketNotification"},
+	"s3.PutBucketReplication":                        {"s3:PutReplicationConfiguration"},
+	"s3.PutObjectLockConfiguration":                  {"s3:PutBucketObjectLockConfiguration"},
+	"s3.PutPublicAccessBlock":                        {"s3:PutBucketPublicAccessBlock"},
+	"s3.UploadPart":                                  {"s3:PutObject"},
+	"s3control.DeletePublicAccessBlock":              {"s3:PutAccountPublicAccessBlock"},
+	"s3control.GetPublicAccessBlock":                 {"s3:GetAccountPublicAccessBlock"},
+	"s3control.PutPublicAccessBlock":                 {"s3:PutAccountPublicAccessBlock"},
+}
+
+// operation is an AWS API operation called via an AWS SDK for Go v2 service client.
+type operation struct {
+	sdkPackage string
+	name       string
+}
+
+func (op operation) String() string {
+	return op.sdkPackage + "." + op.name
+}
+
+func (op operation) iamActions() []string {
+	if v, ok := iamActionOverrides[op.String()]; ok {
+		return v
+	}
+
+	prefix := op.sdkPackage
+	if v, ok := iamServicePrefixes[prefix]; ok {
+		prefix = v
+	}
+
+	return []string{prefix + ":" + op.name}
+}
+
+// resourceDatum is a resource type and the functions implementing each of its CRUD phases.
+type resourceDatum struct {
+	typeName         string
+	roots            map[string][]string // Phase -> function keys.
+	tagsRoots        map[string][]string // Phase -> transparent tagging function keys.
+	tagsResourceType string              // The @Tags annotation's resourceType, if any.
+}
+
+// funcInfo is a function or method declaration together with the import aliases visible to it.
+type funcInfo struct {
+	decl       *ast.FuncDecl
+	importPath string
+	imports    map[string]string // Alias -> import path.
+}
+
+// funcFacts are the facts about a single function gathered from its body.
+type funcFacts struct {
+	operations []operation
+	callees    []string // Function keys.
+}
+
+type analyzer struct {
+	clients   map[string]string // AWSClient method -> SDK service package.
+	funcs     map[string]*funcInfo
+	types     map[string]bool // Type keys.
+	resources []resourceDatum
+	analyzed  map[string]*funcFacts // Function key and resource type -> facts.
+}
+
+var (
+	annotation = regexp.MustCompile(`^//\s*@([0-9A-Za-z]+)(\((.*)\))?\s*$`) // nosemgrep:ci.calling-regexp.MustCompile-directly
+)
+
+func funcKey(importPath, name string) string {
+	return importPath + "." + name
+}
+
+func methodKey(importPath, recv, name string) string {
+	return importPath + "." + recv + "." + name
+}
+
+// loadPackage parses the non-test Go source files in a single service package directory.
+func (a *analyzer) loadPackage(dir, importPath string) error {
+	fileSet := token.NewFileSet()
+	packageMap, err := parser.ParseDir(fileSet, dir, func(fi os.FileInfo) bool {
+		// Skip tests.
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, parser.ParseComments)
+
+	if err != nil {
+		return err
+	}
+
+	type factory struct {
+		decl             *ast.FuncDecl
+		typeName         string
+		isFramework      bool
+		tagged           bool
+		tagsResourceType string
+	}
+	var factories []factory
+
+	for _, pkg := range packageMap {
+		for _, file := range pkg.Files {
+			imports := make(map[string]string)
+			for _, spec := range file.Imports {
+				path, err := strconv.Unquote(spec.Path.Value)
+				if err != nil {
+					return err
+				}
+				alias := path[strings.LastIndex(path, "/")+1:]
+				if spec.Name != nil {
+					alias = spec.Name.Name
+				}
+				imports[alias] = path
+			}
+
+			for _, decl := range file.Decls {
+				switch decl := decl.(type) {
+				case *ast.FuncDecl:
+					info := &funcInfo{
+						decl:       decl,
+						importPath: importPath,
+						imports:    imports,
+					}
+
+					if decl.Recv == nil {
+						a.funcs[funcKey(importPath, decl.Name.Name)] = info
+					} else if recv := receiverTypeName(decl); recv != "" {
+						a.funcs[methodKey(importPath, recv, decl.Name.Name)] = info
+					}
+
+					if decl.Recv != nil || decl.Doc == nil {
+						continue
+					}
+
+					f := factory{
+						decl: decl,
+					}
+					for _, line := range decl.Doc.List {
+						m := annotation.FindStringSubmatch(line.Text)
+						if len(m) == 0 {
+							continue
+						}
+
+						switch m[1] {
+						case "FrameworkResource":
+							f.isFramework = true
+							fallthrough
+						case "SDKResource":
+							args := common.ParseArgs(m[3])
+							if len(args.Positional) > 0 {
+								f.typeName = args.Positional[0]
+							}
+						case "Tags":
+							f.tagged = true
+							f.tagsResourceType = common.ParseArgs(m[3]).Keyword["resourceType"]
+						}
+					}
+					if f.typeName != "" {
+						factories = append(factories, f)
+					}
+
+				case *ast.GenDecl:
+					for _, spec := range decl.Specs {
+						if spec, ok := spec.(*ast.TypeSpec); ok {
+							a.types[funcKey(importPath, spec.Name.Name)] = true
+						}
+					}
+				}
+			}
+		}
+	}
+
+	for _, f := range factories {
+		resource := resourceDatum{
+			typeName:         f.typeName,
+			roots:            make(map[string][]string),
+			tagsRoots:        make(map[string][]string),
+			tagsResourceType: f.tagsResourceType,
+		}
+
+		if f.isFramework {
+			// Find the resource implementation type instantiated by the factory.
+			var implType string
+			ast.Inspect(f.decl.Body, func(n ast.Node) bool {
+				if lit, ok := n.(*ast.CompositeLit); ok && implType == "" {
+					if ident, ok := lit.Type.(*ast.Ident); ok && a.types[funcKey(importPath, ident.Name)] {
+						implType = ident.Name
+					}
+				}
+				return implType == ""
+			})
+
+			if implType == "" {
+				continue
+			}
+
+			for method, phase := range frameworkResourceHandlers {
+				key := methodKey(importPath, implType, method)
+				if _, ok := a.funcs[key]; ok {
+					resource.roots[phase] = append(resource.roots[phase], key)
+				}
+			}
+		} else {
+			// Variables holding a value of a type declared in the package, e.g. a shared attribute handler,
+			// whose methods are used as CRUD handlers.
+			varTypes := make(map[string]string)
+			ast.Inspect(f.decl.Body, func(n ast.Node) bool {
+				if assign, ok := n.(*ast.AssignStmt); ok {
+					for i, rhs := range assign.Rhs {
+						if i >= len(assign.Lhs) {
+							break
+						}
+						if ident, ok := assign.Lhs[i].(*ast.Ident); ok {
+							if typeName := a.compositeLitType(rhs, importPath); typeName != "" {
+								varTypes[ident.Name] = typeName
+							}
+						}
+					}
+				}
+				return true
+			})
+
+			ast.Inspect(f.decl.Body, func(n ast.Node) bool {
+				kv, ok := n.(*ast.KeyValueExpr)
+				if !ok {
+					return true
+				}
+				key, ok := kv.Key.(*ast.Ident)
+				if !ok {
+					return true
+				}
+				if phase, ok := sdkResourceHandlers[key.Name]; ok {
+					ast.Inspect(kv.Value, func(n ast.Node) bool {
+						switch n := n.(type) {
+						case *ast.SelectorExpr:
+							// h.Upsert.
+							if ident, ok := n.X.(*ast.Ident); ok {
+								if typeName, ok := varTypes[ident.Name]; ok {
+									if key := methodKey(importPath, typeName, n.Sel.Name); a.funcs[key] != nil {
+										resource.roots[phase] = append(resource.roots[phase], key)
+									}
+								}
+							}
+							return false
+						case *ast.Ident:
+							if key := funcKey(importPath, n.Name); a.funcs[key] != nil {
+								resource.roots[phase] = append(resource.roots[phase], key)
+							}
+						}
+						return true
+					})
+				}
+				return true
+			})
+		}
+
+		// Transparent tagging lists tags on Read and updates tags on Create and Update
+		// via the service package's ListTags and UpdateTags methods.
+		if f.tagged {
+			if key := a.tagsFunc(importPath, "ListTags", "listTags"); key != "" {
+				resource.tagsRoots[phaseRead] = append(resource.tagsRoots[phaseRead], key)
+			}
+			if key := a.tagsFunc(importPath, "UpdateTags", "updateTags"); key != "" {
+				resource.tagsRoots[phaseCreate] = append(resource.tagsRoots[phaseCreate], key)
+				resource.tagsRoots[phaseUpdate] = append(resource.tagsRoots[phaseUpdate], key)
+			}
+		}
+
+		a.resources = append(a.resources, resource)
+	}
+
+	return nil
+}
+
+// tagsFunc returns the key of the service package's transparent tagging method, falling back to the package-level function.
+func (a *analyzer) tagsFunc(importPath, method, function string) string {
+	if key := methodKey(importPath, "servicePackage", method); a.funcs[key] != nil {
+		return key
+	}
+	if key := funcKey(importPath, function); a.funcs[key] != nil {
+		return key
+	}
+
+	return ""
+}
+
+// compositeLitType returns the name of the package-declared type if the expression is a (pointer to a) composite literal of that type.
+func (a *analyzer) compositeLitType(expr ast.Expr, importPath string) string {
+	if unary, ok := expr.(*ast.UnaryExpr); ok && unary.Op == token.AND {
+		expr = unary.X
+	}
+
+	lit, ok := expr.(*ast.CompositeLit)
+	if !ok {
+		return ""
+	}
+
+	if ident, ok := lit.Type.(*ast.Ident); ok && a.types[funcKey(importPath, ident.Name)] {
+		return ident.Name
+	}
+
+	return ""
+}
+
+// reachableOperations returns the set of API operations reachable from the specified functions.
+// If resourceType is set, switch statements on a resourceType variable only follow the matching case.
+func (a *analyzer) reachableOperations(roots []string, resourceType string) map[operation]struct{} {
+	operations := make(map[operation]struct{})
+	seen := make(map[string]bool)
+	queue := slices.Clone(roots)
+
+	for len(queue) > 0 {
+		key := queue[0]
+		queue = queue[1:]
+
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		facts := a.facts(key, resourceType)
+		if facts == nil {
+			continue
+		}
+
+		for _, op := range facts.operations {
+			operations[op] = struct{}{}
+		}
+		queue = append(queue, facts.callees...)
+	}
+
+	return operations
+}
+
+// facts returns the (cached) facts about the specified function.
+func (a *analyzer) facts(key, resourceType string) *funcFacts {
+	cacheKey := key + "#" + resourceType
+	if v, ok := a.analyzed[cacheKey]; ok {
+		return v
+	}
+
+	info := a.funcs[key]
+	if info == nil {
+		a.analyzed[cacheKey] = nil
+		return nil
+	}
+
+	facts := &funcFacts{}
+	a.analyzed[cacheKey] = facts
+
+	decl := info.decl
+	if decl.Body == nil {
+		return facts
+	}
+
+	// Variables holding an AWS SDK for Go v2 service client.
+	clientVars := make(map[string]string)
+
+	addParams := func(fields *ast.FieldList) {
+		if fields == nil {
+			return
+		}
+		for _, field := range fields.List {
+			if pkg := a.clientType(field.Type, info.imports); pkg != "" {
+				for _, name := range field.Names {
+					clientVars[name.Name] = pkg
+				}
+			}
+		}
+	}
+	addParams(decl.Type.Params)
+
+	var recvName, recvType string
+	if decl.Recv != nil && len(decl.Recv.List) > 0 && len(decl.Recv.List[0].Names) > 0 {
+		recvName = decl.Recv.List[0].Names[0].Name
+		recvType = receiverTypeName(decl)
+	}
+
+	ast.Inspect(decl.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			addParams(n.Type.Params)
+
+		case *ast.AssignStmt:
+			for i, rhs := range n.Rhs {
+				if i >= len(n.Lhs) {
+					break
+				}
+				if ident, ok := n.Lhs[i].(*ast.Ident); ok {
+					if pkg := a.clientCall(rhs); pkg != "" {
+						clientVars[ident.Name] = pkg
+					} else if pkg := a.clientFuncCall(rhs, info.importPath); pkg != "" {
+						clientVars[ident.Name] = pkg
+					}
+				}
+			}
+
+		case *ast.ValueSpec:
+			for i, value := range n.Values {
+				if i >= len(n.Names) {
+					break
+				}
+				if pkg := a.clientCall(value); pkg != "" {
+					clientVars[n.Names[i].Name] = pkg
+				}
+			}
+			if n.Type != nil {
+				if pkg := a.clientType(n.Type, info.imports); pkg != "" {
+					for _, name := range n.Names {
+						clientVars[name.Name] = pkg
+					}
+				}
+			}
+		}
+		return true
+	})
+
+	var visit func(ast.Node) bool
+	visit = func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.CallExpr:
+			sel, ok := n.Fun.(*ast.SelectorExpr)
+			if !ok {
+				return true
+			}
+
+			// conn.Operation(...) or meta.(*conns.AWSClient).XClient(ctx).Operation(...).
+			pkg := a.clientCall(sel.X)
+			if ident, ok := sel.X.(*ast.Ident); ok && pkg == "" {
+				pkg = clientVars[ident.Name]
+			}
+			if pkg != "" && sel.Sel.Name != "Options" {
+				facts.operations = append(facts.operations, operation{sdkPackage: pkg, name: operationName(sel.Sel.Name)})
+				return true
+			}
+
+			// sdk.NewOperationPaginator(conn, ...).
+			if ident, ok := sel.X.(*ast.Ident); ok {
+				if path, ok := info.imports[ident.Name]; ok && strings.HasPrefix(path, sdkServicesPath) {
+					name := sel.Sel.Name
+					if strings.HasPrefix(name, "New") && strings.HasSuffix(name, "Paginator") {
+						facts.operations = append(facts.operations, operation{sdkPackage: path[len(sdkServicesPath):], name: strings.TrimSuffix(strings.TrimPrefix(name, "New"), "Paginator")})
+					}
+				}
+			}
+
+		case *ast.SelectorExpr:
+			ident, ok := n.X.(*ast.Ident)
+			if !ok {
+				return true
+			}
+
+			// Calls to functions in other service packages.
+			if path, ok := info.imports[ident.Name]; ok {
+				if strings.HasPrefix(path, servicePackagesPath) {
+					if key := funcKey(path, n.Sel.Name); a.funcs[key] != nil {
+						facts.callees = append(facts.callees, key)
+					}
+				}
+				return false
+			}
+
+			// Calls to other methods on the receiver.
+			if recvName != "" && ident.Name == recvName {
+				if key := methodKey(info.importPath, recvType, n.Sel.Name); a.funcs[key] != nil {
+					facts.callees = append(facts.callees, key)
+				}
+				return false
+			}
+
+			// Don't treat the selector (field or method name) as a reference to a function.
+			ast.Inspect(n.X, visit)
+			return false
+
+		case *ast.SwitchStmt:
+			// switch resourceType { case "Role": ... } only follows the resource type's case.
+			ident, ok := n.Tag.(*ast.Ident)
+			if !ok || resourceType == "" || ident.Name != "resourceType" {
+				return true
+			}
+
+			if n.Init != nil {
+				ast.Inspect(n.Init, visit)
+			}
+			if clause := matchingCaseClause(n, resourceType); clause != nil {
+				for _, stmt := range clause.Body {
+					ast.Inspect(stmt, visit)
+				}
+			}
+			return false
+
+		case *ast.Ident:
+			// References (calls or function values) to functions in the same package.
+			if key := funcKey(info.importPath, n.Name); a.funcs[key] != nil {
+				facts.callees = append(facts.callees, key)
+			}
+		}
+
+		return true
+	}
+	ast.Inspect(decl.Body, visit)
+
+	return facts
+}
+
+// clientCall returns the SDK service package if the expression is a call to an AWSClient client accessor,
+// e.g. meta.(*conns.AWSClient).LogsClient(ctx) or r.Meta().LogsClient(ctx).
+func (a *analyzer) clientCall(expr ast.Expr) string {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok {
+		return ""
+	}
+
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return ""
+	}
+
+	return a.clients[sel.Sel.Name]
+}
+
+// clientFuncCall returns the SDK service package if the expression is a call to a function in the same package
+// that returns a service client, e.g. simpleDBConn(ctx, r.Meta()).
+func (a *analyzer) clientFuncCall(expr ast.Expr, importPath string) string {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok {
+		return ""
+	}
+
+	ident, ok := call.Fun.(*ast.Ident)
+	if !ok {
+		return ""
+	}
+
+	info := a.funcs[funcKey(importPath, ident.Name)]
+	if info == nil || info.decl.Type.Results == nil || len(info.decl.Type.Results.List) != 1 {
+		return ""
+	}
+
+	return a.clientType(info.decl.Type.Results.List[0].Type, info.imports)
+}
+
+// clientType returns the SDK service package if the expression is an AWS SDK for Go v2 service client type
+// or an AWS SDK for Go v1 service client type, e.g. *simpledb.SimpleDB.
+func (a *analyzer) clientType(expr ast.Expr, imports map[string]string) string {
+	star, ok := expr.(*ast.StarExpr)
+	if !ok {
+		return ""
+	}
+
+	sel, ok := star.X.(*ast.SelectorExpr)
+	if !ok {
+		return ""
+	}
+
+	ident, ok := sel.X.(*ast.Ident)
+	if !ok {
+		return ""
+	}
+
+	path, ok := imports[ident.Name]
+	if !ok {
+		return ""
+	}
+
+	switch {
+	case strings.HasPrefix(path, sdkServicesPath) && sel.Sel.Name == "Client":
+		return path[len(sdkServicesPath):]
+	case strings.HasPrefix(path, sdkV1ServicesPath) && strings.EqualFold(sel.Sel.Name, path[len(sdkV1ServicesPath):]):
+		return path[len(sdkV1ServicesPath):]
+	}
+
+	return ""
+}
+
+// operationName returns the API operation name for an AWS SDK for Go service client method,
+// e.g. CreateDomainWithContext or ListDomainsPagesWithContext (AWS SDK for Go v1) -> CreateDomain or ListDomains.
+func operationName(method string) string {
+	if v, ok := strings.CutSuffix(method, "WithContext"); ok {
+		return strings.TrimSuffix(v, "Pages")
+	}
+
+	return method
+}
+
+// matchingCaseClause returns the switch statement's case clause matching the specified string value,
+// falling back to the default clause.
+func matchingCaseClause(n *ast.SwitchStmt, value string) *ast.CaseClause {
+	var defaultClause *ast.CaseClause
+
+	for _, stmt := range n.Body.List {
+		clause, ok := stmt.(*ast.CaseClause)
+		if !ok {
+			continue
+		}
+
+		if clause.List == nil {
+			defaultClause = clause
+			continue
+		}
+
+		for _, expr := range clause.List {
+			if lit, ok := expr.(*ast.BasicLit); ok && lit.Kind == token.STRING {
+				if v, err := strconv.Unquote(lit.Value); err == nil && v == value {
+					return clause
+				}
+			}
+		}
+	}
+
+	return defaultClause
+}
+
+func receiverTypeName(decl *ast.FuncDecl) string {
+	if decl.Recv == nil || len(decl.Recv.List) == 0 {
+		return ""
+	}
+
+	expr := decl.Recv.List[0].Type
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	if index, ok := expr.(*ast.IndexExpr); ok {
+		expr = index.X
+	}
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name
+	}
+
+	return ""
+}
+
+// readAWSClientMethods returns a map of AWSClient service client accessor method names to AWS SDK for Go v2 service package.
+func readAWSClientMethods(filename string) (map[string]string, error) {
+	fileSet := token.NewFileSet()
+	file, err := parser.ParseFile(fileSet, filename, nil, 0)
+
+	if err != nil {
+		return nil, fmt.Errorf("parsing (%s): %w", filename, err)
+	}
+
+	imports := make(map[string]string)
+	for _, spec := range file.Imports {
+		p, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			return nil, err
+		}
+		alias := path.Base(p)
+		if spec.Name != nil {
+			alias = spec.Name.Name
+		}
+		imports[alias] = p
+	}
+
+	a := &analyzer{}
+	clients := make(map[string]string)
+
+	for _, decl := range file.Decls {
+		decl, ok := decl.(*ast.FuncDecl)
+		if !ok || decl.Recv == nil || decl.Type.Results == nil || len(decl.Type.Results.List) != 1 {
+			continue
+		}
+
+		if pkg := a.clientType(decl.Type.Results.List[0].Type, imports); pkg != "" {
+			clients[decl.Name.Name] = pkg
+		}
+	}
+
+	return clients, nil
+}
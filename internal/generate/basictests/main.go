@@ -0,0 +1,352 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common/tests"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+)
+
+func main() {
+	failed := false
+
+	g := common.NewGenerator()
+
+	serviceData, err := data.ReadAllServiceData()
+
+	if err != nil {
+		g.Fatalf("error reading service data: %s", err)
+	}
+
+	servicePackage := os.Getenv("GOPACKAGE")
+
+	g.Infof("Generating basic acceptance tests for internal/service/%s", servicePackage)
+
+	svc, found := tests.FindServiceRecords(serviceData, servicePackage)
+
+	if !found {
+		g.Fatalf("service package not found: %s", servicePackage)
+	}
+
+	// Look for Terraform Plugin Framework and SDK resource annotations.
+	// These annotations are implemented as comments on factory functions.
+	v := &visitor{
+		g: g,
+	}
+
+	if err := tests.ProcessDir(".", v.processFunc); err != nil {
+		v.errs = append(v.errs, err)
+	}
+
+	if err := errors.Join(v.errs...); err != nil {
+		g.Fatalf("%s", err.Error())
+	}
+
+	handWritten, err := handWrittenTests(".")
+	if err != nil {
+		g.Fatalf("%s", err.Error())
+	}
+
+	for _, resource := range v.resources {
+		sourceName := resource.FileName
+		ext := filepath.Ext(sourceName)
+		sourceName = strings.TrimSuffix(sourceName, ext)
+		sourceName = strings.TrimSuffix(sourceName, "_")
+
+		// Generated tests replace the hand-written tests in the resource type's test file.
+		testFile := sourceName + "_test.go"
+		testFuncs := handWritten[testFile]
+		if hasTest(testFuncs, "basic") {
+			g.Errorf("%s: hand-written basic test found in %q, remove it or the @Testing(basicTest=true) annotation", resource.TypeName, testFile)
+			failed = true
+			continue
+		}
+		if !resource.NoDisappears && hasTest(testFuncs, "disappears") {
+			g.Errorf("%s: hand-written disappears test found in %q, remove it or use @Testing(noDisappears=true)", resource.TypeName, testFile)
+			failed = true
+			continue
+		}
+
+		// The dedicated basic configuration is preferred.
+		// Fall back to the tagging configuration, which is rendered without tags.
+		var configTmplFile string
+		if resource.configFile != "" {
+			configTmplFile = path.Join("testdata", "tmpl", resource.configFile)
+		} else {
+			for _, suffix := range []string{"basic", "tags"} {
+				file := path.Join("testdata", "tmpl", fmt.Sprintf("%s_%s.gtpl", sourceName, suffix))
+				if _, err := os.Stat(file); err == nil {
+					configTmplFile = file
+					break
+				} else if !errors.Is(err, os.ErrNotExist) {
+					g.Fatalf("opening config template %q: %s", file, err)
+				}
+			}
+		}
+
+		if configTmplFile == "" {
+			g.Errorf("no basic test config template found for %s at %q", resource.TypeName, path.Join("testdata", "tmpl", sourceName+"_basic.gtpl"))
+			failed = true
+			continue
+		}
+
+		b, err := os.ReadFile(configTmplFile)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				g.Errorf("no basic test config template found for %s at %q", resource.TypeName, configTmplFile)
+				failed = true
+				continue
+			}
+			g.Fatalf("reading %q: %s", configTmplFile, err)
+		}
+		configTmpl := string(b)
+
+		if name, err := svc.ProviderNameUpper(resource.TypeName); err != nil {
+			g.Fatalf("determining provider service name: %s", err)
+		} else {
+			resource.ResourceProviderNameUpper = name
+		}
+		resource.PackageProviderNameUpper = svc.PackageProviderNameUpper()
+		resource.ProviderPackage = servicePackage
+
+		filename := fmt.Sprintf("%s_basic_gen_test.go", sourceName)
+
+		d := g.NewGoFileDestination(filename)
+		templates, err := template.New("basictests").Parse(resourceTestGoTmpl)
+		if err != nil {
+			g.Fatalf("parsing base Go test template: %s", err)
+		}
+
+		if err := d.BufferTemplateSet(templates, resource); err != nil {
+			g.Fatalf("error generating %q service package data: %s", servicePackage, err)
+		}
+
+		if err := d.Write(); err != nil {
+			g.Fatalf("generating file (%s): %s", filename, err)
+		}
+
+		tfTemplates, err := template.New("basictests").Parse(testTfTmpl)
+		if err != nil {
+			g.Fatalf("parsing base Terraform config template: %s", err)
+		}
+
+		_, err = tfTemplates.New("body").Parse(configTmpl)
+		if err != nil {
+			g.Fatalf("parsing config template %q: %s", configTmplFile, err)
+		}
+
+		configData := ConfigDatum{
+			AdditionalTfVars:        resource.AdditionalTfVarNames(),
+			WithRName:               (resource.Generator != ""),
+			AlternateRegionProvider: resource.AlternateRegionProvider,
+		}
+
+		generateTestConfig(g, path.Join("testdata", resource.Name, "basic"), tfTemplates, configData)
+	}
+
+	if failed {
+		os.Exit(1)
+	}
+}
+
+type ResourceDatum struct {
+	ProviderPackage           string
+	ResourceProviderNameUpper string
+	PackageProviderNameUpper  string
+	NoDisappears              bool
+	configFile                string
+	tests.CommonArgs
+}
+
+type ConfigDatum struct {
+	AdditionalTfVars        []string
+	WithRName               bool
+	AlternateRegionProvider bool
+}
+
+//go:embed resource_test.go.gtpl
+var resourceTestGoTmpl string
+
+//go:embed test.tf.gtpl
+var testTfTmpl string
+
+type visitor struct {
+	errs []error
+	g    *common.Generator
+
+	resources []ResourceDatum
+}
+
+// processFunc processes a single Go function.
+// The function's annotations are scanned for a Plugin Framework or SDK resource that opts in to generated basic tests.
+func (v *visitor) processFunc(fn tests.AnnotatedFunc) {
+	d := ResourceDatum{
+		CommonArgs: tests.NewCommonArgs(fn.FileName),
+	}
+	isResource := false
+	optIn := false
+
+	for _, annotation := range fn.Annotations {
+		args := annotation.Args
+
+		switch annotation.Name {
+		case "FrameworkResource":
+			isResource = true
+			d.Implementation = tests.ImplementationFramework
+			if err := tests.ParseResourceAnnotation(args, &d.CommonArgs); err != nil {
+				v.errs = append(v.errs, fmt.Errorf("%s: %w", fn, err))
+				continue
+			}
+
+		case "SDKResource":
+			isResource = true
+			d.Implementation = tests.ImplementationSDK
+			if err := tests.ParseResourceAnnotation(args, &d.CommonArgs); err != nil {
+				v.errs = append(v.errs, fmt.Errorf("%s: %w", fn, err))
+				continue
+			}
+
+		case "Testing":
+			if err := tests.ParseTestingAnnotations(args, &d.CommonArgs); err != nil {
+				v.errs = append(v.errs, fmt.Errorf("%s: %w", fn, err))
+				continue
+			}
+			if attr, ok := args.Keyword["basicTest"]; ok {
+				if b, err := strconv.ParseBool(attr); err != nil {
+					v.errs = append(v.errs, fmt.Errorf("invalid basicTest value: %q at %s. Should be boolean value.", attr, fn))
+					continue
+				} else {
+					optIn = b
+				}
+			}
+			if attr, ok := args.Keyword["basicTestConfig"]; ok {
+				d.configFile = attr
+			}
+			if attr, ok := args.Keyword["noDisappears"]; ok {
+				if b, err := strconv.ParseBool(attr); err != nil {
+					v.errs = append(v.errs, fmt.Errorf("invalid noDisappears value: %q at %s. Should be boolean value.", attr, fn))
+					continue
+				} else {
+					d.NoDisappears = b
+				}
+			}
+		}
+	}
+
+	if !isResource || !optIn {
+		return
+	}
+
+	if d.Name == "" {
+		v.errs = append(v.errs, fmt.Errorf("no name parameter set: %s", fn))
+		return
+	}
+
+	d.SetDefaults()
+
+	v.resources = append(v.resources, d)
+}
+
+// handWrittenTests returns the names of the test functions declared in each hand-written test file in a directory, keyed by file name.
+func handWrittenTests(path string) (map[string][]string, error) {
+	fileSet := token.NewFileSet()
+	packageMap, err := parser.ParseDir(fileSet, path, func(fi os.FileInfo) bool {
+		// Skip sources and generated tests.
+		return strings.HasSuffix(fi.Name(), "_test.go") && !strings.HasSuffix(fi.Name(), "_gen_test.go")
+	}, parser.SkipObjectResolution)
+
+	if err != nil {
+		return nil, fmt.Errorf("parsing (%s): %w", path, err)
+	}
+
+	tests := make(map[string][]string)
+	for _, pkg := range packageMap {
+		for name, file := range pkg.Files {
+			name := filepath.Base(name)
+
+			for _, decl := range file.Decls {
+				if funcDecl, ok := decl.(*ast.FuncDecl); ok && funcDecl.Recv == nil && isTestFunc(funcDecl) {
+					tests[name] = append(tests[name], funcDecl.Name.Name)
+				}
+			}
+		}
+	}
+
+	return tests, nil
+}
+
+// isTestFunc returns whether a function's only parameter is a *testing.T.
+func isTestFunc(funcDecl *ast.FuncDecl) bool {
+	params := funcDecl.Type.Params.List
+	if len(params) != 1 || len(params[0].Names) > 1 {
+		return false
+	}
+
+	star, ok := params[0].Type.(*ast.StarExpr)
+	if !ok {
+		return false
+	}
+
+	sel, ok := star.X.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+
+	pkg, ok := sel.X.(*ast.Ident)
+
+	return ok && pkg.Name == "testing" && sel.Sel.Name == "T"
+}
+
+// hasTest returns whether any of the functions is an acceptance test, or serialized acceptance test, for the specified case.
+// Variants of the case, e.g. `TestAccAPIGatewayV2API_basicHTTP` or `TestAccM2Application_basic_Content`, also match.
+func hasTest(funcNames []string, testCase string) bool {
+	return slices.ContainsFunc(funcNames, func(name string) bool {
+		if !strings.HasPrefix(name, "TestAcc") && !strings.HasPrefix(name, "testAcc") {
+			return false
+		}
+
+		_, variant, ok := strings.Cut(name, "_"+testCase)
+		if !ok {
+			return false
+		}
+
+		return variant == "" || strings.HasPrefix(variant, "_") || unicode.IsUpper(rune(variant[0]))
+	})
+}
+
+func generateTestConfig(g *common.Generator, dirPath string, tfTemplates *template.Template, configData ConfigDatum) {
+	if err := os.MkdirAll(dirPath, 0755); err != nil {
+		g.Fatalf("creating test directory %q: %s", dirPath, err)
+	}
+
+	mainPath := path.Join(dirPath, "main_gen.tf")
+	tf := g.NewUnformattedFileDestination(mainPath)
+
+	if err := tf.BufferTemplateSet(tfTemplates, configData); err != nil {
+		g.Fatalf("error generating Terraform file %q: %s", mainPath, err)
+	}
+
+	if err := tf.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", mainPath, err)
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package tests contains the annotation processing shared by the acceptance test generators.
+package tests
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+)
+
+var (
+	annotationRegexp = regexp.MustCompile(`^//\s*@([0-9A-Za-z]+)(\((.*)\))?\s*$`) // nosemgrep:ci.calling-regexp.MustCompile-directly
+)
+
+// Annotation is an annotation of the form `@Name(args)` in a function's doc comment.
+type Annotation struct {
+	Name string
+	Args common.Args
+}
+
+// AnnotatedFunc is a function (not a method) with a doc comment, typically a resource type's factory function.
+type AnnotatedFunc struct {
+	PackageName  string
+	FileName     string
+	FunctionName string
+	Annotations  []Annotation
+}
+
+func (f AnnotatedFunc) String() string {
+	return fmt.Sprintf("%s.%s", f.PackageName, f.FunctionName)
+}
+
+// ProcessDir scans a single service package directory's Go source files, skipping tests,
+// and calls f for each function with a doc comment.
+func ProcessDir(path string, f func(AnnotatedFunc)) error {
+	fileSet := token.NewFileSet()
+	packageMap, err := parser.ParseDir(fileSet, path, func(fi os.FileInfo) bool {
+		// Skip tests.
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, parser.ParseComments)
+
+	if err != nil {
+		return fmt.Errorf("parsing (%s): %w", path, err)
+	}
+
+	for packageName, pkg := range packageMap {
+		for fileName, file := range pkg.Files {
+			ast.Inspect(file, func(node ast.Node) bool {
+				// Look at functions (not methods) with comments.
+				if funcDecl, ok := node.(*ast.FuncDecl); ok && funcDecl.Recv == nil && funcDecl.Doc != nil {
+					f(AnnotatedFunc{
+						PackageName:  packageName,
+						FileName:     fileName,
+						FunctionName: funcDecl.Name.Name,
+						Annotations:  ParseAnnotations(funcDecl.Doc),
+					})
+				}
+
+				return true
+			})
+		}
+	}
+
+	return nil
+}
+
+// ParseAnnotations returns the annotations in a doc comment.
+func ParseAnnotations(doc *ast.CommentGroup) []Annotation {
+	var annotations []Annotation
+
+	for _, line := range doc.List {
+		if m := annotationRegexp.FindStringSubmatch(line.Text); len(m) > 0 {
+			annotations = append(annotations, Annotation{
+				Name: m[1],
+				Args: common.ParseArgs(m[3]),
+			})
+		}
+	}
+
+	return annotations
+}
@@ -0,0 +1,247 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tests
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	acctestgen "github.com/hashicorp/terraform-provider-aws/internal/acctest/generate"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	namesgen "github.com/hashicorp/terraform-provider-aws/names/generate"
+)
+
+type Implementation string
+
+const (
+	ImplementationFramework Implementation = "framework"
+	ImplementationSDK       Implementation = "sdk"
+)
+
+type GoImport struct {
+	Path  string
+	Alias string
+}
+
+type CodeBlock struct {
+	Code string
+}
+
+// CommonArgs are the resource type and `@Testing` annotation arguments shared by the acceptance test generators.
+type CommonArgs struct {
+	Name           string
+	TypeName       string
+	FileName       string
+	Implementation Implementation
+
+	AlternateRegionProvider bool
+	CheckDestroyNoop        bool
+	DestroyTakesT           bool
+	ExistsTypeName          string
+	ExistsTakesT            bool
+	Generator               string
+	ImportIgnore            []string
+	ImportStateID           string
+	ImportStateIDFunc       string
+	ImportStateIDSeparator  string
+	NoImport                bool
+	PreCheck                bool
+	Serialize               bool
+	SerializeDelay          bool
+	SerializeParallelTests  bool
+
+	GoImports      []GoImport
+	InitCodeBlocks []CodeBlock
+
+	additionalTfVars        map[string]string
+	generatorSeen           bool
+	importStateIDAttributes []string
+	tlsKey                  bool
+	tlsKeyCN                string
+}
+
+func NewCommonArgs(fileName string) CommonArgs {
+	return CommonArgs{
+		FileName:               fileName,
+		ImportStateIDSeparator: `","`,
+		additionalTfVars:       make(map[string]string),
+	}
+}
+
+func (c CommonArgs) AdditionalTfVars() map[string]string {
+	return tfmaps.ApplyToAllKeys(c.additionalTfVars, func(k string) string {
+		return acctestgen.ConstOrQuote(k)
+	})
+}
+
+// AdditionalTfVarNames returns the sorted names of the additional Terraform variables.
+func (c CommonArgs) AdditionalTfVarNames() []string {
+	names := tfmaps.Keys(c.additionalTfVars)
+	slices.Sort(names)
+
+	return names
+}
+
+func (c CommonArgs) HasImportStateIDAttribute() bool {
+	return len(c.importStateIDAttributes) == 1
+}
+
+func (c CommonArgs) ImportStateIDAttribute() string {
+	return namesgen.ConstOrQuote(c.importStateIDAttributes[0])
+}
+
+func (c CommonArgs) HasImportStateIDAttributes() bool {
+	return len(c.importStateIDAttributes) > 1
+}
+
+func (c CommonArgs) ImportStateIDAttributes() []string {
+	return tfslices.ApplyToAll(c.importStateIDAttributes, namesgen.ConstOrQuote)
+}
+
+// ParseResourceAnnotation parses the arguments of a `@FrameworkResource`, `@SDKResource`,
+// `@FrameworkDataSource` or `@SDKDataSource` annotation.
+func ParseResourceAnnotation(args common.Args, c *CommonArgs) error {
+	if len(args.Positional) == 0 {
+		return errors.New("no type name")
+	}
+	c.TypeName = args.Positional[0]
+
+	if attr, ok := args.Keyword["name"]; ok {
+		attr = strings.ReplaceAll(attr, " ", "")
+		c.Name = strings.ReplaceAll(attr, "-", "")
+	}
+
+	return nil
+}
+
+// ParseTestingAnnotations parses the arguments of a `@Testing` annotation that are shared by the acceptance test generators.
+// Generator-specific arguments are ignored.
+func ParseTestingAnnotations(args common.Args, c *CommonArgs) error {
+	var errs []error
+
+	parseBool := func(key string, v *bool) {
+		if attr, ok := args.Keyword[key]; ok {
+			if b, err := strconv.ParseBool(attr); err != nil {
+				errs = append(errs, fmt.Errorf("invalid %s value: %q. Should be boolean value.", key, attr))
+			} else {
+				*v = b
+			}
+		}
+	}
+
+	parseBool("altRegionProvider", &c.AlternateRegionProvider)
+	parseBool("checkDestroyNoop", &c.CheckDestroyNoop)
+	parseBool("destroyTakesT", &c.DestroyTakesT)
+	if attr, ok := args.Keyword["existsType"]; ok {
+		if typeName, importSpec, err := ParseIdentifierSpec(attr); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", attr, err))
+		} else {
+			c.ExistsTypeName = typeName
+			if importSpec != nil {
+				c.GoImports = append(c.GoImports, *importSpec)
+			}
+		}
+	}
+	parseBool("existsTakesT", &c.ExistsTakesT)
+	if attr, ok := args.Keyword["generator"]; ok {
+		if attr == "false" {
+			c.generatorSeen = true
+		} else if funcName, importSpec, err := ParseIdentifierSpec(attr); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", attr, err))
+		} else {
+			c.Generator = funcName
+			if importSpec != nil {
+				c.GoImports = append(c.GoImports, *importSpec)
+			}
+			c.generatorSeen = true
+		}
+	}
+	if attr, ok := args.Keyword["importIgnore"]; ok {
+		c.ImportIgnore = tfslices.ApplyToAll(strings.Split(attr, ";"), namesgen.ConstOrQuote)
+	}
+	if attr, ok := args.Keyword["importStateId"]; ok {
+		c.ImportStateID = attr
+	}
+	if attr, ok := args.Keyword["importStateIdAttribute"]; ok {
+		c.importStateIDAttributes = []string{attr}
+	}
+	if attr, ok := args.Keyword["importStateIdAttributes"]; ok {
+		c.importStateIDAttributes = strings.Split(attr, ";")
+	}
+	if attr, ok := args.Keyword["importStateIdAttributesSep"]; ok {
+		c.ImportStateIDSeparator = strconv.Quote(attr)
+	}
+	if attr, ok := args.Keyword["importStateIdFunc"]; ok {
+		c.ImportStateIDFunc = attr
+	}
+	if attr, ok := args.Keyword["name"]; ok {
+		c.Name = strings.ReplaceAll(attr, " ", "")
+	}
+	parseBool("noImport", &c.NoImport)
+	parseBool("preCheck", &c.PreCheck)
+	parseBool("serialize", &c.Serialize)
+	parseBool("serializeParallelTests", &c.SerializeParallelTests)
+	parseBool("serializeDelay", &c.SerializeDelay)
+	parseBool("tlsKey", &c.tlsKey)
+	if attr, ok := args.Keyword["tlsKeyDomain"]; ok {
+		c.tlsKeyCN = attr
+	}
+
+	return errors.Join(errs...)
+}
+
+// SetDefaults completes the arguments once all of a resource type's annotations have been parsed.
+func (c *CommonArgs) SetDefaults() {
+	if c.tlsKey {
+		tlsKeyCN := c.tlsKeyCN
+		if tlsKeyCN == "" {
+			tlsKeyCN = "acctest.RandomDomain().String()"
+		}
+		c.InitCodeBlocks = append(c.InitCodeBlocks, CodeBlock{
+			Code: fmt.Sprintf(`privateKeyPEM := acctest.TLSRSAPrivateKeyPEM(t, 2048)
+			certificatePEM := acctest.TLSRSAX509SelfSignedCertificatePEM(t, privateKeyPEM, %s)`, tlsKeyCN),
+		})
+		c.additionalTfVars["certificate_pem"] = "certificatePEM"
+		c.additionalTfVars["private_key_pem"] = "privateKeyPEM"
+	}
+
+	if !c.generatorSeen {
+		c.Generator = "sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)"
+		c.GoImports = append(c.GoImports,
+			GoImport{
+				Path:  "github.com/hashicorp/terraform-plugin-testing/helper/acctest",
+				Alias: "sdkacctest",
+			},
+		)
+	}
+}
+
+// ParseIdentifierSpec parses an identifier of the form `identifier`, `import path;identifier`
+// or `import path;alias;identifier`.
+func ParseIdentifierSpec(s string) (string, *GoImport, error) {
+	parts := strings.Split(s, ";")
+	switch len(parts) {
+	case 1:
+		return parts[0], nil, nil
+
+	case 2:
+		return parts[1], &GoImport{
+			Path: parts[0],
+		}, nil
+
+	case 3:
+		return parts[2], &GoImport{
+			Path:  parts[0],
+			Alias: parts[1],
+		}, nil
+
+	default:
+		return "", nil, fmt.Errorf("invalid generator value: %q", s)
+	}
+}
@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tests
+
+import (
+	"go/ast"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+)
+
+func TestParseAnnotations(t *testing.T) {
+	t.Parallel()
+
+	doc := &ast.CommentGroup{
+		List: []*ast.Comment{
+			{Text: `// newThingResource creates a thing.`},
+			{Text: `// @FrameworkResource("aws_example_thing", name="Thing")`},
+			{Text: `// @Tags`},
+			{Text: `// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/example/types;types.Thing", serialize=true)`},
+		},
+	}
+
+	got := ParseAnnotations(doc)
+	want := []Annotation{
+		{
+			Name: "FrameworkResource",
+			Args: common.Args{Positional: []string{"aws_example_thing"}, Keyword: map[string]string{"name": "Thing"}},
+		},
+		{
+			Name: "Tags",
+			Args: common.Args{Keyword: map[string]string{}},
+		},
+		{
+			Name: "Testing",
+			Args: common.Args{Keyword: map[string]string{"existsType": "github.com/aws/aws-sdk-go-v2/service/example/types;types.Thing", "serialize": "true"}},
+		},
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+}
+
+func TestParseTestingAnnotations(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input          string
+		wantErr        bool
+		wantGenerator  string
+		wantGoImports  []GoImport
+		wantExistsType string
+		wantAttributes []string
+		wantSerialize  bool
+	}{
+		"defaults": {
+			input:         ``,
+			wantGenerator: "sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)",
+			wantGoImports: []GoImport{{Path: "github.com/hashicorp/terraform-plugin-testing/helper/acctest", Alias: "sdkacctest"}},
+		},
+		"no generator": {
+			input: `generator=false`,
+		},
+		"existsType": {
+			input:          `existsType="github.com/aws/aws-sdk-go-v2/service/example/types;awstypes;awstypes.Thing", generator=false`,
+			wantExistsType: "awstypes.Thing",
+			wantGoImports:  []GoImport{{Path: "github.com/aws/aws-sdk-go-v2/service/example/types", Alias: "awstypes"}},
+		},
+		"importStateIdAttributes": {
+			input:          `importStateIdAttributes="name;version", generator=false, serialize=true`,
+			wantAttributes: []string{"names.AttrName", "names.AttrVersion"},
+			wantSerialize:  true,
+		},
+		"invalid bool": {
+			input:   `serialize=maybe`,
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			c := NewCommonArgs("thing.go")
+			err := ParseTestingAnnotations(common.ParseArgs(testCase.input), &c)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("ParseTestingAnnotations error = %v, want error %t", err, want)
+			}
+			if err != nil {
+				return
+			}
+
+			c.SetDefaults()
+
+			if got, want := c.Generator, testCase.wantGenerator; got != want {
+				t.Errorf("Generator = %q, want %q", got, want)
+			}
+			if diff := cmp.Diff(c.GoImports, testCase.wantGoImports); diff != "" {
+				t.Errorf("unexpected GoImports diff (+want, -got): %s", diff)
+			}
+			if got, want := c.ExistsTypeName, testCase.wantExistsType; got != want {
+				t.Errorf("ExistsTypeName = %q, want %q", got, want)
+			}
+			if got, want := c.HasImportStateIDAttributes(), len(testCase.wantAttributes) > 1; got != want {
+				t.Errorf("HasImportStateIDAttributes = %t, want %t", got, want)
+			} else if got {
+				if diff := cmp.Diff(c.ImportStateIDAttributes(), testCase.wantAttributes); diff != "" {
+					t.Errorf("unexpected ImportStateIDAttributes diff (+want, -got): %s", diff)
+				}
+			}
+			if got, want := c.Serialize, testCase.wantSerialize; got != want {
+				t.Errorf("Serialize = %t, want %t", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tests
+
+import (
+	"fmt"
+
+	"github.com/dlclark/regexp2"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+)
+
+// ServiceRecords are the service records implemented by a single service package.
+type ServiceRecords struct {
+	primary    data.ServiceRecord
+	additional []data.ServiceRecord
+}
+
+// FindServiceRecords returns the service records implemented by the specified service package.
+func FindServiceRecords(serviceData []data.ServiceRecord, servicePackage string) (ServiceRecords, bool) {
+	var (
+		svc   ServiceRecords
+		found bool
+	)
+
+	for _, l := range serviceData {
+		// See internal/generate/namesconsts/main.go.
+		if p := l.SplitPackageRealPackage(); p != "" {
+			if p != servicePackage {
+				continue
+			}
+
+			ep := l.ProviderPackage()
+			if p == ep {
+				svc.primary = l
+				found = true
+			} else {
+				svc.additional = append(svc.additional, l)
+			}
+		} else {
+			p := l.ProviderPackage()
+
+			if p != servicePackage {
+				continue
+			}
+
+			svc.primary = l
+			found = true
+		}
+	}
+
+	return svc, found
+}
+
+// ProviderNameUpper returns the provider name of the service implementing the specified resource type.
+func (sr ServiceRecords) ProviderNameUpper(resource string) (string, error) {
+	if len(sr.additional) == 0 {
+		return sr.primary.ProviderNameUpper(), nil
+	}
+
+	var (
+		service data.ServiceRecord
+		found   bool
+	)
+	for _, svc := range sr.additional {
+		re, err := regexp2.Compile(svc.ResourcePrefix(), 0)
+		if err != nil {
+			return "", err
+		}
+		if match, err := re.MatchString(resource); err != nil {
+			return "", err
+		} else if match {
+			service = svc
+			found = true
+		}
+	}
+
+	if !found {
+		re, err := regexp2.Compile(sr.primary.ResourcePrefix(), 0)
+		if err != nil {
+			return "", err
+		}
+		if match, err := re.MatchString(resource); err != nil {
+			return "", err
+		} else if match {
+			service = sr.primary
+			found = true
+		}
+	}
+
+	if found {
+		return service.ProviderNameUpper(), nil
+	}
+
+	return "", fmt.Errorf("No match found for resource type %q", resource)
+}
+
+// PackageProviderNameUpper returns the provider name of the service package's primary service.
+func (sr ServiceRecords) PackageProviderNameUpper() string {
+	return sr.primary.ProviderNameUpper()
+}
@@ -10,9 +10,6 @@ import (
 	_ "embed"
 	"errors"
 	"fmt"
-	"go/ast"
-	"go/parser"
-	"go/token"
 	"iter"
 	"os"
 	"path"
@@ -24,10 +21,8 @@ import (
 	"text/template"
 	"time"
 
-	"github.com/dlclark/regexp2"
-	acctestgen "github.com/hashicorp/terraform-provider-aws/internal/acctest/generate"
 	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
-	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common/tests"
 	"github.com/hashicorp/terraform-provider-aws/names/data"
 	namesgen "github.com/hashicorp/terraform-provider-aws/names/generate"
 )
@@ -47,36 +42,7 @@ func main() {
 
 	g.Infof("Generating tagging tests for internal/service/%s", servicePackage)
 
-	var (
-		svc   serviceRecords
-		found bool
-	)
-
-	for _, l := range serviceData {
-		// See internal/generate/namesconsts/main.go.
-		if p := l.SplitPackageRealPackage(); p != "" {
-			if p != servicePackage {
-				continue
-			}
-
-			ep := l.ProviderPackage()
-			if p == ep {
-				svc.primary = l
-				found = true
-			} else {
-				svc.additional = append(svc.additional, l)
-			}
-		} else {
-			p := l.ProviderPackage()
-
-			if p != servicePackage {
-				continue
-			}
-
-			svc.primary = l
-			found = true
-		}
-	}
+	svc, found := tests.FindServiceRecords(serviceData, servicePackage)
 
 	if !found {
 		g.Fatalf("service package not found: %s", servicePackage)
@@ -88,7 +54,9 @@ func main() {
 		g: g,
 	}
 
-	v.processDir(".")
+	if err := tests.ProcessDir(".", v.processFunc); err != nil {
+		v.errs = append(v.errs, err)
+	}
 
 	if err := errors.Join(v.errs...); err != nil {
 		g.Fatalf("%s", err.Error())
@@ -171,8 +139,6 @@ func main() {
 			}
 
 			if resource.GenerateConfig {
-				additionalTfVars := tfmaps.Keys(resource.additionalTfVars)
-				slices.Sort(additionalTfVars)
 				testDirPath := path.Join("testdata", resource.Name)
 
 				tfTemplates, err := template.New("taggingtests").Parse(testTfTmpl)
@@ -186,7 +152,7 @@ func main() {
 				}
 
 				common := commonConfig{
-					AdditionalTfVars:        additionalTfVars,
+					AdditionalTfVars:        resource.AdditionalTfVarNames(),
 					WithRName:               (resource.Generator != ""),
 					AlternateRegionProvider: resource.AlternateRegionProvider,
 				}
@@ -231,8 +197,6 @@ func main() {
 					g.Fatalf("opening data source config template %q: %w", dataSourceConfigTmplFile, err)
 				}
 
-				additionalTfVars := tfmaps.Keys(resource.additionalTfVars)
-				slices.Sort(additionalTfVars)
 				testDirPath := path.Join("testdata", resource.Name)
 
 				tfTemplates, err := template.New("taggingtests").Parse(testTfTmpl)
@@ -251,7 +215,7 @@ func main() {
 				}
 
 				common := commonConfig{
-					AdditionalTfVars:        additionalTfVars,
+					AdditionalTfVars:        resource.AdditionalTfVarNames(),
 					WithRName:               (resource.Generator != ""),
 					AlternateRegionProvider: resource.AlternateRegionProvider,
 				}
@@ -300,114 +264,21 @@ func main() {
 	}
 }
 
-type serviceRecords struct {
-	primary    data.ServiceRecord
-	additional []data.ServiceRecord
-}
-
-func (sr serviceRecords) ProviderNameUpper(resource string) (string, error) {
-	if len(sr.additional) == 0 {
-		return sr.primary.ProviderNameUpper(), nil
-	}
-
-	var (
-		service data.ServiceRecord
-		found   bool
-	)
-	for _, svc := range sr.additional {
-		re, err := regexp2.Compile(svc.ResourcePrefix(), 0)
-		if err != nil {
-			return "", err
-		}
-		if match, err := re.MatchString(resource); err != nil {
-			return "", err
-		} else if match {
-			service = svc
-			found = true
-		}
-	}
-
-	if !found {
-		re, err := regexp2.Compile(sr.primary.ResourcePrefix(), 0)
-		if err != nil {
-			return "", err
-		}
-		if match, err := re.MatchString(resource); err != nil {
-			return "", err
-		} else if match {
-			service = sr.primary
-			found = true
-		}
-	}
-
-	if found {
-		return service.ProviderNameUpper(), nil
-	}
-
-	return "", fmt.Errorf("No match found for resource type %q", resource)
-}
-
-func (sr serviceRecords) PackageProviderNameUpper() string {
-	return sr.primary.ProviderNameUpper()
-}
-
-type implementation string
-
-const (
-	implementationFramework implementation = "framework"
-	implementationSDK       implementation = "sdk"
-)
-
 type ResourceDatum struct {
 	ProviderPackage                  string
 	ResourceProviderNameUpper        string
 	PackageProviderNameUpper         string
-	Name                             string
-	TypeName                         string
-	DestroyTakesT                    bool
-	ExistsTypeName                   string
-	ExistsTakesT                     bool
-	FileName                         string
-	Generator                        string
-	NoImport                         bool
-	ImportStateID                    string
-	importStateIDAttribute           string
-	ImportStateIDFunc                string
-	ImportIgnore                     []string
-	Implementation                   implementation
-	Serialize                        bool
-	SerializeDelay                   bool
-	SerializeParallelTests           bool
-	PreCheck                         bool
 	SkipEmptyTags                    bool // TODO: Remove when we have a strategy for resources that have a minimum tag value length of 1
 	SkipNullTags                     bool
 	NoRemoveTags                     bool
-	GoImports                        []goImport
 	GenerateConfig                   bool
-	InitCodeBlocks                   []codeBlock
-	additionalTfVars                 map[string]string
-	AlternateRegionProvider          bool
 	TagsUpdateForceNew               bool
 	TagsUpdateGetTagsIn              bool // TODO: Works around a bug when getTagsIn() is used to pass tags directly to Update call
-	CheckDestroyNoop                 bool
 	IsDataSource                     bool
-	DataSourceResourceImplementation implementation
+	DataSourceResourceImplementation tests.Implementation
 	overrideIdentifierAttribute      string
 	OverrideResourceType             string
-}
-
-func (d ResourceDatum) AdditionalTfVars() map[string]string {
-	return tfmaps.ApplyToAllKeys(d.additionalTfVars, func(k string) string {
-		return acctestgen.ConstOrQuote(k)
-	})
-}
-
-func (d ResourceDatum) HasImportStateIDAttribute() bool {
-	return d.importStateIDAttribute != ""
-}
-
-func (d ResourceDatum) ImportStateIDAttribute() string {
-	return namesgen.ConstOrQuote(d.importStateIDAttribute)
+	tests.CommonArgs
 }
 
 func (d ResourceDatum) OverrideIdentifier() bool {
@@ -418,15 +289,6 @@ func (d ResourceDatum) OverrideIdentifierAttribute() string {
 	return namesgen.ConstOrQuote(d.overrideIdentifierAttribute)
 }
 
-type goImport struct {
-	Path  string
-	Alias string
-}
-
-type codeBlock struct {
-	Code string
-}
-
 type commonConfig struct {
 	AdditionalTfVars        []string
 	WithRName               bool
@@ -452,11 +314,6 @@ var testTfTmpl string
 //go:embed tags_check.go.gtpl
 var tagsCheckTmpl string
 
-// Annotation processing.
-var (
-	annotation = regexp.MustCompile(`^//\s*@([0-9A-Za-z]+)(\((.*)\))?\s*$`) // nosemgrep:ci.calling-regexp.MustCompile-directly
-)
-
 var (
 	sdkNameRegexp = regexp.MustCompile(`^(?i:Resource|DataSource)(\w+)$`) // nosemgrep:ci.calling-regexp.MustCompile-directly
 )
@@ -465,374 +322,145 @@ type visitor struct {
 	errs []error
 	g    *common.Generator
 
-	fileName     string
-	functionName string
-	packageName  string
-
 	taggedResources []ResourceDatum
 }
 
-// processDir scans a single service package directory and processes contained Go sources files.
-func (v *visitor) processDir(path string) {
-	fileSet := token.NewFileSet()
-	packageMap, err := parser.ParseDir(fileSet, path, func(fi os.FileInfo) bool {
-		// Skip tests.
-		return !strings.HasSuffix(fi.Name(), "_test.go")
-	}, parser.ParseComments)
-
-	if err != nil {
-		v.errs = append(v.errs, fmt.Errorf("parsing (%s): %w", path, err))
-
-		return
-	}
-
-	for name, pkg := range packageMap {
-		v.packageName = name
-
-		for name, file := range pkg.Files {
-			v.fileName = name
-
-			v.processFile(file)
-
-			v.fileName = ""
-		}
-
-		v.packageName = ""
-	}
-}
-
-// processFile processes a single Go source file.
-func (v *visitor) processFile(file *ast.File) {
-	ast.Walk(v, file)
-}
-
-// processFuncDecl processes a single Go function.
-// The function's comments are scanned for annotations indicating a Plugin Framework or SDK resource or data source.
-func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
-	v.functionName = funcDecl.Name.Name
-
+// processFunc processes a single Go function.
+// The function's annotations are scanned for a Plugin Framework or SDK resource or data source.
+func (v *visitor) processFunc(fn tests.AnnotatedFunc) {
 	// Look first for tagging annotations.
 	d := ResourceDatum{
-		FileName:         v.fileName,
-		additionalTfVars: make(map[string]string),
+		CommonArgs: tests.NewCommonArgs(fn.FileName),
 	}
 	tagged := false
 	skip := false
-	generatorSeen := false
-	tlsKey := false
-	var tlsKeyCN string
 	hasIdentifierAttribute := false
 
-	for _, line := range funcDecl.Doc.List {
-		line := line.Text
+	for _, annotation := range fn.Annotations {
+		args := annotation.Args
 
-		if m := annotation.FindStringSubmatch(line); len(m) > 0 {
-			switch annotationName := m[1]; annotationName {
-			case "FrameworkDataSource":
-				d.IsDataSource = true
-				fallthrough
+		switch annotation.Name {
+		case "FrameworkDataSource":
+			d.IsDataSource = true
+			fallthrough
 
-			case "FrameworkResource":
-				d.Implementation = implementationFramework
-				args := common.ParseArgs(m[3])
-				if len(args.Positional) == 0 {
-					v.errs = append(v.errs, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
-					continue
-				}
-				d.TypeName = args.Positional[0]
+		case "FrameworkResource":
+			d.Implementation = tests.ImplementationFramework
+			if err := tests.ParseResourceAnnotation(args, &d.CommonArgs); err != nil {
+				v.errs = append(v.errs, fmt.Errorf("%s: %w", fn, err))
+				continue
+			}
 
-				if attr, ok := args.Keyword["name"]; ok {
-					attr = strings.ReplaceAll(attr, " ", "")
-					d.Name = strings.ReplaceAll(attr, "-", "")
-				}
+		case "SDKDataSource":
+			d.IsDataSource = true
+			fallthrough
 
-			case "SDKDataSource":
-				d.IsDataSource = true
-				fallthrough
+		case "SDKResource":
+			d.Implementation = tests.ImplementationSDK
+			if err := tests.ParseResourceAnnotation(args, &d.CommonArgs); err != nil {
+				v.errs = append(v.errs, fmt.Errorf("%s: %w", fn, err))
+				continue
+			}
 
-			case "SDKResource":
-				d.Implementation = implementationSDK
-				args := common.ParseArgs(m[3])
-				if len(args.Positional) == 0 {
-					v.errs = append(v.errs, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+			if _, ok := args.Keyword["name"]; !ok && d.IsDataSource {
+				m := sdkNameRegexp.FindStringSubmatch(fn.FunctionName)
+				if m == nil {
+					v.errs = append(v.errs, fmt.Errorf("no name parameter set: %s", fn))
 					continue
 				}
-				d.TypeName = args.Positional[0]
-
-				if attr, ok := args.Keyword["name"]; ok {
-					attr = strings.ReplaceAll(attr, " ", "")
-					d.Name = strings.ReplaceAll(attr, "-", "")
-				} else if d.IsDataSource {
-					m := sdkNameRegexp.FindStringSubmatch(v.functionName)
-					if m == nil {
-						v.errs = append(v.errs, fmt.Errorf("no name parameter set: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
-						continue
-					}
-					d.Name = m[1]
-				}
-
-			case "Tags":
-				tagged = true
-				args := common.ParseArgs(m[3])
-				if _, ok := args.Keyword["identifierAttribute"]; ok {
-					hasIdentifierAttribute = true
-				}
-
-			case "Testing":
-				args := common.ParseArgs(m[3])
-				if attr, ok := args.Keyword["altRegionProvider"]; ok {
-					if b, err := strconv.ParseBool(attr); err != nil {
-						v.errs = append(v.errs, fmt.Errorf("invalid altRegionProvider value: %q at %s. Should be boolean value.", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
-						continue
-					} else {
-						d.AlternateRegionProvider = b
-					}
-				}
+				d.Name = m[1]
+			}
 
-				if attr, ok := args.Keyword["destroyTakesT"]; ok {
-					if b, err := strconv.ParseBool(attr); err != nil {
-						v.errs = append(v.errs, fmt.Errorf("invalid destroyTakesT value: %q at %s. Should be boolean value.", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
-						continue
-					} else {
-						d.DestroyTakesT = b
-					}
-				}
-				if attr, ok := args.Keyword["checkDestroyNoop"]; ok {
-					if b, err := strconv.ParseBool(attr); err != nil {
-						v.errs = append(v.errs, fmt.Errorf("invalid checkDestroyNoop value: %q at %s. Should be boolean value.", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
-						continue
-					} else {
-						d.CheckDestroyNoop = b
-						d.GoImports = append(d.GoImports,
-							goImport{
-								Path: "github.com/hashicorp/terraform-provider-aws/internal/acctest",
-							},
-						)
-					}
-				}
-				if attr, ok := args.Keyword["existsType"]; ok {
-					if typeName, importSpec, err := parseIdentifierSpec(attr); err != nil {
-						v.errs = append(v.errs, fmt.Errorf("%s: %w", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
-						continue
-					} else {
-						d.ExistsTypeName = typeName
-						if importSpec != nil {
-							d.GoImports = append(d.GoImports, *importSpec)
-						}
-					}
-				}
-				if attr, ok := args.Keyword["existsTakesT"]; ok {
-					if b, err := strconv.ParseBool(attr); err != nil {
-						v.errs = append(v.errs, fmt.Errorf("invalid existsTakesT value: %q at %s. Should be boolean value.", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
-						continue
-					} else {
-						d.ExistsTakesT = b
-					}
-				}
-				if attr, ok := args.Keyword["generator"]; ok {
-					if attr == "false" {
-						generatorSeen = true
-					} else if funcName, importSpec, err := parseIdentifierSpec(attr); err != nil {
-						v.errs = append(v.errs, fmt.Errorf("%s: %w", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
-						continue
-					} else {
-						d.Generator = funcName
-						if importSpec != nil {
-							d.GoImports = append(d.GoImports, *importSpec)
-						}
-						generatorSeen = true
-					}
-				}
-				if attr, ok := args.Keyword["importIgnore"]; ok {
-					d.ImportIgnore = strings.Split(attr, ";")
+		case "Tags":
+			tagged = true
+			if _, ok := args.Keyword["identifierAttribute"]; ok {
+				hasIdentifierAttribute = true
+			}
 
-					for i, val := range d.ImportIgnore {
-						d.ImportIgnore[i] = namesgen.ConstOrQuote(val)
-					}
-				}
-				if attr, ok := args.Keyword["importStateId"]; ok {
-					d.ImportStateID = attr
-				}
-				if attr, ok := args.Keyword["importStateIdAttribute"]; ok {
-					d.importStateIDAttribute = attr
-				}
-				if attr, ok := args.Keyword["importStateIdFunc"]; ok {
-					d.ImportStateIDFunc = attr
-				}
-				if attr, ok := args.Keyword["name"]; ok {
-					d.Name = strings.ReplaceAll(attr, " ", "")
-				}
-				if attr, ok := args.Keyword["noImport"]; ok {
-					if b, err := strconv.ParseBool(attr); err != nil {
-						v.errs = append(v.errs, fmt.Errorf("invalid noImport value: %q at %s. Should be boolean value.", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
-						continue
-					} else {
-						d.NoImport = b
-					}
-				}
-				if attr, ok := args.Keyword["preCheck"]; ok {
-					if b, err := strconv.ParseBool(attr); err != nil {
-						v.errs = append(v.errs, fmt.Errorf("invalid preCheck value: %q at %s. Should be boolean value.", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
-						continue
-					} else {
-						d.PreCheck = b
-					}
-				}
-				if attr, ok := args.Keyword["serialize"]; ok {
-					if b, err := strconv.ParseBool(attr); err != nil {
-						v.errs = append(v.errs, fmt.Errorf("invalid serialize value: %q at %s. Should be boolean value.", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
-						continue
-					} else {
-						d.Serialize = b
-					}
-				}
-				if attr, ok := args.Keyword["serializeParallelTests"]; ok {
-					if b, err := strconv.ParseBool(attr); err != nil {
-						v.errs = append(v.errs, fmt.Errorf("invalid serializeParallelTests value: %q at %s. Should be boolean value.", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
-						continue
-					} else {
-						d.SerializeParallelTests = b
-					}
-				}
-				if attr, ok := args.Keyword["serializeDelay"]; ok {
-					if b, err := strconv.ParseBool(attr); err != nil {
-						v.errs = append(v.errs, fmt.Errorf("invalid serializeDelay value: %q at %s. Should be duration value.", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
-						continue
-					} else {
-						d.SerializeDelay = b
-					}
-				}
-				if attr, ok := args.Keyword["tagsIdentifierAttribute"]; ok {
-					d.overrideIdentifierAttribute = attr
-				}
-				if attr, ok := args.Keyword["tagsResourceType"]; ok {
-					d.OverrideResourceType = attr
-				}
-				if attr, ok := args.Keyword["tagsTest"]; ok {
-					switch attr {
-					case "true":
-						// Add tagging tests for non-transparent tagging resources
-						tagged = true
-
-					case "false":
-						v.g.Infof("Skipping tags test for %s.%s", v.packageName, v.functionName)
-						skip = true
-
-					default:
-						v.errs = append(v.errs, fmt.Errorf("invalid tagsTest value: %q at %s.", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
-						continue
-					}
-				}
-				// TODO: should probably be a parameter on @Tags
-				if attr, ok := args.Keyword["tagsUpdateForceNew"]; ok {
-					if b, err := strconv.ParseBool(attr); err != nil {
-						v.errs = append(v.errs, fmt.Errorf("invalid tagsUpdateForceNew value: %q at %s. Should be boolean value.", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
-						continue
-					} else {
-						d.TagsUpdateForceNew = b
-					}
-				}
-				if attr, ok := args.Keyword["tagsUpdateGetTagsIn"]; ok {
-					if b, err := strconv.ParseBool(attr); err != nil {
-						v.errs = append(v.errs, fmt.Errorf("invalid tagsUpdateGetTagsIn value: %q at %s. Should be boolean value.", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
-						continue
-					} else {
-						d.TagsUpdateGetTagsIn = b
-					}
+		case "Testing":
+			if err := tests.ParseTestingAnnotations(args, &d.CommonArgs); err != nil {
+				v.errs = append(v.errs, fmt.Errorf("%s: %w", fn, err))
+				continue
+			}
+			if attr, ok := args.Keyword["tagsIdentifierAttribute"]; ok {
+				d.overrideIdentifierAttribute = attr
+			}
+			if attr, ok := args.Keyword["tagsResourceType"]; ok {
+				d.OverrideResourceType = attr
+			}
+			if attr, ok := args.Keyword["tagsTest"]; ok {
+				switch attr {
+				case "true":
+					// Add tagging tests for non-transparent tagging resources
+					tagged = true
+
+				case "false":
+					v.g.Infof("Skipping tags test for %s", fn)
+					skip = true
+
+				default:
+					v.errs = append(v.errs, fmt.Errorf("invalid tagsTest value: %q at %s.", attr, fn))
+					continue
 				}
-				if attr, ok := args.Keyword["skipEmptyTags"]; ok {
-					if b, err := strconv.ParseBool(attr); err != nil {
-						v.errs = append(v.errs, fmt.Errorf("invalid skipEmptyTags value: %q at %s. Should be boolean value.", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
-						continue
-					} else {
-						d.SkipEmptyTags = b
-					}
+			}
+			// TODO: should probably be a parameter on @Tags
+			if attr, ok := args.Keyword["tagsUpdateForceNew"]; ok {
+				if b, err := strconv.ParseBool(attr); err != nil {
+					v.errs = append(v.errs, fmt.Errorf("invalid tagsUpdateForceNew value: %q at %s. Should be boolean value.", attr, fn))
+					continue
+				} else {
+					d.TagsUpdateForceNew = b
 				}
-				if attr, ok := args.Keyword["skipNullTags"]; ok {
-					if b, err := strconv.ParseBool(attr); err != nil {
-						v.errs = append(v.errs, fmt.Errorf("invalid skipNullTags value: %q at %s. Should be boolean value.", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
-						continue
-					} else {
-						d.SkipNullTags = b
-					}
+			}
+			if attr, ok := args.Keyword["tagsUpdateGetTagsIn"]; ok {
+				if b, err := strconv.ParseBool(attr); err != nil {
+					v.errs = append(v.errs, fmt.Errorf("invalid tagsUpdateGetTagsIn value: %q at %s. Should be boolean value.", attr, fn))
+					continue
+				} else {
+					d.TagsUpdateGetTagsIn = b
 				}
-				if attr, ok := args.Keyword["noRemoveTags"]; ok {
-					if b, err := strconv.ParseBool(attr); err != nil {
-						v.errs = append(v.errs, fmt.Errorf("invalid noRemoveTags value: %q at %s. Should be boolean value.", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
-						continue
-					} else {
-						d.NoRemoveTags = b
-					}
+			}
+			if attr, ok := args.Keyword["skipEmptyTags"]; ok {
+				if b, err := strconv.ParseBool(attr); err != nil {
+					v.errs = append(v.errs, fmt.Errorf("invalid skipEmptyTags value: %q at %s. Should be boolean value.", attr, fn))
+					continue
+				} else {
+					d.SkipEmptyTags = b
 				}
-				if attr, ok := args.Keyword["tlsKey"]; ok {
-					if b, err := strconv.ParseBool(attr); err != nil {
-						v.errs = append(v.errs, fmt.Errorf("invalid skipEmptyTags value: %q at %s. Should be boolean value.", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
-						continue
-					} else {
-						tlsKey = b
-					}
+			}
+			if attr, ok := args.Keyword["skipNullTags"]; ok {
+				if b, err := strconv.ParseBool(attr); err != nil {
+					v.errs = append(v.errs, fmt.Errorf("invalid skipNullTags value: %q at %s. Should be boolean value.", attr, fn))
+					continue
+				} else {
+					d.SkipNullTags = b
 				}
-				if attr, ok := args.Keyword["tlsKeyDomain"]; ok {
-					tlsKeyCN = attr
+			}
+			if attr, ok := args.Keyword["noRemoveTags"]; ok {
+				if b, err := strconv.ParseBool(attr); err != nil {
+					v.errs = append(v.errs, fmt.Errorf("invalid noRemoveTags value: %q at %s. Should be boolean value.", attr, fn))
+					continue
+				} else {
+					d.NoRemoveTags = b
 				}
 			}
 		}
 	}
 
-	if tlsKey {
-		if len(tlsKeyCN) == 0 {
-			tlsKeyCN = "acctest.RandomDomain().String()"
-			d.GoImports = append(d.GoImports,
-				goImport{
-					Path: "github.com/hashicorp/terraform-provider-aws/internal/acctest",
-				},
-			)
-		}
-		d.InitCodeBlocks = append(d.InitCodeBlocks, codeBlock{
-			Code: fmt.Sprintf(`privateKeyPEM := acctest.TLSRSAPrivateKeyPEM(t, 2048)
-			certificatePEM := acctest.TLSRSAX509SelfSignedCertificatePEM(t, privateKeyPEM, %s)`, tlsKeyCN),
-		})
-		d.additionalTfVars["certificate_pem"] = "certificatePEM"
-		d.additionalTfVars["private_key_pem"] = "privateKeyPEM"
-	}
-
 	if tagged {
 		if !skip {
 			if d.Name == "" {
-				v.errs = append(v.errs, fmt.Errorf("no name parameter set: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				v.errs = append(v.errs, fmt.Errorf("no name parameter set: %s", fn))
 				return
 			}
 			if !hasIdentifierAttribute && len(d.overrideIdentifierAttribute) == 0 {
-				v.errs = append(v.errs, fmt.Errorf("@Tags specification for %s does not use identifierAttribute. Missing @Testing(tagsIdentifierAttribute) and possibly tagsResourceType", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+				v.errs = append(v.errs, fmt.Errorf("@Tags specification for %s does not use identifierAttribute. Missing @Testing(tagsIdentifierAttribute) and possibly tagsResourceType", fn))
 				return
 			}
-			if !generatorSeen {
-				d.Generator = "sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)"
-				d.GoImports = append(d.GoImports,
-					goImport{
-						Path:  "github.com/hashicorp/terraform-plugin-testing/helper/acctest",
-						Alias: "sdkacctest",
-					},
-					goImport{
-						Path: "github.com/hashicorp/terraform-provider-aws/internal/acctest",
-					},
-				)
-			}
+			d.SetDefaults()
 			v.taggedResources = append(v.taggedResources, d)
 		}
 	}
-
-	v.functionName = ""
-}
-
-// Visit is called for each node visited by ast.Walk.
-func (v *visitor) Visit(node ast.Node) ast.Visitor {
-	// Look at functions (not methods) with comments.
-	if funcDecl, ok := node.(*ast.FuncDecl); ok && funcDecl.Recv == nil && funcDecl.Doc != nil {
-		v.processFuncDecl(funcDecl)
-	}
-
-	return v
 }
 
 func generateTestConfig(g *common.Generator, dirPath, test string, withDefaults bool, tfTemplates *template.Template, common commonConfig) {
@@ -863,28 +491,6 @@ func generateTestConfig(g *common.Generator, dirPath, test string, withDefaults
 	}
 }
 
-func parseIdentifierSpec(s string) (string, *goImport, error) {
-	parts := strings.Split(s, ";")
-	switch len(parts) {
-	case 1:
-		return parts[0], nil, nil
-
-	case 2:
-		return parts[1], &goImport{
-			Path: parts[0],
-		}, nil
-
-	case 3:
-		return parts[2], &goImport{
-			Path:  parts[0],
-			Alias: parts[1],
-		}, nil
-
-	default:
-		return "", nil, fmt.Errorf("invalid generator value: %q", s)
-	}
-}
-
 func generateDurationStatement(d time.Duration) string {
 	var buf strings.Builder
 
@@ -0,0 +1,367 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+)
+
+func main() {
+	g := common.NewGenerator()
+
+	serviceData, err := data.ReadAllServiceData()
+
+	if err != nil {
+		g.Fatalf("error reading service data: %s", err)
+	}
+
+	servicePackage := os.Getenv("GOPACKAGE")
+
+	g.Infof("Generating write-only attribute acceptance tests for internal/service/%s", servicePackage)
+
+	var (
+		svc   data.ServiceRecord
+		found bool
+	)
+
+	for _, l := range serviceData {
+		if l.ProviderPackage() == servicePackage {
+			svc = l
+			found = true
+			break
+		}
+	}
+
+	if !found {
+		g.Fatalf("service package not found: %s", servicePackage)
+	}
+
+	// Look for Terraform Plugin Framework and SDK resource annotations.
+	// These annotations are implemented as comments on factory functions.
+	v := &visitor{
+		g: g,
+	}
+
+	v.processDir(".")
+
+	if err := errors.Join(v.errs...); err != nil {
+		g.Fatalf("%s", err.Error())
+	}
+
+	for _, resource := range v.resources {
+		sourceName := resource.FileName
+		ext := filepath.Ext(sourceName)
+		sourceName = strings.TrimSuffix(sourceName, ext)
+		sourceName = strings.TrimSuffix(sourceName, "_")
+
+		configTmplFile := path.Join("testdata", "tmpl", fmt.Sprintf("%s_write_only.gtpl", sourceName))
+		b, err := os.ReadFile(configTmplFile)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				g.Fatalf("no write-only test config template found for %s at %q", resource.TypeName, configTmplFile)
+			}
+			g.Fatalf("reading %q: %s", configTmplFile, err)
+		}
+		configTmpl := string(b)
+
+		resource.ProviderNameUpper = svc.ProviderNameUpper()
+		resource.ProviderPackage = servicePackage
+
+		filename := fmt.Sprintf("%s_write_only_gen_test.go", sourceName)
+
+		d := g.NewGoFileDestination(filename)
+		templates, err := template.New("writeonlytests").Parse(resourceTestGoTmpl)
+		if err != nil {
+			g.Fatalf("parsing base Go test template: %s", err)
+		}
+
+		if err := d.BufferTemplateSet(templates, resource); err != nil {
+			g.Fatalf("error generating %q service package data: %s", servicePackage, err)
+		}
+
+		if err := d.Write(); err != nil {
+			g.Fatalf("generating file (%s): %s", filename, err)
+		}
+
+		tfTemplates, err := template.New("writeonlytests").Parse(testTfTmpl)
+		if err != nil {
+			g.Fatalf("parsing base Terraform config template: %s", err)
+		}
+
+		_, err = tfTemplates.New("body").Parse(configTmpl)
+		if err != nil {
+			g.Fatalf("parsing config template %q: %s", configTmplFile, err)
+		}
+
+		generateTestConfig(g, path.Join("testdata", resource.Name, "write_only"), tfTemplates, resource)
+	}
+}
+
+type ResourceDatum struct {
+	ProviderPackage           string
+	ProviderNameUpper         string
+	Name                      string
+	TypeName                  string
+	FileName                  string
+	DestroyTakesT             bool
+	ExistsTypeName            string
+	ExistsTakesT              bool
+	Generator                 string
+	PreCheck                  bool
+	WriteOnlyAttribute        string
+	WriteOnlyVersionAttribute string
+	WriteOnlyReplace          bool
+	GoImports                 []goImport
+}
+
+type goImport struct {
+	Path  string
+	Alias string
+}
+
+//go:embed resource_test.go.gtpl
+var resourceTestGoTmpl string
+
+//go:embed test.tf.gtpl
+var testTfTmpl string
+
+// Annotation processing.
+var (
+	annotation = regexp.MustCompile(`^//\s*@([0-9A-Za-z]+)(\((.*)\))?\s*$`) // nosemgrep:ci.calling-regexp.MustCompile-directly
+)
+
+type visitor struct {
+	errs []error
+	g    *common.Generator
+
+	fileName     string
+	functionName string
+	packageName  string
+
+	resources []ResourceDatum
+}
+
+// processDir scans a single service package directory and processes contained Go sources files.
+func (v *visitor) processDir(path string) {
+	fileSet := token.NewFileSet()
+	packageMap, err := parser.ParseDir(fileSet, path, func(fi os.FileInfo) bool {
+		// Skip tests.
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, parser.ParseComments)
+
+	if err != nil {
+		v.errs = append(v.errs, fmt.Errorf("parsing (%s): %w", path, err))
+
+		return
+	}
+
+	for name, pkg := range packageMap {
+		v.packageName = name
+
+		for name, file := range pkg.Files {
+			v.fileName = name
+
+			v.processFile(file)
+
+			v.fileName = ""
+		}
+
+		v.packageName = ""
+	}
+}
+
+// processFile processes a single Go source file.
+func (v *visitor) processFile(file *ast.File) {
+	ast.Walk(v, file)
+}
+
+// processFuncDecl processes a single Go function.
+// The function's comments are scanned for annotations indicating a Plugin Framework or SDK resource
+// with a write-only attribute to be tested.
+func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
+	v.functionName = funcDecl.Name.Name
+
+	d := ResourceDatum{
+		FileName: v.fileName,
+	}
+	isResource := false
+
+	for _, line := range funcDecl.Doc.List {
+		line := line.Text
+
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 {
+			switch annotationName := m[1]; annotationName {
+			case "FrameworkResource", "SDKResource":
+				isResource = true
+				args := common.ParseArgs(m[3])
+				if len(args.Positional) == 0 {
+					v.errs = append(v.errs, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+				d.TypeName = args.Positional[0]
+
+				if attr, ok := args.Keyword["name"]; ok {
+					attr = strings.ReplaceAll(attr, " ", "")
+					d.Name = strings.ReplaceAll(attr, "-", "")
+				}
+
+			case "Testing":
+				args := common.ParseArgs(m[3])
+				if attr, ok := args.Keyword["destroyTakesT"]; ok {
+					if b, err := strconv.ParseBool(attr); err != nil {
+						v.errs = append(v.errs, fmt.Errorf("invalid destroyTakesT value: %q at %s. Should be boolean value.", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+						continue
+					} else {
+						d.DestroyTakesT = b
+					}
+				}
+				if attr, ok := args.Keyword["existsType"]; ok {
+					if typeName, importSpec, err := parseIdentifierSpec(attr); err != nil {
+						v.errs = append(v.errs, fmt.Errorf("%s: %s: %w", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
+						continue
+					} else {
+						d.ExistsTypeName = typeName
+						if importSpec != nil {
+							d.GoImports = append(d.GoImports, *importSpec)
+						}
+					}
+				}
+				if attr, ok := args.Keyword["existsTakesT"]; ok {
+					if b, err := strconv.ParseBool(attr); err != nil {
+						v.errs = append(v.errs, fmt.Errorf("invalid existsTakesT value: %q at %s. Should be boolean value.", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+						continue
+					} else {
+						d.ExistsTakesT = b
+					}
+				}
+				// The write-only test configuration always takes a name, so "generator=false" is ignored.
+				if attr, ok := args.Keyword["generator"]; ok && attr != "false" {
+					if funcName, importSpec, err := parseIdentifierSpec(attr); err != nil {
+						v.errs = append(v.errs, fmt.Errorf("%s: %s: %w", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
+						continue
+					} else {
+						d.Generator = funcName
+						if importSpec != nil {
+							d.GoImports = append(d.GoImports, *importSpec)
+						}
+					}
+				}
+				if attr, ok := args.Keyword["name"]; ok {
+					d.Name = strings.ReplaceAll(attr, " ", "")
+				}
+				if attr, ok := args.Keyword["preCheck"]; ok {
+					if b, err := strconv.ParseBool(attr); err != nil {
+						v.errs = append(v.errs, fmt.Errorf("invalid preCheck value: %q at %s. Should be boolean value.", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+						continue
+					} else {
+						d.PreCheck = b
+					}
+				}
+				if attr, ok := args.Keyword["writeOnlyAttribute"]; ok {
+					d.WriteOnlyAttribute = attr
+				}
+				if attr, ok := args.Keyword["writeOnlyVersionAttribute"]; ok {
+					d.WriteOnlyVersionAttribute = attr
+				}
+				if attr, ok := args.Keyword["writeOnlyReplace"]; ok {
+					if b, err := strconv.ParseBool(attr); err != nil {
+						v.errs = append(v.errs, fmt.Errorf("invalid writeOnlyReplace value: %q at %s. Should be boolean value.", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+						continue
+					} else {
+						d.WriteOnlyReplace = b
+					}
+				}
+			}
+		}
+	}
+
+	if !isResource || d.WriteOnlyAttribute == "" {
+		v.functionName = ""
+		return
+	}
+
+	if d.Name == "" {
+		v.errs = append(v.errs, fmt.Errorf("no name parameter set: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+		return
+	}
+
+	if d.Generator == "" {
+		d.Generator = "sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)"
+	}
+
+	if d.WriteOnlyVersionAttribute == "" {
+		d.WriteOnlyVersionAttribute = d.WriteOnlyAttribute + "_version"
+	}
+
+	v.resources = append(v.resources, d)
+
+	v.functionName = ""
+}
+
+// Visit is called for each node visited by ast.Walk.
+func (v *visitor) Visit(node ast.Node) ast.Visitor {
+	// Look at functions (not methods) with comments.
+	if funcDecl, ok := node.(*ast.FuncDecl); ok && funcDecl.Recv == nil && funcDecl.Doc != nil {
+		v.processFuncDecl(funcDecl)
+	}
+
+	return v
+}
+
+func generateTestConfig(g *common.Generator, dirPath string, tfTemplates *template.Template, configData ResourceDatum) {
+	if err := os.MkdirAll(dirPath, 0755); err != nil {
+		g.Fatalf("creating test directory %q: %s", dirPath, err)
+	}
+
+	mainPath := path.Join(dirPath, "main_gen.tf")
+	tf := g.NewUnformattedFileDestination(mainPath)
+
+	if err := tf.BufferTemplateSet(tfTemplates, configData); err != nil {
+		g.Fatalf("error generating Terraform file %q: %s", mainPath, err)
+	}
+
+	if err := tf.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", mainPath, err)
+	}
+}
+
+func parseIdentifierSpec(s string) (string, *goImport, error) {
+	parts := strings.Split(s, ";")
+	switch len(parts) {
+	case 1:
+		return parts[0], nil, nil
+
+	case 2:
+		return parts[1], &goImport{
+			Path: parts[0],
+		}, nil
+
+	case 3:
+		return parts[2], &goImport{
+			Path:  parts[0],
+			Alias: parts[1],
+		}, nil
+
+	default:
+		return "", nil, fmt.Errorf("invalid generator value: %q", s)
+	}
+}
@@ -122,6 +122,10 @@ func (*fwprovider) Schema(ctx context.Context, request provider.SchemaRequest, r
 				Optional:    true,
 				Description: "The secret key for API operations. You can retrieve this\nfrom the 'Security & Credentials' section of the AWS console.",
 			},
+			"sfn_definition_warnings_as_errors": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether to treat warnings from the static analysis of Step Functions state machine definitions as errors, e.g. a Map state without MaxConcurrency or a Task state without Catch.",
+			},
 			"shared_config_files": schema.ListAttribute{
 				ElementType: types.StringType,
 				Optional:    true,
@@ -542,6 +546,17 @@ func (p *fwprovider) Functions(_ context.Context) []func() function.Function {
 	return []func() function.Function{
 		tffunction.NewARNBuildFunction,
 		tffunction.NewARNParseFunction,
+		tffunction.NewDurationParseFunction,
+		tffunction.NewDurationToSecondsFunction,
+		tffunction.NewEKSKubeconfigFunction,
+		tffunction.NewEnvelopeDecryptFunction,
+		tffunction.NewEnvelopeEncryptFunction,
+		tffunction.NewEventPatternMatchesFunction,
+		tffunction.NewLogFilterMatchesFunction,
+		tffunction.NewMaintenanceWindowOverlapsFunction,
+		tffunction.NewMaintenanceWindowShiftFunction,
+		tffunction.NewRequiredIAMPolicyFunction,
+		tffunction.NewScheduleExpressionNextFunction,
 		tffunction.NewTrimIAMRolePathFunction,
 	}
 }
@@ -191,6 +191,12 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The secret key for API operations. You can retrieve this\n" +
 					"from the 'Security & Credentials' section of the AWS console.",
 			},
+			"sfn_definition_warnings_as_errors": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Description: "Whether to treat warnings from the static analysis of Step Functions state machine definitions as errors, " +
+					"e.g. a Map state without MaxConcurrency or a Task state without Catch.",
+			},
 			"shared_config_files": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -491,6 +497,7 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		Region:                         d.Get("region").(string),
 		S3UsePathStyle:                 d.Get("s3_use_path_style").(bool),
 		SecretKey:                      d.Get("secret_key").(string),
+		SFNDefinitionWarningsAsErrors:  d.Get("sfn_definition_warnings_as_errors").(bool),
 		SkipCredsValidation:            d.Get("skip_credentials_validation").(bool),
 		SkipRegionValidation:           d.Get("skip_region_validation").(bool),
 		SkipRequestingAccountId:        d.Get("skip_requesting_account_id").(bool),
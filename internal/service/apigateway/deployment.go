@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strconv"
 	"strings"
 	"time"
 
@@ -18,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
@@ -38,6 +40,8 @@ func resourceDeployment() *schema.Resource {
 			StateContext: resourceDeploymentImport,
 		},
 
+		CustomizeDiff: resourceDeploymentCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			names.AttrCreatedDate: {
 				Type:     schema.TypeString,
@@ -70,7 +74,12 @@ func resourceDeployment() *schema.Resource {
 						},
 					},
 				},
-				Deprecated: `The attribute "canary_settings" will be removed in a future major version. Use an explicit "aws_api_gateway_stage" instead.`,
+				Deprecated:    `The attribute "canary_settings" will be removed in a future major version. Use an explicit "aws_api_gateway_stage" instead.`,
+				ConflictsWith: []string{"stage_canary"},
+			},
+			"configuration_fingerprint": {
+				Type:     schema.TypeString,
+				Computed: true,
 			},
 			"execution_arn": {
 				Type:     schema.TypeString,
@@ -80,11 +89,55 @@ func resourceDeployment() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"redeploy_on_change": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"rest_api_body": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"rest_api_id": {
 				Type:     schema.TypeString,
 				Required: true,
 				ForceNew: true,
 			},
+			"stage_canary": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"canary_settings", "stage_name"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"percent_traffic": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							Default:      0.0,
+							ValidateFunc: validation.FloatBetween(0.0, 100.0),
+						},
+						"promote": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+						"stage_name": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+						"stage_variable_overrides": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"use_stage_cache": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+					},
+				},
+			},
 			"stage_description": {
 				Type:       schema.TypeString,
 				Optional:   true,
@@ -92,10 +145,11 @@ func resourceDeployment() *schema.Resource {
 				Deprecated: `The attribute "stage_description" will be removed in a future major version. Use an explicit "aws_api_gateway_stage" instead.`,
 			},
 			"stage_name": {
-				Type:       schema.TypeString,
-				Optional:   true,
-				ForceNew:   true,
-				Deprecated: `The attribute "stage_name" will be removed in a future major version. Use an explicit "aws_api_gateway_stage" instead.`,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Deprecated:    `The attribute "stage_name" will be removed in a future major version. Use an explicit "aws_api_gateway_stage" instead.`,
+				ConflictsWith: []string{"stage_canary"},
 			},
 			names.AttrTriggers: {
 				Type:     schema.TypeMap,
@@ -144,6 +198,28 @@ func resourceDeploymentCreate(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
+	var stageCanary map[string]interface{}
+	if v, ok := d.GetOk("stage_canary"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		stageCanary = v.([]interface{})[0].(map[string]interface{})
+
+		// Deploying to an existing stage with canary settings creates or updates the stage's canary.
+		if !stageCanary["promote"].(bool) {
+			input.CanarySettings = expandDeploymentStageCanary(stageCanary)
+			input.StageDescription = nil
+			input.StageName = aws.String(stageCanary["stage_name"].(string))
+		}
+	}
+
+	if d.Get("redeploy_on_change").(bool) {
+		fingerprint, err := deploymentConfigurationFingerprint(ctx, conn, d)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading API Gateway REST API (%s) configuration: %s", d.Get("rest_api_id").(string), err)
+		}
+
+		d.Set("configuration_fingerprint", fingerprint)
+	}
+
 	deployment, err := conn.CreateDeployment(ctx, &input)
 
 	if err != nil {
@@ -152,6 +228,12 @@ func resourceDeploymentCreate(ctx context.Context, d *schema.ResourceData, meta
 
 	d.SetId(aws.ToString(deployment.Id))
 
+	if stageCanary != nil && stageCanary["promote"].(bool) {
+		if err := promoteDeploymentToStage(ctx, conn, d.Get("rest_api_id").(string), stageCanary["stage_name"].(string), d.Id()); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	return append(diags, resourceDeploymentRead(ctx, d, meta)...)
 }
 
@@ -202,6 +284,26 @@ func resourceDeploymentUpdate(ctx context.Context, d *schema.ResourceData, meta
 		})
 	}
 
+	if d.HasChanges("redeploy_on_change", "rest_api_body") {
+		if d.Get("redeploy_on_change").(bool) {
+			fingerprint, err := deploymentConfigurationFingerprint(ctx, conn, d)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "reading API Gateway REST API (%s) configuration: %s", d.Get("rest_api_id").(string), err)
+			}
+
+			d.Set("configuration_fingerprint", fingerprint)
+		} else {
+			d.Set("configuration_fingerprint", nil)
+		}
+	}
+
+	if d.HasChange("stage_canary") {
+		if err := updateDeploymentStageCanary(ctx, conn, d); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	if len(operations) > 0 {
 		input := apigateway.UpdateDeploymentInput{
 			DeploymentId:    aws.String(d.Id()),
@@ -241,6 +343,38 @@ func resourceDeploymentDelete(ctx context.Context, d *schema.ResourceData, meta
 		}
 	}
 
+	if v, ok := d.GetOk("stage_canary"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		canaryStageName := v.([]interface{})[0].(map[string]interface{})["stage_name"].(string)
+		stage, err := findStageByTwoPartKey(ctx, conn, restAPIID, canaryStageName)
+
+		switch {
+		case tfresource.NotFound(err):
+		case err != nil:
+			return sdkdiag.AppendErrorf(diags, "reading API Gateway Stage (%s): %s", canaryStageName, err)
+		case stage.CanarySettings != nil && aws.ToString(stage.CanarySettings.DeploymentId) == d.Id():
+			input := apigateway.UpdateStageInput{
+				PatchOperations: []types.PatchOperation{{
+					Op:   types.OpRemove,
+					Path: aws.String("/canarySettings"),
+				}},
+				RestApiId: aws.String(restAPIID),
+				StageName: aws.String(canaryStageName),
+			}
+			_, err := conn.UpdateStage(ctx, &input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "removing API Gateway Stage (%s) canary: %s", canaryStageName, err)
+			}
+		case aws.ToString(stage.DeploymentId) == d.Id():
+			// The stage still serves this deployment while a newer deployment is its canary.
+			// API Gateway doesn't allow the deletion of a deployment that a stage points to.
+			return append(diags, errs.NewWarningDiagnostic(
+				"API Gateway Deployment retained",
+				fmt.Sprintf("API Gateway Deployment (%s) is the active deployment of Stage (%s) and was removed from state without being deleted. Promote the stage's canary to release it.", d.Id(), canaryStageName),
+			))
+		}
+	}
+
 	if shouldDeleteStage {
 		input := apigateway.DeleteStageInput{
 			StageName: aws.String(stageName),
@@ -351,6 +485,230 @@ func expandDeploymentCanarySettings(tfMap map[string]interface{}) *types.Deploym
 	return apiObject
 }
 
+func expandDeploymentStageCanary(tfMap map[string]interface{}) *types.DeploymentCanarySettings {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.DeploymentCanarySettings{}
+
+	if v, ok := tfMap["percent_traffic"].(float64); ok {
+		apiObject.PercentTraffic = v
+	}
+
+	if v, ok := tfMap["stage_variable_overrides"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.StageVariableOverrides = flex.ExpandStringValueMap(v)
+	}
+
+	if v, ok := tfMap["use_stage_cache"].(bool); ok {
+		apiObject.UseStageCache = v
+	}
+
+	return apiObject
+}
+
+// updateDeploymentStageCanary applies changes to the stage_canary configuration block to the stage.
+func updateDeploymentStageCanary(ctx context.Context, conn *apigateway.Client, d *schema.ResourceData) error {
+	restAPIID := d.Get("rest_api_id").(string)
+	o, n := d.GetChange("stage_canary")
+	oldList, newList := o.([]interface{}), n.([]interface{})
+
+	if len(newList) == 0 || newList[0] == nil {
+		return nil
+	}
+
+	tfMap := newList[0].(map[string]interface{})
+	stageName := tfMap["stage_name"].(string)
+	oldMap := map[string]interface{}{}
+	if len(oldList) > 0 && oldList[0] != nil {
+		oldMap = oldList[0].(map[string]interface{})
+	}
+
+	if tfMap["promote"].(bool) {
+		// Promotion is a one-way operation. Setting "promote" back to false only affects later deployments.
+		if promote, _ := oldMap["promote"].(bool); promote {
+			return nil
+		}
+
+		return promoteDeploymentToStage(ctx, conn, restAPIID, stageName, d.Id())
+	}
+
+	stage, err := findStageByTwoPartKey(ctx, conn, restAPIID, stageName)
+
+	if err != nil {
+		return fmt.Errorf("reading API Gateway Stage (%s): %w", stageName, err)
+	}
+
+	// Only update the canary if it still routes to this deployment.
+	if stage.CanarySettings == nil || aws.ToString(stage.CanarySettings.DeploymentId) != d.Id() {
+		return nil
+	}
+
+	operations := make([]types.PatchOperation, 0)
+
+	if v := tfMap["percent_traffic"].(float64); v != oldMap["percent_traffic"] {
+		operations = append(operations, types.PatchOperation{
+			Op:    types.OpReplace,
+			Path:  aws.String("/canarySettings/percentTraffic"),
+			Value: aws.String(strconv.FormatFloat(v, 'f', -1, 64)),
+		})
+	}
+
+	oldOverrides, _ := oldMap["stage_variable_overrides"].(map[string]interface{})
+	newOverrides, _ := tfMap["stage_variable_overrides"].(map[string]interface{})
+	operations = append(operations, diffVariablesOps(oldOverrides, newOverrides, "/canarySettings/stageVariableOverrides/")...)
+
+	if v := tfMap["use_stage_cache"].(bool); v != oldMap["use_stage_cache"] {
+		operations = append(operations, types.PatchOperation{
+			Op:    types.OpReplace,
+			Path:  aws.String("/canarySettings/useStageCache"),
+			Value: aws.String(strconv.FormatBool(v)),
+		})
+	}
+
+	if len(operations) == 0 {
+		return nil
+	}
+
+	input := apigateway.UpdateStageInput{
+		PatchOperations: operations,
+		RestApiId:       aws.String(restAPIID),
+		StageName:       aws.String(stageName),
+	}
+	_, err = conn.UpdateStage(ctx, &input)
+
+	if err != nil {
+		return fmt.Errorf("updating API Gateway Stage (%s) canary: %w", stageName, err)
+	}
+
+	return nil
+}
+
+// promoteDeploymentToStage points the stage at the deployment and removes any canary.
+func promoteDeploymentToStage(ctx context.Context, conn *apigateway.Client, restAPIID, stageName, deploymentID string) error {
+	stage, err := findStageByTwoPartKey(ctx, conn, restAPIID, stageName)
+
+	if err != nil {
+		return fmt.Errorf("reading API Gateway Stage (%s): %w", stageName, err)
+	}
+
+	operations := []types.PatchOperation{{
+		Op:    types.OpReplace,
+		Path:  aws.String("/deploymentId"),
+		Value: aws.String(deploymentID),
+	}}
+
+	if stage.CanarySettings != nil {
+		operations = append(operations, types.PatchOperation{
+			Op:   types.OpRemove,
+			Path: aws.String("/canarySettings"),
+		})
+	}
+
+	input := apigateway.UpdateStageInput{
+		PatchOperations: operations,
+		RestApiId:       aws.String(restAPIID),
+		StageName:       aws.String(stageName),
+	}
+	_, err = conn.UpdateStage(ctx, &input)
+
+	if err != nil {
+		return fmt.Errorf("promoting API Gateway Deployment (%s) to Stage (%s): %w", deploymentID, stageName, err)
+	}
+
+	return nil
+}
+
+// resourceDeploymentCustomizeDiff forces a new deployment when the REST API's configuration
+// no longer matches the configuration captured by the deployment.
+func resourceDeploymentCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.Get("redeploy_on_change").(bool) {
+		if d.Id() != "" && d.HasChange("redeploy_on_change") {
+			return d.SetNewComputed("configuration_fingerprint")
+		}
+
+		return nil
+	}
+
+	// The REST API's OpenAPI specification is known at plan time, including changes made in the same apply.
+	if !d.NewValueKnown("rest_api_body") {
+		if err := d.SetNewComputed("configuration_fingerprint"); err != nil {
+			return err
+		}
+
+		if d.Id() == "" {
+			return nil
+		}
+
+		return d.ForceNew("configuration_fingerprint")
+	}
+
+	if v, ok := d.GetOk("rest_api_body"); ok {
+		fingerprint := restAPIBodyFingerprint(v.(string))
+
+		if fingerprint == d.Get("configuration_fingerprint").(string) {
+			return nil
+		}
+
+		if err := d.SetNew("configuration_fingerprint", fingerprint); err != nil {
+			return err
+		}
+
+		if d.Id() == "" || d.HasChange("redeploy_on_change") {
+			return nil
+		}
+
+		return d.ForceNew("configuration_fingerprint")
+	}
+
+	if d.Id() == "" {
+		return nil
+	}
+
+	if d.HasChange("redeploy_on_change") || d.HasChange("rest_api_body") {
+		return d.SetNewComputed("configuration_fingerprint")
+	}
+
+	// The resources referenced by triggers change in this plan, so the REST API's current configuration is stale.
+	// The deployment is replaced and the fingerprint is taken again once they're applied.
+	if d.HasChange(names.AttrTriggers) || !d.NewValueKnown(names.AttrTriggers) {
+		return d.SetNewComputed("configuration_fingerprint")
+	}
+
+	conn := meta.(*conns.AWSClient).APIGatewayClient(ctx)
+
+	restAPIID := d.Get("rest_api_id").(string)
+	fingerprint, err := findRESTAPIConfigurationFingerprint(ctx, conn, restAPIID)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading API Gateway REST API (%s) configuration: %w", restAPIID, err)
+	}
+
+	if fingerprint == d.Get("configuration_fingerprint").(string) {
+		return nil
+	}
+
+	if err := d.SetNewComputed("configuration_fingerprint"); err != nil {
+		return err
+	}
+
+	return d.ForceNew("configuration_fingerprint")
+}
+
+// deploymentConfigurationFingerprint returns the fingerprint of the REST API's OpenAPI specification, if configured,
+// or of the REST API's current configuration.
+func deploymentConfigurationFingerprint(ctx context.Context, conn *apigateway.Client, d *schema.ResourceData) (string, error) {
+	if v, ok := d.GetOk("rest_api_body"); ok {
+		return restAPIBodyFingerprint(v.(string)), nil
+	}
+
+	return findRESTAPIConfigurationFingerprint(ctx, conn, d.Get("rest_api_id").(string))
+}
+
 func noEffectWithoutWarningDiag(path, otherPath cty.Path) diag.Diagnostic {
 	return errs.NewAttributeWarningDiagnostic(
 		path,
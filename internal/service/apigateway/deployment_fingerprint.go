@@ -0,0 +1,214 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apigateway
+
+import (
+	"cmp"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+)
+
+// restAPIConfiguration is the part of a REST API's configuration that is captured by a deployment.
+type restAPIConfiguration struct {
+	APIKeySource           types.ApiKeySourceType
+	Authorizers            []types.Authorizer
+	BinaryMediaTypes       []string
+	GatewayResponses       []types.GatewayResponse
+	MinimumCompressionSize *int32
+	Models                 []types.Model
+	Policy                 *string
+	RequestValidators      []types.RequestValidator
+	Resources              []restAPIResourceConfiguration
+}
+
+type restAPIResourceConfiguration struct {
+	// Methods maps HTTP method to the method, including its integration and responses.
+	Methods map[string]types.Method
+	Path    string
+}
+
+// fingerprint returns a digest of the configuration that doesn't depend on the order of its elements.
+func (c *restAPIConfiguration) fingerprint() (string, error) {
+	slices.SortFunc(c.Authorizers, func(a, b types.Authorizer) int {
+		return cmp.Compare(aws.ToString(a.Name), aws.ToString(b.Name))
+	})
+	slices.Sort(c.BinaryMediaTypes)
+	slices.SortFunc(c.GatewayResponses, func(a, b types.GatewayResponse) int {
+		return cmp.Compare(a.ResponseType, b.ResponseType)
+	})
+	slices.SortFunc(c.Models, func(a, b types.Model) int {
+		return cmp.Compare(aws.ToString(a.Name), aws.ToString(b.Name))
+	})
+	slices.SortFunc(c.RequestValidators, func(a, b types.RequestValidator) int {
+		return cmp.Compare(aws.ToString(a.Name), aws.ToString(b.Name))
+	})
+	slices.SortFunc(c.Resources, func(a, b restAPIResourceConfiguration) int {
+		return cmp.Compare(a.Path, b.Path)
+	})
+	for _, resource := range c.Resources {
+		for _, method := range resource.Methods {
+			slices.Sort(method.AuthorizationScopes)
+		}
+	}
+
+	b, err := json.Marshal(c)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(b)
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// restAPIBodyFingerprint returns a digest of a REST API's OpenAPI specification.
+// JSON specifications are normalized so that formatting changes don't change the digest.
+func restAPIBodyFingerprint(body string) string {
+	if v, err := structure.NormalizeJsonString(body); err == nil {
+		body = v
+	}
+
+	sum := sha256.Sum256([]byte(body))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// findRESTAPIConfigurationFingerprint returns the fingerprint of a REST API's current configuration.
+func findRESTAPIConfigurationFingerprint(ctx context.Context, conn *apigateway.Client, apiID string) (string, error) {
+	config, err := findRESTAPIConfiguration(ctx, conn, apiID)
+
+	if err != nil {
+		return "", err
+	}
+
+	return config.fingerprint()
+}
+
+func findRESTAPIConfiguration(ctx context.Context, conn *apigateway.Client, apiID string) (*restAPIConfiguration, error) {
+	restAPI, err := findRestAPIByID(ctx, conn, apiID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	config := &restAPIConfiguration{
+		APIKeySource:           restAPI.ApiKeySource,
+		BinaryMediaTypes:       restAPI.BinaryMediaTypes,
+		MinimumCompressionSize: restAPI.MinimumCompressionSize,
+		Policy:                 restAPI.Policy,
+	}
+
+	resources, err := findResources(ctx, conn, &apigateway.GetResourcesInput{
+		Embed:     []string{"methods"},
+		RestApiId: aws.String(apiID),
+	}, tfslices.PredicateTrue[*types.Resource]())
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, resource := range resources {
+		r := restAPIResourceConfiguration{
+			Methods: make(map[string]types.Method, len(resource.ResourceMethods)),
+			Path:    aws.ToString(resource.Path),
+		}
+
+		for httpMethod := range resource.ResourceMethods {
+			// The embedded methods don't include all of the method's configuration.
+			method, err := findMethodByThreePartKey(ctx, conn, httpMethod, aws.ToString(resource.Id), apiID)
+
+			if err != nil {
+				return nil, err
+			}
+
+			r.Methods[httpMethod] = types.Method{
+				ApiKeyRequired:      method.ApiKeyRequired,
+				AuthorizationScopes: method.AuthorizationScopes,
+				AuthorizationType:   method.AuthorizationType,
+				AuthorizerId:        method.AuthorizerId,
+				HttpMethod:          method.HttpMethod,
+				MethodIntegration:   method.MethodIntegration,
+				MethodResponses:     method.MethodResponses,
+				OperationName:       method.OperationName,
+				RequestModels:       method.RequestModels,
+				RequestParameters:   method.RequestParameters,
+				RequestValidatorId:  method.RequestValidatorId,
+			}
+		}
+
+		config.Resources = append(config.Resources, r)
+	}
+
+	err = getAuthorizersPages(ctx, conn, &apigateway.GetAuthorizersInput{
+		RestApiId: aws.String(apiID),
+	}, func(page *apigateway.GetAuthorizersOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		config.Authorizers = append(config.Authorizers, page.Items...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	err = getGatewayResponsesPages(ctx, conn, &apigateway.GetGatewayResponsesInput{
+		RestApiId: aws.String(apiID),
+	}, func(page *apigateway.GetGatewayResponsesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		config.GatewayResponses = append(config.GatewayResponses, page.Items...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	pages := apigateway.NewGetModelsPaginator(conn, &apigateway.GetModelsInput{
+		RestApiId: aws.String(apiID),
+	})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		config.Models = append(config.Models, page.Items...)
+	}
+
+	err = getRequestValidatorsPages(ctx, conn, &apigateway.GetRequestValidatorsInput{
+		RestApiId: aws.String(apiID),
+	}, func(page *apigateway.GetRequestValidatorsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		config.RequestValidators = append(config.RequestValidators, page.Items...)
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
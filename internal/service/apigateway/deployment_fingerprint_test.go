@@ -0,0 +1,88 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package apigateway
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/apigateway/types"
+)
+
+func TestRESTAPIConfigurationFingerprint(t *testing.T) {
+	t.Parallel()
+
+	newConfig := func(uri string, reverse bool) *restAPIConfiguration {
+		config := &restAPIConfiguration{
+			BinaryMediaTypes: []string{"image/png", "application/octet-stream"},
+			Models: []types.Model{
+				{Name: aws.String("Empty"), Schema: aws.String(`{}`)},
+				{Name: aws.String("Error"), Schema: aws.String(`{"type":"object"}`)},
+			},
+			Resources: []restAPIResourceConfiguration{
+				{Path: "/"},
+				{
+					Methods: map[string]types.Method{
+						"GET": {
+							AuthorizationType: aws.String("NONE"),
+							HttpMethod:        aws.String("GET"),
+							MethodIntegration: &types.Integration{
+								Type: types.IntegrationTypeHttp,
+								Uri:  aws.String(uri),
+							},
+						},
+					},
+					Path: "/test",
+				},
+			},
+		}
+
+		if reverse {
+			config.BinaryMediaTypes = []string{"application/octet-stream", "image/png"}
+			config.Models[0], config.Models[1] = config.Models[1], config.Models[0]
+			config.Resources[0], config.Resources[1] = config.Resources[1], config.Resources[0]
+		}
+
+		return config
+	}
+
+	fingerprint := func(config *restAPIConfiguration) string {
+		t.Helper()
+
+		v, err := config.fingerprint()
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		return v
+	}
+
+	base := fingerprint(newConfig("https://example.com", false))
+
+	if got := fingerprint(newConfig("https://example.com", true)); got != base {
+		t.Errorf("fingerprint depends on element order: %s != %s", got, base)
+	}
+
+	if got := fingerprint(newConfig("https://example.org", false)); got == base {
+		t.Errorf("fingerprint didn't change with integration URI")
+	}
+}
+
+func TestRESTAPIBodyFingerprint(t *testing.T) {
+	t.Parallel()
+
+	base := restAPIBodyFingerprint(`{"openapi":"3.0.1","paths":{"/test":{"get":{}}}}`)
+
+	if got := restAPIBodyFingerprint("{\n  \"paths\": {\"/test\": {\"get\": {}}},\n  \"openapi\": \"3.0.1\"\n}"); got != base {
+		t.Errorf("fingerprint depends on JSON formatting: %s != %s", got, base)
+	}
+
+	if got := restAPIBodyFingerprint(`{"openapi":"3.0.1","paths":{"/test":{"post":{}}}}`); got == base {
+		t.Errorf("fingerprint didn't change with paths")
+	}
+
+	if got, want := restAPIBodyFingerprint("openapi: 3.0.1\n"), restAPIBodyFingerprint("openapi: 3.0.1\n"); got != want {
+		t.Errorf("YAML fingerprint isn't stable: %s != %s", got, want)
+	}
+}
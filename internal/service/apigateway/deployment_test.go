@@ -13,7 +13,10 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/apigateway"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfapigateway "github.com/hashicorp/terraform-provider-aws/internal/service/apigateway"
@@ -169,6 +172,200 @@ func TestAccAPIGatewayDeployment_triggers(t *testing.T) {
 	})
 }
 
+func TestAccAPIGatewayDeployment_redeployOnChange(t *testing.T) {
+	ctx := acctest.Context(t)
+	var deployment1, deployment2, deployment3 apigateway.GetDeploymentOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_api_gateway_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.APIGatewayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_redeployOnChange(rName, "https://example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &deployment1),
+					resource.TestCheckResourceAttr(resourceName, "redeploy_on_change", acctest.CtTrue),
+					resource.TestMatchResourceAttr(resourceName, "configuration_fingerprint", regexache.MustCompile(`^[0-9a-f]{64}$`)),
+				),
+			},
+			{
+				// Neither rest_api_body nor triggers reference the integration, so its change is only observed
+				// on the REST API once applied and the deployment is replaced at the next plan.
+				Config: testAccDeploymentConfig_redeployOnChange(rName, "https://example.org"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &deployment2),
+					testAccCheckDeploymentNotRecreated(&deployment1, &deployment2),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccDeploymentConfig_redeployOnChange(rName, "https://example.org"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &deployment3),
+					testAccCheckDeploymentRecreated(&deployment2, &deployment3),
+				),
+			},
+			{
+				Config: testAccDeploymentConfig_redeployOnChange(rName, "https://example.org"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccAPIGatewayDeployment_redeployOnChangeTriggers(t *testing.T) {
+	ctx := acctest.Context(t)
+	var deployment1, deployment2 apigateway.GetDeploymentOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_api_gateway_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.APIGatewayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_redeployOnChangeTriggers(rName, "https://example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &deployment1),
+					resource.TestMatchResourceAttr(resourceName, "configuration_fingerprint", regexache.MustCompile(`^[0-9a-f]{64}$`)),
+				),
+			},
+			{
+				// The integration is referenced by triggers, so the deployment is replaced in the same apply.
+				Config: testAccDeploymentConfig_redeployOnChangeTriggers(rName, "https://example.org"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+						plancheck.ExpectUnknownValue(resourceName, tfjsonpath.New("configuration_fingerprint")),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &deployment2),
+					testAccCheckDeploymentRecreated(&deployment1, &deployment2),
+				),
+			},
+			{
+				Config: testAccDeploymentConfig_redeployOnChangeTriggers(rName, "https://example.org"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccAPIGatewayDeployment_redeployOnChangeBody(t *testing.T) {
+	ctx := acctest.Context(t)
+	var deployment1, deployment2 apigateway.GetDeploymentOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_api_gateway_deployment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.APIGatewayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_redeployOnChangeBody(rName, "https://example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &deployment1),
+					resource.TestMatchResourceAttr(resourceName, "configuration_fingerprint", regexache.MustCompile(`^[0-9a-f]{64}$`)),
+				),
+			},
+			{
+				// The body is known at plan time, so the deployment is replaced in the same apply.
+				Config: testAccDeploymentConfig_redeployOnChangeBody(rName, "https://example.org"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionReplace),
+						plancheck.ExpectKnownValue(resourceName, tfjsonpath.New("configuration_fingerprint"), knownvalue.StringRegexp(regexache.MustCompile(`^[0-9a-f]{64}$`))),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &deployment2),
+					testAccCheckDeploymentRecreated(&deployment1, &deployment2),
+				),
+			},
+			{
+				Config: testAccDeploymentConfig_redeployOnChangeBody(rName, "https://example.org"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccAPIGatewayDeployment_stageCanary(t *testing.T) {
+	ctx := acctest.Context(t)
+	var deployment apigateway.GetDeploymentOutput
+	var stage apigateway.GetStageOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_api_gateway_deployment.test"
+	stageResourceName := "aws_api_gateway_stage.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckAPIGatewayTypeEDGE(t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.APIGatewayServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDeploymentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDeploymentConfig_stageCanary(rName, 10, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDeploymentExists(ctx, resourceName, &deployment),
+					testAccCheckStageExists(ctx, stageResourceName, &stage),
+					resource.TestCheckResourceAttr(resourceName, "stage_canary.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "stage_canary.0.percent_traffic", "10"),
+					resource.TestCheckResourceAttr(resourceName, "stage_canary.0.promote", acctest.CtFalse),
+				),
+			},
+			{
+				Config: testAccDeploymentConfig_stageCanary(rName, 10, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStageExists(ctx, stageResourceName, &stage),
+					resource.TestCheckResourceAttr(stageResourceName, "canary_settings.#", "1"),
+					resource.TestCheckResourceAttrPair(stageResourceName, "canary_settings.0.deployment_id", resourceName, names.AttrID),
+					resource.TestCheckResourceAttr(stageResourceName, "canary_settings.0.percent_traffic", "10"),
+				),
+			},
+			{
+				Config: testAccDeploymentConfig_stageCanary(rName, 10, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "stage_canary.0.promote", acctest.CtTrue),
+				),
+			},
+			{
+				Config: testAccDeploymentConfig_stageCanary(rName, 10, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(stageResourceName, "canary_settings.#", "0"),
+					resource.TestCheckResourceAttrPair(stageResourceName, "deployment_id", resourceName, names.AttrID),
+				),
+			},
+		},
+	})
+}
+
 func TestAccAPIGatewayDeployment_description(t *testing.T) {
 	ctx := acctest.Context(t)
 	var deployment apigateway.GetDeploymentOutput
@@ -513,6 +710,106 @@ resource "aws_api_gateway_deployment" "test" {
 `, description))
 }
 
+func testAccDeploymentConfig_redeployOnChange(rName, url string) string {
+	return acctest.ConfigCompose(testAccDeploymentConfig_base(rName, url), `
+resource "aws_api_gateway_deployment" "test" {
+  depends_on = [aws_api_gateway_integration.test, aws_api_gateway_integration_response.test]
+
+  rest_api_id        = aws_api_gateway_rest_api.test.id
+  redeploy_on_change = true
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+`)
+}
+
+func testAccDeploymentConfig_redeployOnChangeTriggers(rName, url string) string {
+	return acctest.ConfigCompose(testAccDeploymentConfig_base(rName, url), `
+resource "aws_api_gateway_deployment" "test" {
+  rest_api_id        = aws_api_gateway_rest_api.test.id
+  redeploy_on_change = true
+
+  triggers = {
+    redeployment = sha1(jsonencode([aws_api_gateway_integration.test, aws_api_gateway_integration_response.test]))
+  }
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+`)
+}
+
+func testAccDeploymentConfig_redeployOnChangeBody(rName, uri string) string {
+	return fmt.Sprintf(`
+resource "aws_api_gateway_rest_api" "test" {
+  name = %[1]q
+
+  body = jsonencode({
+    openapi = "3.0.1"
+    info = {
+      title   = %[1]q
+      version = "1.0"
+    }
+    paths = {
+      "/test" = {
+        get = {
+          x-amazon-apigateway-integration = {
+            httpMethod           = "GET"
+            payloadFormatVersion = "1.0"
+            type                 = "HTTP_PROXY"
+            uri                  = %[2]q
+          }
+        }
+      }
+    }
+  })
+}
+
+resource "aws_api_gateway_deployment" "test" {
+  rest_api_id        = aws_api_gateway_rest_api.test.id
+  rest_api_body      = aws_api_gateway_rest_api.test.body
+  redeploy_on_change = true
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+`, rName, uri)
+}
+
+func testAccDeploymentConfig_stageCanary(rName string, percentTraffic int, promote bool) string {
+	return acctest.ConfigCompose(testAccDeploymentConfig_base(rName, "https://example.com"), fmt.Sprintf(`
+resource "aws_api_gateway_deployment" "initial" {
+  depends_on = [aws_api_gateway_integration.test, aws_api_gateway_integration_response.test]
+
+  rest_api_id = aws_api_gateway_rest_api.test.id
+}
+
+resource "aws_api_gateway_stage" "test" {
+  rest_api_id   = aws_api_gateway_rest_api.test.id
+  deployment_id = aws_api_gateway_deployment.initial.id
+  stage_name    = "test"
+
+  lifecycle {
+    ignore_changes = [deployment_id, canary_settings]
+  }
+}
+
+resource "aws_api_gateway_deployment" "test" {
+  rest_api_id = aws_api_gateway_rest_api.test.id
+
+  stage_canary {
+    stage_name      = aws_api_gateway_stage.test.stage_name
+    percent_traffic = %[1]d
+    promote         = %[2]t
+  }
+}
+`, percentTraffic, promote))
+}
+
 func testAccDeploymentConfig_description(rName, description string) string {
 	return acctest.ConfigCompose(testAccDeploymentConfig_base(rName, "http://example.com"), fmt.Sprintf(`
 resource "aws_api_gateway_deployment" "test" {
@@ -44,7 +44,6 @@ func TestAccAppMesh_serial(t *testing.T) {
 			"dataSource_tags":     testAccAppMeshServiceMeshDataSource_tagsSerial,
 		},
 		"Route": {
-			acctest.CtDisappears:               testAccRoute_disappears,
 			"grpcRoute":                        testAccRoute_grpcRoute,
 			"grpcRouteWithPortMatch":           testAccRoute_grpcRouteWithPortMatch,
 			"grpcRouteEmptyMatch":              testAccRoute_grpcRouteEmptyMatch,
@@ -63,6 +62,7 @@ func TestAccAppMesh_serial(t *testing.T) {
 			"tcpRoute":                         testAccRoute_tcpRoute,
 			"tcpRouteWithPortMatch":            testAccRoute_tcpRouteWithPortMatch,
 			"tcpRouteTimeout":                  testAccRoute_tcpRouteTimeout,
+			"generated":                        testAccAppMeshRoute_generatedSerial,
 			"tags":                             testAccAppMeshRoute_tagsSerial,
 			"dataSourceHTTP2Route":             testAccRouteDataSource_http2Route,
 			"dataSourceHTTPRoute":              testAccRouteDataSource_httpRoute,
@@ -114,9 +114,9 @@ func TestAccAppMesh_serial(t *testing.T) {
 			"dataSource_tags":    testAccAppMeshVirtualRouterDataSource_tagsSerial,
 		},
 		"VirtualService": {
-			acctest.CtDisappears:      testAccVirtualService_disappears,
 			"virtualNode":             testAccVirtualService_virtualNode,
 			"virtualRouter":           testAccVirtualService_virtualRouter,
+			"generated":               testAccAppMeshVirtualService_generatedSerial,
 			"tags":                    testAccAppMeshVirtualService_tagsSerial,
 			"dataSourceVirtualNode":   testAccVirtualServiceDataSource_virtualNode,
 			"dataSourceVirtualRouter": testAccVirtualServiceDataSource_virtualRouter,
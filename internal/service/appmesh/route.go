@@ -33,6 +33,7 @@ import (
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/appmesh/types;types.RouteData")
 // @Testing(serialize=true)
 // @Testing(importStateIdFunc=testAccRouteImportStateIdFunc)
+// @Testing(basicTest=true)
 func resourceRoute() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceRouteCreate,
@@ -31,6 +31,7 @@ import (
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/appmesh/types;types.VirtualServiceData")
 // @Testing(serialize=true)
 // @Testing(importStateIdFunc=testAccVirtualServiceImportStateIdFunc)
+// @Testing(basicTest=true)
 func resourceVirtualService() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceVirtualServiceCreate,
@@ -122,33 +122,6 @@ func testAccVirtualService_virtualRouter(t *testing.T) {
 	})
 }
 
-func testAccVirtualService_disappears(t *testing.T) {
-	ctx := acctest.Context(t)
-	var vs awstypes.VirtualServiceData
-	resourceName := "aws_appmesh_virtual_service.test"
-	meshName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vnName1 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vnName2 := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	vsName := fmt.Sprintf("tf-acc-test-%d.mesh.local", sdkacctest.RandInt())
-
-	resource.Test(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.AppMeshEndpointID) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.AppMeshServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckVirtualServiceDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccVirtualServiceConfig_virtualNode(meshName, vnName1, vnName2, vsName, "aws_appmesh_virtual_node.test1"),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckVirtualServiceExists(ctx, resourceName, &vs),
-					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfappmesh.ResourceVirtualService(), resourceName),
-				),
-				ExpectNonEmptyPlan: true,
-			},
-		},
-	})
-}
-
 func testAccCheckVirtualServiceDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).AppMeshClient(ctx)
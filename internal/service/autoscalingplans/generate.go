@@ -3,6 +3,7 @@
 
 //go:generate go run ../../generate/listpages/main.go -ListOps=DescribeScalingPlans
 //go:generate go run ../../generate/servicepackage/main.go
+//go:generate go run ../../generate/basictests/main.go
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package autoscalingplans
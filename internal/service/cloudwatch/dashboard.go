@@ -17,8 +17,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/cloudwatch/dashboardbody"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
-	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
 // @SDKResource("aws_cloudwatch_dashboard", name="Dashboard")
@@ -46,7 +46,7 @@ func resourceDashboard() *schema.Resource {
 				Type:                  schema.TypeString,
 				Required:              true,
 				ValidateFunc:          validation.StringIsJSON,
-				DiffSuppressFunc:      verify.SuppressEquivalentJSONDiffs,
+				DiffSuppressFunc:      suppressEquivalentDashboardBodyDiffs,
 				DiffSuppressOnRefresh: true,
 				StateFunc: func(v interface{}) string {
 					json, _ := structure.NormalizeJsonString(v)
@@ -149,3 +149,9 @@ func findDashboardByName(ctx context.Context, conn *cloudwatch.Client, name stri
 
 	return output, nil
 }
+
+// suppressEquivalentDashboardBodyDiffs suppresses differences between semantically equivalent dashboard bodies,
+// e.g. positioned widgets listed in a different order or omitted default widget dimensions.
+func suppressEquivalentDashboardBodyDiffs(k, old, new string, d *schema.ResourceData) bool {
+	return dashboardbody.Equivalent(old, new)
+}
@@ -0,0 +1,618 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/cloudwatch/dashboardbody"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_cloudwatch_dashboard_document", name="Dashboard Document")
+func dataSourceDashboardDocument() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceDashboardDocumentRead,
+
+		SchemaFunc: func() map[string]*schema.Schema {
+			periodSchema := func() *schema.Schema {
+				return &schema.Schema{
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validDashboardPeriod,
+				}
+			}
+			yAxisSchema := func() *schema.Schema {
+				return &schema.Schema{
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice([]string{"left", "right"}, false),
+				}
+			}
+
+			return map[string]*schema.Schema{
+				"end": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					RequiredWith: []string{"start"},
+				},
+				names.AttrJSON: {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"period_override": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice([]string{"auto", "inherit"}, false),
+				},
+				"start": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"widget": {
+					Type:     schema.TypeList,
+					Required: true,
+					MinItems: 1,
+					MaxItems: 500,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"alarm_status": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"alarms": {
+											Type:     schema.TypeList,
+											Required: true,
+											MinItems: 1,
+											MaxItems: 100,
+											Elem: &schema.Schema{
+												Type: schema.TypeString,
+											},
+										},
+										"sort_by": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: validation.StringInSlice([]string{"default", "stateUpdatedTimestamp", "timestamp"}, false),
+										},
+										"states": {
+											Type:     schema.TypeList,
+											Optional: true,
+											Elem: &schema.Schema{
+												Type:         schema.TypeString,
+												ValidateFunc: validation.StringInSlice([]string{"ALARM", "INSUFFICIENT_DATA", "OK"}, false),
+											},
+										},
+										"title": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+									},
+								},
+							},
+							"explorer": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"label": {
+											Type:     schema.TypeList,
+											Required: true,
+											MinItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													names.AttrKey: {
+														Type:     schema.TypeString,
+														Required: true,
+													},
+													names.AttrValue: {
+														Type:     schema.TypeString,
+														Optional: true,
+													},
+												},
+											},
+										},
+										"metric": {
+											Type:     schema.TypeList,
+											Required: true,
+											MinItems: 1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													names.AttrMetricName: {
+														Type:     schema.TypeString,
+														Required: true,
+													},
+													names.AttrResourceType: {
+														Type:     schema.TypeString,
+														Required: true,
+													},
+													"stat": {
+														Type:         schema.TypeString,
+														Required:     true,
+														ValidateFunc: validDashboardStat,
+													},
+												},
+											},
+										},
+										"period": periodSchema(),
+										names.AttrRegion: {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+										"rows_per_page": {
+											Type:         schema.TypeInt,
+											Optional:     true,
+											ValidateFunc: validation.IntBetween(1, 1000),
+										},
+										"split_by": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+										"stacked": {
+											Type:     schema.TypeBool,
+											Optional: true,
+										},
+										"title": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+										"view": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: validation.StringInSlice([]string{"bar", "pie", "timeSeries"}, false),
+										},
+										"widgets_per_row": {
+											Type:         schema.TypeInt,
+											Optional:     true,
+											ValidateFunc: validation.IntBetween(1, 4),
+										},
+									},
+								},
+							},
+							"height": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Default:      dashboardbody.DefaultWidgetHeight,
+								ValidateFunc: validation.IntBetween(1, dashboardbody.MaxWidgetHeight),
+							},
+							"log_query": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"log_group_names": {
+											Type:     schema.TypeList,
+											Required: true,
+											MinItems: 1,
+											Elem: &schema.Schema{
+												Type: schema.TypeString,
+											},
+										},
+										"query": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validLogsInsightsQuery,
+										},
+										names.AttrRegion: {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+										"stacked": {
+											Type:     schema.TypeBool,
+											Optional: true,
+										},
+										"title": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+										"view": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: validation.StringInSlice([]string{"bar", "pie", "table", "timeSeries"}, false),
+										},
+									},
+								},
+							},
+							"metric": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										names.AttrExpression: {
+											Type:     schema.TypeList,
+											Optional: true,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"color": {
+														Type:     schema.TypeString,
+														Optional: true,
+													},
+													names.AttrExpression: {
+														Type:         schema.TypeString,
+														Required:     true,
+														ValidateFunc: validMetricMathExpression,
+													},
+													names.AttrID: {
+														Type:     schema.TypeString,
+														Required: true,
+													},
+													"label": {
+														Type:     schema.TypeString,
+														Optional: true,
+													},
+													"period": periodSchema(),
+													"visible": {
+														Type:     schema.TypeBool,
+														Optional: true,
+														Default:  true,
+													},
+													"y_axis": yAxisSchema(),
+												},
+											},
+										},
+										"live_data": {
+											Type:     schema.TypeBool,
+											Optional: true,
+										},
+										"metric": {
+											Type:     schema.TypeList,
+											Optional: true,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													names.AttrAccountID: {
+														Type:     schema.TypeString,
+														Optional: true,
+													},
+													"color": {
+														Type:     schema.TypeString,
+														Optional: true,
+													},
+													"dimensions": {
+														Type:     schema.TypeMap,
+														Optional: true,
+														Elem: &schema.Schema{
+															Type: schema.TypeString,
+														},
+													},
+													names.AttrID: {
+														Type:     schema.TypeString,
+														Optional: true,
+													},
+													"label": {
+														Type:     schema.TypeString,
+														Optional: true,
+													},
+													names.AttrMetricName: {
+														Type:     schema.TypeString,
+														Required: true,
+													},
+													names.AttrNamespace: {
+														Type:     schema.TypeString,
+														Required: true,
+													},
+													"period": periodSchema(),
+													names.AttrRegion: {
+														Type:     schema.TypeString,
+														Optional: true,
+													},
+													"stat": {
+														Type:         schema.TypeString,
+														Optional:     true,
+														ValidateFunc: validDashboardStat,
+													},
+													"visible": {
+														Type:     schema.TypeBool,
+														Optional: true,
+														Default:  true,
+													},
+													"y_axis": yAxisSchema(),
+												},
+											},
+										},
+										"period": periodSchema(),
+										names.AttrRegion: {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+										"set_period_to_time_range": {
+											Type:     schema.TypeBool,
+											Optional: true,
+										},
+										"stacked": {
+											Type:     schema.TypeBool,
+											Optional: true,
+										},
+										"stat": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: validDashboardStat,
+										},
+										"title": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+										"view": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: validation.StringInSlice([]string{"bar", "pie", "singleValue", "timeSeries"}, false),
+										},
+									},
+								},
+							},
+							"text": {
+								Type:     schema.TypeList,
+								Optional: true,
+								MaxItems: 1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"background": {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: validation.StringInSlice([]string{"solid", "transparent"}, false),
+										},
+										"markdown": {
+											Type:         schema.TypeString,
+											Required:     true,
+											ValidateFunc: validation.StringIsNotEmpty,
+										},
+									},
+								},
+							},
+							"width": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Default:      dashboardbody.DefaultWidgetWidth,
+								ValidateFunc: validation.IntBetween(1, dashboardbody.GridWidth),
+							},
+							"x": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Default:      -1,
+								ValidateFunc: validation.IntBetween(-1, dashboardbody.GridWidth-1),
+							},
+							"y": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Default:      -1,
+								ValidateFunc: validation.IntAtLeast(-1),
+							},
+						},
+					},
+				},
+			}
+		},
+	}
+}
+
+func dataSourceDashboardDocumentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	region := meta.(*conns.AWSClient).Region(ctx)
+
+	body := &dashboardbody.Body{
+		End:            d.Get("end").(string),
+		PeriodOverride: d.Get("period_override").(string),
+		Start:          d.Get("start").(string),
+	}
+
+	for i, v := range d.Get("widget").([]interface{}) {
+		tfMap, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		widget, err := expandDashboardWidget(tfMap, region)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "widget %d: %s", i, err)
+		}
+
+		body.Widgets = append(body.Widgets, widget)
+	}
+
+	if err := body.Validate(); err != nil {
+		return sdkdiag.AppendErrorf(diags, "invalid CloudWatch Dashboard document: %s", err)
+	}
+
+	body.Layout()
+
+	jsonBytes, err := json.MarshalIndent(body, "", "  ")
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	jsonString := string(jsonBytes)
+
+	d.Set(names.AttrJSON, jsonString)
+	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
+
+	return diags
+}
+
+func expandDashboardWidget(tfMap map[string]interface{}, region string) (*dashboardbody.Widget, error) {
+	widget := &dashboardbody.Widget{
+		Height: tfMap["height"].(int),
+		Width:  tfMap["width"].(int),
+	}
+
+	// -1 means that the widget is placed automatically.
+	if v := tfMap["x"].(int); v >= 0 {
+		widget.X = &v
+	}
+	if v := tfMap["y"].(int); v >= 0 {
+		widget.Y = &v
+	}
+
+	var n int
+
+	if v, ok := tfMap["alarm_status"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		n++
+		widget.Type = dashboardbody.WidgetTypeAlarm
+		widget.Properties = expandDashboardAlarmProperties(v[0].(map[string]interface{}))
+	}
+
+	if v, ok := tfMap["explorer"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		n++
+		widget.Type = dashboardbody.WidgetTypeExplorer
+		widget.Properties = expandDashboardExplorerProperties(v[0].(map[string]interface{}), region)
+	}
+
+	if v, ok := tfMap["log_query"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		n++
+		widget.Type = dashboardbody.WidgetTypeLog
+		widget.Properties = expandDashboardLogProperties(v[0].(map[string]interface{}), region)
+	}
+
+	if v, ok := tfMap["metric"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		n++
+		widget.Type = dashboardbody.WidgetTypeMetric
+		widget.Properties = expandDashboardMetricProperties(v[0].(map[string]interface{}), region)
+	}
+
+	if v, ok := tfMap["text"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		n++
+		widget.Type = dashboardbody.WidgetTypeText
+		widget.Properties = expandDashboardTextProperties(v[0].(map[string]interface{}))
+	}
+
+	if n != 1 {
+		return nil, errors.New("exactly one of alarm_status, explorer, log_query, metric or text must be set")
+	}
+
+	return widget, nil
+}
+
+func expandDashboardAlarmProperties(tfMap map[string]interface{}) *dashboardbody.AlarmProperties {
+	return &dashboardbody.AlarmProperties{
+		Alarms: flex.ExpandStringValueList(tfMap["alarms"].([]interface{})),
+		SortBy: tfMap["sort_by"].(string),
+		States: flex.ExpandStringValueList(tfMap["states"].([]interface{})),
+		Title:  tfMap["title"].(string),
+	}
+}
+
+func expandDashboardExplorerProperties(tfMap map[string]interface{}, region string) *dashboardbody.ExplorerProperties {
+	properties := &dashboardbody.ExplorerProperties{
+		Period:  tfMap["period"].(int),
+		Region:  region,
+		SplitBy: tfMap["split_by"].(string),
+		Title:   tfMap["title"].(string),
+		WidgetOptions: dashboardbody.ExplorerWidgetOptions{
+			RowsPerPage:   tfMap["rows_per_page"].(int),
+			Stacked:       tfMap["stacked"].(bool),
+			View:          tfMap["view"].(string),
+			WidgetsPerRow: tfMap["widgets_per_row"].(int),
+		},
+	}
+
+	if v := tfMap[names.AttrRegion].(string); v != "" {
+		properties.Region = v
+	}
+
+	for _, v := range tfMap["label"].([]interface{}) {
+		tfMap := v.(map[string]interface{})
+		properties.Labels = append(properties.Labels, dashboardbody.ExplorerLabel{
+			Key:   tfMap[names.AttrKey].(string),
+			Value: tfMap[names.AttrValue].(string),
+		})
+	}
+
+	for _, v := range tfMap["metric"].([]interface{}) {
+		tfMap := v.(map[string]interface{})
+		properties.Metrics = append(properties.Metrics, dashboardbody.ExplorerMetric{
+			MetricName:   tfMap[names.AttrMetricName].(string),
+			ResourceType: tfMap[names.AttrResourceType].(string),
+			Stat:         tfMap["stat"].(string),
+		})
+	}
+
+	return properties
+}
+
+func expandDashboardLogProperties(tfMap map[string]interface{}, region string) *dashboardbody.LogProperties {
+	properties := &dashboardbody.LogProperties{
+		Query:   dashboardbody.LogQuery(flex.ExpandStringValueList(tfMap["log_group_names"].([]interface{})), tfMap["query"].(string)),
+		Region:  region,
+		Stacked: tfMap["stacked"].(bool),
+		Title:   tfMap["title"].(string),
+		View:    tfMap["view"].(string),
+	}
+
+	if v := tfMap[names.AttrRegion].(string); v != "" {
+		properties.Region = v
+	}
+
+	return properties
+}
+
+func expandDashboardMetricProperties(tfMap map[string]interface{}, region string) *dashboardbody.MetricProperties {
+	properties := &dashboardbody.MetricProperties{
+		LiveData:             tfMap["live_data"].(bool),
+		Period:               tfMap["period"].(int),
+		Region:               region,
+		SetPeriodToTimeRange: tfMap["set_period_to_time_range"].(bool),
+		Stacked:              tfMap["stacked"].(bool),
+		Stat:                 tfMap["stat"].(string),
+		Title:                tfMap["title"].(string),
+		View:                 tfMap["view"].(string),
+	}
+
+	if v := tfMap[names.AttrRegion].(string); v != "" {
+		properties.Region = v
+	}
+
+	// Metrics are emitted before expressions; the order of the entries doesn't affect evaluation.
+	for _, v := range tfMap["metric"].([]interface{}) {
+		tfMap := v.(map[string]interface{})
+		properties.Metrics = append(properties.Metrics, &dashboardbody.Metric{
+			AccountID:  tfMap[names.AttrAccountID].(string),
+			Color:      tfMap["color"].(string),
+			Dimensions: flex.ExpandStringValueMap(tfMap["dimensions"].(map[string]interface{})),
+			Hidden:     !tfMap["visible"].(bool),
+			ID:         tfMap[names.AttrID].(string),
+			Label:      tfMap["label"].(string),
+			MetricName: tfMap[names.AttrMetricName].(string),
+			Namespace:  tfMap[names.AttrNamespace].(string),
+			Period:     tfMap["period"].(int),
+			Region:     tfMap[names.AttrRegion].(string),
+			Stat:       tfMap["stat"].(string),
+			YAxis:      tfMap["y_axis"].(string),
+		})
+	}
+
+	for _, v := range tfMap[names.AttrExpression].([]interface{}) {
+		tfMap := v.(map[string]interface{})
+		properties.Metrics = append(properties.Metrics, &dashboardbody.Metric{
+			Color:      tfMap["color"].(string),
+			Expression: tfMap[names.AttrExpression].(string),
+			Hidden:     !tfMap["visible"].(bool),
+			ID:         tfMap[names.AttrID].(string),
+			Label:      tfMap["label"].(string),
+			Period:     tfMap["period"].(int),
+			YAxis:      tfMap["y_axis"].(string),
+		})
+	}
+
+	return properties
+}
+
+func expandDashboardTextProperties(tfMap map[string]interface{}) *dashboardbody.TextProperties {
+	return &dashboardbody.TextProperties{
+		Background: tfMap["background"].(string),
+		Markdown:   tfMap["markdown"].(string),
+	}
+}
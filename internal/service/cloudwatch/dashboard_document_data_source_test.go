@@ -0,0 +1,244 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudwatch_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCloudWatchDashboardDocumentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dashboard cloudwatch.GetDashboardOutput
+	dataSourceName := "data.aws_cloudwatch_dashboard_document.test"
+	resourceName := "aws_cloudwatch_dashboard.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDashboardDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardDocumentDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, names.AttrJSON, testAccDashboardDocumentDataSourceConfig_basic_expectedJSON(rName)),
+					testAccCheckDashboardExists(ctx, resourceName, &dashboard),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCloudWatchDashboardDocumentDataSource_invalid(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDashboardDocumentDataSourceConfig_expression("100 * errors / invocations"),
+				ExpectError: regexache.MustCompile(`expression references unknown id "invocations"`),
+			},
+			{
+				Config:      testAccDashboardDocumentDataSourceConfig_expression("AVERAGE(errors)"),
+				ExpectError: regexache.MustCompile(`unknown function "AVERAGE"`),
+			},
+			{
+				Config:      testAccDashboardDocumentDataSourceConfig_noWidgetType,
+				ExpectError: regexache.MustCompile(`exactly one of alarm_status, explorer, log_query, metric or text must be set`),
+			},
+			{
+				Config:      testAccDashboardDocumentDataSourceConfig_period(90),
+				ExpectError: regexache.MustCompile(`period must be 1, 5, 10, 20, 30 or a multiple of 60, got 90`),
+			},
+			{
+				Config:      testAccDashboardDocumentDataSourceConfig_logQuery("stats count(*) by bin(5mins)"),
+				ExpectError: regexache.MustCompile(`invalid time unit "mins" in "5mins"`),
+			},
+		},
+	})
+}
+
+func testAccDashboardDocumentDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+data "aws_cloudwatch_dashboard_document" "test" {
+  widget {
+    width  = 24
+    height = 2
+
+    text {
+      markdown = "# %[1]s"
+    }
+  }
+
+  widget {
+    width = 12
+
+    metric {
+      title  = "Error rate"
+      period = 300
+
+      metric {
+        id          = "errors"
+        namespace   = "AWS/Lambda"
+        metric_name = "Errors"
+        dimensions = {
+          FunctionName = %[1]q
+        }
+        stat    = "Sum"
+        visible = false
+      }
+
+      metric {
+        id          = "invocations"
+        namespace   = "AWS/Lambda"
+        metric_name = "Invocations"
+        dimensions = {
+          FunctionName = %[1]q
+        }
+        stat    = "Sum"
+        visible = false
+      }
+
+      expression {
+        id         = "rate"
+        expression = "100 * errors / invocations"
+        label      = "Error rate (%%)"
+      }
+    }
+  }
+
+  widget {
+    width = 12
+
+    log_query {
+      log_group_names = ["/aws/lambda/%[1]s"]
+      query           = "fields @timestamp, @message | sort @timestamp desc | limit 20"
+      view            = "table"
+    }
+  }
+}
+
+resource "aws_cloudwatch_dashboard" "test" {
+  dashboard_name = %[1]q
+  dashboard_body = data.aws_cloudwatch_dashboard_document.test.json
+}
+`, rName)
+}
+
+func testAccDashboardDocumentDataSourceConfig_basic_expectedJSON(rName string) string {
+	return fmt.Sprintf(`{
+  "widgets": [
+    {
+      "height": 2,
+      "properties": {
+        "markdown": "# %[1]s"
+      },
+      "type": "text",
+      "width": 24,
+      "x": 0,
+      "y": 0
+    },
+    {
+      "height": 6,
+      "properties": {
+        "metrics": [
+          ["AWS/Lambda", "Errors", "FunctionName", %[1]q, {"id": "errors", "stat": "Sum", "visible": false}],
+          ["AWS/Lambda", "Invocations", "FunctionName", %[1]q, {"id": "invocations", "stat": "Sum", "visible": false}],
+          [{"expression": "100 * errors / invocations", "id": "rate", "label": "Error rate (%%)"}]
+        ],
+        "period": 300,
+        "region": %[2]q,
+        "title": "Error rate"
+      },
+      "type": "metric",
+      "width": 12,
+      "x": 0,
+      "y": 2
+    },
+    {
+      "height": 6,
+      "properties": {
+        "query": "SOURCE '/aws/lambda/%[1]s' | fields @timestamp, @message | sort @timestamp desc | limit 20",
+        "region": %[2]q,
+        "view": "table"
+      },
+      "type": "log",
+      "width": 12,
+      "x": 12,
+      "y": 2
+    }
+  ]
+}`, rName, acctest.Region())
+}
+
+func testAccDashboardDocumentDataSourceConfig_expression(expression string) string {
+	return fmt.Sprintf(`
+data "aws_cloudwatch_dashboard_document" "test" {
+  widget {
+    metric {
+      metric {
+        id          = "errors"
+        namespace   = "AWS/Lambda"
+        metric_name = "Errors"
+      }
+
+      expression {
+        id         = "e1"
+        expression = %[1]q
+      }
+    }
+  }
+}
+`, expression)
+}
+
+const testAccDashboardDocumentDataSourceConfig_noWidgetType = `
+data "aws_cloudwatch_dashboard_document" "test" {
+  widget {
+    width = 12
+  }
+}
+`
+
+func testAccDashboardDocumentDataSourceConfig_period(period int) string {
+	return fmt.Sprintf(`
+data "aws_cloudwatch_dashboard_document" "test" {
+  widget {
+    metric {
+      period = %[1]d
+
+      metric {
+        namespace   = "AWS/Lambda"
+        metric_name = "Errors"
+      }
+    }
+  }
+}
+`, period)
+}
+
+func testAccDashboardDocumentDataSourceConfig_logQuery(query string) string {
+	return fmt.Sprintf(`
+data "aws_cloudwatch_dashboard_document" "test" {
+  widget {
+    log_query {
+      log_group_names = ["/aws/lambda/test"]
+      query           = %[1]q
+    }
+  }
+}
+`, query)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -78,6 +79,36 @@ func TestAccCloudWatchDashboard_disappears(t *testing.T) {
 	})
 }
 
+func TestAccCloudWatchDashboard_equivalentBody(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dashboard cloudwatch.GetDashboardOutput
+	resourceName := "aws_cloudwatch_dashboard.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CloudWatchServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDashboardDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDashboardConfig_basic(rName, twoWidgets),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckDashboardExists(ctx, resourceName, &dashboard),
+				),
+			},
+			{
+				Config: testAccDashboardConfig_basic(rName, twoWidgetsReordered),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+		},
+	})
+}
+
 func testAccCheckDashboardExists(ctx context.Context, n string, v *cloudwatch.GetDashboardOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -157,6 +188,55 @@ const (
 }`
 )
 
+const (
+	twoWidgets = `{
+  "widgets": [
+    {
+      "type": "text",
+      "x": 0,
+      "y": 0,
+      "width": 6,
+      "height": 6,
+      "properties": {
+        "markdown": "First"
+      }
+    },
+    {
+      "type": "text",
+      "x": 6,
+      "y": 0,
+      "width": 6,
+      "height": 6,
+      "properties": {
+        "markdown": "Second"
+      }
+    }
+  ]
+}`
+
+	// Same dashboard: widgets in a different order, default dimensions omitted.
+	twoWidgetsReordered = `{
+  "widgets": [
+    {
+      "type": "text",
+      "x": 6,
+      "y": 0,
+      "properties": {
+        "markdown": "Second"
+      }
+    },
+    {
+      "type": "text",
+      "x": 0,
+      "y": 0,
+      "properties": {
+        "markdown": "First"
+      }
+    }
+  ]
+}`
+)
+
 func testAccDashboardConfig_basic(rName, body string) string {
 	return fmt.Sprintf(`
 resource "aws_cloudwatch_dashboard" "test" {
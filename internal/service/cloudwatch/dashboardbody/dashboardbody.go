@@ -0,0 +1,464 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package dashboardbody builds, lays out and compares CloudWatch dashboard bodies.
+//
+// See https://docs.aws.amazon.com/AmazonCloudWatch/latest/APIReference/CloudWatch-Dashboard-Body-Structure.html.
+package dashboardbody
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/service/logs/insightsquery"
+)
+
+const (
+	// GridWidth is the number of columns in the dashboard grid.
+	GridWidth = 24
+	// MaxWidgetHeight is the maximum height of a widget, in grid units.
+	MaxWidgetHeight = 1000
+
+	DefaultWidgetHeight = 6
+	DefaultWidgetWidth  = 6
+)
+
+const (
+	WidgetTypeAlarm    = "alarm"
+	WidgetTypeExplorer = "explorer"
+	WidgetTypeLog      = "log"
+	WidgetTypeMetric   = "metric"
+	WidgetTypeText     = "text"
+)
+
+// Body is a dashboard body.
+// Fields are declared in JSON name order so that the encoding is canonical.
+type Body struct {
+	End            string    `json:"end,omitempty"`
+	PeriodOverride string    `json:"periodOverride,omitempty"`
+	Start          string    `json:"start,omitempty"`
+	Widgets        []*Widget `json:"widgets"`
+}
+
+// Widget is a dashboard widget. X and Y are nil until the widget has been placed.
+type Widget struct {
+	Height     int        `json:"height"`
+	Properties Properties `json:"properties"`
+	Type       string     `json:"type"`
+	Width      int        `json:"width"`
+	X          *int       `json:"x,omitempty"`
+	Y          *int       `json:"y,omitempty"`
+}
+
+// Properties are the type-specific properties of a widget.
+type Properties interface {
+	validate() error
+}
+
+type AlarmProperties struct {
+	Alarms []string `json:"alarms"`
+	SortBy string   `json:"sortBy,omitempty"`
+	States []string `json:"states,omitempty"`
+	Title  string   `json:"title,omitempty"`
+}
+
+type ExplorerProperties struct {
+	Labels        []ExplorerLabel       `json:"labels"`
+	Metrics       []ExplorerMetric      `json:"metrics"`
+	Period        int                   `json:"period,omitempty"`
+	Region        string                `json:"region,omitempty"`
+	SplitBy       string                `json:"splitBy,omitempty"`
+	Title         string                `json:"title,omitempty"`
+	WidgetOptions ExplorerWidgetOptions `json:"widgetOptions"`
+}
+
+type ExplorerLabel struct {
+	Key   string `json:"key"`
+	Value string `json:"value,omitempty"`
+}
+
+type ExplorerMetric struct {
+	MetricName   string `json:"metricName"`
+	ResourceType string `json:"resourceType"`
+	Stat         string `json:"stat"`
+}
+
+type ExplorerWidgetOptions struct {
+	RowsPerPage   int    `json:"rowsPerPage,omitempty"`
+	Stacked       bool   `json:"stacked,omitempty"`
+	View          string `json:"view,omitempty"`
+	WidgetsPerRow int    `json:"widgetsPerRow,omitempty"`
+}
+
+type LogProperties struct {
+	Query   string `json:"query"`
+	Region  string `json:"region"`
+	Stacked bool   `json:"stacked,omitempty"`
+	Title   string `json:"title,omitempty"`
+	View    string `json:"view,omitempty"`
+}
+
+type MetricProperties struct {
+	LiveData             bool      `json:"liveData,omitempty"`
+	Metrics              []*Metric `json:"metrics"`
+	Period               int       `json:"period,omitempty"`
+	Region               string    `json:"region"`
+	SetPeriodToTimeRange bool      `json:"setPeriodToTimeRange,omitempty"`
+	Stacked              bool      `json:"stacked,omitempty"`
+	Stat                 string    `json:"stat,omitempty"`
+	Title                string    `json:"title,omitempty"`
+	View                 string    `json:"view,omitempty"`
+}
+
+type TextProperties struct {
+	Background string `json:"background,omitempty"`
+	Markdown   string `json:"markdown"`
+}
+
+// Metric is an entry in a metric widget's metrics array: either a metric or a metric math expression.
+type Metric struct {
+	AccountID  string
+	Color      string
+	Dimensions map[string]string
+	Expression string
+	Hidden     bool
+	ID         string
+	Label      string
+	MetricName string
+	Namespace  string
+	Period     int
+	Region     string
+	Stat       string
+	YAxis      string
+}
+
+type metricOptions struct {
+	AccountID  string `json:"accountId,omitempty"`
+	Color      string `json:"color,omitempty"`
+	Expression string `json:"expression,omitempty"`
+	ID         string `json:"id,omitempty"`
+	Label      string `json:"label,omitempty"`
+	Period     int    `json:"period,omitempty"`
+	Region     string `json:"region,omitempty"`
+	Stat       string `json:"stat,omitempty"`
+	Visible    *bool  `json:"visible,omitempty"`
+	YAxis      string `json:"yAxis,omitempty"`
+}
+
+// MarshalJSON encodes the metric in the dashboard array form:
+// [namespace, metric name, dimension name, dimension value, ..., {options}].
+func (m *Metric) MarshalJSON() ([]byte, error) {
+	options := metricOptions{
+		AccountID:  m.AccountID,
+		Color:      m.Color,
+		Expression: m.Expression,
+		ID:         m.ID,
+		Label:      m.Label,
+		Period:     m.Period,
+		Region:     m.Region,
+		Stat:       m.Stat,
+		YAxis:      m.YAxis,
+	}
+	if m.Hidden {
+		visible := false
+		options.Visible = &visible
+	}
+
+	var elems []any
+	if !m.isExpression() {
+		elems = append(elems, m.Namespace, m.MetricName)
+		for _, k := range slices.Sorted(maps.Keys(m.Dimensions)) {
+			elems = append(elems, k, m.Dimensions[k])
+		}
+	}
+	if options != (metricOptions{}) {
+		elems = append(elems, options)
+	}
+
+	return json.Marshal(elems)
+}
+
+func (m *Metric) isExpression() bool {
+	return m.Expression != ""
+}
+
+// LogQuery returns a Logs Insights query string that queries the specified log groups.
+func LogQuery(logGroupNames []string, query string) string {
+	var sb strings.Builder
+
+	for _, v := range logGroupNames {
+		sb.WriteString("SOURCE '")
+		sb.WriteString(v)
+		sb.WriteString("' | ")
+	}
+	sb.WriteString(query)
+
+	return sb.String()
+}
+
+// Validate checks the body's widgets and their properties.
+func (b *Body) Validate() error {
+	var errs []error
+
+	if len(b.Widgets) == 0 {
+		errs = append(errs, errors.New("at least one widget is required"))
+	}
+
+	for i, w := range b.Widgets {
+		if err := w.validate(); err != nil {
+			errs = append(errs, fmt.Errorf("widget %d: %w", i, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (w *Widget) validate() error {
+	var errs []error
+
+	if w.Width < 1 || w.Width > GridWidth {
+		errs = append(errs, fmt.Errorf("width must be between 1 and %d, got %d", GridWidth, w.Width))
+	}
+	if w.Height < 1 || w.Height > MaxWidgetHeight {
+		errs = append(errs, fmt.Errorf("height must be between 1 and %d, got %d", MaxWidgetHeight, w.Height))
+	}
+	if (w.X == nil) != (w.Y == nil) {
+		errs = append(errs, errors.New("x and y must be set together"))
+	}
+	if w.X != nil && (*w.X < 0 || *w.X+w.Width > GridWidth) {
+		errs = append(errs, fmt.Errorf("widget at x %d with width %d does not fit in the %d column grid", *w.X, w.Width, GridWidth))
+	}
+	if w.Y != nil && *w.Y < 0 {
+		errs = append(errs, fmt.Errorf("y must not be negative, got %d", *w.Y))
+	}
+
+	if w.Properties == nil {
+		errs = append(errs, errors.New("properties are required"))
+	} else if err := w.Properties.validate(); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+func (p *AlarmProperties) validate() error {
+	var errs []error
+
+	if n := len(p.Alarms); n < 1 || n > 100 {
+		errs = append(errs, fmt.Errorf("between 1 and 100 alarms are required, got %d", n))
+	}
+	for _, v := range p.States {
+		if !slices.Contains([]string{"ALARM", "INSUFFICIENT_DATA", "OK"}, v) {
+			errs = append(errs, fmt.Errorf("unsupported alarm state %q", v))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (p *ExplorerProperties) validate() error {
+	var errs []error
+
+	if len(p.Metrics) == 0 {
+		errs = append(errs, errors.New("at least one metric is required"))
+	}
+	for i, m := range p.Metrics {
+		if err := ValidateStat(m.Stat); err != nil {
+			errs = append(errs, fmt.Errorf("metric %d: %w", i, err))
+		}
+	}
+	if len(p.Labels) == 0 {
+		errs = append(errs, errors.New("at least one label is required"))
+	}
+	if p.Period != 0 {
+		if err := ValidatePeriod(p.Period); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (p *LogProperties) validate() error {
+	var errs []error
+
+	if p.Query == "" {
+		errs = append(errs, errors.New("query is required"))
+	} else if err := insightsquery.Validate(p.Query); err != nil {
+		errs = append(errs, fmt.Errorf("query: %w", err))
+	}
+	if p.Region == "" {
+		errs = append(errs, errors.New("region is required"))
+	}
+
+	return errors.Join(errs...)
+}
+
+func (p *MetricProperties) validate() error {
+	var errs []error
+
+	if p.Region == "" {
+		errs = append(errs, errors.New("region is required"))
+	}
+	if p.Period != 0 {
+		if err := ValidatePeriod(p.Period); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if p.Stat != "" {
+		if err := ValidateStat(p.Stat); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if p.SetPeriodToTimeRange {
+		// The period of the metrics is the dashboard's time range, so a fixed period would be ignored.
+		if p.Period != 0 {
+			errs = append(errs, errors.New("period cannot be set when setPeriodToTimeRange is true"))
+		}
+		if p.View == "" || p.View == "timeSeries" {
+			errs = append(errs, errors.New(`setPeriodToTimeRange can only be used with the "singleValue", "bar" or "pie" views`))
+		}
+	}
+
+	if err := ValidateMetrics(p.Metrics); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+func (p *TextProperties) validate() error {
+	if p.Markdown == "" {
+		return errors.New("markdown is required")
+	}
+
+	return nil
+}
+
+// Layout assigns a position to every widget that doesn't have one.
+// Widgets are placed in order at the first free position, scanning the
+// 24-column grid left to right and then top to bottom, around any widgets
+// that already have a position.
+func (b *Body) Layout() {
+	var occupied [][GridWidth]bool
+
+	fits := func(x, y, width, height int) bool {
+		if x+width > GridWidth {
+			return false
+		}
+		for row := y; row < y+height && row < len(occupied); row++ {
+			for col := x; col < x+width; col++ {
+				if occupied[row][col] {
+					return false
+				}
+			}
+		}
+		return true
+	}
+	occupy := func(x, y, width, height int) {
+		for len(occupied) < y+height {
+			occupied = append(occupied, [GridWidth]bool{})
+		}
+		for row := y; row < y+height; row++ {
+			for col := max(x, 0); col < min(x+width, GridWidth); col++ {
+				occupied[row][col] = true
+			}
+		}
+	}
+
+	for _, w := range b.Widgets {
+		if w.X != nil && w.Y != nil {
+			occupy(*w.X, *w.Y, w.Width, w.Height)
+		}
+	}
+
+	for _, w := range b.Widgets {
+		if w.X != nil && w.Y != nil {
+			continue
+		}
+
+		width := min(max(w.Width, 1), GridWidth)
+	place:
+		for y := 0; ; y++ {
+			for x := 0; x+width <= GridWidth; x++ {
+				if fits(x, y, width, w.Height) {
+					w.X, w.Y = &x, &y
+					occupy(x, y, width, w.Height)
+					break place
+				}
+			}
+		}
+	}
+}
+
+// Equivalent reports whether two dashboard bodies are semantically equivalent.
+// Besides JSON formatting and object key order, the comparison ignores omitted
+// default widget dimensions and, when every widget has an explicit position,
+// the order of the widgets.
+func Equivalent(a, b string) bool {
+	va, err := canonicalize(a)
+	if err != nil {
+		return false
+	}
+
+	vb, err := canonicalize(b)
+	if err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(va, vb)
+}
+
+func canonicalize(body string) (any, error) {
+	var v any
+	if err := json.Unmarshal([]byte(body), &v); err != nil {
+		return nil, err
+	}
+
+	m, ok := v.(map[string]any)
+	if !ok {
+		return v, nil
+	}
+	widgets, ok := m["widgets"].([]any)
+	if !ok {
+		return v, nil
+	}
+
+	positioned := true
+	for _, widget := range widgets {
+		w, ok := widget.(map[string]any)
+		if !ok {
+			return m, nil
+		}
+
+		if _, ok := w["width"]; !ok {
+			w["width"] = float64(DefaultWidgetWidth)
+		}
+		if _, ok := w["height"]; !ok {
+			w["height"] = float64(DefaultWidgetHeight)
+		}
+
+		_, okX := w["x"].(float64)
+		_, okY := w["y"].(float64)
+		positioned = positioned && okX && okY
+	}
+
+	// Widgets with explicit positions are rendered the same regardless of their order in the body.
+	if positioned {
+		slices.SortStableFunc(widgets, func(a, b any) int {
+			wa, wb := a.(map[string]any), b.(map[string]any)
+			return cmp.Or(
+				cmp.Compare(wa["y"].(float64), wb["y"].(float64)),
+				cmp.Compare(wa["x"].(float64), wb["x"].(float64)),
+			)
+		})
+	}
+
+	return m, nil
+}
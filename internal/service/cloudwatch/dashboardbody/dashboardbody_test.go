@@ -0,0 +1,272 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dashboardbody
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestBodyValidate(t *testing.T) {
+	t.Parallel()
+
+	metricWidget := func(properties *MetricProperties) *Widget {
+		return &Widget{Type: WidgetTypeMetric, Width: 6, Height: 6, Properties: properties}
+	}
+	cpu := &Metric{Namespace: "AWS/EC2", MetricName: "CPUUtilization"}
+
+	testCases := map[string]struct {
+		body    *Body
+		wantErr string
+	}{
+		"valid": {
+			body: &Body{Widgets: []*Widget{
+				metricWidget(&MetricProperties{Metrics: []*Metric{cpu}, Period: 300, Region: "us-west-2", Stat: "p99"}), //lintignore:AWSAT003
+				{Type: WidgetTypeText, Width: 24, Height: 2, Properties: &TextProperties{Markdown: "# Title"}},
+				{Type: WidgetTypeAlarm, Width: 6, Height: 3, Properties: &AlarmProperties{Alarms: []string{"arn"}, States: []string{"ALARM"}}},
+				{Type: WidgetTypeLog, Width: 12, Height: 6, Properties: &LogProperties{Query: "fields @message", Region: "us-west-2"}}, //lintignore:AWSAT003
+				{Type: WidgetTypeExplorer, Width: 24, Height: 15, Properties: &ExplorerProperties{
+					Labels:  []ExplorerLabel{{Key: "Env", Value: "prod"}},
+					Metrics: []ExplorerMetric{{MetricName: "CPUUtilization", ResourceType: "AWS::EC2::Instance", Stat: "Average"}},
+				}},
+			}},
+		},
+		"no widgets": {
+			body:    &Body{},
+			wantErr: "at least one widget is required",
+		},
+		"too wide": {
+			body:    &Body{Widgets: []*Widget{{Type: WidgetTypeText, Width: 25, Height: 1, Properties: &TextProperties{Markdown: "x"}}}},
+			wantErr: "widget 0: width must be between 1 and 24",
+		},
+		"does not fit": {
+			body:    &Body{Widgets: []*Widget{{Type: WidgetTypeText, Width: 6, Height: 1, X: intPtr(20), Y: intPtr(0), Properties: &TextProperties{Markdown: "x"}}}},
+			wantErr: "does not fit in the 24 column grid",
+		},
+		"x without y": {
+			body:    &Body{Widgets: []*Widget{{Type: WidgetTypeText, Width: 6, Height: 1, X: intPtr(0), Properties: &TextProperties{Markdown: "x"}}}},
+			wantErr: "x and y must be set together",
+		},
+		"invalid period": {
+			body:    &Body{Widgets: []*Widget{metricWidget(&MetricProperties{Metrics: []*Metric{cpu}, Period: 90, Region: "r"})}},
+			wantErr: "period must be 1, 5, 10, 20, 30 or a multiple of 60, got 90",
+		},
+		"invalid stat": {
+			body:    &Body{Widgets: []*Widget{metricWidget(&MetricProperties{Metrics: []*Metric{cpu}, Region: "r", Stat: "Avg"})}},
+			wantErr: `unsupported statistic "Avg"`,
+		},
+		"period with setPeriodToTimeRange": {
+			body:    &Body{Widgets: []*Widget{metricWidget(&MetricProperties{Metrics: []*Metric{cpu}, Period: 60, Region: "r", SetPeriodToTimeRange: true, View: "singleValue"})}},
+			wantErr: "period cannot be set when setPeriodToTimeRange is true",
+		},
+		"setPeriodToTimeRange with time series": {
+			body:    &Body{Widgets: []*Widget{metricWidget(&MetricProperties{Metrics: []*Metric{cpu}, Region: "r", SetPeriodToTimeRange: true})}},
+			wantErr: "setPeriodToTimeRange can only be used",
+		},
+		"missing region": {
+			body:    &Body{Widgets: []*Widget{metricWidget(&MetricProperties{Metrics: []*Metric{cpu}})}},
+			wantErr: "region is required",
+		},
+		"invalid log query": {
+			body:    &Body{Widgets: []*Widget{{Type: WidgetTypeLog, Width: 12, Height: 6, Properties: &LogProperties{Query: "SOURCE 'a' | stats count(*) by bin(5mins)", Region: "r"}}}},
+			wantErr: `query: at offset 36: invalid time unit "mins"`,
+		},
+		"invalid alarm state": {
+			body:    &Body{Widgets: []*Widget{{Type: WidgetTypeAlarm, Width: 6, Height: 3, Properties: &AlarmProperties{Alarms: []string{"arn"}, States: []string{"alarm"}}}}},
+			wantErr: `unsupported alarm state "alarm"`,
+		},
+		"explorer without labels": {
+			body: &Body{Widgets: []*Widget{{Type: WidgetTypeExplorer, Width: 6, Height: 3, Properties: &ExplorerProperties{
+				Metrics: []ExplorerMetric{{MetricName: "CPUUtilization", ResourceType: "AWS::EC2::Instance", Stat: "Average"}},
+			}}}},
+			wantErr: "at least one label is required",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.body.Validate()
+
+			if testCase.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), testCase.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", testCase.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestBodyLayout(t *testing.T) {
+	t.Parallel()
+
+	type position struct{ x, y int }
+
+	testCases := map[string]struct {
+		widgets []*Widget
+		want    []position
+	}{
+		"flow": {
+			widgets: []*Widget{
+				{Width: 12, Height: 6},
+				{Width: 12, Height: 6},
+				{Width: 8, Height: 3},
+				{Width: 24, Height: 2},
+			},
+			want: []position{{0, 0}, {12, 0}, {0, 6}, {0, 9}},
+		},
+		"fill gaps": {
+			widgets: []*Widget{
+				{Width: 6, Height: 12},
+				{Width: 18, Height: 6},
+				{Width: 18, Height: 6},
+				{Width: 6, Height: 6},
+			},
+			want: []position{{0, 0}, {6, 0}, {6, 6}, {0, 12}},
+		},
+		"around explicit": {
+			widgets: []*Widget{
+				{Width: 12, Height: 6},
+				{Width: 12, Height: 4, X: intPtr(6), Y: intPtr(0)},
+				{Width: 6, Height: 6},
+			},
+			want: []position{{0, 4}, {6, 0}, {18, 0}},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			body := &Body{Widgets: testCase.widgets}
+			body.Layout()
+
+			for i, w := range body.Widgets {
+				if w.X == nil || w.Y == nil {
+					t.Fatalf("widget %d was not placed", i)
+				}
+				if got := (position{*w.X, *w.Y}); got != testCase.want[i] {
+					t.Errorf("widget %d: got position %v, want %v", i, got, testCase.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestBodyMarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	body := &Body{
+		PeriodOverride: "inherit",
+		Widgets: []*Widget{
+			{
+				Type:   WidgetTypeMetric,
+				Width:  12,
+				Height: 6,
+				Properties: &MetricProperties{
+					Metrics: []*Metric{
+						{Namespace: "AWS/EC2", MetricName: "CPUUtilization", Dimensions: map[string]string{"InstanceId": "i-1", "AutoScalingGroupName": "asg"}, ID: "m1", Hidden: true},
+						{Expression: "m1 * 100", ID: "e1", Label: "Scaled"},
+					},
+					Region: "us-west-2", //lintignore:AWSAT003
+					Stat:   "Average",
+				},
+			},
+			{
+				Type:       WidgetTypeText,
+				Width:      12,
+				Height:     6,
+				Properties: &TextProperties{Markdown: "Hello"},
+			},
+		},
+	}
+	body.Layout()
+
+	got, err := json.Marshal(body)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := `{"periodOverride":"inherit","widgets":[` +
+		`{"height":6,"properties":{"metrics":[` +
+		`["AWS/EC2","CPUUtilization","AutoScalingGroupName","asg","InstanceId","i-1",{"id":"m1","visible":false}],` +
+		`[{"expression":"m1 * 100","id":"e1","label":"Scaled"}]` +
+		`],"region":"us-west-2","stat":"Average"},"type":"metric","width":12,"x":0,"y":0},` + //lintignore:AWSAT003
+		`{"height":6,"properties":{"markdown":"Hello"},"type":"text","width":12,"x":12,"y":0}]}`
+
+	if string(got) != want {
+		t.Errorf("got  %s\nwant %s", got, want)
+	}
+}
+
+func TestEquivalent(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		a, b string
+		want bool
+	}{
+		"formatting": {
+			a:    `{"widgets":[{"type":"text","x":0,"y":0,"width":6,"height":6,"properties":{"markdown":"x"}}]}`,
+			b:    "{\n  \"widgets\": [{\"properties\": {\"markdown\": \"x\"}, \"height\": 6, \"width\": 6, \"y\": 0, \"x\": 0, \"type\": \"text\"}]\n}",
+			want: true,
+		},
+		"default dimensions": {
+			a:    `{"widgets":[{"type":"text","x":0,"y":0,"properties":{"markdown":"x"}}]}`,
+			b:    `{"widgets":[{"type":"text","x":0,"y":0,"width":6,"height":6,"properties":{"markdown":"x"}}]}`,
+			want: true,
+		},
+		"different dimensions": {
+			a:    `{"widgets":[{"type":"text","x":0,"y":0,"width":12,"properties":{"markdown":"x"}}]}`,
+			b:    `{"widgets":[{"type":"text","x":0,"y":0,"width":6,"properties":{"markdown":"x"}}]}`,
+			want: false,
+		},
+		"positioned widget order": {
+			a:    `{"widgets":[{"type":"text","x":0,"y":0,"properties":{"markdown":"a"}},{"type":"text","x":6,"y":0,"properties":{"markdown":"b"}}]}`,
+			b:    `{"widgets":[{"type":"text","x":6,"y":0,"properties":{"markdown":"b"}},{"type":"text","x":0,"y":0,"properties":{"markdown":"a"}}]}`,
+			want: true,
+		},
+		"unpositioned widget order": {
+			a:    `{"widgets":[{"type":"text","properties":{"markdown":"a"}},{"type":"text","properties":{"markdown":"b"}}]}`,
+			b:    `{"widgets":[{"type":"text","properties":{"markdown":"b"}},{"type":"text","properties":{"markdown":"a"}}]}`,
+			want: false,
+		},
+		"metric order is significant": {
+			a:    `{"widgets":[{"type":"metric","x":0,"y":0,"properties":{"metrics":[["A","a"],["B","b"]]}}]}`,
+			b:    `{"widgets":[{"type":"metric","x":0,"y":0,"properties":{"metrics":[["B","b"],["A","a"]]}}]}`,
+			want: false,
+		},
+		"differ after non-object widget": {
+			a:    `{"widgets":[null,{"type":"text","x":0,"y":0,"properties":{"markdown":"a"}}]}`,
+			b:    `{"widgets":[null,{"type":"text","x":0,"y":0,"properties":{"markdown":"b"}}]}`,
+			want: false,
+		},
+		"invalid JSON": {
+			a:    `{"widgets":[]}`,
+			b:    `{"widgets":`,
+			want: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := Equivalent(testCase.a, testCase.b); got != testCase.want {
+				t.Errorf("Equivalent() = %t, want %t", got, testCase.want)
+			}
+		})
+	}
+}
+
+func intPtr(v int) *int {
+	return &v
+}
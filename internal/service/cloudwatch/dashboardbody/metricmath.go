@@ -0,0 +1,503 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dashboardbody
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// metricMathFunctions are the functions supported in metric math expressions.
+// See https://docs.aws.amazon.com/AmazonCloudWatch/latest/monitoring/using-metric-math.html#metric-math-syntax.
+var metricMathFunctions = []string{
+	"ABS",
+	"ANOMALY_DETECTION_BAND",
+	"AVG",
+	"CEIL",
+	"DATAPOINT_COUNT",
+	"DAY",
+	"DB_PERF_INSIGHTS",
+	"DIFF",
+	"DIFF_TIME",
+	"EPOCH",
+	"EXP",
+	"FILL",
+	"FIRST",
+	"FLOOR",
+	"HOUR",
+	"IF",
+	"INSIGHT_RULE_METRIC",
+	"LAMBDA",
+	"LAST",
+	"LOG",
+	"LOG10",
+	"MAX",
+	"METRIC_COUNT",
+	"METRICS",
+	"MIN",
+	"MINUTE",
+	"MONTH",
+	"PERIOD",
+	"RATE",
+	"REMOVE_EMPTY",
+	"RUNNING_SUM",
+	"SEARCH",
+	"SERVICE_QUOTA",
+	"SLICE",
+	"SORT",
+	"SQRT",
+	"STDDEV",
+	"SUM",
+	"TIME_SERIES",
+	"YEAR",
+}
+
+// metricMathKeywords are the bare words that can be used as function arguments, e.g. FILL(m1, REPEAT).
+var metricMathKeywords = []string{
+	"ASC",
+	"DESC",
+	"LINEAR",
+	"REPEAT",
+}
+
+// ValidatePeriod checks that period is a supported metric period in seconds:
+// 1, 5, 10, 20 or 30 for high-resolution metrics, or any multiple of 60.
+func ValidatePeriod(period int) error {
+	if slices.Contains([]int{1, 5, 10, 20, 30}, period) || (period > 0 && period%60 == 0) {
+		return nil
+	}
+
+	return fmt.Errorf("period must be 1, 5, 10, 20, 30 or a multiple of 60, got %d", period)
+}
+
+// ValidateStat checks that stat is a supported statistic or extended statistic.
+// See https://docs.aws.amazon.com/AmazonCloudWatch/latest/monitoring/Statistics-definitions.html.
+func ValidateStat(stat string) error {
+	switch stat {
+	case "Average", "IQM", "Maximum", "Minimum", "SampleCount", "Sum":
+		return nil
+	}
+
+	// Percentile and shorthand forms, e.g. p99, tm90, wm99.9.
+	for _, prefix := range []string{"p", "tc", "tm", "ts", "wm"} {
+		if v, ok := strings.CutPrefix(stat, prefix); ok {
+			if isPercentage(v) {
+				return nil
+			}
+			return fmt.Errorf("statistic %q: %q is not a percentage between 0 and 100", stat, v)
+		}
+	}
+
+	// Range forms, e.g. TM(10%:90%), PR(:300).
+	for _, prefix := range []string{"PR", "TC", "TM", "TS", "WM"} {
+		if v, ok := strings.CutPrefix(stat, prefix+"("); ok {
+			v, ok := strings.CutSuffix(v, ")")
+			if !ok {
+				return fmt.Errorf("statistic %q: missing closing parenthesis", stat)
+			}
+			lower, upper, ok := strings.Cut(v, ":")
+			if !ok || (lower == "" && upper == "") {
+				return fmt.Errorf("statistic %q: range must be of the form lower:upper", stat)
+			}
+			for _, bound := range []string{lower, upper} {
+				if bound == "" {
+					continue
+				}
+				if b, ok := strings.CutSuffix(bound, "%"); ok {
+					if !isPercentage(b) {
+						return fmt.Errorf("statistic %q: %q is not a percentage between 0 and 100", stat, bound)
+					}
+				} else if _, err := strconv.ParseFloat(bound, 64); err != nil {
+					return fmt.Errorf("statistic %q: %q is not a number", stat, bound)
+				}
+			}
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unsupported statistic %q", stat)
+}
+
+func isPercentage(s string) bool {
+	if s == "" || strings.ContainsAny(s, "eE+-") {
+		return false
+	}
+
+	v, err := strconv.ParseFloat(s, 64)
+
+	return err == nil && v >= 0 && v <= 100
+}
+
+// ValidateMetrics checks the entries of a metric widget's metrics array:
+// IDs must be unique and well formed, metric math expressions must parse and
+// may only reference the IDs of other entries, and references must not be circular.
+func ValidateMetrics(metrics []*Metric) error {
+	var errs []error
+
+	if len(metrics) == 0 {
+		errs = append(errs, errors.New("at least one metric or expression is required"))
+	}
+
+	ids := make(map[string]int)
+	for i, m := range metrics {
+		if m.ID == "" {
+			if m.isExpression() {
+				errs = append(errs, fmt.Errorf("metric %d: id is required for an expression", i))
+			}
+			continue
+		}
+		if !isMetricID(m.ID) {
+			errs = append(errs, fmt.Errorf("metric %d: id %q must start with a lowercase letter and contain only letters, numbers and underscores", i, m.ID))
+		}
+		if j, ok := ids[m.ID]; ok {
+			errs = append(errs, fmt.Errorf("metric %d: id %q is already used by metric %d", i, m.ID, j))
+			continue
+		}
+		ids[m.ID] = i
+	}
+
+	references := make(map[string][]string)
+	for i, m := range metrics {
+		if m.Period != 0 {
+			if err := ValidatePeriod(m.Period); err != nil {
+				errs = append(errs, fmt.Errorf("metric %d: %w", i, err))
+			}
+		}
+
+		if !m.isExpression() {
+			if m.Namespace == "" || m.MetricName == "" {
+				errs = append(errs, fmt.Errorf("metric %d: namespace and metric name are required", i))
+			}
+			if m.Stat != "" {
+				if err := ValidateStat(m.Stat); err != nil {
+					errs = append(errs, fmt.Errorf("metric %d: %w", i, err))
+				}
+			}
+			continue
+		}
+
+		if m.Stat != "" {
+			errs = append(errs, fmt.Errorf("metric %d: stat cannot be set on an expression", i))
+		}
+
+		refs, err := ParseExpression(m.Expression)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("metric %d: %w", i, err))
+			continue
+		}
+		for _, ref := range refs {
+			if _, ok := ids[ref]; !ok {
+				errs = append(errs, fmt.Errorf("metric %d: expression references unknown id %q", i, ref))
+			}
+		}
+		references[m.ID] = refs
+	}
+
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	// Depth-first search for circular references between expressions.
+	const (
+		visiting = iota + 1
+		visited
+	)
+	state := make(map[string]int)
+	var visit func(id string, path []string) error
+	visit = func(id string, path []string) error {
+		switch state[id] {
+		case visiting:
+			return fmt.Errorf("circular reference between expressions: %s", strings.Join(append(path, id), " -> "))
+		case visited:
+			return nil
+		}
+
+		state[id] = visiting
+		for _, ref := range references[id] {
+			if err := visit(ref, append(path, id)); err != nil {
+				return err
+			}
+		}
+		state[id] = visited
+
+		return nil
+	}
+	for _, m := range metrics {
+		if m.isExpression() {
+			if err := visit(m.ID, nil); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func isMetricID(s string) bool {
+	for i, r := range s {
+		switch {
+		case r >= 'a' && r <= 'z':
+		case i > 0 && (r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_'):
+		default:
+			return false
+		}
+	}
+
+	return s != ""
+}
+
+// ParseExpression parses a metric math expression and returns the metric IDs it references, in order of first use.
+func ParseExpression(expression string) ([]string, error) {
+	tokens, err := tokenizeExpression(expression)
+	if err != nil {
+		return nil, fmt.Errorf("expression %q: %w", expression, err)
+	}
+
+	p := &expressionParser{tokens: tokens}
+	if err := p.parseExpression(); err != nil {
+		return nil, fmt.Errorf("expression %q: %w", expression, err)
+	}
+	if t := p.peek(); t.kind != tokenEOF {
+		return nil, fmt.Errorf("expression %q: unexpected %s at offset %d", expression, t, t.offset)
+	}
+
+	return p.references, nil
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdentifier
+	tokenNumber
+	tokenOperator
+	tokenPunctuation
+	tokenString
+)
+
+type token struct {
+	kind   tokenKind
+	offset int
+	value  string
+}
+
+func (t token) String() string {
+	if t.kind == tokenEOF {
+		return "end of expression"
+	}
+
+	return strconv.Quote(t.value)
+}
+
+func tokenizeExpression(s string) ([]token, error) {
+	var tokens []token
+
+	for i := 0; i < len(s); {
+		c := s[i]
+		start := i
+
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+			continue
+		case isIdentifierStart(c):
+			for i < len(s) && (isIdentifierStart(s[i]) || isDigit(s[i])) {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenIdentifier, offset: start, value: s[start:i]})
+		case isDigit(c) || (c == '.' && i+1 < len(s) && isDigit(s[i+1])):
+			for i < len(s) && (isDigit(s[i]) || s[i] == '.') {
+				i++
+			}
+			if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+				i++
+				if i < len(s) && (s[i] == '+' || s[i] == '-') {
+					i++
+				}
+				for i < len(s) && isDigit(s[i]) {
+					i++
+				}
+			}
+			if _, err := strconv.ParseFloat(s[start:i], 64); err != nil {
+				return nil, fmt.Errorf("invalid number %q at offset %d", s[start:i], start)
+			}
+			tokens = append(tokens, token{kind: tokenNumber, offset: start, value: s[start:i]})
+		case c == '\'' || c == '"':
+			i++
+			for i < len(s) && s[i] != c {
+				if s[i] == '\\' {
+					i++
+				}
+				i++
+			}
+			if i >= len(s) {
+				return nil, fmt.Errorf("unterminated string starting at offset %d", start)
+			}
+			i++
+			tokens = append(tokens, token{kind: tokenString, offset: start, value: s[start:i]})
+		case strings.HasPrefix(s[i:], "==") || strings.HasPrefix(s[i:], "!=") || strings.HasPrefix(s[i:], "<=") ||
+			strings.HasPrefix(s[i:], ">=") || strings.HasPrefix(s[i:], "&&") || strings.HasPrefix(s[i:], "||"):
+			i += 2
+			tokens = append(tokens, token{kind: tokenOperator, offset: start, value: s[start:i]})
+		case strings.IndexByte("+-*/^<>!", c) >= 0:
+			i++
+			tokens = append(tokens, token{kind: tokenOperator, offset: start, value: s[start:i]})
+		case strings.IndexByte("()[],", c) >= 0:
+			i++
+			tokens = append(tokens, token{kind: tokenPunctuation, offset: start, value: s[start:i]})
+		default:
+			return nil, fmt.Errorf("unexpected character %q at offset %d", c, i)
+		}
+	}
+
+	return append(tokens, token{kind: tokenEOF, offset: len(s)}), nil
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isIdentifierStart(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == '_'
+}
+
+// expressionParser is a recursive descent parser for metric math expressions.
+// Precedence, lowest first: OR/||, AND/&&, comparison, +/-, * and /, unary -/NOT/!, ^.
+type expressionParser struct {
+	pos        int
+	references []string
+	tokens     []token
+}
+
+func (p *expressionParser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *expressionParser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *expressionParser) accept(values ...string) bool {
+	if t := p.peek(); (t.kind == tokenOperator || t.kind == tokenPunctuation || t.kind == tokenIdentifier) && slices.Contains(values, t.value) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *expressionParser) expect(value string) error {
+	if !p.accept(value) {
+		t := p.peek()
+		return fmt.Errorf("expected %q, got %s at offset %d", value, t, t.offset)
+	}
+	return nil
+}
+
+func (p *expressionParser) parseExpression() error {
+	return p.parseBinary(0)
+}
+
+var binaryOperators = [][]string{
+	{"OR", "||"},
+	{"AND", "&&"},
+	{"==", "!=", "<", "<=", ">", ">="},
+	{"+", "-"},
+	{"*", "/"},
+}
+
+func (p *expressionParser) parseBinary(level int) error {
+	if level == len(binaryOperators) {
+		return p.parseUnary()
+	}
+
+	if err := p.parseBinary(level + 1); err != nil {
+		return err
+	}
+	for p.accept(binaryOperators[level]...) {
+		if err := p.parseBinary(level + 1); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (p *expressionParser) parseUnary() error {
+	if p.accept("-", "!", "NOT") {
+		return p.parseUnary()
+	}
+
+	if err := p.parsePrimary(); err != nil {
+		return err
+	}
+	if p.accept("^") {
+		return p.parseUnary()
+	}
+
+	return nil
+}
+
+func (p *expressionParser) parsePrimary() error {
+	t := p.next()
+
+	switch t.kind {
+	case tokenNumber, tokenString:
+		return nil
+	case tokenPunctuation:
+		switch t.value {
+		case "(":
+			if err := p.parseExpression(); err != nil {
+				return err
+			}
+			return p.expect(")")
+		case "[":
+			return p.parseList("]")
+		}
+	case tokenIdentifier:
+		if p.accept("(") {
+			if !slices.Contains(metricMathFunctions, t.value) {
+				return fmt.Errorf("unknown function %q at offset %d", t.value, t.offset)
+			}
+			if p.accept(")") {
+				return nil
+			}
+			return p.parseList(")")
+		}
+
+		// Metric IDs start with a lowercase letter; anything else is a keyword such as a sort order or fill method.
+		if isMetricID(t.value) {
+			if !slices.Contains(p.references, t.value) {
+				p.references = append(p.references, t.value)
+			}
+			return nil
+		}
+		if slices.Contains(metricMathKeywords, t.value) || slices.Contains(metricMathFunctions, t.value) {
+			return nil
+		}
+		return fmt.Errorf("unknown identifier %q at offset %d", t.value, t.offset)
+	}
+
+	return fmt.Errorf("unexpected %s at offset %d", t, t.offset)
+}
+
+func (p *expressionParser) parseList(end string) error {
+	for {
+		if err := p.parseExpression(); err != nil {
+			return err
+		}
+		if p.accept(end) {
+			return nil
+		}
+		if err := p.expect(","); err != nil {
+			return err
+		}
+	}
+}
@@ -0,0 +1,220 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dashboardbody
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestValidateStat(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		stat    string
+		wantErr string
+	}{
+		"Average":         {stat: "Average"},
+		"SampleCount":     {stat: "SampleCount"},
+		"IQM":             {stat: "IQM"},
+		"percentile":      {stat: "p99"},
+		"percentile frac": {stat: "p99.9"},
+		"trimmed mean":    {stat: "tm90"},
+		"range percent":   {stat: "TM(10%:90%)"},
+		"range open":      {stat: "PR(:300)"},
+		"range absolute":  {stat: "TC(0.005:0.030)"},
+		"lowercase":       {stat: "average", wantErr: `unsupported statistic "average"`},
+		"percentile 101":  {stat: "p101", wantErr: "is not a percentage"},
+		"percentile sign": {stat: "p-1", wantErr: "is not a percentage"},
+		"range empty":     {stat: "TM(:)", wantErr: "range must be of the form lower:upper"},
+		"range unclosed":  {stat: "TM(10%:90%", wantErr: "missing closing parenthesis"},
+		"range bad bound": {stat: "WM(x:90%)", wantErr: `"x" is not a number`},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateStat(testCase.stat)
+
+			if testCase.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), testCase.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", testCase.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestParseExpression(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		expression string
+		want       []string
+		wantErr    string
+	}{
+		"arithmetic": {
+			expression: "(m1 + m2) / 2 * 100",
+			want:       []string{"m1", "m2"},
+		},
+		"power and unary": {
+			expression: "-m1 ^ 2",
+			want:       []string{"m1"},
+		},
+		"functions": {
+			expression: "SUM([m1, m2, FILL(m3, REPEAT)])",
+			want:       []string{"m1", "m2", "m3"},
+		},
+		"metrics function": {
+			expression: `SORT(METRICS("errors"), MAX, DESC, 10)`,
+		},
+		"search": {
+			expression: `SEARCH('{AWS/EC2,InstanceId} MetricName="CPUUtilization"', 'Average', 300)`,
+		},
+		"comparison and logic": {
+			expression: "IF(m1 > 10 AND m2 != 0, m1 / m2, 0)",
+			want:       []string{"m1", "m2"},
+		},
+		"repeated reference": {
+			expression: "m1 + m1",
+			want:       []string{"m1"},
+		},
+		"unknown function": {
+			expression: "AVERAGE(m1)",
+			wantErr:    `unknown function "AVERAGE" at offset 0`,
+		},
+		"unknown identifier": {
+			expression: "M1 + 1",
+			wantErr:    `unknown identifier "M1"`,
+		},
+		"unbalanced parentheses": {
+			expression: "(m1 + m2",
+			wantErr:    `expected ")", got end of expression`,
+		},
+		"trailing operator": {
+			expression: "m1 +",
+			wantErr:    "unexpected end of expression",
+		},
+		"missing operator": {
+			expression: "m1 m2",
+			wantErr:    `unexpected "m2" at offset 3`,
+		},
+		"unterminated string": {
+			expression: "SEARCH('x",
+			wantErr:    "unterminated string",
+		},
+		"invalid character": {
+			expression: "m1 % 2",
+			wantErr:    "unexpected character",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseExpression(testCase.expression)
+
+			if testCase.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", testCase.wantErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !slices.Equal(got, testCase.want) {
+				t.Errorf("got references %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestValidateMetrics(t *testing.T) {
+	t.Parallel()
+
+	metric := func(id string) *Metric {
+		return &Metric{Namespace: "AWS/Lambda", MetricName: "Errors", ID: id}
+	}
+	expression := func(id, expression string) *Metric {
+		return &Metric{Expression: expression, ID: id}
+	}
+
+	testCases := map[string]struct {
+		metrics []*Metric
+		wantErr string
+	}{
+		"valid": {
+			metrics: []*Metric{metric("errors"), metric("invocations"), expression("rate", "100 * errors / invocations"), expression("alert", "IF(rate > 5, 1, 0)")},
+		},
+		"empty": {
+			wantErr: "at least one metric or expression is required",
+		},
+		"expression without id": {
+			metrics: []*Metric{metric("m1"), expression("", "m1 * 2")},
+			wantErr: "metric 1: id is required for an expression",
+		},
+		"invalid id": {
+			metrics: []*Metric{metric("M1")},
+			wantErr: `id "M1" must start with a lowercase letter`,
+		},
+		"duplicate id": {
+			metrics: []*Metric{metric("m1"), metric("m1")},
+			wantErr: `metric 1: id "m1" is already used by metric 0`,
+		},
+		"unknown reference": {
+			metrics: []*Metric{metric("m1"), expression("e1", "m1 + m2")},
+			wantErr: `metric 1: expression references unknown id "m2"`,
+		},
+		"circular reference": {
+			metrics: []*Metric{expression("e1", "e2 + 1"), expression("e2", "e1 * 2")},
+			wantErr: "circular reference between expressions: e1 -> e2 -> e1",
+		},
+		"self reference": {
+			metrics: []*Metric{expression("e1", "e1 + 1")},
+			wantErr: "circular reference between expressions: e1 -> e1",
+		},
+		"stat on expression": {
+			metrics: []*Metric{metric("m1"), {Expression: "m1 * 2", ID: "e1", Stat: "Sum"}},
+			wantErr: "metric 1: stat cannot be set on an expression",
+		},
+		"invalid metric period": {
+			metrics: []*Metric{{Namespace: "AWS/Lambda", MetricName: "Errors", Period: 45}},
+			wantErr: "metric 0: period must be",
+		},
+		"missing metric name": {
+			metrics: []*Metric{{Namespace: "AWS/Lambda"}},
+			wantErr: "metric 0: namespace and metric name are required",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateMetrics(testCase.metrics)
+
+			if testCase.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), testCase.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", testCase.wantErr, err)
+			}
+		})
+	}
+}
@@ -7,6 +7,8 @@ import (
 	"fmt"
 
 	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/cloudwatch/dashboardbody"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/logs/insightsquery"
 )
 
 func validDashboardName(v interface{}, k string) (ws []string, errors []error) {
@@ -27,6 +29,38 @@ func validDashboardName(v interface{}, k string) (ws []string, errors []error) {
 	return
 }
 
+func validDashboardPeriod(v interface{}, k string) (ws []string, errors []error) {
+	if err := dashboardbody.ValidatePeriod(v.(int)); err != nil {
+		errors = append(errors, fmt.Errorf("%q: %w", k, err))
+	}
+
+	return
+}
+
+func validDashboardStat(v interface{}, k string) (ws []string, errors []error) {
+	if err := dashboardbody.ValidateStat(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q: %w", k, err))
+	}
+
+	return
+}
+
+func validMetricMathExpression(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := dashboardbody.ParseExpression(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q: %w", k, err))
+	}
+
+	return
+}
+
+func validLogsInsightsQuery(v interface{}, k string) (ws []string, errors []error) {
+	if err := insightsquery.Validate(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q: %w", k, err))
+	}
+
+	return
+}
+
 func validEC2AutomateARN(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 
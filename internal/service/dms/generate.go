@@ -3,7 +3,9 @@
 
 //go:generate go run ../../generate/tags/main.go -ListTags -ListTagsOutTagsElem=TagList -ServiceTagsSlice -TagOp=AddTagsToResource -UntagOp=RemoveTagsFromResource -UpdateTags
 //go:generate go run ../../generate/servicepackage/main.go
+//go:generate go run ../../generate/basictests/main.go
 //go:generate go run ../../generate/tagstests/main.go
+//go:generate go run ../../generate/writeonlytests/main.go
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package dms
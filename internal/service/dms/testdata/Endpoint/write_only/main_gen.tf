@@ -0,0 +1,34 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_dms_endpoint" "test" {
+  endpoint_id         = var.rName
+  endpoint_type       = "source"
+  engine_name         = "postgres"
+  server_name         = "tftest"
+  port                = 27017
+  username            = "tftest"
+  password_wo         = var.secret
+  password_wo_version = var.secret_version
+  database_name       = "tftest"
+  ssl_mode            = "none"
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+
+variable "secret" {
+  description = "Value of the write-only attribute"
+  type        = string
+  nullable    = false
+  sensitive   = true
+}
+
+variable "secret_version" {
+  description = "Version of the write-only attribute"
+  type        = number
+  nullable    = false
+}
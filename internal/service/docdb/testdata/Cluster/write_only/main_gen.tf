@@ -0,0 +1,29 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_docdb_cluster" "test" {
+  cluster_identifier         = var.rName
+  master_username            = "tfacctest"
+  master_password_wo         = var.secret
+  master_password_wo_version = var.secret_version
+  skip_final_snapshot        = true
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+
+variable "secret" {
+  description = "Value of the write-only attribute"
+  type        = string
+  nullable    = false
+  sensitive   = true
+}
+
+variable "secret_version" {
+  description = "Version of the write-only attribute"
+  type        = number
+  nullable    = false
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/directoryservice"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/directoryservice/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -35,6 +36,10 @@ const (
 
 // @SDKResource("aws_directory_service_directory", name="Directory")
 // @Tags(identifierAttribute="id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/directoryservice/types;awstypes;awstypes.DirectoryDescription")
+// @Testing(generator="acctest.RandomDomainName()")
+// @Testing(writeOnlyAttribute="password_wo")
+// @Testing(writeOnlyReplace=true)
 func resourceDirectory() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceDirectoryCreate,
@@ -143,10 +148,24 @@ func resourceDirectory() *schema.Resource {
 				ValidateFunc: domainValidator,
 			},
 			names.AttrPassword: {
-				Type:      schema.TypeString,
-				Required:  true,
-				ForceNew:  true,
-				Sensitive: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{names.AttrPassword, "password_wo"},
+			},
+			"password_wo": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				WriteOnly:    true,
+				ExactlyOneOf: []string{names.AttrPassword, "password_wo"},
+			},
+			"password_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"password_wo"},
 			},
 			"security_group_id": {
 				Type:     schema.TypeString,
@@ -203,6 +222,10 @@ func resourceDirectory() *schema.Resource {
 		},
 
 		CustomizeDiff: verify.SetTagsDiff,
+
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			validation.PreferWriteOnlyAttribute(cty.GetAttrPath(names.AttrPassword), cty.GetAttrPath("password_wo")),
+		},
 	}
 }
 
@@ -395,6 +418,21 @@ type directoryCreator interface {
 	Create(ctx context.Context, conn *directoryservice.Client, name string, d *schema.ResourceData) error
 }
 
+// directoryPassword returns the directory administrator password, preferring the write-only value.
+func directoryPassword(d *schema.ResourceData) (string, error) {
+	// get write-only value from configuration
+	passwordWO, diags := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("password_wo"))
+	if diags.HasError() {
+		return "", sdkdiag.DiagnosticsError(diags)
+	}
+
+	if passwordWO != "" {
+		return passwordWO, nil
+	}
+
+	return d.Get(names.AttrPassword).(string), nil
+}
+
 type adConnectorCreator struct{}
 
 func (c adConnectorCreator) TypeName() string {
@@ -402,9 +440,14 @@ func (c adConnectorCreator) TypeName() string {
 }
 
 func (c adConnectorCreator) Create(ctx context.Context, conn *directoryservice.Client, name string, d *schema.ResourceData) error {
+	password, err := directoryPassword(d)
+	if err != nil {
+		return err
+	}
+
 	input := &directoryservice.ConnectDirectoryInput{
 		Name:     aws.String(name),
-		Password: aws.String(d.Get(names.AttrPassword).(string)),
+		Password: aws.String(password),
 		Tags:     getTagsIn(ctx),
 	}
 
@@ -445,9 +488,14 @@ func (c microsoftADCreator) TypeName() string {
 }
 
 func (c microsoftADCreator) Create(ctx context.Context, conn *directoryservice.Client, name string, d *schema.ResourceData) error {
+	password, err := directoryPassword(d)
+	if err != nil {
+		return err
+	}
+
 	input := &directoryservice.CreateMicrosoftADInput{
 		Name:     aws.String(name),
-		Password: aws.String(d.Get(names.AttrPassword).(string)),
+		Password: aws.String(password),
 		Tags:     getTagsIn(ctx),
 	}
 
@@ -485,9 +533,14 @@ func (c simpleADCreator) TypeName() string {
 }
 
 func (c simpleADCreator) Create(ctx context.Context, conn *directoryservice.Client, name string, d *schema.ResourceData) error {
+	password, err := directoryPassword(d)
+	if err != nil {
+		return err
+	}
+
 	input := &directoryservice.CreateDirectoryInput{
 		Name:     aws.String(name),
-		Password: aws.String(d.Get(names.AttrPassword).(string)),
+		Password: aws.String(password),
 		Tags:     getTagsIn(ctx),
 	}
 
@@ -0,0 +1,737 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb
+
+import (
+	"bufio"
+	"cmp"
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"math/big"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfjson "github.com/hashicorp/terraform-provider-aws/internal/json"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	"github.com/hashicorp/terraform-provider-aws/internal/retry"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	batchGetItemMaxKeys    = 100
+	batchWriteItemMaxItems = 25
+)
+
+// @SDKResource("aws_dynamodb_table_items", name="Table Items")
+func resourceTableItems() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTableItemsCreate,
+		ReadWithoutTimeout:   resourceTableItemsRead,
+		UpdateWithoutTimeout: resourceTableItemsUpdate,
+		DeleteWithoutTimeout: resourceTableItemsDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		CustomizeDiff: resourceTableItemsCustomizeDiff,
+
+		// The item sources are stored in state as content digests.
+		Schema: map[string]*schema.Schema{
+			"hash_key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"items": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ExactlyOneOf: []string{"items", "items_csv", "items_json_lines"},
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validateTableItem,
+					StateFunc: func(v interface{}) string {
+						return tableItemsStateFunc(v.(string), func(s string) ([]map[string]awstypes.AttributeValue, error) {
+							item, err := expandTableItemAttributes(s)
+							if err != nil {
+								return nil, err
+							}
+							return []map[string]awstypes.AttributeValue{item}, nil
+						})
+					},
+				},
+			},
+			"items_csv": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"items", "items_csv", "items_json_lines"},
+				ValidateFunc: validateTableItemsSource(expandTableItemsCSV),
+				StateFunc: func(v interface{}) string {
+					return tableItemsStateFunc(v.(string), expandTableItemsCSV)
+				},
+			},
+			"items_json_lines": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"items", "items_csv", "items_json_lines"},
+				ValidateFunc: validateTableItemsSource(expandTableItemsJSONLines),
+				StateFunc: func(v interface{}) string {
+					return tableItemsStateFunc(v.(string), expandTableItemsJSONLines)
+				},
+			},
+			"keys": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"range_key": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTableName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func validateTableItemsSource(f func(string) ([]map[string]awstypes.AttributeValue, error)) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		if _, err := f(v.(string)); err != nil {
+			errors = append(errors, fmt.Errorf("Invalid format of %q: %s", k, err))
+		}
+		return
+	}
+}
+
+func resourceTableItemsCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	if d.HasChanges("items", "items_csv", "items_json_lines") {
+		return d.SetNewComputed("keys")
+	}
+
+	return nil
+}
+
+func resourceTableItemsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
+
+	tableName := d.Get(names.AttrTableName).(string)
+	keys, err := putTableItems(ctx, conn, d, nil, d.Timeout(schema.TimeoutCreate))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating DynamoDB Table (%s) Items: %s", tableName, err)
+	}
+
+	d.SetId(tableName)
+	d.Set("keys", keys)
+
+	return append(diags, resourceTableItemsRead(ctx, d, meta)...)
+}
+
+func resourceTableItemsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
+
+	table, err := findTableByName(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DynamoDB Table (%s) not found, removing Table Items from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DynamoDB Table (%s): %s", d.Id(), err)
+	}
+
+	hashKey, rangeKey := tableKeySchema(table)
+	d.Set("hash_key", hashKey)
+	d.Set("range_key", rangeKey)
+	d.Set(names.AttrTableName, table.TableName)
+
+	keys := tfslices.ApplyToAll(d.Get("keys").([]interface{}), func(v interface{}) string {
+		return v.(string)
+	})
+	items, err := findTableItemsByKeys(ctx, conn, d.Id(), hashKey, rangeKey, keys)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading DynamoDB Table (%s) Items: %s", d.Id(), err)
+	}
+
+	// Record digests of the items as they are now so that any drift shows as a diff.
+	var found []map[string]awstypes.AttributeValue
+	for _, key := range keys {
+		if item, ok := items[key]; ok {
+			found = append(found, item)
+		}
+	}
+
+	switch {
+	case d.Get("items_csv").(string) != "":
+		digest, err := tableItemsDigest(found)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+		d.Set("items_csv", digest)
+	case d.Get("items_json_lines").(string) != "":
+		digest, err := tableItemsDigest(found)
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+		d.Set("items_json_lines", digest)
+	default:
+		digests := make([]string, len(keys))
+		for i, key := range keys {
+			if item, ok := items[key]; ok {
+				digest, err := tableItemsDigest([]map[string]awstypes.AttributeValue{item})
+				if err != nil {
+					return sdkdiag.AppendFromErr(diags, err)
+				}
+				digests[i] = digest
+			}
+		}
+		d.Set("items", digests)
+	}
+
+	return diags
+}
+
+func resourceTableItemsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
+
+	if d.HasChanges("items", "items_csv", "items_json_lines") {
+		o, _ := d.GetChange("keys")
+		oldKeys := tfslices.ApplyToAll(o.([]interface{}), func(v interface{}) string {
+			return v.(string)
+		})
+
+		keys, err := putTableItems(ctx, conn, d, oldKeys, d.Timeout(schema.TimeoutUpdate))
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating DynamoDB Table (%s) Items: %s", d.Id(), err)
+		}
+
+		d.Set("keys", keys)
+	}
+
+	return append(diags, resourceTableItemsRead(ctx, d, meta)...)
+}
+
+func resourceTableItemsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).DynamoDBClient(ctx)
+
+	var requests []awstypes.WriteRequest
+	for _, v := range d.Get("keys").([]interface{}) {
+		key, err := expandTableItemAttributes(v.(string))
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		requests = append(requests, awstypes.WriteRequest{
+			DeleteRequest: &awstypes.DeleteRequest{
+				Key: key,
+			},
+		})
+	}
+
+	log.Printf("[DEBUG] Deleting DynamoDB Table (%s) Items: %d", d.Id(), len(requests))
+	err := batchWriteTableItems(ctx, conn, d.Id(), requests, d.Timeout(schema.TimeoutDelete))
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting DynamoDB Table (%s) Items: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+// putTableItems writes the configured items that differ from those in the table and
+// deletes the items with the specified old keys that are no longer configured.
+// The keys of the configured items are returned.
+func putTableItems(ctx context.Context, conn *dynamodb.Client, d *schema.ResourceData, oldKeys []string, timeout time.Duration) ([]string, error) {
+	tableName := d.Get(names.AttrTableName).(string)
+
+	table, err := findTableByName(ctx, conn, tableName)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading DynamoDB Table (%s): %w", tableName, err)
+	}
+
+	// Use the raw configuration as the item sources are stored as digests.
+	items, err := expandTableItemsFromConfig(d.GetRawConfig())
+
+	if err != nil {
+		return nil, err
+	}
+
+	hashKey, rangeKey := tableKeySchema(table)
+	keys, err := tableItemsKeys(items, hashKey, rangeKey)
+
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := findTableItemsByKeys(ctx, conn, tableName, hashKey, rangeKey, keys)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var requests []awstypes.WriteRequest
+	for i, item := range items {
+		if v, ok := existing[keys[i]]; ok {
+			if equal, err := tableItemsEqual(v, item); err != nil {
+				return nil, err
+			} else if equal {
+				continue
+			}
+		}
+
+		requests = append(requests, awstypes.WriteRequest{
+			PutRequest: &awstypes.PutRequest{
+				Item: item,
+			},
+		})
+	}
+
+	configured := make(map[string]struct{}, len(keys))
+	for _, v := range keys {
+		configured[v] = struct{}{}
+	}
+
+	for _, v := range oldKeys {
+		if _, ok := configured[v]; ok {
+			continue
+		}
+
+		key, err := expandTableItemAttributes(v)
+		if err != nil {
+			return nil, err
+		}
+
+		requests = append(requests, awstypes.WriteRequest{
+			DeleteRequest: &awstypes.DeleteRequest{
+				Key: key,
+			},
+		})
+	}
+
+	log.Printf("[DEBUG] Writing DynamoDB Table (%s) Items: %d", tableName, len(requests))
+	if err := batchWriteTableItems(ctx, conn, tableName, requests, timeout); err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+// batchWriteTableItems writes requests in batches, retrying unprocessed items with exponential backoff.
+func batchWriteTableItems(ctx context.Context, conn *dynamodb.Client, tableName string, requests []awstypes.WriteRequest, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for chunk := range slices.Chunk(requests, batchWriteItemMaxItems) {
+		pending := chunk
+
+		for r := retry.Begin(); r.Continue(ctx); {
+			input := &dynamodb.BatchWriteItemInput{
+				RequestItems: map[string][]awstypes.WriteRequest{
+					tableName: pending,
+				},
+			}
+
+			output, err := conn.BatchWriteItem(ctx, input)
+
+			if err != nil {
+				return err
+			}
+
+			if pending = output.UnprocessedItems[tableName]; len(pending) == 0 {
+				break
+			}
+		}
+
+		if len(pending) > 0 {
+			return fmt.Errorf("%d unprocessed items: %w", len(pending), ctx.Err())
+		}
+	}
+
+	return nil
+}
+
+// findTableItemsByKeys reads the items with the specified keys in batches, retrying unprocessed keys with exponential backoff.
+// The found items are returned keyed by key.
+func findTableItemsByKeys(ctx context.Context, conn *dynamodb.Client, tableName, hashKey, rangeKey string, keys []string) (map[string]map[string]awstypes.AttributeValue, error) {
+	items := make(map[string]map[string]awstypes.AttributeValue, len(keys))
+
+	for chunk := range slices.Chunk(keys, batchGetItemMaxKeys) {
+		pending, err := tfslices.ApplyToAllWithError(chunk, expandTableItemAttributes)
+		if err != nil {
+			return nil, err
+		}
+
+		for r := retry.Begin(); r.Continue(ctx); {
+			input := &dynamodb.BatchGetItemInput{
+				RequestItems: map[string]awstypes.KeysAndAttributes{
+					tableName: {
+						ConsistentRead: aws.Bool(true),
+						Keys:           pending,
+					},
+				},
+			}
+
+			output, err := conn.BatchGetItem(ctx, input)
+
+			if err != nil {
+				return nil, err
+			}
+
+			for _, item := range output.Responses[tableName] {
+				key, err := tableItemKey(item, hashKey, rangeKey)
+				if err != nil {
+					return nil, err
+				}
+				items[key] = item
+			}
+
+			if pending = output.UnprocessedKeys[tableName].Keys; len(pending) == 0 {
+				break
+			}
+		}
+
+		if len(pending) > 0 {
+			return nil, fmt.Errorf("%d unprocessed keys: %w", len(pending), ctx.Err())
+		}
+	}
+
+	return items, nil
+}
+
+func tableKeySchema(table *awstypes.TableDescription) (string, string) {
+	var hashKey, rangeKey string
+
+	for _, v := range table.KeySchema {
+		switch v.KeyType {
+		case awstypes.KeyTypeHash:
+			hashKey = aws.ToString(v.AttributeName)
+		case awstypes.KeyTypeRange:
+			rangeKey = aws.ToString(v.AttributeName)
+		}
+	}
+
+	return hashKey, rangeKey
+}
+
+// tableItemKey returns an item's primary key as a DynamoDB JSON document.
+func tableItemKey(item map[string]awstypes.AttributeValue, hashKey, rangeKey string) (string, error) {
+	if _, ok := item[hashKey]; !ok {
+		return "", fmt.Errorf("item is missing hash key attribute (%s)", hashKey)
+	}
+	if _, ok := item[rangeKey]; rangeKey != "" && !ok {
+		return "", fmt.Errorf("item is missing range key attribute (%s)", rangeKey)
+	}
+
+	// Numeric keys are canonicalized so that, e.g., "1.0" and "01" identify the same item as "1".
+	return flattenTableItemAttributes(canonicalTableItemAttributes(expandTableItemQueryKey(item, hashKey, rangeKey)))
+}
+
+func tableItemsKeys(items []map[string]awstypes.AttributeValue, hashKey, rangeKey string) ([]string, error) {
+	keys := make([]string, 0, len(items))
+	seen := make(map[string]struct{}, len(items))
+
+	for i, item := range items {
+		key, err := tableItemKey(item, hashKey, rangeKey)
+		if err != nil {
+			return nil, fmt.Errorf("item %d: %w", i, err)
+		}
+
+		if _, ok := seen[key]; ok {
+			return nil, fmt.Errorf("item %d: duplicate key %s", i, key)
+		}
+		seen[key] = struct{}{}
+
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+func expandTableItemsFromConfig(config cty.Value) ([]map[string]awstypes.AttributeValue, error) {
+	if v := config.GetAttr("items_csv"); !v.IsNull() && v.IsWhollyKnown() {
+		return expandTableItemsCSV(v.AsString())
+	}
+
+	if v := config.GetAttr("items_json_lines"); !v.IsNull() && v.IsWhollyKnown() {
+		return expandTableItemsJSONLines(v.AsString())
+	}
+
+	var items []map[string]awstypes.AttributeValue
+	if v := config.GetAttr("items"); !v.IsNull() && v.IsWhollyKnown() {
+		for i, v := range v.AsValueSlice() {
+			item, err := expandTableItemAttributes(v.AsString())
+			if err != nil {
+				return nil, fmt.Errorf("item %d: %w", i, err)
+			}
+			items = append(items, item)
+		}
+	}
+
+	return items, nil
+}
+
+// expandTableItemsJSONLines parses items from DynamoDB JSON, one item per line.
+// Lines may also be in the DynamoDB export format, with each item wrapped in an "Item" object.
+func expandTableItemsJSONLines(s string) ([]map[string]awstypes.AttributeValue, error) {
+	var items []map[string]awstypes.AttributeValue
+
+	scanner := bufio.NewScanner(strings.NewReader(s))
+	scanner.Buffer(nil, 1024*1024)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		item, err := expandTableItemAttributes(line)
+		if err != nil {
+			var wrapper struct {
+				Item map[string]any `json:"Item"`
+			}
+			if tfjson.DecodeFromString(line, &wrapper) != nil || len(wrapper.Item) == 0 {
+				return nil, fmt.Errorf("line %d: %w", n, err)
+			}
+
+			item, err = tfmaps.ApplyToAllValuesWithError(wrapper.Item, attributeFromRaw)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", n, err)
+			}
+		}
+
+		items = append(items, item)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// expandTableItemsCSV parses items from CSV with a header row of attribute names.
+// A header of the form name:TYPE sets the attribute's data type, one of S (the default), N, B or BOOL.
+// Empty values are omitted from the item.
+func expandTableItemsCSV(s string) ([]map[string]awstypes.AttributeValue, error) {
+	r := csv.NewReader(strings.NewReader(s))
+	r.TrimLeadingSpace = true
+
+	header, err := r.Read()
+
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	type column struct {
+		name     string
+		dataType string
+	}
+	columns := make([]column, len(header))
+	for i, v := range header {
+		name, dataType, _ := strings.Cut(strings.TrimSpace(v), ":")
+		if dataType == "" {
+			dataType = dataTypeDescriptorString
+		}
+
+		switch dataType {
+		case dataTypeDescriptorBinary, dataTypeDescriptorBoolean, dataTypeDescriptorNumber, dataTypeDescriptorString:
+		default:
+			return nil, fmt.Errorf("column %q: unsupported data type %q", v, dataType)
+		}
+
+		if name == "" {
+			return nil, fmt.Errorf("column %d: empty attribute name", i+1)
+		}
+
+		columns[i] = column{name: name, dataType: dataType}
+	}
+
+	var items []map[string]awstypes.AttributeValue
+	for {
+		record, err := r.Read()
+
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		line, _ := r.FieldPos(0)
+		item := make(map[string]awstypes.AttributeValue)
+		for i, v := range record {
+			if v == "" {
+				continue
+			}
+
+			var raw any = v
+			if columns[i].dataType == dataTypeDescriptorBoolean {
+				switch strings.ToLower(v) {
+				case "true":
+					raw = true
+				case "false":
+					raw = false
+				default:
+					return nil, fmt.Errorf("line %d: column %s: invalid boolean %q", line, columns[i].name, v)
+				}
+			}
+
+			a, err := attributeFromRaw(map[string]any{columns[i].dataType: raw})
+			if err != nil {
+				return nil, fmt.Errorf("line %d: column %s: %w", line, columns[i].name, err)
+			}
+
+			item[columns[i].name] = a
+		}
+
+		items = append(items, item)
+	}
+
+	return items, nil
+}
+
+// tableItemsStateFunc returns the digest of the items parsed from s.
+// If s can't be parsed, e.g. it's unknown, the digest of s itself is returned.
+func tableItemsStateFunc(s string, f func(string) ([]map[string]awstypes.AttributeValue, error)) string {
+	if items, err := f(s); err == nil {
+		if digest, err := tableItemsDigest(items); err == nil {
+			return digest
+		}
+	}
+
+	hash := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(hash[:])
+}
+
+// tableItemsDigest returns a digest of a set of items that doesn't depend on the order of the items,
+// of their attributes or of the members of their set attributes, or on the representation of numbers.
+func tableItemsDigest(items []map[string]awstypes.AttributeValue) (string, error) {
+	lines := make([]string, 0, len(items))
+
+	for _, item := range items {
+		line, err := flattenTableItemAttributes(canonicalTableItemAttributes(item))
+		if err != nil {
+			return "", err
+		}
+		lines = append(lines, line)
+	}
+
+	slices.Sort(lines)
+
+	hash := sha256.Sum256([]byte(strings.Join(lines, "\n")))
+	return hex.EncodeToString(hash[:]), nil
+}
+
+func tableItemsEqual(item1, item2 map[string]awstypes.AttributeValue) (bool, error) {
+	s1, err := flattenTableItemAttributes(canonicalTableItemAttributes(item1))
+	if err != nil {
+		return false, err
+	}
+
+	s2, err := flattenTableItemAttributes(canonicalTableItemAttributes(item2))
+	if err != nil {
+		return false, err
+	}
+
+	return s1 == s2, nil
+}
+
+func canonicalTableItemAttributes(item map[string]awstypes.AttributeValue) map[string]awstypes.AttributeValue {
+	m := make(map[string]awstypes.AttributeValue, len(item))
+
+	for k, v := range item {
+		m[k] = canonicalAttribute(v)
+	}
+
+	return m
+}
+
+// canonicalAttribute returns an attribute value with its set members sorted and its numbers in a canonical form.
+func canonicalAttribute(a awstypes.AttributeValue) awstypes.AttributeValue {
+	switch a := a.(type) {
+	case *awstypes.AttributeValueMemberBS:
+		v := slices.Clone(a.Value)
+		slices.SortFunc(v, func(x, y []byte) int {
+			return cmp.Compare(itypes.Base64Encode(x), itypes.Base64Encode(y))
+		})
+		return &awstypes.AttributeValueMemberBS{Value: v}
+	case *awstypes.AttributeValueMemberL:
+		return &awstypes.AttributeValueMemberL{Value: tfslices.ApplyToAll(a.Value, canonicalAttribute)}
+	case *awstypes.AttributeValueMemberM:
+		return &awstypes.AttributeValueMemberM{Value: canonicalTableItemAttributes(a.Value)}
+	case *awstypes.AttributeValueMemberN:
+		return &awstypes.AttributeValueMemberN{Value: canonicalNumber(a.Value)}
+	case *awstypes.AttributeValueMemberNS:
+		v := tfslices.ApplyToAll(a.Value, canonicalNumber)
+		slices.Sort(v)
+		return &awstypes.AttributeValueMemberNS{Value: v}
+	case *awstypes.AttributeValueMemberSS:
+		v := slices.Clone(a.Value)
+		slices.Sort(v)
+		return &awstypes.AttributeValueMemberSS{Value: v}
+	default:
+		return a
+	}
+}
+
+// canonicalNumber returns a number in its shortest decimal form, e.g. "1.50", "01.5" and "15e-1" are all "1.5".
+// The result is a valid DynamoDB number, so it can be used in keys sent to the API.
+func canonicalNumber(s string) string {
+	v, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return s
+	}
+
+	// Numbers parsed from decimal strings always have a finite decimal expansion.
+	prec := 0
+	for x, ten := new(big.Rat).Set(v), big.NewRat(10, 1); !x.IsInt(); x.Mul(x, ten) {
+		prec++
+	}
+
+	return v.FloatString(prec)
+}
@@ -0,0 +1,509 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package dynamodb_test
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdynamodb "github.com/hashicorp/terraform-provider-aws/internal/service/dynamodb"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestExpandTableItemsCSV(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    string
+		expected []map[string]awstypes.AttributeValue
+		wantErr  string
+	}{
+		"empty": {
+			input: "",
+		},
+		"header only": {
+			input: "pk,name\n",
+		},
+		"typed columns": {
+			input: "pk,count:N,active:BOOL,data:B\nitem1,10,true,YmxvYg==\nitem2,,FALSE,\n",
+			expected: []map[string]awstypes.AttributeValue{
+				{
+					"pk":     &awstypes.AttributeValueMemberS{Value: "item1"},
+					"count":  &awstypes.AttributeValueMemberN{Value: "10"},
+					"active": &awstypes.AttributeValueMemberBOOL{Value: true},
+					"data":   &awstypes.AttributeValueMemberB{Value: []byte("blob")},
+				},
+				{
+					"pk":     &awstypes.AttributeValueMemberS{Value: "item2"},
+					"active": &awstypes.AttributeValueMemberBOOL{Value: false},
+				},
+			},
+		},
+		"quoted": {
+			input: "pk,description\n\"a,b\",\"say \"\"hi\"\"\"\n",
+			expected: []map[string]awstypes.AttributeValue{
+				{
+					"pk":          &awstypes.AttributeValueMemberS{Value: "a,b"},
+					"description": &awstypes.AttributeValueMemberS{Value: `say "hi"`},
+				},
+			},
+		},
+		"unsupported type": {
+			input:   "pk,tags:SS\na,b\n",
+			wantErr: `unsupported data type "SS"`,
+		},
+		"invalid boolean": {
+			input:   "pk,active:BOOL\na,yes\n",
+			wantErr: `line 2: column active: invalid boolean "yes"`,
+		},
+		"wrong number of fields": {
+			input:   "pk,name\na\n",
+			wantErr: "wrong number of fields",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfdynamodb.ExpandTableItemsCSV(testCase.input)
+
+			if testCase.wantErr != "" {
+				if err == nil {
+					t.Fatalf("expected error containing %q, got none", testCase.wantErr)
+				}
+				if !strings.Contains(err.Error(), testCase.wantErr) {
+					t.Fatalf("expected error containing %q, got %q", testCase.wantErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !slices.EqualFunc(got, testCase.expected, tableItemsEqual) {
+				t.Errorf("got %v, expected %v", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestExpandTableItemsJSONLines(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    string
+		expected []map[string]awstypes.AttributeValue
+		wantErr  string
+	}{
+		"empty": {
+			input: "\n\n",
+		},
+		"items": {
+			input: `{"pk":{"S":"a"},"n":{"N":"1"}}
+
+{"pk":{"S":"b"},"tags":{"SS":["x","y"]}}
+`,
+			expected: []map[string]awstypes.AttributeValue{
+				{
+					"pk": &awstypes.AttributeValueMemberS{Value: "a"},
+					"n":  &awstypes.AttributeValueMemberN{Value: "1"},
+				},
+				{
+					"pk":   &awstypes.AttributeValueMemberS{Value: "b"},
+					"tags": &awstypes.AttributeValueMemberSS{Value: []string{"x", "y"}},
+				},
+			},
+		},
+		"export format": {
+			input: `{"Item":{"pk":{"S":"a"}}}`,
+			expected: []map[string]awstypes.AttributeValue{
+				{
+					"pk": &awstypes.AttributeValueMemberS{Value: "a"},
+				},
+			},
+		},
+		"attribute named Item": {
+			input: `{"Item":{"S":"a"}}`,
+			expected: []map[string]awstypes.AttributeValue{
+				{
+					"Item": &awstypes.AttributeValueMemberS{Value: "a"},
+				},
+			},
+		},
+		"invalid line": {
+			input:   "{\"pk\":{\"S\":\"a\"}}\n{\"pk\":\"b\"}\n",
+			wantErr: "line 2:",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tfdynamodb.ExpandTableItemsJSONLines(testCase.input)
+
+			if testCase.wantErr != "" {
+				if err == nil {
+					t.Fatalf("expected error containing %q, got none", testCase.wantErr)
+				}
+				if !strings.Contains(err.Error(), testCase.wantErr) {
+					t.Fatalf("expected error containing %q, got %q", testCase.wantErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !slices.EqualFunc(got, testCase.expected, tableItemsEqual) {
+				t.Errorf("got %v, expected %v", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestTableItemsDigest(t *testing.T) {
+	t.Parallel()
+
+	digest := func(t *testing.T, s string) string {
+		t.Helper()
+
+		items, err := tfdynamodb.ExpandTableItemsJSONLines(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		v, err := tfdynamodb.TableItemsDigest(items)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return v
+	}
+
+	base := digest(t, `{"pk":{"S":"a"},"n":{"N":"1.50"},"ns":{"NS":["2","1"]},"m":{"M":{"ss":{"SS":["y","x"]}}}}
+{"pk":{"S":"b"}}`)
+
+	if got := digest(t, `{"pk":{"S":"b"}}
+{"m":{"M":{"ss":{"SS":["x","y"]}}},"ns":{"NS":["1","2.0"]},"n":{"N":"1.5"},"pk":{"S":"a"}}`); got != base {
+		t.Errorf("digest of equivalent items differs: %s != %s", got, base)
+	}
+
+	for name, s := range map[string]string{
+		"changed value":   `{"pk":{"S":"a"},"n":{"N":"1.51"},"ns":{"NS":["2","1"]},"m":{"M":{"ss":{"SS":["y","x"]}}}}` + "\n" + `{"pk":{"S":"b"}}`,
+		"changed type":    `{"pk":{"S":"a"},"n":{"S":"1.50"},"ns":{"NS":["2","1"]},"m":{"M":{"ss":{"SS":["y","x"]}}}}` + "\n" + `{"pk":{"S":"b"}}`,
+		"list order":      `{"pk":{"S":"a"},"n":{"N":"1.50"},"ns":{"NS":["2","1"]},"m":{"L":[{"S":"y"},{"S":"x"}]}}` + "\n" + `{"pk":{"S":"b"}}`,
+		"missing item":    `{"pk":{"S":"b"}}`,
+		"additional item": `{"pk":{"S":"a"},"n":{"N":"1.50"},"ns":{"NS":["2","1"]},"m":{"M":{"ss":{"SS":["y","x"]}}}}` + "\n" + `{"pk":{"S":"b"}}` + "\n" + `{"pk":{"S":"c"}}`,
+	} {
+		if got := digest(t, s); got == base {
+			t.Errorf("%s: digest unchanged", name)
+		}
+	}
+}
+
+func TestTableItemsKeys(t *testing.T) {
+	t.Parallel()
+
+	items, err := tfdynamodb.ExpandTableItemsJSONLines(`{"pk":{"N":"1.0"},"sk":{"S":"a"}}
+{"pk":{"N":"1.50"},"sk":{"S":"a"}}
+{"pk":{"N":"2"},"sk":{"S":"a"},"n":{"N":"01"}}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := tfdynamodb.TableItemsKeys(items, "pk", "sk")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		`{"pk":{"N":"1"},"sk":{"S":"a"}}`,
+		`{"pk":{"N":"1.5"},"sk":{"S":"a"}}`,
+		`{"pk":{"N":"2"},"sk":{"S":"a"}}`,
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("keys = %q, want %q", got, want)
+	}
+
+	items, err = tfdynamodb.ExpandTableItemsJSONLines(`{"pk":{"N":"1"},"sk":{"S":"a"}}
+{"pk":{"N":"01"},"sk":{"S":"a"}}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := tfdynamodb.TableItemsKeys(items, "pk", "sk"); err == nil {
+		t.Error("expected duplicate key error")
+	}
+}
+
+func tableItemsEqual(a, b map[string]awstypes.AttributeValue) bool {
+	return maps.EqualFunc(a, b, attributeValuesEqual)
+}
+
+func TestAccDynamoDBTableItems_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_items.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_items(rName, `["a", "b", "c"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemCount(ctx, rName, 3),
+					resource.TestCheckResourceAttr(resourceName, "hash_key", "pk"),
+					resource.TestCheckResourceAttr(resourceName, "items.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "keys.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "keys.0", `{"pk":{"S":"a"}}`),
+					resource.TestCheckResourceAttr(resourceName, "range_key", ""),
+					resource.TestCheckResourceAttr(resourceName, names.AttrTableName, rName),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: testAccTableItemsConfig_items(rName, `["a", "c", "d", "e"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemCount(ctx, rName, 4),
+					resource.TestCheckResourceAttr(resourceName, "items.#", "4"),
+					resource.TestCheckResourceAttr(resourceName, "keys.#", "4"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_csv(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_items.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_csv(rName, 120),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemCount(ctx, rName, 120),
+					resource.TestCheckResourceAttr(resourceName, "keys.#", "120"),
+					resource.TestCheckResourceAttr(resourceName, "range_key", "sk"),
+					resource.TestMatchResourceAttr(resourceName, "items_csv", regexache.MustCompile(`^[0-9a-f]{64}$`)),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: testAccTableItemsConfig_csv(rName, 30),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemCount(ctx, rName, 30),
+					resource.TestCheckResourceAttr(resourceName, "keys.#", "30"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDynamoDBTableItems_jsonLinesDrift(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dynamodb_table_items.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DynamoDBServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableItemsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableItemsConfig_jsonLines(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemCount(ctx, rName, 2),
+					resource.TestCheckResourceAttr(resourceName, "keys.#", "2"),
+					testAccCheckTableItemsDeleteItem(ctx, rName, `{"pk":{"S":"b"}}`),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccTableItemsConfig_jsonLines(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableItemCount(ctx, rName, 2),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckTableItemsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_dynamodb_table_items" {
+				continue
+			}
+
+			n, err := strconv.Atoi(rs.Primary.Attributes["keys.#"])
+			if err != nil {
+				return err
+			}
+
+			for i := range n {
+				key, err := tfdynamodb.ExpandTableItemAttributes(rs.Primary.Attributes[fmt.Sprintf("keys.%d", i)])
+				if err != nil {
+					return err
+				}
+
+				_, err = tfdynamodb.FindTableItemByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrTableName], key)
+
+				if tfresource.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					return err
+				}
+
+				return fmt.Errorf("DynamoDB Table Items %s still exist.", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTableItemsDeleteItem(ctx context.Context, tableName, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DynamoDBClient(ctx)
+
+		attributes, err := tfdynamodb.ExpandTableItemAttributes(key)
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.DeleteItem(ctx, &dynamodb.DeleteItemInput{
+			Key:       attributes,
+			TableName: aws.String(tableName),
+		})
+
+		return err
+	}
+}
+
+func testAccTableItemsConfig_items(rName, hashKeys string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name         = %[1]q
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "pk"
+
+  attribute {
+    name = "pk"
+    type = "S"
+  }
+}
+
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+
+  items = [for k in %[2]s : jsonencode({
+    pk    = { S = k }
+    value = { N = "1" }
+  })]
+}
+`, rName, hashKeys)
+}
+
+func testAccTableItemsConfig_csv(rName string, n int) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name         = %[1]q
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "pk"
+  range_key    = "sk"
+
+  attribute {
+    name = "pk"
+    type = "S"
+  }
+
+  attribute {
+    name = "sk"
+    type = "N"
+  }
+}
+
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+
+  items_csv = join("\n", concat(["pk,sk:N,enabled:BOOL"], [for i in range(%[2]d) : "item${i %% 10},${i},${i %% 2 == 0}"]))
+}
+`, rName, n)
+}
+
+func testAccTableItemsConfig_jsonLines(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_dynamodb_table" "test" {
+  name         = %[1]q
+  billing_mode = "PAY_PER_REQUEST"
+  hash_key     = "pk"
+
+  attribute {
+    name = "pk"
+    type = "S"
+  }
+}
+
+resource "aws_dynamodb_table_items" "test" {
+  table_name = aws_dynamodb_table.test.name
+
+  items_json_lines = <<EOT
+{"pk":{"S":"a"},"tags":{"SS":["x","y"]}}
+{"Item":{"pk":{"S":"b"},"count":{"N":"2.0"}}}
+EOT
+}
+`, rName)
+}
@@ -45,6 +45,16 @@ func containerDefinitionsAreEquivalent(def1, def2 string, isAWSVPC bool) (bool,
 	return tfjson.EqualBytes(b1, b2), nil
 }
 
+// Defaults applied by ECS to unset container definition fields.
+// See https://docs.aws.amazon.com/AmazonECS/latest/developerguide/task_definition_parameters.html#container_definitions.
+const (
+	containerDefinitionEssentialDefault           = true
+	containerDefinitionHealthCheckIntervalDefault = 30
+	containerDefinitionHealthCheckRetriesDefault  = 3
+	containerDefinitionHealthCheckTimeoutDefault  = 5
+	containerDefinitionPortMappingProtocolDefault = awstypes.TransportProtocolTcp
+)
+
 type containerDefinitions []awstypes.ContainerDefinition
 
 func (cd containerDefinitions) reduce(isAWSVPC bool) {
@@ -57,26 +67,25 @@ func (cd containerDefinitions) reduce(isAWSVPC bool) {
 	cd.compactArrays()
 
 	// Deal with special fields which have defaults.
-	// See https://docs.aws.amazon.com/AmazonECS/latest/developerguide/task_definition_parameters.html#container_definitions.
 	for i, def := range cd {
 		if def.Essential == nil {
-			cd[i].Essential = aws.Bool(true)
+			cd[i].Essential = aws.Bool(containerDefinitionEssentialDefault)
 		}
 
 		if hc := def.HealthCheck; hc != nil {
 			if hc.Interval == nil {
-				hc.Interval = aws.Int32(30)
+				hc.Interval = aws.Int32(containerDefinitionHealthCheckIntervalDefault)
 			}
 			if hc.Retries == nil {
-				hc.Retries = aws.Int32(3)
+				hc.Retries = aws.Int32(containerDefinitionHealthCheckRetriesDefault)
 			}
 			if hc.Timeout == nil {
-				hc.Timeout = aws.Int32(5)
+				hc.Timeout = aws.Int32(containerDefinitionHealthCheckTimeoutDefault)
 			}
 		}
 
 		for j, pm := range def.PortMappings {
-			if pm.Protocol == awstypes.TransportProtocolTcp {
+			if pm.Protocol == containerDefinitionPortMappingProtocolDefault {
 				cd[i].PortMappings[j].Protocol = ""
 			}
 			if aws.ToInt32(pm.HostPort) == 0 {
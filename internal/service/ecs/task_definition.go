@@ -18,6 +18,7 @@ import (
 	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -65,7 +66,10 @@ func resourceTaskDefinition() *schema.Resource {
 			},
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			containerDefinitionCustomizeDiff,
+		),
 
 		SchemaVersion: 1,
 		MigrateState:  resourceTaskDefinitionMigrateState,
@@ -79,10 +83,13 @@ func resourceTaskDefinition() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"container_definition": containerDefinitionSchema(),
 			"container_definitions": {
-				Type:     schema.TypeString,
-				Required: true,
-				ForceNew: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"container_definition", "container_definitions"},
 				StateFunc: func(v interface{}) string {
 					// Sort the lists of environment variables as they are serialized to state, so we won't get
 					// spurious reorderings in plans (diff is suppressed if the environment variables haven't changed,
@@ -555,9 +562,15 @@ func resourceTaskDefinitionCreate(ctx context.Context, d *schema.ResourceData, m
 	conn := meta.(*conns.AWSClient).ECSClient(ctx)
 	partition := meta.(*conns.AWSClient).Partition(ctx)
 
-	definitions, err := expandContainerDefinitions(d.Get("container_definitions").(string))
-	if err != nil {
-		return sdkdiag.AppendFromErr(diags, err)
+	var definitions []awstypes.ContainerDefinition
+	if v, ok := d.GetOk("container_definition"); ok && len(v.([]interface{})) > 0 {
+		definitions = expandContainerDefinitionBlocks(v.([]interface{}))
+	} else {
+		var err error
+		definitions, err = expandContainerDefinitions(d.Get("container_definitions").(string))
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
 	}
 
 	input := &ecs.RegisterTaskDefinitionInput{
@@ -720,6 +733,16 @@ func resourceTaskDefinitionRead(ctx context.Context, d *schema.ResourceData, met
 		return sdkdiag.AppendErrorf(diags, "setting volume: %s", err)
 	}
 
+	var order []string
+	for _, tfMapRaw := range d.Get("container_definition").([]interface{}) {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			order = append(order, tfMap[names.AttrName].(string))
+		}
+	}
+	if err := d.Set("container_definition", flattenContainerDefinitionBlocks(taskDefinition.ContainerDefinitions, order)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting container_definition: %s", err)
+	}
+
 	// Sort the lists of environment variables as they come in, so we won't get spurious reorderings in plans
 	// (diff is suppressed if the environment variables haven't changed, but they still show in the plan if
 	// some other property changes).
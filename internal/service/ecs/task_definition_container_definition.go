@@ -0,0 +1,1054 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func containerDefinitionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeList,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     true,
+		ExactlyOneOf: []string{"container_definition", "container_definitions"},
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"command": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"cpu": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"depends_on": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							names.AttrCondition: {
+								Type:             schema.TypeString,
+								Required:         true,
+								ForceNew:         true,
+								ValidateDiagFunc: enum.Validate[awstypes.ContainerCondition](),
+							},
+							"container_name": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+						},
+					},
+				},
+				"docker_labels": {
+					Type:     schema.TypeMap,
+					Optional: true,
+					ForceNew: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"entry_point": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				names.AttrEnvironment: {
+					Type:     schema.TypeMap,
+					Optional: true,
+					ForceNew: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"essential": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					ForceNew: true,
+					Default:  containerDefinitionEssentialDefault,
+				},
+				"health_check": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"command": {
+								Type:     schema.TypeList,
+								Required: true,
+								ForceNew: true,
+								MinItems: 1,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							names.AttrInterval: {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ForceNew:     true,
+								Default:      containerDefinitionHealthCheckIntervalDefault,
+								ValidateFunc: validation.IntBetween(5, 300),
+							},
+							"retries": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ForceNew:     true,
+								Default:      containerDefinitionHealthCheckRetriesDefault,
+								ValidateFunc: validation.IntBetween(1, 10),
+							},
+							"start_period": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IntBetween(0, 300),
+							},
+							names.AttrTimeout: {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ForceNew:     true,
+								Default:      containerDefinitionHealthCheckTimeoutDefault,
+								ValidateFunc: validation.IntBetween(2, 120),
+							},
+						},
+					},
+				},
+				"image": {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+				},
+				"log_configuration": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"log_driver": {
+								Type:             schema.TypeString,
+								Required:         true,
+								ForceNew:         true,
+								ValidateDiagFunc: enum.Validate[awstypes.LogDriver](),
+							},
+							"options": {
+								Type:     schema.TypeMap,
+								Optional: true,
+								ForceNew: true,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"secret_option": {
+								Type:     schema.TypeList,
+								Optional: true,
+								ForceNew: true,
+								Elem:     containerDefinitionSecretResource(),
+							},
+						},
+					},
+				},
+				"memory": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntAtLeast(6),
+				},
+				"memory_reservation": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntAtLeast(6),
+				},
+				"mount_point": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"container_path": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+							"read_only": {
+								Type:     schema.TypeBool,
+								Optional: true,
+								ForceNew: true,
+							},
+							"source_volume": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+							},
+						},
+					},
+				},
+				names.AttrName: {
+					Type:     schema.TypeString,
+					Required: true,
+					ForceNew: true,
+					ValidateFunc: validation.All(
+						validation.StringLenBetween(1, 255),
+						validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_-]+$`), "must contain only alphanumeric characters, underscores and hyphens"),
+					),
+				},
+				"port_mapping": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"app_protocol": {
+								Type:             schema.TypeString,
+								Optional:         true,
+								ForceNew:         true,
+								ValidateDiagFunc: enum.Validate[awstypes.ApplicationProtocol](),
+							},
+							"container_port": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IsPortNumber,
+							},
+							"container_port_range": {
+								Type:     schema.TypeString,
+								Optional: true,
+								ForceNew: true,
+							},
+							"host_port": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								Computed:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.IsPortNumberOrZero,
+							},
+							names.AttrName: {
+								Type:     schema.TypeString,
+								Optional: true,
+								ForceNew: true,
+							},
+							names.AttrProtocol: {
+								Type:             schema.TypeString,
+								Optional:         true,
+								ForceNew:         true,
+								Default:          string(containerDefinitionPortMappingProtocolDefault),
+								ValidateDiagFunc: enum.Validate[awstypes.TransportProtocol](),
+							},
+						},
+					},
+				},
+				"privileged": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					ForceNew: true,
+				},
+				"readonly_root_filesystem": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					ForceNew: true,
+				},
+				"secret": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					ForceNew: true,
+					Elem:     containerDefinitionSecretResource(),
+				},
+				"start_timeout": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntAtLeast(0),
+				},
+				"stop_timeout": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.IntBetween(0, 120),
+				},
+				"ulimit": {
+					Type:     schema.TypeList,
+					Optional: true,
+					ForceNew: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"hard_limit": {
+								Type:     schema.TypeInt,
+								Required: true,
+								ForceNew: true,
+							},
+							names.AttrName: {
+								Type:             schema.TypeString,
+								Required:         true,
+								ForceNew:         true,
+								ValidateDiagFunc: enum.Validate[awstypes.UlimitName](),
+							},
+							"soft_limit": {
+								Type:     schema.TypeInt,
+								Required: true,
+								ForceNew: true,
+							},
+						},
+					},
+				},
+				"user": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+				},
+				"working_directory": {
+					Type:     schema.TypeString,
+					Optional: true,
+					ForceNew: true,
+				},
+			},
+		},
+	}
+}
+
+func containerDefinitionSecretResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"value_from": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+// containerDefinitionCustomizeDiff validates the container_definition blocks as a whole once all their values are known.
+func containerDefinitionCustomizeDiff(_ context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+
+	if v := config.GetAttr("container_definition"); v.IsNull() || !v.IsWhollyKnown() || v.LengthInt() == 0 {
+		return nil
+	}
+
+	isAWSVPC := d.Get("network_mode").(string) == string(awstypes.NetworkModeAwsvpc)
+	apiObjects := expandContainerDefinitionBlocks(d.Get("container_definition").([]interface{}))
+
+	if err := validateContainerDefinitionBlocks(apiObjects, isAWSVPC); err != nil {
+		return fmt.Errorf("invalid container_definition: %w", err)
+	}
+
+	return nil
+}
+
+// validateContainerDefinitionBlocks checks the constraints ECS places on a task definition's containers
+// that can't be expressed in the schema.
+func validateContainerDefinitionBlocks(apiObjects []awstypes.ContainerDefinition, isAWSVPC bool) error {
+	var errs []error
+
+	containers := make(map[string]awstypes.ContainerDefinition, len(apiObjects))
+	for _, apiObject := range apiObjects {
+		name := aws.ToString(apiObject.Name)
+		if _, ok := containers[name]; ok {
+			errs = append(errs, fmt.Errorf("duplicate container name (%s)", name))
+			continue
+		}
+		containers[name] = apiObject
+	}
+
+	portMappingNames := make(map[string]string)
+	for _, apiObject := range apiObjects {
+		name := aws.ToString(apiObject.Name)
+
+		for _, err := range validateContainerPortMappings(apiObject.PortMappings, isAWSVPC) {
+			errs = append(errs, fmt.Errorf("container (%s): %w", name, err))
+		}
+		for _, v := range apiObject.PortMappings {
+			if v := aws.ToString(v.Name); v != "" {
+				if other, ok := portMappingNames[v]; ok {
+					errs = append(errs, fmt.Errorf("container (%s): port mapping name (%s) is already used by container (%s)", name, v, other))
+				}
+				portMappingNames[v] = name
+			}
+		}
+
+		if err := validateContainerLogConfiguration(apiObject.LogConfiguration); err != nil {
+			errs = append(errs, fmt.Errorf("container (%s): %w", name, err))
+		}
+
+		if err := validateContainerHealthCheck(apiObject.HealthCheck); err != nil {
+			errs = append(errs, fmt.Errorf("container (%s): %w", name, err))
+		}
+
+		secretNames := make(map[string]struct{})
+		for _, v := range apiObject.Secrets {
+			if _, ok := secretNames[aws.ToString(v.Name)]; ok {
+				errs = append(errs, fmt.Errorf("container (%s): duplicate secret name (%s)", name, aws.ToString(v.Name)))
+			}
+			secretNames[aws.ToString(v.Name)] = struct{}{}
+
+			if err := validateContainerSecretValueFrom(aws.ToString(v.ValueFrom)); err != nil {
+				errs = append(errs, fmt.Errorf("container (%s): secret (%s): %w", name, aws.ToString(v.Name), err))
+			}
+		}
+
+		for _, v := range apiObject.DependsOn {
+			dependency := aws.ToString(v.ContainerName)
+			target, ok := containers[dependency]
+
+			switch {
+			case dependency == name:
+				errs = append(errs, fmt.Errorf("container (%s) depends on itself", name))
+			case !ok:
+				errs = append(errs, fmt.Errorf("container (%s) depends on undefined container (%s)", name, dependency))
+			case v.Condition == awstypes.ContainerConditionHealthy && target.HealthCheck == nil:
+				errs = append(errs, fmt.Errorf("container (%s) depends on container (%s) being %s but it has no health check", name, dependency, v.Condition))
+			case (v.Condition == awstypes.ContainerConditionComplete || v.Condition == awstypes.ContainerConditionSuccess) && aws.ToBool(target.Essential):
+				errs = append(errs, fmt.Errorf("container (%s) depends on essential container (%s) with condition %s", name, dependency, v.Condition))
+			}
+		}
+	}
+
+	if cycle := findContainerDependencyCycle(apiObjects); len(cycle) > 0 {
+		errs = append(errs, fmt.Errorf("container dependency cycle: %s", strings.Join(cycle, " -> ")))
+	}
+
+	return errors.Join(errs...)
+}
+
+func validateContainerPortMappings(apiObjects []awstypes.PortMapping, isAWSVPC bool) []error {
+	var errs []error
+
+	type portProtocol struct {
+		port     int32
+		protocol awstypes.TransportProtocol
+	}
+	seen := make(map[portProtocol]struct{})
+
+	for i, apiObject := range apiObjects {
+		containerPort, hostPort := aws.ToInt32(apiObject.ContainerPort), aws.ToInt32(apiObject.HostPort)
+
+		switch portRange := aws.ToString(apiObject.ContainerPortRange); {
+		case containerPort == 0 && portRange == "":
+			errs = append(errs, fmt.Errorf("port mapping (%d): one of container_port or container_port_range must be specified", i))
+		case containerPort != 0 && portRange != "":
+			errs = append(errs, fmt.Errorf("port mapping (%d): only one of container_port or container_port_range may be specified", i))
+		case portRange != "":
+			if err := validateContainerPortRange(portRange); err != nil {
+				errs = append(errs, fmt.Errorf("port mapping (%d): %w", i, err))
+			}
+			if hostPort != 0 {
+				errs = append(errs, fmt.Errorf("port mapping (%d): host_port can't be specified with container_port_range", i))
+			}
+		}
+
+		if isAWSVPC && hostPort != 0 && containerPort != 0 && hostPort != containerPort {
+			errs = append(errs, fmt.Errorf("port mapping (%d): host_port (%d) must equal container_port (%d) when network_mode is %s", i, hostPort, containerPort, awstypes.NetworkModeAwsvpc))
+		}
+
+		if apiObject.AppProtocol != "" && apiObject.Protocol == awstypes.TransportProtocolUdp {
+			errs = append(errs, fmt.Errorf("port mapping (%d): app_protocol can't be specified with protocol %s", i, apiObject.Protocol))
+		}
+
+		if containerPort != 0 {
+			k := portProtocol{port: containerPort, protocol: apiObject.Protocol}
+			if k.protocol == "" {
+				k.protocol = containerDefinitionPortMappingProtocolDefault
+			}
+			if _, ok := seen[k]; ok {
+				errs = append(errs, fmt.Errorf("port mapping (%d): duplicate container_port (%d) and protocol (%s)", i, k.port, k.protocol))
+			}
+			seen[k] = struct{}{}
+		}
+	}
+
+	return errs
+}
+
+func validateContainerPortRange(v string) error {
+	from, to, ok := strings.Cut(v, "-")
+	if !ok {
+		return fmt.Errorf("container_port_range (%s) must be of the form from-to", v)
+	}
+
+	fromPort, err := strconv.Atoi(from)
+	if err != nil || fromPort < 1 || fromPort > 65535 {
+		return fmt.Errorf("container_port_range (%s) has an invalid start port", v)
+	}
+	toPort, err := strconv.Atoi(to)
+	if err != nil || toPort < 1 || toPort > 65535 {
+		return fmt.Errorf("container_port_range (%s) has an invalid end port", v)
+	}
+	if fromPort >= toPort {
+		return fmt.Errorf("container_port_range (%s) start port must be less than end port", v)
+	}
+
+	return nil
+}
+
+func validateContainerLogConfiguration(apiObject *awstypes.LogConfiguration) error {
+	if apiObject == nil {
+		return nil
+	}
+
+	var errs []error
+
+	switch apiObject.LogDriver {
+	case awstypes.LogDriverAwslogs:
+		if apiObject.Options["awslogs-group"] == "" {
+			errs = append(errs, fmt.Errorf("log driver %s requires the awslogs-group option", apiObject.LogDriver))
+		}
+		if v, ok := apiObject.Options["awslogs-create-group"]; ok && v != "true" && v != "false" {
+			errs = append(errs, fmt.Errorf("log driver %s option awslogs-create-group must be true or false", apiObject.LogDriver))
+		}
+	case awstypes.LogDriverSplunk:
+		if apiObject.Options["splunk-url"] == "" {
+			errs = append(errs, fmt.Errorf("log driver %s requires the splunk-url option", apiObject.LogDriver))
+		}
+	}
+
+	for _, v := range apiObject.SecretOptions {
+		if err := validateContainerSecretValueFrom(aws.ToString(v.ValueFrom)); err != nil {
+			errs = append(errs, fmt.Errorf("log configuration secret option (%s): %w", aws.ToString(v.Name), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func validateContainerHealthCheck(apiObject *awstypes.HealthCheck) error {
+	if apiObject == nil {
+		return nil
+	}
+
+	command := apiObject.Command
+	if len(command) == 0 {
+		return errors.New("health check command must not be empty")
+	}
+
+	switch command[0] {
+	case "NONE":
+		if len(command) > 1 {
+			return errors.New(`health check command "NONE" takes no arguments`)
+		}
+	case "CMD", "CMD-SHELL":
+		if len(command) < 2 {
+			return fmt.Errorf("health check command %q requires at least one argument", command[0])
+		}
+	default:
+		return fmt.Errorf(`health check command must start with "CMD", "CMD-SHELL" or "NONE", got %q`, command[0])
+	}
+
+	return nil
+}
+
+// validateContainerSecretValueFrom checks that a secret reference is either the ARN of
+// a Secrets Manager secret or Systems Manager parameter, or the name of a parameter in the same Region.
+func validateContainerSecretValueFrom(v string) error {
+	if v == "" {
+		return errors.New("value_from must not be empty")
+	}
+
+	if arn.IsARN(v) {
+		parsedARN, err := arn.Parse(v)
+		if err != nil {
+			return fmt.Errorf("value_from (%s) is not a valid ARN: %w", v, err)
+		}
+
+		switch parsedARN.Service {
+		case "secretsmanager":
+			if !strings.HasPrefix(parsedARN.Resource, "secret:") {
+				return fmt.Errorf("value_from (%s) is not a Secrets Manager secret ARN", v)
+			}
+		case "ssm":
+			if !strings.HasPrefix(parsedARN.Resource, "parameter/") {
+				return fmt.Errorf("value_from (%s) is not a Systems Manager parameter ARN", v)
+			}
+		default:
+			return fmt.Errorf("value_from (%s) must be a Secrets Manager or Systems Manager ARN", v)
+		}
+
+		return nil
+	}
+
+	if !regexache.MustCompile(`^/?[0-9A-Za-z_.-]+(/[0-9A-Za-z_.-]+)*$`).MatchString(v) {
+		return fmt.Errorf("value_from (%s) is not a valid Systems Manager parameter name or ARN", v)
+	}
+
+	return nil
+}
+
+// findContainerDependencyCycle returns the container names forming the first dependency cycle found, or nil.
+// The first name is repeated at the end of the cycle.
+func findContainerDependencyCycle(apiObjects []awstypes.ContainerDefinition) []string {
+	dependencies := make(map[string][]string, len(apiObjects))
+	for _, apiObject := range apiObjects {
+		name := aws.ToString(apiObject.Name)
+		for _, v := range apiObject.DependsOn {
+			// Self-references are reported separately.
+			if v := aws.ToString(v.ContainerName); v != name {
+				dependencies[name] = append(dependencies[name], v)
+			}
+		}
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int, len(apiObjects))
+	var path []string
+
+	var visit func(string) []string
+	visit = func(name string) []string {
+		switch state[name] {
+		case visiting:
+			i := slices.Index(path, name)
+			return append(slices.Clone(path[i:]), name)
+		case visited:
+			return nil
+		}
+
+		state[name] = visiting
+		path = append(path, name)
+
+		for _, v := range dependencies[name] {
+			if cycle := visit(v); cycle != nil {
+				return cycle
+			}
+		}
+
+		path = path[:len(path)-1]
+		state[name] = visited
+
+		return nil
+	}
+
+	for _, apiObject := range apiObjects {
+		if cycle := visit(aws.ToString(apiObject.Name)); cycle != nil {
+			return cycle
+		}
+	}
+
+	return nil
+}
+
+func expandContainerDefinitionBlocks(tfList []interface{}) []awstypes.ContainerDefinition {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObjects := make([]awstypes.ContainerDefinition, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.ContainerDefinition{
+			Essential: aws.Bool(tfMap["essential"].(bool)),
+			Image:     aws.String(tfMap["image"].(string)),
+			Name:      aws.String(tfMap[names.AttrName].(string)),
+		}
+
+		if v, ok := tfMap["command"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Command = flex.ExpandStringValueList(v)
+		}
+
+		if v, ok := tfMap["cpu"].(int); ok && v != 0 {
+			apiObject.Cpu = int32(v)
+		}
+
+		if v, ok := tfMap["depends_on"].([]interface{}); ok && len(v) > 0 {
+			apiObject.DependsOn = expandContainerDependencies(v)
+		}
+
+		if v, ok := tfMap["docker_labels"].(map[string]interface{}); ok && len(v) > 0 {
+			apiObject.DockerLabels = flex.ExpandStringValueMap(v)
+		}
+
+		if v, ok := tfMap["entry_point"].([]interface{}); ok && len(v) > 0 {
+			apiObject.EntryPoint = flex.ExpandStringValueList(v)
+		}
+
+		if v, ok := tfMap[names.AttrEnvironment].(map[string]interface{}); ok && len(v) > 0 {
+			for k, v := range flex.ExpandStringValueMap(v) {
+				apiObject.Environment = append(apiObject.Environment, awstypes.KeyValuePair{
+					Name:  aws.String(k),
+					Value: aws.String(v),
+				})
+			}
+		}
+
+		if v, ok := tfMap["health_check"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.HealthCheck = expandContainerHealthCheck(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["log_configuration"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.LogConfiguration = expandLogConfiguration(v)
+		}
+
+		if v, ok := tfMap["memory"].(int); ok && v != 0 {
+			apiObject.Memory = aws.Int32(int32(v))
+		}
+
+		if v, ok := tfMap["memory_reservation"].(int); ok && v != 0 {
+			apiObject.MemoryReservation = aws.Int32(int32(v))
+		}
+
+		if v, ok := tfMap["mount_point"].([]interface{}); ok && len(v) > 0 {
+			apiObject.MountPoints = expandContainerMountPoints(v)
+		}
+
+		if v, ok := tfMap["port_mapping"].([]interface{}); ok && len(v) > 0 {
+			apiObject.PortMappings = expandContainerPortMappings(v)
+		}
+
+		if v, ok := tfMap["privileged"].(bool); ok && v {
+			apiObject.Privileged = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["readonly_root_filesystem"].(bool); ok && v {
+			apiObject.ReadonlyRootFilesystem = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["secret"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Secrets = expandSecretOptions(v.List())
+		}
+
+		if v, ok := tfMap["start_timeout"].(int); ok && v != 0 {
+			apiObject.StartTimeout = aws.Int32(int32(v))
+		}
+
+		if v, ok := tfMap["stop_timeout"].(int); ok && v != 0 {
+			apiObject.StopTimeout = aws.Int32(int32(v))
+		}
+
+		if v, ok := tfMap["ulimit"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Ulimits = expandContainerUlimits(v)
+		}
+
+		if v, ok := tfMap["user"].(string); ok && v != "" {
+			apiObject.User = aws.String(v)
+		}
+
+		if v, ok := tfMap["working_directory"].(string); ok && v != "" {
+			apiObject.WorkingDirectory = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	containerDefinitions(apiObjects).orderEnvironmentVariables()
+
+	return apiObjects
+}
+
+func expandContainerDependencies(tfList []interface{}) []awstypes.ContainerDependency {
+	apiObjects := make([]awstypes.ContainerDependency, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, awstypes.ContainerDependency{
+			Condition:     awstypes.ContainerCondition(tfMap[names.AttrCondition].(string)),
+			ContainerName: aws.String(tfMap["container_name"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandContainerHealthCheck(tfMap map[string]interface{}) *awstypes.HealthCheck {
+	apiObject := &awstypes.HealthCheck{
+		Command: flex.ExpandStringValueList(tfMap["command"].([]interface{})),
+	}
+
+	if v, ok := tfMap[names.AttrInterval].(int); ok && v != 0 {
+		apiObject.Interval = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["retries"].(int); ok && v != 0 {
+		apiObject.Retries = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["start_period"].(int); ok && v != 0 {
+		apiObject.StartPeriod = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap[names.AttrTimeout].(int); ok && v != 0 {
+		apiObject.Timeout = aws.Int32(int32(v))
+	}
+
+	return apiObject
+}
+
+func expandContainerMountPoints(tfList []interface{}) []awstypes.MountPoint {
+	apiObjects := make([]awstypes.MountPoint, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, awstypes.MountPoint{
+			ContainerPath: aws.String(tfMap["container_path"].(string)),
+			ReadOnly:      aws.Bool(tfMap["read_only"].(bool)),
+			SourceVolume:  aws.String(tfMap["source_volume"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandContainerPortMappings(tfList []interface{}) []awstypes.PortMapping {
+	apiObjects := make([]awstypes.PortMapping, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.PortMapping{}
+
+		if v, ok := tfMap["app_protocol"].(string); ok && v != "" {
+			apiObject.AppProtocol = awstypes.ApplicationProtocol(v)
+		}
+
+		if v, ok := tfMap["container_port"].(int); ok && v != 0 {
+			apiObject.ContainerPort = aws.Int32(int32(v))
+		}
+
+		if v, ok := tfMap["container_port_range"].(string); ok && v != "" {
+			apiObject.ContainerPortRange = aws.String(v)
+		}
+
+		if v, ok := tfMap["host_port"].(int); ok && v != 0 {
+			apiObject.HostPort = aws.Int32(int32(v))
+		}
+
+		if v, ok := tfMap[names.AttrName].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		if v, ok := tfMap[names.AttrProtocol].(string); ok && v != "" {
+			apiObject.Protocol = awstypes.TransportProtocol(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandContainerUlimits(tfList []interface{}) []awstypes.Ulimit {
+	apiObjects := make([]awstypes.Ulimit, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, awstypes.Ulimit{
+			HardLimit: int32(tfMap["hard_limit"].(int)),
+			Name:      awstypes.UlimitName(tfMap[names.AttrName].(string)),
+			SoftLimit: int32(tfMap["soft_limit"].(int)),
+		})
+	}
+
+	return apiObjects
+}
+
+// flattenContainerDefinitionBlocks flattens the containers in the order of the names in order,
+// which is that of the configuration, followed by any other containers ordered by name.
+func flattenContainerDefinitionBlocks(apiObjects []awstypes.ContainerDefinition, order []string) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	apiObjects = slices.Clone(apiObjects)
+	containerDefinitions(apiObjects).orderContainers()
+	containerDefinitions(apiObjects).compactArrays()
+	slices.SortStableFunc(apiObjects, func(a, b awstypes.ContainerDefinition) int {
+		i, j := slices.Index(order, aws.ToString(a.Name)), slices.Index(order, aws.ToString(b.Name))
+		switch {
+		case i == j:
+			return 0
+		case i == -1:
+			return 1
+		case j == -1:
+			return -1
+		default:
+			return i - j
+		}
+	})
+
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"command":                  flex.FlattenStringValueList(apiObject.Command),
+			"cpu":                      apiObject.Cpu,
+			"depends_on":               flattenContainerDependencies(apiObject.DependsOn),
+			"docker_labels":            apiObject.DockerLabels,
+			"entry_point":              flex.FlattenStringValueList(apiObject.EntryPoint),
+			"essential":                aws.ToBool(apiObject.Essential),
+			"health_check":             flattenContainerHealthCheck(apiObject.HealthCheck),
+			"image":                    aws.ToString(apiObject.Image),
+			"log_configuration":        flattenContainerLogConfiguration(apiObject.LogConfiguration),
+			"memory":                   aws.ToInt32(apiObject.Memory),
+			"memory_reservation":       aws.ToInt32(apiObject.MemoryReservation),
+			"mount_point":              flattenContainerMountPoints(apiObject.MountPoints),
+			names.AttrName:             aws.ToString(apiObject.Name),
+			"port_mapping":             flattenContainerPortMappings(apiObject.PortMappings),
+			"privileged":               aws.ToBool(apiObject.Privileged),
+			"readonly_root_filesystem": aws.ToBool(apiObject.ReadonlyRootFilesystem),
+			"secret":                   flattenContainerSecrets(apiObject.Secrets),
+			"start_timeout":            aws.ToInt32(apiObject.StartTimeout),
+			"stop_timeout":             aws.ToInt32(apiObject.StopTimeout),
+			"ulimit":                   flattenContainerUlimits(apiObject.Ulimits),
+			"user":                     aws.ToString(apiObject.User),
+			"working_directory":        aws.ToString(apiObject.WorkingDirectory),
+		}
+
+		if len(apiObject.Environment) > 0 {
+			environment := make(map[string]interface{}, len(apiObject.Environment))
+			for _, v := range apiObject.Environment {
+				environment[aws.ToString(v.Name)] = aws.ToString(v.Value)
+			}
+			tfMap[names.AttrEnvironment] = environment
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenContainerDependencies(apiObjects []awstypes.ContainerDependency) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrCondition: apiObject.Condition,
+			"container_name":    aws.ToString(apiObject.ContainerName),
+		})
+	}
+
+	return tfList
+}
+
+func flattenContainerHealthCheck(apiObject *awstypes.HealthCheck) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"command":          flex.FlattenStringValueList(apiObject.Command),
+		names.AttrInterval: aws.ToInt32(apiObject.Interval),
+		"retries":          aws.ToInt32(apiObject.Retries),
+		"start_period":     aws.ToInt32(apiObject.StartPeriod),
+		names.AttrTimeout:  aws.ToInt32(apiObject.Timeout),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenContainerLogConfiguration(apiObject *awstypes.LogConfiguration) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"log_driver":    apiObject.LogDriver,
+		"options":       apiObject.Options,
+		"secret_option": flattenContainerSecrets(apiObject.SecretOptions),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenContainerMountPoints(apiObjects []awstypes.MountPoint) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"container_path": aws.ToString(apiObject.ContainerPath),
+			"read_only":      aws.ToBool(apiObject.ReadOnly),
+			"source_volume":  aws.ToString(apiObject.SourceVolume),
+		})
+	}
+
+	return tfList
+}
+
+func flattenContainerPortMappings(apiObjects []awstypes.PortMapping) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"app_protocol":         apiObject.AppProtocol,
+			"container_port":       aws.ToInt32(apiObject.ContainerPort),
+			"container_port_range": aws.ToString(apiObject.ContainerPortRange),
+			"host_port":            aws.ToInt32(apiObject.HostPort),
+			names.AttrName:         aws.ToString(apiObject.Name),
+			names.AttrProtocol:     apiObject.Protocol,
+		})
+	}
+
+	return tfList
+}
+
+func flattenContainerSecrets(apiObjects []awstypes.Secret) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrName: aws.ToString(apiObject.Name),
+			"value_from":   aws.ToString(apiObject.ValueFrom),
+		})
+	}
+
+	return tfList
+}
+
+func flattenContainerUlimits(apiObjects []awstypes.Ulimit) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			"hard_limit":   apiObject.HardLimit,
+			names.AttrName: apiObject.Name,
+			"soft_limit":   apiObject.SoftLimit,
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,310 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestValidateContainerDefinitionBlocks(t *testing.T) {
+	t.Parallel()
+
+	container := func(name string, optFns ...func(*awstypes.ContainerDefinition)) awstypes.ContainerDefinition {
+		apiObject := awstypes.ContainerDefinition{
+			Essential: aws.Bool(true),
+			Image:     aws.String("nginx"),
+			Name:      aws.String(name),
+		}
+		for _, optFn := range optFns {
+			optFn(&apiObject)
+		}
+		return apiObject
+	}
+	dependsOn := func(name string, condition awstypes.ContainerCondition) func(*awstypes.ContainerDefinition) {
+		return func(apiObject *awstypes.ContainerDefinition) {
+			apiObject.DependsOn = append(apiObject.DependsOn, awstypes.ContainerDependency{
+				Condition:     condition,
+				ContainerName: aws.String(name),
+			})
+		}
+	}
+	portMappings := func(v ...awstypes.PortMapping) func(*awstypes.ContainerDefinition) {
+		return func(apiObject *awstypes.ContainerDefinition) {
+			apiObject.PortMappings = v
+		}
+	}
+	secrets := func(v ...string) func(*awstypes.ContainerDefinition) {
+		return func(apiObject *awstypes.ContainerDefinition) {
+			for i, v := range v {
+				apiObject.Secrets = append(apiObject.Secrets, awstypes.Secret{
+					Name:      aws.String(strings.Repeat("S", i+1)),
+					ValueFrom: aws.String(v),
+				})
+			}
+		}
+	}
+	healthCheck := func(command ...string) func(*awstypes.ContainerDefinition) {
+		return func(apiObject *awstypes.ContainerDefinition) {
+			apiObject.HealthCheck = &awstypes.HealthCheck{
+				Command: command,
+			}
+		}
+	}
+	logConfiguration := func(driver awstypes.LogDriver, options map[string]string) func(*awstypes.ContainerDefinition) {
+		return func(apiObject *awstypes.ContainerDefinition) {
+			apiObject.LogConfiguration = &awstypes.LogConfiguration{
+				LogDriver: driver,
+				Options:   options,
+			}
+		}
+	}
+	nonEssential := func(apiObject *awstypes.ContainerDefinition) {
+		apiObject.Essential = aws.Bool(false)
+	}
+
+	testCases := map[string]struct {
+		apiObjects []awstypes.ContainerDefinition
+		isAWSVPC   bool
+		wantErr    string
+	}{
+		"valid": {
+			apiObjects: []awstypes.ContainerDefinition{
+				container("app",
+					dependsOn("init", awstypes.ContainerConditionSuccess),
+					dependsOn("proxy", awstypes.ContainerConditionHealthy),
+					portMappings(awstypes.PortMapping{ContainerPort: aws.Int32(8080), HostPort: aws.Int32(8080), Name: aws.String("http"), AppProtocol: awstypes.ApplicationProtocolHttp}),
+					secrets("arn:aws:secretsmanager:us-west-2:123456789012:secret:db-AbCdEf", "arn:aws:ssm:us-west-2:123456789012:parameter/app/key", "/app/token"), //lintignore:AWSAT003,AWSAT005
+					logConfiguration(awstypes.LogDriverAwslogs, map[string]string{"awslogs-group": "app", "awslogs-region": "us-west-2"}),                           //lintignore:AWSAT003
+				),
+				container("init", nonEssential),
+				container("proxy",
+					healthCheck("CMD-SHELL", "curl -f http://localhost/ || exit 1"),
+					portMappings(awstypes.PortMapping{ContainerPortRange: aws.String("9000-9100"), Protocol: awstypes.TransportProtocolUdp}),
+				),
+			},
+			isAWSVPC: true,
+		},
+		"duplicate container name": {
+			apiObjects: []awstypes.ContainerDefinition{container("app"), container("app")},
+			wantErr:    "duplicate container name (app)",
+		},
+		"port mapping no port": {
+			apiObjects: []awstypes.ContainerDefinition{container("app", portMappings(awstypes.PortMapping{}))},
+			wantErr:    "container (app): port mapping (0): one of container_port or container_port_range must be specified",
+		},
+		"port mapping port and range": {
+			apiObjects: []awstypes.ContainerDefinition{container("app", portMappings(awstypes.PortMapping{ContainerPort: aws.Int32(80), ContainerPortRange: aws.String("80-90")}))},
+			wantErr:    "container (app): port mapping (0): only one of container_port or container_port_range may be specified",
+		},
+		"port mapping invalid range": {
+			apiObjects: []awstypes.ContainerDefinition{container("app", portMappings(awstypes.PortMapping{ContainerPortRange: aws.String("90-80")}))},
+			wantErr:    "container (app): port mapping (0): container_port_range (90-80) start port must be less than end port",
+		},
+		"port mapping awsvpc host port": {
+			apiObjects: []awstypes.ContainerDefinition{container("app", portMappings(awstypes.PortMapping{ContainerPort: aws.Int32(80), HostPort: aws.Int32(8080)}))},
+			isAWSVPC:   true,
+			wantErr:    "container (app): port mapping (0): host_port (8080) must equal container_port (80) when network_mode is awsvpc",
+		},
+		"port mapping bridge host port": {
+			apiObjects: []awstypes.ContainerDefinition{container("app", portMappings(awstypes.PortMapping{ContainerPort: aws.Int32(80), HostPort: aws.Int32(8080)}))},
+		},
+		"port mapping duplicate port": {
+			apiObjects: []awstypes.ContainerDefinition{container("app", portMappings(
+				awstypes.PortMapping{ContainerPort: aws.Int32(80)},
+				awstypes.PortMapping{ContainerPort: aws.Int32(80), Protocol: awstypes.TransportProtocolTcp},
+				awstypes.PortMapping{ContainerPort: aws.Int32(80), Protocol: awstypes.TransportProtocolUdp},
+			))},
+			wantErr: "container (app): port mapping (1): duplicate container_port (80) and protocol (tcp)",
+		},
+		"port mapping duplicate name": {
+			apiObjects: []awstypes.ContainerDefinition{
+				container("app", portMappings(awstypes.PortMapping{ContainerPort: aws.Int32(80), Name: aws.String("http")})),
+				container("sidecar", portMappings(awstypes.PortMapping{ContainerPort: aws.Int32(81), Name: aws.String("http")})),
+			},
+			wantErr: "container (sidecar): port mapping name (http) is already used by container (app)",
+		},
+		"port mapping udp app protocol": {
+			apiObjects: []awstypes.ContainerDefinition{container("app", portMappings(awstypes.PortMapping{ContainerPort: aws.Int32(80), Protocol: awstypes.TransportProtocolUdp, AppProtocol: awstypes.ApplicationProtocolGrpc}))},
+			wantErr:    "container (app): port mapping (0): app_protocol can't be specified with protocol udp",
+		},
+		"awslogs missing group": {
+			apiObjects: []awstypes.ContainerDefinition{container("app", logConfiguration(awstypes.LogDriverAwslogs, map[string]string{"awslogs-region": "us-west-2"}))}, //lintignore:AWSAT003
+			wantErr:    "container (app): log driver awslogs requires the awslogs-group option",
+		},
+		"awslogs invalid create group": {
+			apiObjects: []awstypes.ContainerDefinition{container("app", logConfiguration(awstypes.LogDriverAwslogs, map[string]string{"awslogs-group": "app", "awslogs-create-group": "yes"}))},
+			wantErr:    "container (app): log driver awslogs option awslogs-create-group must be true or false",
+		},
+		"splunk missing url": {
+			apiObjects: []awstypes.ContainerDefinition{container("app", logConfiguration(awstypes.LogDriverSplunk, nil))},
+			wantErr:    "container (app): log driver splunk requires the splunk-url option",
+		},
+		"health check invalid command": {
+			apiObjects: []awstypes.ContainerDefinition{container("app", healthCheck("curl", "-f", "http://localhost/"))},
+			wantErr:    `container (app): health check command must start with "CMD", "CMD-SHELL" or "NONE", got "curl"`,
+		},
+		"health check missing arguments": {
+			apiObjects: []awstypes.ContainerDefinition{container("app", healthCheck("CMD"))},
+			wantErr:    `container (app): health check command "CMD" requires at least one argument`,
+		},
+		"health check none": {
+			apiObjects: []awstypes.ContainerDefinition{container("app", healthCheck("NONE"))},
+		},
+		"secret other service ARN": {
+			apiObjects: []awstypes.ContainerDefinition{container("app", secrets("arn:aws:s3:::bucket/key"))}, //lintignore:AWSAT005
+			wantErr:    "container (app): secret (S): value_from (arn:aws:s3:::bucket/key) must be a Secrets Manager or Systems Manager ARN",
+		},
+		"secret invalid parameter name": {
+			apiObjects: []awstypes.ContainerDefinition{container("app", secrets("my secret"))},
+			wantErr:    "container (app): secret (S): value_from (my secret) is not a valid Systems Manager parameter name or ARN",
+		},
+		"secret not a parameter ARN": {
+			apiObjects: []awstypes.ContainerDefinition{container("app", secrets("arn:aws:ssm:us-west-2:123456789012:document/app"))}, //lintignore:AWSAT003,AWSAT005
+			wantErr:    "container (app): secret (S): value_from (arn:aws:ssm:us-west-2:123456789012:document/app) is not a Systems Manager parameter ARN",
+		},
+		"depends on itself": {
+			apiObjects: []awstypes.ContainerDefinition{container("app", dependsOn("app", awstypes.ContainerConditionStart))},
+			wantErr:    "container (app) depends on itself",
+		},
+		"depends on undefined": {
+			apiObjects: []awstypes.ContainerDefinition{container("app", dependsOn("db", awstypes.ContainerConditionStart))},
+			wantErr:    "container (app) depends on undefined container (db)",
+		},
+		"depends on healthy without health check": {
+			apiObjects: []awstypes.ContainerDefinition{container("app", dependsOn("db", awstypes.ContainerConditionHealthy)), container("db")},
+			wantErr:    "container (app) depends on container (db) being HEALTHY but it has no health check",
+		},
+		"depends on essential completion": {
+			apiObjects: []awstypes.ContainerDefinition{container("app", dependsOn("init", awstypes.ContainerConditionComplete)), container("init")},
+			wantErr:    "container (app) depends on essential container (init) with condition COMPLETE",
+		},
+		"dependency cycle": {
+			apiObjects: []awstypes.ContainerDefinition{
+				container("a", dependsOn("b", awstypes.ContainerConditionStart)),
+				container("b", dependsOn("c", awstypes.ContainerConditionStart)),
+				container("c", dependsOn("a", awstypes.ContainerConditionStart)),
+			},
+			wantErr: "container dependency cycle: a -> b -> c -> a",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateContainerDefinitionBlocks(testCase.apiObjects, testCase.isAWSVPC)
+
+			if testCase.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error %q, got none", testCase.wantErr)
+			}
+			if !strings.Contains(err.Error(), testCase.wantErr) {
+				t.Errorf("expected error %q, got %q", testCase.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestFindContainerDependencyCycle(t *testing.T) {
+	t.Parallel()
+
+	container := func(name string, dependencies ...string) awstypes.ContainerDefinition {
+		apiObject := awstypes.ContainerDefinition{
+			Name: aws.String(name),
+		}
+		for _, v := range dependencies {
+			apiObject.DependsOn = append(apiObject.DependsOn, awstypes.ContainerDependency{
+				Condition:     awstypes.ContainerConditionStart,
+				ContainerName: aws.String(v),
+			})
+		}
+		return apiObject
+	}
+
+	testCases := map[string]struct {
+		apiObjects []awstypes.ContainerDefinition
+		want       []string
+	}{
+		"no dependencies": {
+			apiObjects: []awstypes.ContainerDefinition{container("a"), container("b")},
+		},
+		"diamond": {
+			apiObjects: []awstypes.ContainerDefinition{container("a", "b", "c"), container("b", "d"), container("c", "d"), container("d")},
+		},
+		"self reference ignored": {
+			apiObjects: []awstypes.ContainerDefinition{container("a", "a")},
+		},
+		"undefined dependency ignored": {
+			apiObjects: []awstypes.ContainerDefinition{container("a", "z")},
+		},
+		"two node cycle": {
+			apiObjects: []awstypes.ContainerDefinition{container("a", "b"), container("b", "a")},
+			want:       []string{"a", "b", "a"},
+		},
+		"cycle reached from acyclic container": {
+			apiObjects: []awstypes.ContainerDefinition{container("a", "b"), container("b", "c"), container("c", "d"), container("d", "b")},
+			want:       []string{"b", "c", "d", "b"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := findContainerDependencyCycle(testCase.apiObjects)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestExpandFlattenContainerDefinitionBlocks(t *testing.T) {
+	t.Parallel()
+
+	apiObjects := []awstypes.ContainerDefinition{
+		{
+			Essential: aws.Bool(true),
+			Image:     aws.String("nginx"),
+			Name:      aws.String("web"),
+		},
+		{
+			Essential: aws.Bool(false),
+			Image:     aws.String("busybox"),
+			Name:      aws.String("init"),
+		},
+		{
+			Essential: aws.Bool(true),
+			Image:     aws.String("envoy"),
+			Name:      aws.String("proxy"),
+		},
+	}
+
+	tfList := flattenContainerDefinitionBlocks(apiObjects, []string{"web", "init"})
+
+	var got []string
+	for _, v := range tfList {
+		got = append(got, v.(map[string]interface{})["name"].(string))
+	}
+
+	if diff := cmp.Diff(got, []string{"web", "init", "proxy"}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	if got, want := aws.ToString(apiObjects[0].Name), "web"; got != want {
+		t.Errorf("flatten reordered its input: got %q, want %q", got, want)
+	}
+}
@@ -1714,6 +1714,91 @@ func TestAccECSTaskDefinition_Volume_detectChangeInConfigureAtLaunch(t *testing.
 	})
 }
 
+func TestAccECSTaskDefinition_containerDefinitionBlock(t *testing.T) {
+	ctx := acctest.Context(t)
+	var def awstypes.TaskDefinition
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_task_definition.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaskDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaskDefinitionConfig_containerDefinitionBlock(rName, "nginx:1.27"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTaskDefinitionExists(ctx, resourceName, &def),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.name", "web"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.image", "nginx:1.27"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.essential", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.environment.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.environment.LISTEN_PORT", "80"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.depends_on.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.depends_on.0.condition", "SUCCESS"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.depends_on.0.container_name", "init"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.health_check.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.health_check.0.interval", "30"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.health_check.0.retries", "3"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.health_check.0.timeout", "5"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.port_mapping.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.port_mapping.0.container_port", "80"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.port_mapping.0.host_port", "80"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.port_mapping.0.protocol", "tcp"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.1.name", "init"),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.1.essential", acctest.CtFalse),
+					acctest.CheckResourceAttrJMES(resourceName, "container_definitions", "length(@)", "2"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectEmptyPlan(),
+					},
+				},
+			},
+			{
+				Config: testAccTaskDefinitionConfig_containerDefinitionBlock(rName, "nginx:1.28"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTaskDefinitionExists(ctx, resourceName, &def),
+					resource.TestCheckResourceAttr(resourceName, "container_definition.0.image", "nginx:1.28"),
+					resource.TestCheckResourceAttr(resourceName, "revision", "2"),
+				),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionDestroyBeforeCreate),
+					},
+				},
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateIdFunc:       acctest.AttrImportStateIdFunc(resourceName, names.AttrARN),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrSkipDestroy, "track_latest"},
+			},
+		},
+	})
+}
+
+func TestAccECSTaskDefinition_containerDefinitionBlockDependencyCycle(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaskDefinitionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTaskDefinitionConfig_containerDefinitionBlockDependencyCycle(rName),
+				ExpectError: regexache.MustCompile(`container dependency cycle: app -> proxy -> app`),
+			},
+		},
+	})
+}
+
 func testAccCheckTaskDefinitionProxyConfiguration(after *awstypes.TaskDefinition, containerName string, proxyType string,
 	ignoredUid string, ignoredGid string, appPorts string, proxyIngressPort string, proxyEgressPort string,
 	egressIgnoredPorts string, egressIgnoredIPs string) resource.TestCheckFunc {
@@ -3827,3 +3912,74 @@ TASK_DEFINITION
 }
 `, rName, enableFaultInjection)
 }
+
+func testAccTaskDefinitionConfig_containerDefinitionBlock(rName, image string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_task_definition" "test" {
+  family                   = %[1]q
+  network_mode             = "awsvpc"
+  requires_compatibilities = ["FARGATE"]
+  cpu                      = "256"
+  memory                   = "512"
+
+  container_definition {
+    name  = "web"
+    image = %[2]q
+
+    environment = {
+      LISTEN_PORT = "80"
+    }
+
+    depends_on {
+      condition      = "SUCCESS"
+      container_name = "init"
+    }
+
+    health_check {
+      command = ["CMD-SHELL", "curl -f http://localhost/ || exit 1"]
+    }
+
+    port_mapping {
+      container_port = 80
+    }
+  }
+
+  container_definition {
+    name      = "init"
+    image     = "busybox"
+    essential = false
+    command   = ["true"]
+  }
+}
+`, rName, image)
+}
+
+func testAccTaskDefinitionConfig_containerDefinitionBlockDependencyCycle(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ecs_task_definition" "test" {
+  family = %[1]q
+
+  container_definition {
+    name   = "app"
+    image  = "nginx"
+    memory = 128
+
+    depends_on {
+      condition      = "START"
+      container_name = "proxy"
+    }
+  }
+
+  container_definition {
+    name   = "proxy"
+    image  = "envoyproxy/envoy"
+    memory = 128
+
+    depends_on {
+      condition      = "START"
+      container_name = "app"
+    }
+  }
+}
+`, rName)
+}
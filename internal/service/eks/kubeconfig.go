@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/eks/kubeconfig"
+)
+
+const (
+	kubeconfigAuthenticationExec  = "exec"
+	kubeconfigAuthenticationToken = "token"
+)
+
+func kubeconfigAuthentication_Values() []string {
+	return []string{
+		kubeconfigAuthenticationExec,
+		kubeconfigAuthenticationToken,
+	}
+}
+
+type kubeconfigClusterSpec struct {
+	alias     string
+	name      string
+	namespace string
+}
+
+type kubeconfigOptions struct {
+	authentication string
+	currentContext string
+	execCommand    string
+	profile        string
+	roleARN        string
+}
+
+// renderKubeconfig renders a kubeconfig for the specified EKS clusters.
+// With token authentication a token is generated for each cluster and embedded,
+// otherwise each user runs `aws eks get-token` as an exec plugin.
+func renderKubeconfig(ctx context.Context, client *conns.AWSClient, clusters []kubeconfigClusterSpec, opts kubeconfigOptions) (string, error) {
+	conn := client.EKSClient(ctx)
+
+	var generator Generator
+	var stsConn *sts.Client
+	if opts.authentication == kubeconfigAuthenticationToken {
+		var err error
+		generator, err = NewGenerator(false, false)
+		if err != nil {
+			return "", err
+		}
+
+		stsConn = client.STSClient(ctx)
+		if opts.roleARN != "" {
+			stsConn = sts.New(stsConn.Options(), func(o *sts.Options) {
+				o.Credentials = aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(stsConn, opts.roleARN))
+			})
+		}
+	}
+
+	config := kubeconfig.Config{
+		CurrentContext: opts.currentContext,
+	}
+
+	for _, v := range clusters {
+		cluster, err := findClusterByName(ctx, conn, v.name)
+		if err != nil {
+			return "", fmt.Errorf("reading EKS Cluster (%s): %w", v.name, err)
+		}
+
+		c := kubeconfig.Cluster{
+			Alias:     v.alias,
+			ARN:       aws.ToString(cluster.Arn),
+			Endpoint:  aws.ToString(cluster.Endpoint),
+			Name:      v.name,
+			Namespace: v.namespace,
+		}
+		if cluster.CertificateAuthority != nil {
+			c.CertificateAuthorityData = aws.ToString(cluster.CertificateAuthority.Data)
+		}
+
+		if generator != nil {
+			token, err := generator.GetWithSTS(ctx, v.name, stsConn)
+			if err != nil {
+				return "", fmt.Errorf("generating EKS Cluster (%s) Authentication Token: %w", v.name, err)
+			}
+
+			c.Token = token.Token
+		} else {
+			c.Exec = kubeconfig.Exec{
+				Command: opts.execCommand,
+				Profile: opts.profile,
+				Region:  client.Region(ctx),
+				RoleARN: opts.roleARN,
+			}
+		}
+
+		config.Clusters = append(config.Clusters, c)
+	}
+
+	output, err := config.Marshal()
+	if err != nil {
+		return "", err
+	}
+
+	return string(output), nil
+}
@@ -0,0 +1,269 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package kubeconfig renders kubeconfig files for EKS clusters, equivalent to
+// those written by `aws eks update-kubeconfig`.
+package kubeconfig
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// ExecAPIVersion is the client authentication API version used by the exec plugin.
+	ExecAPIVersion = "client.authentication.k8s.io/v1beta1"
+	// DefaultExecCommand is the command run by the exec plugin to obtain a token.
+	DefaultExecCommand = "aws"
+)
+
+// Cluster describes an EKS cluster to be included in a kubeconfig.
+type Cluster struct {
+	// Name is the EKS cluster name.
+	Name string
+	// ARN is the EKS cluster ARN. When set it is used to name the kubeconfig cluster
+	// and context entries, as the AWS CLI does. Otherwise Name is used.
+	ARN string
+	// Endpoint is the Kubernetes API server endpoint.
+	Endpoint string
+	// CertificateAuthorityData is the base64 encoded certificate authority data.
+	CertificateAuthorityData string
+	// Alias overrides the context name.
+	Alias string
+	// Namespace is the default namespace for the context.
+	Namespace string
+
+	// Token, if set, is embedded in the kubeconfig instead of an exec plugin.
+	Token string
+	// Exec configures the exec plugin used when no token is embedded.
+	Exec Exec
+}
+
+// Exec configures an exec plugin which runs `aws eks get-token`.
+type Exec struct {
+	// Command is the command to run. Defaults to DefaultExecCommand.
+	Command string
+	// Region is passed as --region.
+	Region string
+	// RoleARN is passed as --role-arn.
+	RoleARN string
+	// Profile is passed in the AWS_PROFILE environment variable.
+	Profile string
+}
+
+// Config is a kubeconfig for one or more EKS clusters.
+type Config struct {
+	Clusters []Cluster
+	// CurrentContext is the name of the current context. Defaults to the first cluster's context.
+	CurrentContext string
+}
+
+type file struct {
+	APIVersion     string       `yaml:"apiVersion"`
+	Clusters       []namedEntry `yaml:"clusters"`
+	Contexts       []namedEntry `yaml:"contexts"`
+	CurrentContext string       `yaml:"current-context"`
+	Kind           string       `yaml:"kind"`
+	Preferences    struct{}     `yaml:"preferences"`
+	Users          []namedEntry `yaml:"users"`
+}
+
+type namedEntry struct {
+	Name    string        `yaml:"name"`
+	Cluster *clusterEntry `yaml:"cluster,omitempty"`
+	Context *contextEntry `yaml:"context,omitempty"`
+	User    *userEntry    `yaml:"user,omitempty"`
+}
+
+type clusterEntry struct {
+	CertificateAuthorityData string `yaml:"certificate-authority-data"`
+	Server                   string `yaml:"server"`
+}
+
+type contextEntry struct {
+	Cluster   string `yaml:"cluster"`
+	Namespace string `yaml:"namespace,omitempty"`
+	User      string `yaml:"user"`
+}
+
+type userEntry struct {
+	Exec  *execEntry `yaml:"exec,omitempty"`
+	Token string     `yaml:"token,omitempty"`
+}
+
+type execEntry struct {
+	APIVersion         string     `yaml:"apiVersion"`
+	Args               []string   `yaml:"args"`
+	Command            string     `yaml:"command"`
+	Env                []envEntry `yaml:"env,omitempty"`
+	InteractiveMode    string     `yaml:"interactiveMode"`
+	ProvideClusterInfo bool       `yaml:"provideClusterInfo"`
+}
+
+type envEntry struct {
+	Name  string `yaml:"name"`
+	Value string `yaml:"value"`
+}
+
+// Validate checks that the cluster has the information required to connect to it.
+func (c Cluster) Validate() error {
+	if c.Name == "" {
+		return errors.New("cluster name is required")
+	}
+
+	if c.Endpoint == "" {
+		return fmt.Errorf("cluster %q: endpoint is required", c.Name)
+	}
+	if !strings.HasPrefix(c.Endpoint, "https://") {
+		return fmt.Errorf("cluster %q: endpoint %q must be an https URL", c.Name, c.Endpoint)
+	}
+
+	if c.CertificateAuthorityData == "" {
+		return fmt.Errorf("cluster %q: certificate authority data is required", c.Name)
+	}
+	if _, err := base64.StdEncoding.DecodeString(c.CertificateAuthorityData); err != nil {
+		return fmt.Errorf("cluster %q: certificate authority data is not valid base64: %w", c.Name, err)
+	}
+
+	return nil
+}
+
+// entryName is the name of the kubeconfig cluster entry for the cluster.
+func (c Cluster) entryName() string {
+	if c.ARN != "" {
+		return c.ARN
+	}
+
+	return c.Name
+}
+
+// ContextName is the name of the kubeconfig context for the cluster.
+func (c Cluster) ContextName() string {
+	if c.Alias != "" {
+		return c.Alias
+	}
+
+	return c.entryName()
+}
+
+func (e Exec) entry(clusterName string) *execEntry {
+	command := e.Command
+	if command == "" {
+		command = DefaultExecCommand
+	}
+
+	var args []string
+	if e.Region != "" {
+		args = append(args, "--region", e.Region)
+	}
+	args = append(args, "eks", "get-token", "--cluster-name", clusterName, "--output", "json")
+	if e.RoleARN != "" {
+		args = append(args, "--role-arn", e.RoleARN)
+	}
+
+	entry := &execEntry{
+		APIVersion:      ExecAPIVersion,
+		Args:            args,
+		Command:         command,
+		InteractiveMode: "IfAvailable",
+	}
+
+	if e.Profile != "" {
+		entry.Env = append(entry.Env, envEntry{
+			Name:  "AWS_PROFILE",
+			Value: e.Profile,
+		})
+	}
+
+	return entry
+}
+
+// Marshal renders the kubeconfig as YAML.
+func (c Config) Marshal() ([]byte, error) {
+	if len(c.Clusters) == 0 {
+		return nil, errors.New("at least one cluster is required")
+	}
+
+	f := file{
+		APIVersion:     "v1",
+		Clusters:       []namedEntry{},
+		Contexts:       []namedEntry{},
+		CurrentContext: c.CurrentContext,
+		Kind:           "Config",
+		Users:          []namedEntry{},
+	}
+
+	var contexts []string
+	for _, cluster := range c.Clusters {
+		if err := cluster.Validate(); err != nil {
+			return nil, err
+		}
+
+		contextName := cluster.ContextName()
+		if slices.Contains(contexts, contextName) {
+			return nil, fmt.Errorf("duplicate context name %q", contextName)
+		}
+		contexts = append(contexts, contextName)
+
+		name := cluster.entryName()
+		entry := &clusterEntry{
+			CertificateAuthorityData: cluster.CertificateAuthorityData,
+			Server:                   cluster.Endpoint,
+		}
+		// The same cluster may be included more than once, e.g. under different aliases or namespaces.
+		if i := slices.IndexFunc(f.Clusters, func(v namedEntry) bool { return v.Name == name }); i == -1 {
+			f.Clusters = append(f.Clusters, namedEntry{
+				Name:    name,
+				Cluster: entry,
+			})
+		} else if *f.Clusters[i].Cluster != *entry {
+			return nil, fmt.Errorf("cluster %q: conflicting endpoint or certificate authority data", name)
+		}
+
+		user := &userEntry{}
+		if cluster.Token != "" {
+			user.Token = cluster.Token
+		} else {
+			user.Exec = cluster.Exec.entry(cluster.Name)
+		}
+
+		// Each context has its own user, as credentials may differ between aliases of the same cluster.
+		f.Users = append(f.Users, namedEntry{
+			Name: contextName,
+			User: user,
+		})
+
+		f.Contexts = append(f.Contexts, namedEntry{
+			Name: contextName,
+			Context: &contextEntry{
+				Cluster:   name,
+				Namespace: cluster.Namespace,
+				User:      contextName,
+			},
+		})
+	}
+
+	if f.CurrentContext == "" {
+		f.CurrentContext = contexts[0]
+	} else if !slices.Contains(contexts, f.CurrentContext) {
+		return nil, fmt.Errorf("current context %q does not match any cluster context", f.CurrentContext)
+	}
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(f); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
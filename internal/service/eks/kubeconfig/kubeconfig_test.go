@@ -0,0 +1,271 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kubeconfig
+
+import (
+	"strings"
+	"testing"
+)
+
+const (
+	testClusterARN      = "arn:aws:eks:us-west-2:123456789012:cluster/example" //lintignore:AWSAT003,AWSAT005
+	testClusterEndpoint = "https://EXAMPLE.gr7.us-west-2.eks.amazonaws.com"    //lintignore:AWSAT003
+	testClusterCAData   = "Y2VydGlmaWNhdGU="
+)
+
+func TestConfigMarshal(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		config   Config
+		expected string
+		wantErr  string
+	}{
+		"exec": {
+			config: Config{
+				Clusters: []Cluster{{
+					ARN:                      testClusterARN,
+					CertificateAuthorityData: testClusterCAData,
+					Endpoint:                 testClusterEndpoint,
+					Exec: Exec{
+						Profile: "example",
+						Region:  "us-west-2",                              //lintignore:AWSAT003
+						RoleARN: "arn:aws:iam::123456789012:role/example", //lintignore:AWSAT005
+					},
+					Name: "example",
+				}},
+			},
+			expected: `apiVersion: v1
+clusters:
+  - name: arn:aws:eks:us-west-2:123456789012:cluster/example
+    cluster:
+      certificate-authority-data: Y2VydGlmaWNhdGU=
+      server: https://EXAMPLE.gr7.us-west-2.eks.amazonaws.com
+contexts:
+  - name: arn:aws:eks:us-west-2:123456789012:cluster/example
+    context:
+      cluster: arn:aws:eks:us-west-2:123456789012:cluster/example
+      user: arn:aws:eks:us-west-2:123456789012:cluster/example
+current-context: arn:aws:eks:us-west-2:123456789012:cluster/example
+kind: Config
+preferences: {}
+users:
+  - name: arn:aws:eks:us-west-2:123456789012:cluster/example
+    user:
+      exec:
+        apiVersion: client.authentication.k8s.io/v1beta1
+        args:
+          - --region
+          - us-west-2
+          - eks
+          - get-token
+          - --cluster-name
+          - example
+          - --output
+          - json
+          - --role-arn
+          - arn:aws:iam::123456789012:role/example
+        command: aws
+        env:
+          - name: AWS_PROFILE
+            value: example
+        interactiveMode: IfAvailable
+        provideClusterInfo: false
+`,
+		},
+		"token with aliases": {
+			config: Config{
+				Clusters: []Cluster{
+					{
+						Alias:                    "admin",
+						CertificateAuthorityData: testClusterCAData,
+						Endpoint:                 testClusterEndpoint,
+						Name:                     "example",
+						Token:                    "k8s-aws-v1.admin",
+					},
+					{
+						Alias:                    "apps",
+						CertificateAuthorityData: testClusterCAData,
+						Endpoint:                 testClusterEndpoint,
+						Name:                     "example",
+						Namespace:                "apps",
+						Token:                    "k8s-aws-v1.apps",
+					},
+				},
+				CurrentContext: "apps",
+			},
+			expected: `apiVersion: v1
+clusters:
+  - name: example
+    cluster:
+      certificate-authority-data: Y2VydGlmaWNhdGU=
+      server: https://EXAMPLE.gr7.us-west-2.eks.amazonaws.com
+contexts:
+  - name: admin
+    context:
+      cluster: example
+      user: admin
+  - name: apps
+    context:
+      cluster: example
+      namespace: apps
+      user: apps
+current-context: apps
+kind: Config
+preferences: {}
+users:
+  - name: admin
+    user:
+      token: k8s-aws-v1.admin
+  - name: apps
+    user:
+      token: k8s-aws-v1.apps
+`,
+		},
+		"custom exec command": {
+			config: Config{
+				Clusters: []Cluster{{
+					CertificateAuthorityData: testClusterCAData,
+					Endpoint:                 testClusterEndpoint,
+					Exec: Exec{
+						Command: "/usr/local/bin/aws",
+					},
+					Name: "example",
+				}},
+			},
+			expected: `apiVersion: v1
+clusters:
+  - name: example
+    cluster:
+      certificate-authority-data: Y2VydGlmaWNhdGU=
+      server: https://EXAMPLE.gr7.us-west-2.eks.amazonaws.com
+contexts:
+  - name: example
+    context:
+      cluster: example
+      user: example
+current-context: example
+kind: Config
+preferences: {}
+users:
+  - name: example
+    user:
+      exec:
+        apiVersion: client.authentication.k8s.io/v1beta1
+        args:
+          - eks
+          - get-token
+          - --cluster-name
+          - example
+          - --output
+          - json
+        command: /usr/local/bin/aws
+        interactiveMode: IfAvailable
+        provideClusterInfo: false
+`,
+		},
+		"no clusters": {
+			wantErr: "at least one cluster is required",
+		},
+		"no endpoint": {
+			config: Config{
+				Clusters: []Cluster{{
+					CertificateAuthorityData: testClusterCAData,
+					Name:                     "example",
+				}},
+			},
+			wantErr: `cluster "example": endpoint is required`,
+		},
+		"http endpoint": {
+			config: Config{
+				Clusters: []Cluster{{
+					CertificateAuthorityData: testClusterCAData,
+					Endpoint:                 "http://example.com",
+					Name:                     "example",
+				}},
+			},
+			wantErr: "must be an https URL",
+		},
+		"invalid certificate authority data": {
+			config: Config{
+				Clusters: []Cluster{{
+					CertificateAuthorityData: "not base64!",
+					Endpoint:                 testClusterEndpoint,
+					Name:                     "example",
+				}},
+			},
+			wantErr: "certificate authority data is not valid base64",
+		},
+		"duplicate context": {
+			config: Config{
+				Clusters: []Cluster{
+					{
+						CertificateAuthorityData: testClusterCAData,
+						Endpoint:                 testClusterEndpoint,
+						Name:                     "example",
+					},
+					{
+						CertificateAuthorityData: testClusterCAData,
+						Endpoint:                 testClusterEndpoint,
+						Name:                     "example",
+						Namespace:                "apps",
+					},
+				},
+			},
+			wantErr: `duplicate context name "example"`,
+		},
+		"conflicting cluster": {
+			config: Config{
+				Clusters: []Cluster{
+					{
+						CertificateAuthorityData: testClusterCAData,
+						Endpoint:                 testClusterEndpoint,
+						Name:                     "example",
+					},
+					{
+						Alias:                    "other",
+						CertificateAuthorityData: testClusterCAData,
+						Endpoint:                 "https://OTHER.gr7.us-west-2.eks.amazonaws.com", //lintignore:AWSAT003
+						Name:                     "example",
+					},
+				},
+			},
+			wantErr: `cluster "example": conflicting endpoint or certificate authority data`,
+		},
+		"unknown current context": {
+			config: Config{
+				Clusters: []Cluster{{
+					CertificateAuthorityData: testClusterCAData,
+					Endpoint:                 testClusterEndpoint,
+					Name:                     "example",
+				}},
+				CurrentContext: "other",
+			},
+			wantErr: `current context "other" does not match any cluster context`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			output, err := testCase.config.Marshal()
+
+			if testCase.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", testCase.wantErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := string(output); got != testCase.expected {
+				t.Errorf("got:\n%s\nexpected:\n%s", got, testCase.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_eks_kubeconfig", name="Kubeconfig")
+func dataSourceKubeconfig() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceKubeconfigRead,
+
+		Schema: map[string]*schema.Schema{
+			"cluster": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alias": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						names.AttrName: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.NoZeroValues,
+						},
+						names.AttrNamespace: {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"current_context": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"exec_command": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"kubeconfig": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			names.AttrProfile: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			names.AttrRoleARN: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func dataSourceKubeconfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var clusters []kubeconfigClusterSpec
+	var clusterNames []string
+	for _, tfMapRaw := range d.Get("cluster").([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		cluster := kubeconfigClusterSpec{
+			alias:     tfMap["alias"].(string),
+			name:      tfMap[names.AttrName].(string),
+			namespace: tfMap[names.AttrNamespace].(string),
+		}
+		clusters = append(clusters, cluster)
+		clusterNames = append(clusterNames, cluster.name)
+	}
+
+	// Users always run an exec plugin. Tokens are only embedded by the ephemeral resource and the function
+	// so that they're never persisted in state.
+	opts := kubeconfigOptions{
+		authentication: kubeconfigAuthenticationExec,
+		currentContext: d.Get("current_context").(string),
+		execCommand:    d.Get("exec_command").(string),
+		profile:        d.Get(names.AttrProfile).(string),
+		roleARN:        d.Get(names.AttrRoleARN).(string),
+	}
+
+	output, err := renderKubeconfig(ctx, meta.(*conns.AWSClient), clusters, opts)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EKS Kubeconfig: %s", err)
+	}
+
+	d.SetId(strings.Join(clusterNames, ","))
+	d.Set("kubeconfig", output)
+
+	return diags
+}
@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEKSKubeconfigDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_eks_kubeconfig.test"
+	resourceName := "aws_eks_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubeconfigDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrID, resourceName, names.AttrName),
+					resource.TestMatchResourceAttr(dataSourceName, "kubeconfig", regexache.MustCompile(`current-context: arn:`)),
+					resource.TestMatchResourceAttr(dataSourceName, "kubeconfig", regexache.MustCompile(`get-token`)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEKSKubeconfigDataSource_alias(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_eks_kubeconfig.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubeconfigDataSourceConfig_alias(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "kubeconfig", regexache.MustCompile(`current-context: apps`)),
+					resource.TestMatchResourceAttr(dataSourceName, "kubeconfig", regexache.MustCompile(`namespace: apps`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccKubeconfigDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_basic(rName), `
+data "aws_eks_kubeconfig" "test" {
+  cluster {
+    name = aws_eks_cluster.test.name
+  }
+}
+`)
+}
+
+func testAccKubeconfigDataSourceConfig_alias(rName string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_basic(rName), fmt.Sprintf(`
+data "aws_eks_kubeconfig" "test" {
+  current_context = "apps"
+
+  cluster {
+    name  = aws_eks_cluster.test.name
+    alias = %[1]q
+  }
+
+  cluster {
+    name      = aws_eks_cluster.test.name
+    alias     = "apps"
+    namespace = "apps"
+  }
+}
+`, rName))
+}
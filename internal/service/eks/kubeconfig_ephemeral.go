@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ERNameKubeconfig = "Ephemeral Resource Kubeconfig"
+)
+
+// @EphemeralResource(aws_eks_kubeconfig, name="Kubeconfig")
+func newEphemeralKubeconfig(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralKubeconfig{}, nil
+}
+
+type ephemeralKubeconfig struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (e *ephemeralKubeconfig) Metadata(_ context.Context, _ ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = "aws_eks_kubeconfig"
+}
+
+func (e *ephemeralKubeconfig) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"authentication": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(kubeconfigAuthentication_Values()...),
+				},
+			},
+			"current_context": schema.StringAttribute{
+				Optional: true,
+			},
+			"exec_command": schema.StringAttribute{
+				Optional: true,
+			},
+			"kubeconfig": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			names.AttrProfile: schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"cluster": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[epKubeconfigClusterModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"alias": schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						names.AttrNamespace: schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (e *ephemeralKubeconfig) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data epKubeconfigModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	tfClusters, diags := data.Clusters.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var clusters []kubeconfigClusterSpec
+	for _, v := range tfClusters {
+		clusters = append(clusters, kubeconfigClusterSpec{
+			alias:     v.Alias.ValueString(),
+			name:      v.Name.ValueString(),
+			namespace: v.Namespace.ValueString(),
+		})
+	}
+
+	opts := kubeconfigOptions{
+		authentication: data.Authentication.ValueString(),
+		currentContext: data.CurrentContext.ValueString(),
+		execCommand:    data.ExecCommand.ValueString(),
+		profile:        data.Profile.ValueString(),
+		roleARN:        data.RoleARN.ValueString(),
+	}
+	if opts.authentication == "" {
+		opts.authentication = kubeconfigAuthenticationExec
+	}
+
+	output, err := renderKubeconfig(ctx, e.Meta(), clusters, opts)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.EKS, create.ErrActionReading, ERNameKubeconfig, "", err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.Kubeconfig = types.StringValue(output)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type epKubeconfigModel struct {
+	Authentication types.String                                              `tfsdk:"authentication"`
+	Clusters       fwtypes.ListNestedObjectValueOf[epKubeconfigClusterModel] `tfsdk:"cluster"`
+	CurrentContext types.String                                              `tfsdk:"current_context"`
+	ExecCommand    types.String                                              `tfsdk:"exec_command"`
+	Kubeconfig     types.String                                              `tfsdk:"kubeconfig"`
+	Profile        types.String                                              `tfsdk:"profile"`
+	RoleARN        fwtypes.ARN                                               `tfsdk:"role_arn"`
+}
+
+type epKubeconfigClusterModel struct {
+	Alias     types.String `tfsdk:"alias"`
+	Name      types.String `tfsdk:"name"`
+	Namespace types.String `tfsdk:"namespace"`
+}
@@ -21,6 +21,11 @@ func (p *servicePackage) EphemeralResources(ctx context.Context) []*types.Servic
 			TypeName: "aws_eks_cluster_auth",
 			Name:     "ClusterAuth",
 		},
+		{
+			Factory:  newEphemeralKubeconfig,
+			TypeName: "aws_eks_kubeconfig",
+			Name:     "Kubeconfig",
+		},
 	}
 }
 
@@ -73,6 +78,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_eks_clusters",
 			Name:     "Clusters",
 		},
+		{
+			Factory:  dataSourceKubeconfig,
+			TypeName: "aws_eks_kubeconfig",
+			Name:     "Kubeconfig",
+		},
 		{
 			Factory:  dataSourceNodeGroup,
 			TypeName: "aws_eks_node_group",
@@ -3,6 +3,8 @@
 
 //go:generate go run ../../generate/tags/main.go -CreateTags -ListTags -ListTagsInIDElem=ResourceName -ListTagsOutTagsElem=TagList -ServiceTagsSlice -TagOp=AddTagsToResource -TagInIDElem=ResourceName -UntagOp=RemoveTagsFromResource -UpdateTags
 //go:generate go run ../../generate/servicepackage/main.go
+//go:generate go run ../../generate/basictests/main.go
+//go:generate go run ../../generate/writeonlytests/main.go
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package elasticache
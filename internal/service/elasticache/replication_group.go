@@ -45,6 +45,8 @@ const (
 
 // @SDKResource("aws_elasticache_replication_group", name="Replication Group")
 // @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/elasticache/types;awstypes;awstypes.ReplicationGroup")
+// @Testing(writeOnlyAttribute="auth_token_wo")
 func resourceReplicationGroup() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
@@ -79,7 +81,7 @@ func resourceReplicationGroup() *schema.Resource {
 				Optional:      true,
 				Sensitive:     true,
 				ValidateFunc:  validReplicationGroupAuthToken,
-				ConflictsWith: []string{"user_group_ids"},
+				ConflictsWith: []string{"auth_token_wo", "user_group_ids"},
 			},
 			"auth_token_update_strategy": {
 				Type:             schema.TypeString,
@@ -87,6 +89,19 @@ func resourceReplicationGroup() *schema.Resource {
 				ValidateDiagFunc: enum.Validate[awstypes.AuthTokenUpdateStrategyType](),
 				Default:          awstypes.AuthTokenUpdateStrategyTypeRotate,
 			},
+			"auth_token_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				WriteOnly:     true,
+				ValidateFunc:  validReplicationGroupAuthToken,
+				ConflictsWith: []string{"auth_token", "user_group_ids"},
+			},
+			"auth_token_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"auth_token_wo"},
+			},
 			names.AttrAutoMinorVersionUpgrade: {
 				Type:         nullable.TypeNullableBool,
 				Optional:     true,
@@ -367,7 +382,7 @@ func resourceReplicationGroup() *schema.Resource {
 				Type:          schema.TypeSet,
 				Optional:      true,
 				Elem:          &schema.Schema{Type: schema.TypeString},
-				ConflictsWith: []string{"auth_token"},
+				ConflictsWith: []string{"auth_token", "auth_token_wo"},
 			},
 		},
 
@@ -422,6 +437,10 @@ func resourceReplicationGroup() *schema.Resource {
 			replicationGroupValidateAutomaticFailoverNumCacheClusters,
 			verify.SetTagsDiff,
 		),
+
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			validation.PreferWriteOnlyAttribute(cty.GetAttrPath("auth_token"), cty.GetAttrPath("auth_token_wo")),
+		},
 	}
 }
 
@@ -446,6 +465,17 @@ func resourceReplicationGroupCreate(ctx context.Context, d *schema.ResourceData,
 		input.AuthToken = aws.String(v.(string))
 	}
 
+	// get write-only value from configuration
+	authTokenWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("auth_token_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if authTokenWO != "" {
+		input.AuthToken = aws.String(authTokenWO)
+	}
+
 	if v, ok := d.GetOk(names.AttrAutoMinorVersionUpgrade); ok {
 		if v, null, _ := nullable.Bool(v.(string)).ValueBool(); !null {
 			input.AutoMinorVersionUpgrade = aws.Bool(v)
@@ -986,10 +1016,23 @@ func resourceReplicationGroupUpdate(ctx context.Context, d *schema.ResourceData,
 			}
 		}
 
-		if d.HasChanges("auth_token", "auth_token_update_strategy") {
+		if d.HasChanges("auth_token", "auth_token_update_strategy", "auth_token_wo_version") {
+			authToken := d.Get("auth_token").(string)
+
+			// get write-only value from configuration
+			authTokenWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("auth_token_wo"))
+			diags = append(diags, di...)
+			if diags.HasError() {
+				return diags
+			}
+
+			if authTokenWO != "" {
+				authToken = authTokenWO
+			}
+
 			input := &elasticache.ModifyReplicationGroupInput{
 				ApplyImmediately:        aws.Bool(true),
-				AuthToken:               aws.String(d.Get("auth_token").(string)),
+				AuthToken:               aws.String(authToken),
 				AuthTokenUpdateStrategy: awstypes.AuthTokenUpdateStrategyType(d.Get("auth_token_update_strategy").(string)),
 				ReplicationGroupId:      aws.String(d.Id()),
 			}
@@ -0,0 +1,30 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_elasticache_user" "test" {
+  user_id              = var.rName
+  user_name            = "username1"
+  access_string        = "on ~app::* -@all +@read +@hash +@bitmap +@geo -setbit -bitfield -hset -hsetnx -hmset -hincrby -hincrbyfloat -hdel -bitop -geoadd -georadius -georadiusbymember"
+  engine               = "redis"
+  passwords_wo         = [var.secret]
+  passwords_wo_version = var.secret_version
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+
+variable "secret" {
+  description = "Value of the write-only attribute"
+  type        = string
+  nullable    = false
+  sensitive   = true
+}
+
+variable "secret_version" {
+  description = "Version of the write-only attribute"
+  type        = number
+  nullable    = false
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/elasticache"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -30,6 +31,8 @@ import (
 
 // @SDKResource("aws_elasticache_user", name="User")
 // @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/elasticache/types;awstypes;awstypes.User")
+// @Testing(writeOnlyAttribute="passwords_wo")
 func resourceUser() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceUserCreate,
@@ -106,7 +109,25 @@ func resourceUser() *schema.Resource {
 					Type:         schema.TypeString,
 					ValidateFunc: validation.StringLenBetween(16, 128),
 				},
-				Sensitive: true,
+				Sensitive:     true,
+				ConflictsWith: []string{"passwords_wo"},
+			},
+			"passwords_wo": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 2,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(16, 128),
+				},
+				Sensitive:     true,
+				WriteOnly:     true,
+				ConflictsWith: []string{"passwords"},
+			},
+			"passwords_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"passwords_wo"},
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
@@ -121,6 +142,10 @@ func resourceUser() *schema.Resource {
 				ForceNew: true,
 			},
 		},
+
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			validation.PreferWriteOnlyAttribute(cty.GetAttrPath("passwords"), cty.GetAttrPath("passwords_wo")),
+		},
 	}
 }
 
@@ -147,6 +172,17 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta interf
 		input.Passwords = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
+	// get write-only value from configuration
+	passwordsWO, di := flex.GetWriteOnlyStringListValue(d, cty.GetAttrPath("passwords_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if len(passwordsWO) > 0 {
+		input.Passwords = passwordsWO
+	}
+
 	output, err := conn.CreateUser(ctx, input)
 
 	// Some partitions (e.g. ISO) may not support tag-on-create.
@@ -254,6 +290,18 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 			input.Passwords = flex.ExpandStringValueSet(d.Get("passwords").(*schema.Set))
 		}
 
+		if d.HasChange("passwords_wo_version") {
+			passwordsWO, di := flex.GetWriteOnlyStringListValue(d, cty.GetAttrPath("passwords_wo"))
+			diags = append(diags, di...)
+			if diags.HasError() {
+				return diags
+			}
+
+			if len(passwordsWO) > 0 {
+				input.Passwords = passwordsWO
+			}
+		}
+
 		_, err := conn.ModifyUser(ctx, input)
 
 		if err != nil {
@@ -16,6 +16,7 @@ import (
 	elasticsearch "github.com/aws/aws-sdk-go-v2/service/elasticsearchservice"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticsearchservice/types"
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -35,8 +36,17 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+var (
+	masterUserOptionsPath    = cty.GetAttrPath("advanced_security_options").IndexInt(0).GetAttr("master_user_options").IndexInt(0)
+	masterUserPasswordPath   = masterUserOptionsPath.GetAttr("master_user_password")
+	masterUserPasswordWOPath = masterUserOptionsPath.GetAttr("master_user_password_wo")
+)
+
 // @SDKResource("aws_elasticsearch_domain", name="Domain")
 // @Tags(identifierAttribute="id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/elasticsearchservice/types;awstypes;awstypes.ElasticsearchDomainStatus")
+// @Testing(generator="testAccRandomDomainName()")
+// @Testing(writeOnlyAttribute="advanced_security_options.0.master_user_options.0.master_user_password_wo")
 func resourceDomain() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceDomainCreate,
@@ -97,6 +107,10 @@ func resourceDomain() *schema.Resource {
 			verify.SetTagsDiff,
 		),
 
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			validation.PreferWriteOnlyAttribute(masterUserPasswordPath, masterUserPasswordWOPath),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"access_policies": {
 				Type:                  schema.TypeString,
@@ -149,9 +163,22 @@ func resourceDomain() *schema.Resource {
 										Optional: true,
 									},
 									"master_user_password": {
-										Type:      schema.TypeString,
-										Optional:  true,
-										Sensitive: true,
+										Type:          schema.TypeString,
+										Optional:      true,
+										Sensitive:     true,
+										ConflictsWith: []string{"advanced_security_options.0.master_user_options.0.master_user_password_wo"},
+									},
+									"master_user_password_wo": {
+										Type:          schema.TypeString,
+										Optional:      true,
+										Sensitive:     true,
+										WriteOnly:     true,
+										ConflictsWith: []string{"advanced_security_options.0.master_user_options.0.master_user_password"},
+									},
+									"master_user_password_wo_version": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										RequiredWith: []string{"advanced_security_options.0.master_user_options.0.master_user_password_wo"},
 									},
 								},
 							},
@@ -573,6 +600,19 @@ func resourceDomainCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		input.AdvancedSecurityOptions = expandAdvancedSecurityOptions(v.([]interface{}))
 	}
 
+	// get write-only value from configuration
+	if input.AdvancedSecurityOptions != nil && input.AdvancedSecurityOptions.MasterUserOptions != nil {
+		masterUserPasswordWO, di := flex.GetWriteOnlyStringValue(d, masterUserPasswordWOPath)
+		diags = append(diags, di...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if masterUserPasswordWO != "" {
+			input.AdvancedSecurityOptions.MasterUserOptions.MasterUserPassword = aws.String(masterUserPasswordWO)
+		}
+	}
+
 	if v, ok := d.GetOk("auto_tune_options"); ok && len(v.([]interface{})) > 0 {
 		input.AutoTuneOptions = expandAutoTuneOptionsInput(v.([]interface{})[0].(map[string]interface{}))
 	}
@@ -838,6 +878,18 @@ func resourceDomainUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 
 		if d.HasChange("advanced_security_options") {
 			input.AdvancedSecurityOptions = expandAdvancedSecurityOptions(d.Get("advanced_security_options").([]interface{}))
+
+			if input.AdvancedSecurityOptions.MasterUserOptions != nil {
+				masterUserPasswordWO, di := flex.GetWriteOnlyStringValue(d, masterUserPasswordWOPath)
+				diags = append(diags, di...)
+				if diags.HasError() {
+					return diags
+				}
+
+				if masterUserPasswordWO != "" {
+					input.AdvancedSecurityOptions.MasterUserOptions.MasterUserPassword = aws.String(masterUserPasswordWO)
+				}
+			}
 		}
 
 		if d.HasChange("auto_tune_options") {
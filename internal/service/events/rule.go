@@ -27,6 +27,8 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/eventpattern"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/schedule"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -120,9 +122,13 @@ func resourceRule() *schema.Resource {
 				ValidateFunc: verify.ValidARN,
 			},
 			names.AttrScheduleExpression: {
-				Type:         schema.TypeString,
-				Optional:     true,
-				ValidateFunc: validation.StringLenBetween(0, 256),
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(0, 256),
+					verify.ValidScheduleExpression(schedule.TypeCron, schedule.TypeRate),
+					verify.ValidRateScheduleExpressionUnit,
+				),
 				AtLeastOneOf: []string{names.AttrScheduleExpression, "event_pattern"},
 			},
 			names.AttrState: {
@@ -488,6 +494,14 @@ func validateEventPatternValue() schema.SchemaValidateFunc {
 		if len(json) > maxJSONLength {
 			errors = append(errors, fmt.Errorf("%q cannot be longer than %d characters: %q", k, maxJSONLength, json))
 		}
+
+		if json == "" {
+			return
+		}
+
+		if err := eventpattern.Validate(json); err != nil {
+			errors = append(errors, fmt.Errorf("%q is not a valid event pattern: %w", k, err))
+		}
 		return
 	}
 }
@@ -453,6 +453,28 @@ func TestAccEventsRule_patternJSONEncoder(t *testing.T) {
 	})
 }
 
+func TestAccEventsRule_patternInvalid(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EventsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuleDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccRuleConfig_pattern(rName, "{\"source\":\"aws.ec2\"}"),
+				ExpectError: regexache.MustCompile(`source: value must be an object or an array`),
+			},
+			{
+				Config:      testAccRuleConfig_pattern(rName, "{\"detail\":{\"count\":[{\"numeric\":[\"=\",0,\"<\",5]}]}}"),
+				ExpectError: regexache.MustCompile(`"=" cannot be combined with other operators`),
+			},
+		},
+	})
+}
+
 func TestAccEventsRule_scheduleAndPattern(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v eventbridge.DescribeRuleOutput
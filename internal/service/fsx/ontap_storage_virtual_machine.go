@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/fsx"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/fsx/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -32,6 +33,8 @@ import (
 
 // @SDKResource("aws_fsx_ontap_storage_virtual_machine", name="ONTAP Storage Virtual Machine")
 // @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/fsx/types;awstypes;awstypes.StorageVirtualMachine")
+// @Testing(writeOnlyAttribute="svm_admin_password_wo")
 func resourceONTAPStorageVirtualMachine() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceONTAPStorageVirtualMachineCreate,
@@ -222,10 +225,24 @@ func resourceONTAPStorageVirtualMachine() *schema.Resource {
 				Computed: true,
 			},
 			"svm_admin_password": {
-				Type:         schema.TypeString,
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ValidateFunc:  validation.StringLenBetween(8, 50),
+				ConflictsWith: []string{"svm_admin_password_wo"},
+			},
+			"svm_admin_password_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				WriteOnly:     true,
+				ValidateFunc:  validation.StringLenBetween(8, 50),
+				ConflictsWith: []string{"svm_admin_password"},
+			},
+			"svm_admin_password_wo_version": {
+				Type:         schema.TypeInt,
 				Optional:     true,
-				Sensitive:    true,
-				ValidateFunc: validation.StringLenBetween(8, 50),
+				RequiredWith: []string{"svm_admin_password_wo"},
 			},
 			names.AttrTags:    tftags.TagsSchema(),
 			names.AttrTagsAll: tftags.TagsSchemaComputed(),
@@ -236,6 +253,10 @@ func resourceONTAPStorageVirtualMachine() *schema.Resource {
 		},
 
 		CustomizeDiff: verify.SetTagsDiff,
+
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			validation.PreferWriteOnlyAttribute(cty.GetAttrPath("svm_admin_password"), cty.GetAttrPath("svm_admin_password_wo")),
+		},
 	}
 }
 
@@ -262,6 +283,17 @@ func resourceONTAPStorageVirtualMachineCreate(ctx context.Context, d *schema.Res
 		input.SvmAdminPassword = aws.String(v.(string))
 	}
 
+	// get write-only value from configuration
+	svmAdminPasswordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("svm_admin_password_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if svmAdminPasswordWO != "" {
+		input.SvmAdminPassword = aws.String(svmAdminPasswordWO)
+	}
+
 	output, err := conn.CreateStorageVirtualMachine(ctx, input)
 
 	if err != nil {
@@ -332,6 +364,18 @@ func resourceONTAPStorageVirtualMachineUpdate(ctx context.Context, d *schema.Res
 			input.SvmAdminPassword = aws.String(d.Get("svm_admin_password").(string))
 		}
 
+		if d.HasChange("svm_admin_password_wo_version") {
+			svmAdminPasswordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("svm_admin_password_wo"))
+			diags = append(diags, di...)
+			if diags.HasError() {
+				return diags
+			}
+
+			if svmAdminPasswordWO != "" {
+				input.SvmAdminPassword = aws.String(svmAdminPasswordWO)
+			}
+		}
+
 		_, err := conn.UpdateStorageVirtualMachine(ctx, input)
 
 		if err != nil {
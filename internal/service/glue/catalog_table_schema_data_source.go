@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package glue
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/glue/catalogschema"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_glue_catalog_table_schema", name="Catalog Table Schema")
+func DataSourceCatalogTableSchema() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceCatalogTableSchemaRead,
+
+		Schema: map[string]*schema.Schema{
+			"columns": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrComment: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"definition": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			names.AttrFormat: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(catalogTableSchemaFormat_Values(), false),
+			},
+			"message_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"warnings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceCatalogTableSchemaRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	definition, format := d.Get("definition").(string), d.Get(names.AttrFormat).(string)
+	messageName := d.Get("message_name").(string)
+
+	var output *catalogschema.Schema
+	var err error
+
+	switch format {
+	case catalogTableSchemaFormatAvro:
+		output, err = catalogschema.FromAvro(definition)
+	case catalogTableSchemaFormatJSONSchema:
+		output, err = catalogschema.FromJSONSchema(definition)
+	case catalogTableSchemaFormatParquet:
+		var footer []byte
+		if footer, err = itypes.Base64Decode(definition); err == nil {
+			output, err = catalogschema.FromParquet(footer)
+		}
+	case catalogTableSchemaFormatProtobuf:
+		if messageName == "" {
+			return sdkdiag.AppendErrorf(diags, "message_name is required when format is %s", format)
+		}
+		var descriptorSet []byte
+		if descriptorSet, err = itypes.Base64Decode(definition); err == nil {
+			output, err = catalogschema.FromProtobuf(descriptorSet, messageName)
+		}
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "converting %s schema to Glue Catalog Table columns: %s", format, err)
+	}
+
+	for _, v := range output.Warnings {
+		diags = sdkdiag.AppendWarningf(diags, "lossy conversion of %s schema: %s", format, v)
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(format + messageName + definition)))
+	if err := d.Set("columns", flattenCatalogTableSchemaColumns(output.Columns)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting columns: %s", err)
+	}
+	d.Set("warnings", output.Warnings)
+
+	return diags
+}
+
+func flattenCatalogTableSchemaColumns(apiObjects []catalogschema.Column) []interface{} {
+	tfList := make([]interface{}, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]interface{}{
+			names.AttrComment: apiObject.Comment,
+			names.AttrName:    apiObject.Name,
+			names.AttrType:    apiObject.Type,
+		})
+	}
+
+	return tfList
+}
@@ -0,0 +1,226 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package glue_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccGlueCatalogTableSchemaDataSource_avro(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_glue_catalog_table_schema.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlueServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCatalogTableSchemaDataSourceConfig_avro,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "columns.#", "4"),
+					resource.TestCheckResourceAttr(dataSourceName, "columns.0.name", names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName, "columns.0.type", "string"),
+					resource.TestCheckResourceAttr(dataSourceName, "columns.0.comment", "Order ID"),
+					resource.TestCheckResourceAttr(dataSourceName, "columns.1.name", "amount"),
+					resource.TestCheckResourceAttr(dataSourceName, "columns.1.type", "decimal(10,2)"),
+					resource.TestCheckResourceAttr(dataSourceName, "columns.2.name", "items"),
+					resource.TestCheckResourceAttr(dataSourceName, "columns.2.type", "array<struct<sku:string,quantity:int>>"),
+					resource.TestCheckResourceAttr(dataSourceName, "columns.3.name", names.AttrValue),
+					resource.TestCheckResourceAttr(dataSourceName, "columns.3.type", "string"),
+					resource.TestCheckResourceAttr(dataSourceName, "warnings.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "warnings.0", `field "value": union of int, string converted to string`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGlueCatalogTableSchemaDataSource_jsonSchema(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_glue_catalog_table_schema.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlueServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCatalogTableSchemaDataSourceConfig_jsonSchema,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "columns.#", "3"),
+					// jsonencode sorts object keys.
+					resource.TestCheckResourceAttr(dataSourceName, "columns.0.name", "created"),
+					resource.TestCheckResourceAttr(dataSourceName, "columns.0.type", "timestamp"),
+					resource.TestCheckResourceAttr(dataSourceName, "columns.1.name", names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName, "columns.1.type", "bigint"),
+					resource.TestCheckResourceAttr(dataSourceName, "columns.2.name", "labels"),
+					resource.TestCheckResourceAttr(dataSourceName, "columns.2.type", "map<string,string>"),
+					resource.TestCheckResourceAttr(dataSourceName, "warnings.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGlueCatalogTableSchemaDataSource_protobuf(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_glue_catalog_table_schema.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlueServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCatalogTableSchemaDataSourceConfig_protobuf("example.Order"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "columns.#", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "columns.0.name", names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName, "columns.0.type", "bigint"),
+					resource.TestCheckResourceAttr(dataSourceName, "columns.1.name", names.AttrTags),
+					resource.TestCheckResourceAttr(dataSourceName, "columns.1.type", "array<string>"),
+					resource.TestCheckResourceAttr(dataSourceName, "columns.2.name", "created"),
+					resource.TestCheckResourceAttr(dataSourceName, "columns.2.type", "timestamp"),
+				),
+			},
+			{
+				Config:      testAccCatalogTableSchemaDataSourceConfig_protobuf("example.Unknown"),
+				ExpectError: regexache.MustCompile(`message "example.Unknown" not found in the Protobuf descriptor set`),
+			},
+		},
+	})
+}
+
+func TestAccGlueCatalogTableSchemaDataSource_parquet(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_glue_catalog_table_schema.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlueServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCatalogTableSchemaDataSourceConfig_parquet,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "columns.#", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "columns.0.name", names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName, "columns.0.type", "bigint"),
+					resource.TestCheckResourceAttr(dataSourceName, "columns.1.name", names.AttrName),
+					resource.TestCheckResourceAttr(dataSourceName, "columns.1.type", "string"),
+					resource.TestCheckResourceAttr(dataSourceName, "columns.2.name", "amount"),
+					resource.TestCheckResourceAttr(dataSourceName, "columns.2.type", "decimal(10,2)"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGlueCatalogTableSchemaDataSource_invalid(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlueServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCatalogTableSchemaDataSourceConfig_recursive,
+				ExpectError: regexache.MustCompile(`recursive type "Node" cannot be represented in the Glue Data Catalog`),
+			},
+		},
+	})
+}
+
+const testAccCatalogTableSchemaDataSourceConfig_avro = `
+data "aws_glue_catalog_table_schema" "test" {
+  format = "AVRO"
+  definition = jsonencode({
+    type      = "record"
+    name      = "Order"
+    namespace = "example"
+    fields = [
+      { name = "id", type = "string", doc = "Order ID" },
+      { name = "amount", type = { type = "bytes", logicalType = "decimal", precision = 10, scale = 2 } },
+      {
+        name = "items"
+        type = {
+          type = "array"
+          items = {
+            type = "record"
+            name = "Item"
+            fields = [
+              { name = "sku", type = "string" },
+              { name = "quantity", type = "int" },
+            ]
+          }
+        }
+      },
+      { name = "value", type = ["null", "int", "string"] },
+    ]
+  })
+}
+`
+
+const testAccCatalogTableSchemaDataSourceConfig_jsonSchema = `
+data "aws_glue_catalog_table_schema" "test" {
+  format = "JSON"
+  definition = jsonencode({
+    type = "object"
+    properties = {
+      id      = { type = "integer" }
+      created = { type = "string", format = "date-time" }
+      labels  = { type = "object", additionalProperties = { type = "string" } }
+    }
+  })
+}
+`
+
+func testAccCatalogTableSchemaDataSourceConfig_protobuf(messageName string) string {
+	// A FileDescriptorSet for:
+	//
+	//	syntax = "proto3";
+	//	package example;
+	//	import "google/protobuf/timestamp.proto";
+	//	message Order {
+	//	  int64 id = 1;
+	//	  repeated string tags = 2;
+	//	  google.protobuf.Timestamp created = 3;
+	//	}
+	return fmt.Sprintf(`
+data "aws_glue_catalog_table_schema" "test" {
+  format       = "PROTOBUF"
+  definition   = "CmAKC29yZGVyLnByb3RvEgdleGFtcGxlIkgKBU9yZGVyEggKAmlkIAEoAxIKCgR0YWdzIAMoCRIpCgdjcmVhdGVkIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXA="
+  message_name = %[1]q
+}
+`, messageName)
+}
+
+// The footer of a Parquet file with id (int64), name (string) and amount (decimal(10,2)) columns.
+const testAccCatalogTableSchemaDataSourceConfig_parquet = `
+data "aws_glue_catalog_table_schema" "test" {
+  format     = "PARQUET"
+  definition = "FQQZTDUAGAZzY2hlbWEVBgAVBCUAGAJpZAAVDCUCGARuYW1lJQAAFQ4lAhgGYW1vdW50JQoVBBUUABYAAA=="
+}
+`
+
+const testAccCatalogTableSchemaDataSourceConfig_recursive = `
+data "aws_glue_catalog_table_schema" "test" {
+  format = "AVRO"
+  definition = jsonencode({
+    type = "record"
+    name = "Node"
+    fields = [
+      { name = "next", type = ["null", "Node"] },
+    ]
+  })
+}
+`
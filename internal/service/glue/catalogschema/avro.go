@@ -0,0 +1,271 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package catalogschema
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// avroSchema is a complex Avro type.
+type avroSchema struct {
+	Doc         string          `json:"doc"`
+	Fields      []avroField     `json:"fields"`
+	Items       json.RawMessage `json:"items"`
+	LogicalType string          `json:"logicalType"`
+	Name        string          `json:"name"`
+	Namespace   string          `json:"namespace"`
+	Precision   int             `json:"precision"`
+	Scale       int             `json:"scale"`
+	Type        json.RawMessage `json:"type"`
+	Values      json.RawMessage `json:"values"`
+}
+
+type avroField struct {
+	Doc  string          `json:"doc"`
+	Name string          `json:"name"`
+	Type json.RawMessage `json:"type"`
+}
+
+var avroPrimitiveTypes = map[string]string{
+	"boolean": "boolean",
+	"bytes":   "binary",
+	"double":  "double",
+	"float":   "float",
+	"int":     "int",
+	"long":    "bigint",
+	"string":  "string",
+}
+
+type avroConverter struct {
+	converter
+	// named maps the full names of named types to their Glue types. A named type being converted maps to "".
+	named map[string]string
+}
+
+// FromAvro converts an Avro schema, whose top-level type must be a record, into columns.
+// See https://avro.apache.org/docs/1.11.1/specification/.
+func FromAvro(definition string) (*Schema, error) {
+	var root avroSchema
+	if err := json.Unmarshal([]byte(definition), &root); err != nil {
+		return nil, fmt.Errorf("parsing Avro schema: %w", err)
+	}
+
+	var typ string
+	if err := json.Unmarshal(root.Type, &typ); err != nil || typ != "record" {
+		return nil, errors.New("top-level Avro type must be a record")
+	}
+
+	c := &avroConverter{named: map[string]string{}}
+	var fields []structField
+	_, err := c.define(&root, "", func(namespace string) (string, error) {
+		var err error
+		fields, err = c.convertRecord(&root, namespace, "")
+		if err != nil {
+			return "", err
+		}
+		return structType(fields), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	schema := &Schema{}
+	for i, f := range fields {
+		schema.Columns = append(schema.Columns, Column{
+			Comment: root.Fields[i].Doc,
+			Name:    f.name,
+			Type:    f.typ,
+		})
+	}
+	schema.Warnings = c.warnings
+
+	return schema, nil
+}
+
+// convert returns the Glue type of an Avro type.
+func (c *avroConverter) convert(raw json.RawMessage, namespace, path string) (string, error) {
+	switch s := strings.TrimSpace(string(raw)); {
+	case strings.HasPrefix(s, `"`):
+		var name string
+		if err := json.Unmarshal(raw, &name); err != nil {
+			return "", err
+		}
+		return c.convertName(name, namespace, path)
+	case strings.HasPrefix(s, "["):
+		var types []json.RawMessage
+		if err := json.Unmarshal(raw, &types); err != nil {
+			return "", err
+		}
+		return c.convertUnion(types, namespace, path)
+	case strings.HasPrefix(s, "{"):
+		var schema avroSchema
+		if err := json.Unmarshal(raw, &schema); err != nil {
+			return "", err
+		}
+		return c.convertComplex(&schema, namespace, path)
+	}
+
+	return "", fmt.Errorf("field %q: invalid Avro type %s", path, raw)
+}
+
+func (c *avroConverter) convertName(name, namespace, path string) (string, error) {
+	if typ, ok := avroPrimitiveTypes[name]; ok {
+		return typ, nil
+	}
+	if name == "null" {
+		return "", fmt.Errorf("field %q: null type can only be used in a union", path)
+	}
+
+	fullName := name
+	if !strings.Contains(name, ".") && namespace != "" {
+		fullName = namespace + "." + name
+	}
+	for _, n := range []string{fullName, name} {
+		if typ, ok := c.named[n]; ok {
+			if typ == "" {
+				return "", fmt.Errorf("field %q: recursive type %q cannot be represented in the Glue Data Catalog", path, name)
+			}
+			return typ, nil
+		}
+	}
+
+	return "", fmt.Errorf("field %q: unknown Avro type %q", path, name)
+}
+
+// convertUnion converts a union. A union of null and one other type is that type; other unions are converted to strings.
+func (c *avroConverter) convertUnion(types []json.RawMessage, namespace, path string) (string, error) {
+	var glueTypes []string
+
+	for _, raw := range types {
+		var name string
+		if json.Unmarshal(raw, &name) == nil && name == "null" {
+			continue
+		}
+
+		typ, err := c.convert(raw, namespace, path)
+		if err != nil {
+			return "", err
+		}
+		glueTypes = append(glueTypes, typ)
+	}
+
+	switch len(glueTypes) {
+	case 0:
+		return "", fmt.Errorf("field %q: union must contain a type other than null", path)
+	case 1:
+		return glueTypes[0], nil
+	}
+
+	c.warnf(path, "union of %s converted to string", strings.Join(glueTypes, ", "))
+
+	return "string", nil
+}
+
+func (c *avroConverter) convertComplex(schema *avroSchema, namespace, path string) (string, error) {
+	var typ string
+	if err := json.Unmarshal(schema.Type, &typ); err != nil {
+		// e.g. {"type": {"type": "array", ...}} or {"type": ["null", "string"]}.
+		return c.convert(schema.Type, namespace, path)
+	}
+
+	switch typ {
+	case "array":
+		element, err := c.convert(schema.Items, namespace, path)
+		if err != nil {
+			return "", err
+		}
+		return arrayType(element), nil
+	case "map":
+		value, err := c.convert(schema.Values, namespace, path)
+		if err != nil {
+			return "", err
+		}
+		return mapType("string", value), nil
+	case "enum":
+		return c.define(schema, namespace, func(string) (string, error) { return "string", nil })
+	case "fixed":
+		return c.define(schema, namespace, func(string) (string, error) { return c.convertLogical(schema, "binary", path), nil })
+	case "record", "error":
+		return c.define(schema, namespace, func(namespace string) (string, error) {
+			fields, err := c.convertRecord(schema, namespace, path)
+			if err != nil {
+				return "", err
+			}
+			return structType(fields), nil
+		})
+	}
+
+	glueType, err := c.convertName(typ, namespace, path)
+	if err != nil {
+		return "", err
+	}
+
+	return c.convertLogical(schema, glueType, path), nil
+}
+
+// define converts a named type and records it so that it can be referenced by name.
+func (c *avroConverter) define(schema *avroSchema, namespace string, convert func(namespace string) (string, error)) (string, error) {
+	name := schema.Name
+	switch i := strings.LastIndexByte(name, '.'); {
+	case name == "":
+		return "", errors.New("named Avro type must have a name")
+	case i >= 0:
+		namespace = name[:i]
+	case schema.Namespace != "":
+		namespace = schema.Namespace
+		name = namespace + "." + name
+	case namespace != "":
+		name = namespace + "." + name
+	}
+
+	c.named[name] = ""
+	typ, err := convert(namespace)
+	if err != nil {
+		return "", err
+	}
+	c.named[name] = typ
+
+	return typ, nil
+}
+
+func (c *avroConverter) convertRecord(schema *avroSchema, namespace, path string) ([]structField, error) {
+	if len(schema.Fields) == 0 {
+		return nil, fmt.Errorf("record %q must have at least one field", schema.Name)
+	}
+
+	var fields []structField
+	for _, f := range schema.Fields {
+		typ, err := c.convert(f.Type, namespace, joinPath(path, f.Name))
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, structField{name: f.Name, typ: typ})
+	}
+
+	return fields, nil
+}
+
+// convertLogical returns the Glue type of a logical type annotating a primitive or fixed type.
+// Unknown logical types are ignored, as required by the Avro specification.
+func (c *avroConverter) convertLogical(schema *avroSchema, glueType, path string) string {
+	switch schema.LogicalType {
+	case "date":
+		return "date"
+	case "decimal":
+		return c.decimalType(path, schema.Precision, schema.Scale)
+	case "duration":
+		c.warnf(path, "duration converted to binary")
+	case "local-timestamp-micros", "local-timestamp-millis", "local-timestamp-nanos", "timestamp-micros", "timestamp-millis", "timestamp-nanos":
+		return "timestamp"
+	case "time-micros", "time-millis":
+		c.warnf(path, "%s converted to %s", schema.LogicalType, glueType)
+	case "uuid":
+		return "string"
+	}
+
+	return glueType
+}
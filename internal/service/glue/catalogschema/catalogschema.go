@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package catalogschema converts data schemas into Glue Data Catalog columns.
+//
+// Avro schemas, JSON Schemas, Protobuf descriptor sets and Parquet file footers are supported.
+// Column types are Hive type strings, e.g. `struct<id:bigint,tags:array<string>>`.
+// Conversions that lose information, e.g. an Avro union of several types, are reported as warnings.
+//
+// See https://docs.aws.amazon.com/athena/latest/ug/data-types.html.
+package catalogschema
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// maxDecimalPrecision is the maximum precision of a decimal column.
+	maxDecimalPrecision = 38
+)
+
+// Column is a Glue Data Catalog column.
+type Column struct {
+	Comment string
+	Name    string
+	Type    string
+}
+
+// Schema is the result of a conversion.
+type Schema struct {
+	Columns []Column
+	// Warnings describe lossy conversions.
+	Warnings []string
+}
+
+// converter accumulates the warnings of a conversion.
+type converter struct {
+	warnings []string
+}
+
+// warnf records a lossy conversion of the field at path.
+func (c *converter) warnf(path, format string, a ...any) {
+	c.warnings = append(c.warnings, fmt.Sprintf("field %q: %s", path, fmt.Sprintf(format, a...)))
+}
+
+type structField struct {
+	name string
+	typ  string
+}
+
+func structType(fields []structField) string {
+	var sb strings.Builder
+
+	sb.WriteString("struct<")
+	for i, f := range fields {
+		if i > 0 {
+			sb.WriteByte(',')
+		}
+		sb.WriteString(f.name)
+		sb.WriteByte(':')
+		sb.WriteString(f.typ)
+	}
+	sb.WriteByte('>')
+
+	return sb.String()
+}
+
+func arrayType(element string) string {
+	return "array<" + element + ">"
+}
+
+func mapType(key, value string) string {
+	return "map<" + key + "," + value + ">"
+}
+
+// decimalType returns a decimal type, reducing the precision to the maximum supported if necessary.
+func (c *converter) decimalType(path string, precision, scale int) string {
+	if precision > maxDecimalPrecision {
+		c.warnf(path, "decimal precision %d reduced to %d", precision, maxDecimalPrecision)
+		precision = maxDecimalPrecision
+	}
+	if scale > precision {
+		scale = precision
+	}
+
+	return fmt.Sprintf("decimal(%d,%d)", precision, scale)
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
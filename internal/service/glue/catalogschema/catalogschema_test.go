@@ -0,0 +1,619 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package catalogschema
+
+import (
+	"encoding/binary"
+	"slices"
+	"strings"
+	"testing"
+)
+
+type testCase struct {
+	wantColumns  []Column
+	wantErr      string
+	wantWarnings []string
+}
+
+func (testCase testCase) check(t *testing.T, schema *Schema, err error) {
+	t.Helper()
+
+	if testCase.wantErr != "" {
+		if err == nil || !strings.Contains(err.Error(), testCase.wantErr) {
+			t.Fatalf("expected error containing %q, got %v", testCase.wantErr, err)
+		}
+		return
+	}
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !slices.Equal(schema.Columns, testCase.wantColumns) {
+		t.Errorf("Columns = %v, want %v", schema.Columns, testCase.wantColumns)
+	}
+	if !slices.Equal(schema.Warnings, testCase.wantWarnings) {
+		t.Errorf("Warnings = %q, want %q", schema.Warnings, testCase.wantWarnings)
+	}
+}
+
+func TestFromAvro(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		definition string
+		testCase
+	}{
+		"primitives": {
+			definition: `{"type": "record", "name": "r", "fields": [
+				{"name": "a", "type": "boolean", "doc": "A flag"},
+				{"name": "b", "type": "int"},
+				{"name": "c", "type": "long"},
+				{"name": "d", "type": "float"},
+				{"name": "e", "type": "double"},
+				{"name": "f", "type": "bytes"},
+				{"name": "g", "type": "string"}
+			]}`,
+			testCase: testCase{wantColumns: []Column{
+				{Name: "a", Type: "boolean", Comment: "A flag"},
+				{Name: "b", Type: "int"},
+				{Name: "c", Type: "bigint"},
+				{Name: "d", Type: "float"},
+				{Name: "e", Type: "double"},
+				{Name: "f", Type: "binary"},
+				{Name: "g", Type: "string"},
+			}},
+		},
+		"complex": {
+			definition: `{"type": "record", "name": "Order", "namespace": "example", "fields": [
+				{"name": "id", "type": ["null", "string"]},
+				{"name": "items", "type": {"type": "array", "items": {"type": "record", "name": "Item", "fields": [
+					{"name": "sku", "type": "string"},
+					{"name": "quantity", "type": "int"}
+				]}}},
+				{"name": "returns", "type": {"type": "array", "items": "Item"}},
+				{"name": "attributes", "type": {"type": "map", "values": "long"}},
+				{"name": "status", "type": {"type": "enum", "name": "Status", "symbols": ["NEW", "SHIPPED"]}},
+				{"name": "previous_status", "type": ["null", "example.Status"]},
+				{"name": "hash", "type": {"type": "fixed", "name": "md5", "size": 16}}
+			]}`,
+			testCase: testCase{wantColumns: []Column{
+				{Name: "id", Type: "string"},
+				{Name: "items", Type: "array<struct<sku:string,quantity:int>>"},
+				{Name: "returns", Type: "array<struct<sku:string,quantity:int>>"},
+				{Name: "attributes", Type: "map<string,bigint>"},
+				{Name: "status", Type: "string"},
+				{Name: "previous_status", Type: "string"},
+				{Name: "hash", Type: "binary"},
+			}},
+		},
+		"logical types": {
+			definition: `{"type": "record", "name": "r", "fields": [
+				{"name": "amount", "type": {"type": "bytes", "logicalType": "decimal", "precision": 10, "scale": 2}},
+				{"name": "big", "type": {"type": "fixed", "name": "big", "size": 32, "logicalType": "decimal", "precision": 50}},
+				{"name": "day", "type": {"type": "int", "logicalType": "date"}},
+				{"name": "at", "type": {"type": "long", "logicalType": "timestamp-millis"}},
+				{"name": "at_time", "type": {"type": "int", "logicalType": "time-millis"}},
+				{"name": "uuid", "type": {"type": "string", "logicalType": "uuid"}},
+				{"name": "other", "type": {"type": "string", "logicalType": "unknown"}}
+			]}`,
+			testCase: testCase{
+				wantColumns: []Column{
+					{Name: "amount", Type: "decimal(10,2)"},
+					{Name: "big", Type: "decimal(38,0)"},
+					{Name: "day", Type: "date"},
+					{Name: "at", Type: "timestamp"},
+					{Name: "at_time", Type: "int"},
+					{Name: "uuid", Type: "string"},
+					{Name: "other", Type: "string"},
+				},
+				wantWarnings: []string{
+					`field "big": decimal precision 50 reduced to 38`,
+					`field "at_time": time-millis converted to int`,
+				},
+			},
+		},
+		"union": {
+			definition: `{"type": "record", "name": "r", "fields": [
+				{"name": "nested", "type": {"type": "record", "name": "n", "fields": [
+					{"name": "value", "type": ["null", "int", "string"]}
+				]}}
+			]}`,
+			testCase: testCase{
+				wantColumns:  []Column{{Name: "nested", Type: "struct<value:string>"}},
+				wantWarnings: []string{`field "nested.value": union of int, string converted to string`},
+			},
+		},
+		"not a record": {
+			definition: `{"type": "array", "items": "string"}`,
+			testCase:   testCase{wantErr: "top-level Avro type must be a record"},
+		},
+		"invalid JSON": {
+			definition: `{"type": "record"`,
+			testCase:   testCase{wantErr: "parsing Avro schema"},
+		},
+		"unknown type": {
+			definition: `{"type": "record", "name": "r", "fields": [{"name": "a", "type": "Address"}]}`,
+			testCase:   testCase{wantErr: `field "a": unknown Avro type "Address"`},
+		},
+		"recursive": {
+			definition: `{"type": "record", "name": "Node", "fields": [{"name": "next", "type": ["null", "Node"]}]}`,
+			testCase:   testCase{wantErr: `field "next": recursive type "Node" cannot be represented`},
+		},
+		"null": {
+			definition: `{"type": "record", "name": "r", "fields": [{"name": "a", "type": "null"}]}`,
+			testCase:   testCase{wantErr: `field "a": null type can only be used in a union`},
+		},
+		"no fields": {
+			definition: `{"type": "record", "name": "r", "fields": []}`,
+			testCase:   testCase{wantErr: `record "r" must have at least one field`},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			schema, err := FromAvro(testCase.definition)
+			testCase.check(t, schema, err)
+		})
+	}
+}
+
+func TestFromJSONSchema(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		definition string
+		testCase
+	}{
+		"types": {
+			definition: `{
+				"type": "object",
+				"properties": {
+					"id": {"type": "integer", "description": "Order ID"},
+					"total": {"type": "number"},
+					"paid": {"type": "boolean"},
+					"note": {"type": ["string", "null"]},
+					"created": {"type": "string", "format": "date-time"},
+					"day": {"type": "string", "format": "date"},
+					"tags": {"type": "array", "items": {"type": "string"}},
+					"labels": {"type": "object", "additionalProperties": {"type": "integer"}},
+					"address": {"type": "object", "properties": {"city": {"type": "string"}, "zip": {"type": "string"}}},
+					"ratio": {"type": ["integer", "number"]},
+					"status": {"enum": ["new", "shipped", null]}
+				}
+			}`,
+			testCase: testCase{wantColumns: []Column{
+				{Name: "id", Type: "bigint", Comment: "Order ID"},
+				{Name: "total", Type: "double"},
+				{Name: "paid", Type: "boolean"},
+				{Name: "note", Type: "string"},
+				{Name: "created", Type: "timestamp"},
+				{Name: "day", Type: "date"},
+				{Name: "tags", Type: "array<string>"},
+				{Name: "labels", Type: "map<string,bigint>"},
+				{Name: "address", Type: "struct<city:string,zip:string>"},
+				{Name: "ratio", Type: "double"},
+				{Name: "status", Type: "string"},
+			}},
+		},
+		"references": {
+			definition: `{
+				"$defs": {
+					"address": {"type": "object", "description": "An address", "properties": {"city": {"type": "string"}}},
+					"base": {"properties": {"id": {"type": "integer"}}}
+				},
+				"allOf": [{"$ref": "#/$defs/base"}],
+				"properties": {
+					"billing": {"$ref": "#/$defs/address"},
+					"shipping": {"$ref": "#/$defs/address", "description": "Where to ship"},
+					"nullable": {"oneOf": [{"type": "null"}, {"$ref": "#/$defs/address"}]}
+				}
+			}`,
+			testCase: testCase{wantColumns: []Column{
+				{Name: "billing", Type: "struct<city:string>", Comment: "An address"},
+				{Name: "shipping", Type: "struct<city:string>", Comment: "Where to ship"},
+				{Name: "nullable", Type: "struct<city:string>"},
+				{Name: "id", Type: "bigint"},
+			}},
+		},
+		"lossy": {
+			definition: `{
+				"type": "object",
+				"properties": {
+					"any": {},
+					"mixed": {"type": ["string", "integer"]},
+					"choice": {"anyOf": [{"type": "string"}, {"type": "integer"}]},
+					"free": {"type": "object"},
+					"tuple": {"type": "array", "items": [{"type": "string"}, {"type": "integer"}]},
+					"extra": {"type": "object", "properties": {"a": {"type": "string"}}, "additionalProperties": {"type": "string"}}
+				}
+			}`,
+			testCase: testCase{
+				wantColumns: []Column{
+					{Name: "any", Type: "string"},
+					{Name: "mixed", Type: "string"},
+					{Name: "choice", Type: "string"},
+					{Name: "free", Type: "map<string,string>"},
+					{Name: "tuple", Type: "array<string>"},
+					{Name: "extra", Type: "struct<a:string>"},
+				},
+				wantWarnings: []string{
+					`field "any": no type converted to string`,
+					`field "mixed": types string, integer converted to string`,
+					`field "choice": alternatives string, bigint converted to string`,
+					`field "free": object without properties converted to map<string,string>`,
+					`field "tuple": array without an items schema converted to array<string>`,
+					`field "extra": additional properties are not included`,
+				},
+			},
+		},
+		"not an object": {
+			definition: `{"type": "array", "items": {"type": "string"}}`,
+			testCase:   testCase{wantErr: "top-level JSON Schema must be an object with properties"},
+		},
+		"invalid JSON": {
+			definition: `{"type": "object"`,
+			testCase:   testCase{wantErr: "parsing JSON Schema"},
+		},
+		"recursive": {
+			definition: `{"$defs": {"node": {"type": "object", "properties": {"next": {"$ref": "#/$defs/node"}}}}, "properties": {"head": {"$ref": "#/$defs/node"}}}`,
+			testCase:   testCase{wantErr: `field "head.next": recursive reference "#/$defs/node" cannot be represented`},
+		},
+		"missing reference": {
+			definition: `{"properties": {"a": {"$ref": "#/definitions/missing"}}}`,
+			testCase:   testCase{wantErr: `field "a": reference "#/definitions/missing" not found`},
+		},
+		"remote reference": {
+			definition: `{"properties": {"a": {"$ref": "https://example.com/schema.json"}}}`,
+			testCase:   testCase{wantErr: "only local references are supported"},
+		},
+		"unsupported type": {
+			definition: `{"properties": {"a": {"type": "decimal"}}}`,
+			testCase:   testCase{wantErr: `field "a": unsupported type "decimal"`},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			schema, err := FromJSONSchema(testCase.definition)
+			testCase.check(t, schema, err)
+		})
+	}
+}
+
+func TestFromProtobuf(t *testing.T) {
+	t.Parallel()
+
+	field := func(name string, label, typ int, typeName string) []byte {
+		b := protoString(1, name)
+		b = append(b, protoVarint(4, uint64(label))...)
+		b = append(b, protoVarint(5, uint64(typ))...)
+		if typeName != "" {
+			b = append(b, protoString(6, typeName)...)
+		}
+		return protoBytes(2, b)
+	}
+	message := func(name string, parts ...[]byte) []byte {
+		return slices.Concat(append([][]byte{protoString(1, name)}, parts...)...)
+	}
+	const (
+		optional = 1
+		repeated = 3
+	)
+
+	itemsEntry := message("ItemsEntry",
+		field("key", optional, protoTypeString, ""),
+		field("value", optional, protoTypeMessage, ".example.v1.Item"),
+		protoBytes(7, protoVarint(7, 1)),
+	)
+	order := message("Order",
+		field("id", optional, protoTypeInt64, ""),
+		field("total", optional, protoTypeDouble, ""),
+		field("tags", repeated, protoTypeString, ""),
+		field("status", optional, protoTypeEnum, ".example.v1.Status"),
+		field("items", repeated, protoTypeMessage, ".example.v1.Order.ItemsEntry"),
+		field("created", optional, protoTypeMessage, ".google.protobuf.Timestamp"),
+		field("metadata", optional, protoTypeMessage, ".google.protobuf.Struct"),
+		field("count", optional, protoTypeUint64, ""),
+		field("empty", optional, protoTypeMessage, ".example.v1.Empty"),
+		protoBytes(3, itemsEntry),
+	)
+	item := message("Item",
+		field("sku", optional, protoTypeString, ""),
+		field("quantity", optional, protoTypeUint32, ""),
+	)
+	node := message("Node",
+		field("children", repeated, protoTypeMessage, ".example.v1.Node"),
+	)
+	file := slices.Concat(
+		protoString(1, "order.proto"),
+		protoString(2, "example.v1"),
+		protoBytes(4, order),
+		protoBytes(4, item),
+		protoBytes(4, message("Empty")),
+		protoBytes(4, node),
+		protoBytes(4, message("Missing", field("a", optional, protoTypeMessage, ".other.Type"))),
+	)
+	descriptorSet := protoBytes(1, file)
+
+	testCases := map[string]struct {
+		descriptorSet []byte
+		messageName   string
+		testCase
+	}{
+		"message": {
+			descriptorSet: descriptorSet,
+			messageName:   "example.v1.Order",
+			testCase: testCase{
+				wantColumns: []Column{
+					{Name: "id", Type: "bigint"},
+					{Name: "total", Type: "double"},
+					{Name: "tags", Type: "array<string>"},
+					{Name: "status", Type: "string"},
+					{Name: "items", Type: "map<string,struct<sku:string,quantity:bigint>>"},
+					{Name: "created", Type: "timestamp"},
+					{Name: "metadata", Type: "string"},
+					{Name: "count", Type: "bigint"},
+				},
+				wantWarnings: []string{
+					`field "metadata": google.protobuf.Struct converted to string`,
+					`field "count": unsigned 64-bit integer converted to bigint`,
+					`field "empty": message example.v1.Empty without fields omitted`,
+				},
+			},
+		},
+		"leading dot": {
+			descriptorSet: descriptorSet,
+			messageName:   ".example.v1.Item",
+			testCase:      testCase{wantColumns: []Column{{Name: "sku", Type: "string"}, {Name: "quantity", Type: "bigint"}}},
+		},
+		"unknown message": {
+			descriptorSet: descriptorSet,
+			messageName:   "example.v1.Unknown",
+			testCase:      testCase{wantErr: `message "example.v1.Unknown" not found in the Protobuf descriptor set`},
+		},
+		"recursive": {
+			descriptorSet: descriptorSet,
+			messageName:   "example.v1.Node",
+			testCase:      testCase{wantErr: `field "children": recursive message "example.v1.Node" cannot be represented`},
+		},
+		"missing import": {
+			descriptorSet: descriptorSet,
+			messageName:   "example.v1.Missing",
+			testCase:      testCase{wantErr: `field "a": message "other.Type" not found in the Protobuf descriptor set, which must include imports`},
+		},
+		"empty message": {
+			descriptorSet: descriptorSet,
+			messageName:   "example.v1.Empty",
+			testCase:      testCase{wantErr: `message "example.v1.Empty" must have at least one field`},
+		},
+		"truncated": {
+			descriptorSet: descriptorSet[:len(descriptorSet)-3],
+			messageName:   "example.v1.Order",
+			testCase:      testCase{wantErr: "parsing Protobuf descriptor set"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			schema, err := FromProtobuf(testCase.descriptorSet, testCase.messageName)
+			testCase.check(t, schema, err)
+		})
+	}
+}
+
+func TestFromParquet(t *testing.T) {
+	t.Parallel()
+
+	const (
+		required = 0
+		optional = 1
+		repeated = 2
+	)
+	column := func(name string, typ, repetition int, fields ...[]byte) []byte {
+		return thriftStruct(slices.Concat(
+			[][]byte{thriftI32(1, typ), thriftI32(3, repetition), thriftString(4, name)},
+			fields,
+		)...)
+	}
+	group := func(name string, repetition, children int, fields ...[]byte) []byte {
+		return thriftStruct(slices.Concat(
+			[][]byte{thriftI32(3, repetition), thriftString(4, name), thriftI32(5, children)},
+			fields,
+		)...)
+	}
+	logical := func(id int16, fields ...[]byte) []byte {
+		return thriftStructField(10, thriftStructField(id, fields...))
+	}
+	footer := func(elements ...[]byte) []byte {
+		return thriftStruct(
+			thriftI32(1, 2),
+			thriftList(2, thriftTypeStruct, elements),
+			thriftI64(3, 100),
+			thriftList(4, thriftTypeStruct, nil),
+		)
+	}
+
+	metadata := footer(
+		group("schema", required, 11),
+		column("id", parquetInt64, required),
+		column("name", parquetByteArray, optional, thriftI32(6, parquetConvertedUTF8)),
+		column("amount", parquetFixedLenByteArray, optional, thriftI32(6, parquetConvertedDecimal), thriftI32(7, 2), thriftI32(8, 10)),
+		column("created", parquetInt64, optional, logical(parquetLogicalTimestamp, thriftBool(1, true))),
+		column("legacy_created", parquetInt96, optional),
+		column("small", parquetInt32, optional, logical(parquetLogicalInteger, thriftByte(1, 8), thriftBool(2, false))),
+		group("tags", optional, 1, thriftI32(6, parquetConvertedList)),
+		group("list", repeated, 1),
+		column("element", parquetByteArray, optional, thriftI32(6, parquetConvertedUTF8)),
+		group("attributes", optional, 1, thriftI32(6, parquetConvertedMap)),
+		group("key_value", repeated, 2),
+		column("key", parquetByteArray, required, thriftI32(6, parquetConvertedUTF8)),
+		column("value", parquetDouble, optional),
+		group("address", optional, 2),
+		column("city", parquetByteArray, optional, logical(parquetLogicalString)),
+		column("counts", parquetInt32, repeated),
+		column("big", parquetInt64, optional, thriftI32(6, parquetConvertedUint64)),
+		group("legacy_list", optional, 1, thriftI32(6, parquetConvertedList)),
+		column("array", parquetInt32, repeated),
+	)
+
+	wantColumns := []Column{
+		{Name: "id", Type: "bigint"},
+		{Name: "name", Type: "string"},
+		{Name: "amount", Type: "decimal(10,2)"},
+		{Name: "created", Type: "timestamp"},
+		{Name: "legacy_created", Type: "timestamp"},
+		{Name: "small", Type: "smallint"},
+		{Name: "tags", Type: "array<string>"},
+		{Name: "attributes", Type: "map<string,double>"},
+		{Name: "address", Type: "struct<city:string,counts:array<int>>"},
+		{Name: "big", Type: "bigint"},
+		{Name: "legacy_list", Type: "array<int>"},
+	}
+
+	file := slices.Concat([]byte(parquetMagic), []byte("column data"), metadata, binary.LittleEndian.AppendUint32(nil, uint32(len(metadata))), []byte(parquetMagic))
+
+	testCases := map[string]struct {
+		footer []byte
+		testCase
+	}{
+		"metadata": {
+			footer: metadata,
+			testCase: testCase{
+				wantColumns:  wantColumns,
+				wantWarnings: []string{`field "big": unsigned 64-bit integer converted to bigint`},
+			},
+		},
+		"file": {
+			footer: file,
+			testCase: testCase{
+				wantColumns:  wantColumns,
+				wantWarnings: []string{`field "big": unsigned 64-bit integer converted to bigint`},
+			},
+		},
+		"time": {
+			footer: footer(
+				group("schema", required, 1),
+				column("at", parquetInt32, optional, thriftI32(6, parquetConvertedTimeMillis)),
+			),
+			testCase: testCase{
+				wantColumns:  []Column{{Name: "at", Type: "int"}},
+				wantWarnings: []string{`field "at": time converted to int`},
+			},
+		},
+		"invalid length": {
+			footer:   slices.Concat(metadata, binary.LittleEndian.AppendUint32(nil, 1<<20), []byte(parquetMagic)),
+			testCase: testCase{wantErr: "footer length 1048576 exceeds"},
+		},
+		"truncated": {
+			footer:   metadata[:len(metadata)/2],
+			testCase: testCase{wantErr: "parsing Parquet footer"},
+		},
+		"missing children": {
+			footer:   footer(group("schema", required, 2), column("id", parquetInt64, required)),
+			testCase: testCase{wantErr: `group "schema" has fewer children than declared`},
+		},
+		"invalid list": {
+			footer: footer(
+				group("schema", required, 1),
+				group("tags", optional, 1, thriftI32(6, parquetConvertedList)),
+				column("element", parquetInt32, optional),
+			),
+			testCase: testCase{wantErr: `field "tags": list must have a single repeated field`},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			schema, err := FromParquet(testCase.footer)
+			testCase.check(t, schema, err)
+		})
+	}
+}
+
+func protoVarint(num int, v uint64) []byte {
+	return binary.AppendUvarint(binary.AppendUvarint(nil, uint64(num)<<3|protoWireVarint), v)
+}
+
+func protoBytes(num int, b []byte) []byte {
+	return append(binary.AppendUvarint(binary.AppendUvarint(nil, uint64(num)<<3|protoWireBytes), uint64(len(b))), b...)
+}
+
+func protoString(num int, s string) []byte {
+	return protoBytes(num, []byte(s))
+}
+
+// thriftStruct encodes a struct from its encoded fields, which must be in ascending order of ID.
+func thriftStruct(fields ...[]byte) []byte {
+	var b []byte
+	var last int16
+
+	for _, f := range fields {
+		id := int16(binary.BigEndian.Uint16(f))
+		typ, value := f[2], f[3:]
+
+		if delta := id - last; delta > 0 && delta <= 15 {
+			b = append(b, byte(delta)<<4|typ)
+		} else {
+			b = append(b, typ)
+			b = binary.AppendUvarint(b, uint64(id)<<1)
+		}
+		b = append(b, value...)
+		last = id
+	}
+
+	return append(b, thriftTypeStop)
+}
+
+// thriftField returns a field as its ID, type and encoded value, for use with thriftStruct.
+func thriftField(id int16, typ byte, value []byte) []byte {
+	return append(binary.BigEndian.AppendUint16(nil, uint16(id)), append([]byte{typ}, value...)...)
+}
+
+func thriftI32(id int16, v int) []byte {
+	return thriftField(id, thriftTypeI32, binary.AppendUvarint(nil, uint64(v)<<1))
+}
+
+func thriftI64(id int16, v int) []byte {
+	return thriftField(id, thriftTypeI64, binary.AppendUvarint(nil, uint64(v)<<1))
+}
+
+func thriftByte(id int16, v byte) []byte {
+	return thriftField(id, thriftTypeByte, []byte{v})
+}
+
+func thriftBool(id int16, v bool) []byte {
+	if v {
+		return thriftField(id, thriftTypeBooleanTrue, nil)
+	}
+
+	return thriftField(id, thriftTypeBooleanFalse, nil)
+}
+
+func thriftString(id int16, s string) []byte {
+	return thriftField(id, thriftTypeBinary, append(binary.AppendUvarint(nil, uint64(len(s))), s...))
+}
+
+func thriftStructField(id int16, fields ...[]byte) []byte {
+	return thriftField(id, thriftTypeStruct, thriftStruct(fields...))
+}
+
+func thriftList(id int16, elemType byte, elems [][]byte) []byte {
+	var b []byte
+	if n := len(elems); n < 15 {
+		b = append(b, byte(n)<<4|elemType)
+	} else {
+		b = binary.AppendUvarint(append(b, 0xf0|elemType), uint64(n))
+	}
+
+	return thriftField(id, thriftTypeList, append(b, slices.Concat(elems...)...))
+}
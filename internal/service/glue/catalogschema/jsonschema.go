@@ -0,0 +1,437 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package catalogschema
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+type jsonSchema struct {
+	AdditionalProperties json.RawMessage   `json:"additionalProperties"`
+	AllOf                []json.RawMessage `json:"allOf"`
+	AnyOf                []json.RawMessage `json:"anyOf"`
+	Const                json.RawMessage   `json:"const"`
+	Description          string            `json:"description"`
+	Enum                 []json.RawMessage `json:"enum"`
+	Format               string            `json:"format"`
+	Items                json.RawMessage   `json:"items"`
+	OneOf                []json.RawMessage `json:"oneOf"`
+	Properties           orderedObject     `json:"properties"`
+	Ref                  string            `json:"$ref"`
+	Type                 json.RawMessage   `json:"type"`
+}
+
+// orderedObject is a JSON object whose keys are kept in document order, so that columns are in the same order as properties.
+type orderedObject struct {
+	keys   []string
+	values map[string]json.RawMessage
+}
+
+func (o *orderedObject) UnmarshalJSON(b []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(b))
+
+	if t, err := dec.Token(); err != nil {
+		return err
+	} else if t != json.Delim('{') {
+		return errors.New("properties must be a JSON object")
+	}
+
+	o.keys, o.values = nil, map[string]json.RawMessage{}
+	for dec.More() {
+		t, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		key := t.(string)
+
+		var v json.RawMessage
+		if err := dec.Decode(&v); err != nil {
+			return err
+		}
+
+		if _, ok := o.values[key]; !ok {
+			o.keys = append(o.keys, key)
+		}
+		o.values[key] = v
+	}
+
+	return nil
+}
+
+type jsonSchemaConverter struct {
+	converter
+	// refs are the references being resolved, used to detect recursion.
+	refs []string
+	root json.RawMessage
+}
+
+// FromJSONSchema converts a JSON Schema, whose top-level type must be an object with properties, into columns.
+// Local references, e.g. `#/$defs/address`, are resolved.
+// See https://json-schema.org/draft/2020-12/json-schema-core.
+func FromJSONSchema(definition string) (*Schema, error) {
+	c := &jsonSchemaConverter{root: json.RawMessage(definition)}
+
+	root, err := c.resolve(c.root, "")
+	if err != nil {
+		return nil, err
+	}
+	if len(root.Properties.keys) == 0 {
+		return nil, errors.New("top-level JSON Schema must be an object with properties")
+	}
+
+	fields, err := c.convertProperties(root, "")
+	if err != nil {
+		return nil, err
+	}
+
+	schema := &Schema{}
+	for i, f := range fields {
+		property, err := c.resolve(root.Properties.values[root.Properties.keys[i]], f.name)
+		if err != nil {
+			return nil, err
+		}
+		schema.Columns = append(schema.Columns, Column{
+			Comment: property.Description,
+			Name:    f.name,
+			Type:    f.typ,
+		})
+	}
+	schema.Warnings = c.warnings
+
+	return schema, nil
+}
+
+// resolve parses a schema, following references and merging allOf subschemas.
+func (c *jsonSchemaConverter) resolve(raw json.RawMessage, path string) (*jsonSchema, error) {
+	switch strings.TrimSpace(string(raw)) {
+	case "true":
+		return &jsonSchema{}, nil
+	case "false":
+		return nil, fmt.Errorf("field %q: schema false doesn't allow any value", path)
+	}
+
+	var schema jsonSchema
+	if err := json.Unmarshal(raw, &schema); err != nil {
+		return nil, fmt.Errorf("parsing JSON Schema: %w", err)
+	}
+
+	if schema.Ref != "" {
+		target, done, err := c.follow(schema.Ref, path)
+		if err != nil {
+			return nil, err
+		}
+		defer done()
+
+		resolved, err := c.resolve(target, path)
+		if err != nil {
+			return nil, err
+		}
+		if schema.Description != "" {
+			resolved.Description = schema.Description
+		}
+		return resolved, nil
+	}
+
+	for _, raw := range schema.AllOf {
+		sub, err := c.resolve(raw, path)
+		if err != nil {
+			return nil, err
+		}
+		if len(schema.Type) == 0 {
+			schema.Type = sub.Type
+		}
+		if schema.Format == "" {
+			schema.Format = sub.Format
+		}
+		if schema.Properties.values == nil {
+			schema.Properties.values = map[string]json.RawMessage{}
+		}
+		for _, k := range sub.Properties.keys {
+			if _, ok := schema.Properties.values[k]; !ok {
+				schema.Properties.keys = append(schema.Properties.keys, k)
+				schema.Properties.values[k] = sub.Properties.values[k]
+			}
+		}
+	}
+	schema.AllOf = nil
+
+	return &schema, nil
+}
+
+// follow returns the subschema identified by a reference and a function to call once the subschema has been converted.
+// References are tracked until then, so that recursive schemas are detected.
+func (c *jsonSchemaConverter) follow(ref, path string) (json.RawMessage, func(), error) {
+	if slices.Contains(c.refs, ref) {
+		return nil, nil, fmt.Errorf("field %q: recursive reference %q cannot be represented in the Glue Data Catalog", path, ref)
+	}
+
+	target, err := c.lookup(ref, path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	c.refs = append(c.refs, ref)
+
+	return target, func() { c.refs = c.refs[:len(c.refs)-1] }, nil
+}
+
+// lookup returns the subschema identified by a local reference such as #/$defs/name.
+func (c *jsonSchemaConverter) lookup(ref, path string) (json.RawMessage, error) {
+	pointer, ok := strings.CutPrefix(ref, "#")
+	if !ok {
+		return nil, fmt.Errorf("field %q: only local references are supported, got %q", path, ref)
+	}
+
+	raw := c.root
+	if pointer == "" {
+		return raw, nil
+	}
+
+	for _, token := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+		token = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+
+		var next json.RawMessage
+		var object map[string]json.RawMessage
+		var array []json.RawMessage
+		if json.Unmarshal(raw, &object) == nil {
+			next = object[token]
+		} else if i, err := strconv.Atoi(token); err == nil && json.Unmarshal(raw, &array) == nil && i >= 0 && i < len(array) {
+			next = array[i]
+		}
+		if next == nil {
+			return nil, fmt.Errorf("field %q: reference %q not found", path, ref)
+		}
+
+		raw = next
+	}
+
+	return raw, nil
+}
+
+func (c *jsonSchemaConverter) convert(raw json.RawMessage, path string) (string, error) {
+	var ref struct {
+		Ref string `json:"$ref"`
+	}
+	if json.Unmarshal(raw, &ref) == nil && ref.Ref != "" {
+		target, done, err := c.follow(ref.Ref, path)
+		if err != nil {
+			return "", err
+		}
+		defer done()
+
+		return c.convert(target, path)
+	}
+
+	schema, err := c.resolve(raw, path)
+	if err != nil {
+		return "", err
+	}
+
+	if alternatives := slices.Concat(schema.OneOf, schema.AnyOf); len(alternatives) > 0 {
+		return c.convertAlternatives(alternatives, path)
+	}
+
+	types, err := schema.types()
+	if err != nil {
+		return "", fmt.Errorf("field %q: %w", path, err)
+	}
+
+	switch {
+	case len(types) == 0:
+		c.warnf(path, "no type converted to string")
+		return "string", nil
+	case len(types) == 1 && types[0] == "null":
+		c.warnf(path, "null type converted to string")
+		return "string", nil
+	case len(types) == 2 && slices.Contains(types, "integer") && slices.Contains(types, "number"):
+		return "double", nil
+	case len(types) > 1:
+		c.warnf(path, "types %s converted to string", strings.Join(types, ", "))
+		return "string", nil
+	}
+
+	switch typ := types[0]; typ {
+	case "array":
+		return c.convertArray(schema, path)
+	case "boolean":
+		return "boolean", nil
+	case "integer":
+		return "bigint", nil
+	case "number":
+		return "double", nil
+	case "object":
+		return c.convertObject(schema, path)
+	case "string":
+		switch schema.Format {
+		case "date":
+			return "date", nil
+		case "date-time":
+			return "timestamp", nil
+		}
+		return "string", nil
+	default:
+		return "", fmt.Errorf("field %q: unsupported type %q", path, typ)
+	}
+}
+
+// convertAlternatives converts oneOf and anyOf subschemas. Subschemas that convert to different types are converted to a string.
+func (c *jsonSchemaConverter) convertAlternatives(alternatives []json.RawMessage, path string) (string, error) {
+	var glueTypes []string
+
+	for _, raw := range alternatives {
+		schema, err := c.resolve(raw, path)
+		if err != nil {
+			return "", err
+		}
+		if types, err := schema.types(); err == nil && len(types) == 1 && types[0] == "null" {
+			continue
+		}
+
+		typ, err := c.convert(raw, path)
+		if err != nil {
+			return "", err
+		}
+		if !slices.Contains(glueTypes, typ) {
+			glueTypes = append(glueTypes, typ)
+		}
+	}
+
+	switch len(glueTypes) {
+	case 0:
+		return "", fmt.Errorf("field %q: oneOf or anyOf must contain a type other than null", path)
+	case 1:
+		return glueTypes[0], nil
+	}
+
+	c.warnf(path, "alternatives %s converted to string", strings.Join(glueTypes, ", "))
+
+	return "string", nil
+}
+
+func (c *jsonSchemaConverter) convertArray(schema *jsonSchema, path string) (string, error) {
+	items := strings.TrimSpace(string(schema.Items))
+	if items == "" || strings.HasPrefix(items, "[") {
+		c.warnf(path, "array without an items schema converted to array<string>")
+		return arrayType("string"), nil
+	}
+
+	element, err := c.convert(schema.Items, path)
+	if err != nil {
+		return "", err
+	}
+
+	return arrayType(element), nil
+}
+
+func (c *jsonSchemaConverter) convertObject(schema *jsonSchema, path string) (string, error) {
+	additional := strings.TrimSpace(string(schema.AdditionalProperties))
+	hasAdditionalSchema := strings.HasPrefix(additional, "{")
+
+	if len(schema.Properties.keys) == 0 {
+		if !hasAdditionalSchema {
+			c.warnf(path, "object without properties converted to map<string,string>")
+			return mapType("string", "string"), nil
+		}
+
+		value, err := c.convert(schema.AdditionalProperties, path)
+		if err != nil {
+			return "", err
+		}
+		return mapType("string", value), nil
+	}
+
+	if hasAdditionalSchema {
+		c.warnf(path, "additional properties are not included")
+	}
+
+	fields, err := c.convertProperties(schema, path)
+	if err != nil {
+		return "", err
+	}
+
+	return structType(fields), nil
+}
+
+func (c *jsonSchemaConverter) convertProperties(schema *jsonSchema, path string) ([]structField, error) {
+	var fields []structField
+
+	for _, k := range schema.Properties.keys {
+		typ, err := c.convert(schema.Properties.values[k], joinPath(path, k))
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, structField{name: k, typ: typ})
+	}
+
+	return fields, nil
+}
+
+// types returns the schema's types other than null.
+// If the schema has no type, it is inferred from the other keywords.
+func (s *jsonSchema) types() ([]string, error) {
+	var types []string
+
+	switch raw := strings.TrimSpace(string(s.Type)); {
+	case raw == "":
+	case strings.HasPrefix(raw, "["):
+		if err := json.Unmarshal(s.Type, &types); err != nil {
+			return nil, fmt.Errorf("invalid type %s", raw)
+		}
+	default:
+		var typ string
+		if err := json.Unmarshal(s.Type, &typ); err != nil {
+			return nil, fmt.Errorf("invalid type %s", raw)
+		}
+		types = []string{typ}
+	}
+
+	if len(types) == 0 {
+		switch {
+		case len(s.Properties.keys) > 0 || len(s.AdditionalProperties) > 0:
+			types = []string{"object"}
+		case len(s.Items) > 0:
+			types = []string{"array"}
+		default:
+			for _, v := range append(slices.Clone(s.Enum), s.Const) {
+				if typ := jsonValueType(v); typ != "" && !slices.Contains(types, typ) {
+					types = append(types, typ)
+				}
+			}
+		}
+	}
+
+	if len(types) > 1 || (len(types) == 1 && types[0] != "null") {
+		types = slices.DeleteFunc(types, func(t string) bool { return t == "null" })
+	}
+
+	return types, nil
+}
+
+// jsonValueType returns the JSON Schema type of an enum or const value.
+func jsonValueType(v json.RawMessage) string {
+	switch s := strings.TrimSpace(string(v)); {
+	case s == "":
+		return ""
+	case s == "null":
+		return "null"
+	case s == "true" || s == "false":
+		return "boolean"
+	case strings.HasPrefix(s, `"`):
+		return "string"
+	case strings.HasPrefix(s, "{"):
+		return "object"
+	case strings.HasPrefix(s, "["):
+		return "array"
+	case strings.ContainsAny(s, ".eE"):
+		return "number"
+	default:
+		return "integer"
+	}
+}
@@ -0,0 +1,627 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package catalogschema
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+)
+
+// parquetMagic starts and ends a Parquet file.
+const parquetMagic = "PAR1"
+
+// Parquet physical types.
+const (
+	parquetBoolean           = 0
+	parquetInt32             = 1
+	parquetInt64             = 2
+	parquetInt96             = 3
+	parquetFloat             = 4
+	parquetDouble            = 5
+	parquetByteArray         = 6
+	parquetFixedLenByteArray = 7
+)
+
+// Parquet converted types.
+const (
+	parquetConvertedUTF8            = 0
+	parquetConvertedMap             = 1
+	parquetConvertedMapKeyValue     = 2
+	parquetConvertedList            = 3
+	parquetConvertedEnum            = 4
+	parquetConvertedDecimal         = 5
+	parquetConvertedDate            = 6
+	parquetConvertedTimeMillis      = 7
+	parquetConvertedTimeMicros      = 8
+	parquetConvertedTimestampMillis = 9
+	parquetConvertedTimestampMicros = 10
+	parquetConvertedUint8           = 11
+	parquetConvertedUint16          = 12
+	parquetConvertedUint32          = 13
+	parquetConvertedUint64          = 14
+	parquetConvertedInt8            = 15
+	parquetConvertedInt16           = 16
+	parquetConvertedInt32           = 17
+	parquetConvertedInt64           = 18
+	parquetConvertedJSON            = 19
+	parquetConvertedBSON            = 20
+	parquetConvertedInterval        = 21
+)
+
+// Parquet logical types, identified by their field in the LogicalType union.
+const (
+	parquetLogicalString    = 1
+	parquetLogicalMap       = 2
+	parquetLogicalList      = 3
+	parquetLogicalEnum      = 4
+	parquetLogicalDecimal   = 5
+	parquetLogicalDate      = 6
+	parquetLogicalTime      = 7
+	parquetLogicalTimestamp = 8
+	parquetLogicalInteger   = 10
+	parquetLogicalJSON      = 12
+	parquetLogicalBSON      = 13
+	parquetLogicalUUID      = 14
+	parquetLogicalFloat16   = 15
+)
+
+const (
+	parquetRepeated = 2
+
+	// noValue marks an unset optional SchemaElement field.
+	noValue = -1
+)
+
+// parquetElement is a SchemaElement.
+type parquetElement struct {
+	bitWidth      int // INTEGER logical type
+	children      []*parquetElement
+	convertedType int
+	logicalType   int
+	name          string
+	numChildren   int
+	precision     int
+	repetition    int
+	scale         int
+	signed        bool // INTEGER logical type
+	typ           int
+}
+
+type parquetConverter struct {
+	converter
+}
+
+// FromParquet converts the schema in a Parquet file footer into columns.
+// The footer is the serialized FileMetaData, optionally followed by its 4-byte length and the PAR1 magic number,
+// so the end of a Parquet file, or the whole file, can be used.
+// See https://github.com/apache/parquet-format.
+func FromParquet(footer []byte) (*Schema, error) {
+	if n := len(footer); n >= 8 && string(footer[n-4:]) == parquetMagic {
+		length := int(binary.LittleEndian.Uint32(footer[n-8:]))
+		if length > n-8 {
+			return nil, fmt.Errorf("footer length %d exceeds the %d bytes provided", length, n-8)
+		}
+		footer = footer[n-8-length : n-8]
+	}
+
+	elements, err := readParquetSchema(footer)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Parquet footer: %w", err)
+	}
+	if len(elements) == 0 {
+		return nil, errors.New("no schema in the Parquet footer")
+	}
+
+	root, rest, err := parquetTree(elements)
+	if err != nil {
+		return nil, err
+	}
+	if len(rest) > 0 {
+		return nil, errors.New("the Parquet schema has more than one root")
+	}
+	if len(root.children) == 0 {
+		return nil, errors.New("the Parquet schema must have at least one column")
+	}
+
+	c := &parquetConverter{}
+	schema := &Schema{}
+	for _, e := range root.children {
+		typ, err := c.convert(e, e.name)
+		if err != nil {
+			return nil, err
+		}
+		if typ == "" {
+			continue
+		}
+		schema.Columns = append(schema.Columns, Column{Name: e.name, Type: typ})
+	}
+	schema.Warnings = c.warnings
+
+	return schema, nil
+}
+
+// parquetTree builds the tree of schema elements from their depth-first order.
+func parquetTree(elements []*parquetElement) (*parquetElement, []*parquetElement, error) {
+	e, rest := elements[0], elements[1:]
+
+	for range e.numChildren {
+		if len(rest) == 0 {
+			return nil, nil, fmt.Errorf("group %q has fewer children than declared", e.name)
+		}
+
+		var child *parquetElement
+		var err error
+		child, rest, err = parquetTree(rest)
+		if err != nil {
+			return nil, nil, err
+		}
+		e.children = append(e.children, child)
+	}
+
+	return e, rest, nil
+}
+
+// convert returns the Glue type of an element, or "" if the element is an empty group and is omitted.
+func (c *parquetConverter) convert(e *parquetElement, path string) (string, error) {
+	typ, err := c.convertElement(e, path)
+	if err != nil || typ == "" {
+		return typ, err
+	}
+
+	// A repeated field that isn't part of an annotated list or map is a list.
+	if e.repetition == parquetRepeated {
+		typ = arrayType(typ)
+	}
+
+	return typ, nil
+}
+
+func (c *parquetConverter) convertElement(e *parquetElement, path string) (string, error) {
+	if e.numChildren == 0 && e.typ == noValue {
+		c.warnf(path, "group without fields omitted")
+		return "", nil
+	}
+
+	if e.typ != noValue {
+		return c.convertPrimitive(e, path)
+	}
+
+	switch {
+	case e.logicalType == parquetLogicalList || e.convertedType == parquetConvertedList:
+		return c.convertList(e, path)
+	case e.logicalType == parquetLogicalMap || e.convertedType == parquetConvertedMap || e.convertedType == parquetConvertedMapKeyValue:
+		return c.convertMap(e, path)
+	}
+
+	var fields []structField
+	for _, child := range e.children {
+		typ, err := c.convert(child, joinPath(path, child.name))
+		if err != nil {
+			return "", err
+		}
+		if typ == "" {
+			continue
+		}
+		fields = append(fields, structField{name: child.name, typ: typ})
+	}
+	if len(fields) == 0 {
+		c.warnf(path, "group without fields omitted")
+		return "", nil
+	}
+
+	return structType(fields), nil
+}
+
+// convertList converts a LIST-annotated group, including the legacy two-level representations.
+// See https://github.com/apache/parquet-format/blob/master/LogicalTypes.md#lists.
+func (c *parquetConverter) convertList(e *parquetElement, path string) (string, error) {
+	if len(e.children) != 1 || e.children[0].repetition != parquetRepeated {
+		return "", fmt.Errorf("field %q: list must have a single repeated field", path)
+	}
+
+	repeated := e.children[0]
+	element := repeated
+	if repeated.typ == noValue && len(repeated.children) == 1 && repeated.name != "array" && repeated.name != e.name+"_tuple" {
+		element = repeated.children[0]
+	}
+
+	typ, err := c.convertElement(element, path)
+	if err != nil || typ == "" {
+		return typ, err
+	}
+
+	return arrayType(typ), nil
+}
+
+// convertMap converts a MAP-annotated group.
+// See https://github.com/apache/parquet-format/blob/master/LogicalTypes.md#maps.
+func (c *parquetConverter) convertMap(e *parquetElement, path string) (string, error) {
+	if len(e.children) != 1 || len(e.children[0].children) != 2 {
+		return "", fmt.Errorf("field %q: map must have a single repeated group with key and value fields", path)
+	}
+
+	entry := e.children[0]
+	key, err := c.convertElement(entry.children[0], path)
+	if err != nil {
+		return "", err
+	}
+	value, err := c.convert(entry.children[1], path)
+	if err != nil {
+		return "", err
+	}
+	if key == "" || value == "" {
+		return "", fmt.Errorf("field %q: map key and value must have types", path)
+	}
+
+	return mapType(key, value), nil
+}
+
+func (c *parquetConverter) convertPrimitive(e *parquetElement, path string) (string, error) {
+	switch e.logicalType {
+	case parquetLogicalString, parquetLogicalEnum, parquetLogicalJSON:
+		return "string", nil
+	case parquetLogicalBSON, parquetLogicalUUID:
+		return "binary", nil
+	case parquetLogicalDecimal:
+		return c.decimalType(path, e.precision, e.scale), nil
+	case parquetLogicalDate:
+		return "date", nil
+	case parquetLogicalTimestamp:
+		return "timestamp", nil
+	case parquetLogicalInteger:
+		return c.integerType(path, e.bitWidth, e.signed), nil
+	case parquetLogicalFloat16:
+		return "float", nil
+	}
+
+	switch e.convertedType {
+	case parquetConvertedUTF8, parquetConvertedEnum, parquetConvertedJSON:
+		return "string", nil
+	case parquetConvertedBSON:
+		return "binary", nil
+	case parquetConvertedDecimal:
+		return c.decimalType(path, e.precision, e.scale), nil
+	case parquetConvertedDate:
+		return "date", nil
+	case parquetConvertedTimestampMillis, parquetConvertedTimestampMicros:
+		return "timestamp", nil
+	case parquetConvertedInt8:
+		return c.integerType(path, 8, true), nil
+	case parquetConvertedInt16:
+		return c.integerType(path, 16, true), nil
+	case parquetConvertedInt32:
+		return c.integerType(path, 32, true), nil
+	case parquetConvertedInt64:
+		return c.integerType(path, 64, true), nil
+	case parquetConvertedUint8:
+		return c.integerType(path, 8, false), nil
+	case parquetConvertedUint16:
+		return c.integerType(path, 16, false), nil
+	case parquetConvertedUint32:
+		return c.integerType(path, 32, false), nil
+	case parquetConvertedUint64:
+		return c.integerType(path, 64, false), nil
+	case parquetConvertedInterval:
+		c.warnf(path, "interval converted to binary")
+		return "binary", nil
+	}
+
+	switch e.typ {
+	case parquetBoolean:
+		return "boolean", nil
+	case parquetInt32:
+		if e.logicalType == parquetLogicalTime || e.convertedType == parquetConvertedTimeMillis {
+			c.warnf(path, "time converted to int")
+		}
+		return "int", nil
+	case parquetInt64:
+		if e.logicalType == parquetLogicalTime || e.convertedType == parquetConvertedTimeMicros {
+			c.warnf(path, "time converted to bigint")
+		}
+		return "bigint", nil
+	case parquetInt96:
+		return "timestamp", nil
+	case parquetFloat:
+		return "float", nil
+	case parquetDouble:
+		return "double", nil
+	case parquetByteArray, parquetFixedLenByteArray:
+		return "binary", nil
+	}
+
+	return "", fmt.Errorf("field %q: unsupported Parquet type %d", path, e.typ)
+}
+
+// integerType returns the smallest Glue integer type that holds integers of the specified width.
+func (c *parquetConverter) integerType(path string, bitWidth int, signed bool) string {
+	if !signed {
+		if bitWidth == 64 {
+			c.warnf(path, "unsigned 64-bit integer converted to bigint")
+			return "bigint"
+		}
+		bitWidth *= 2
+	}
+
+	switch {
+	case bitWidth <= 8:
+		return "tinyint"
+	case bitWidth <= 16:
+		return "smallint"
+	case bitWidth <= 32:
+		return "int"
+	default:
+		return "bigint"
+	}
+}
+
+// Thrift compact protocol types.
+const (
+	thriftTypeStop         = 0
+	thriftTypeBooleanTrue  = 1
+	thriftTypeBooleanFalse = 2
+	thriftTypeByte         = 3
+	thriftTypeI16          = 4
+	thriftTypeI32          = 5
+	thriftTypeI64          = 6
+	thriftTypeDouble       = 7
+	thriftTypeBinary       = 8
+	thriftTypeList         = 9
+	thriftTypeSet          = 10
+	thriftTypeMap          = 11
+	thriftTypeStruct       = 12
+)
+
+// thriftReader reads the Thrift compact protocol, in which Parquet metadata is serialized.
+// See https://github.com/apache/thrift/blob/master/doc/specs/thrift-compact-protocol.md.
+type thriftReader struct {
+	r *bytes.Reader
+}
+
+// readParquetSchema reads the schema field of a FileMetaData.
+func readParquetSchema(b []byte) ([]*parquetElement, error) {
+	t := &thriftReader{r: bytes.NewReader(b)}
+	var elements []*parquetElement
+
+	err := t.readStruct(func(id int16, typ byte) error {
+		if id != 2 || typ != thriftTypeList { // schema
+			return t.skip(typ)
+		}
+
+		n, elemType, err := t.readListHeader()
+		if err != nil {
+			return err
+		}
+		if elemType != thriftTypeStruct {
+			return errors.New("schema must be a list of structs")
+		}
+
+		for range n {
+			e, err := t.readSchemaElement()
+			if err != nil {
+				return err
+			}
+			elements = append(elements, e)
+		}
+
+		return nil
+	})
+
+	return elements, err
+}
+
+func (t *thriftReader) readSchemaElement() (*parquetElement, error) {
+	e := &parquetElement{convertedType: noValue, logicalType: noValue, typ: noValue}
+
+	err := t.readStruct(func(id int16, typ byte) error {
+		var err error
+
+		switch {
+		case id == 1 && typ == thriftTypeI32:
+			e.typ, err = t.readInt()
+		case id == 3 && typ == thriftTypeI32:
+			e.repetition, err = t.readInt()
+		case id == 4 && typ == thriftTypeBinary:
+			var b []byte
+			b, err = t.readBinary()
+			e.name = string(b)
+		case id == 5 && typ == thriftTypeI32:
+			e.numChildren, err = t.readInt()
+		case id == 6 && typ == thriftTypeI32:
+			e.convertedType, err = t.readInt()
+		case id == 7 && typ == thriftTypeI32:
+			e.scale, err = t.readInt()
+		case id == 8 && typ == thriftTypeI32:
+			e.precision, err = t.readInt()
+		case id == 10 && typ == thriftTypeStruct:
+			err = t.readLogicalType(e)
+		default:
+			err = t.skip(typ)
+		}
+
+		return err
+	})
+
+	return e, err
+}
+
+// readLogicalType reads a LogicalType union.
+func (t *thriftReader) readLogicalType(e *parquetElement) error {
+	return t.readStruct(func(id int16, typ byte) error {
+		if typ != thriftTypeStruct {
+			return t.skip(typ)
+		}
+
+		e.logicalType = int(id)
+
+		switch id {
+		case parquetLogicalDecimal:
+			return t.readStruct(func(id int16, typ byte) error {
+				var err error
+				switch {
+				case id == 1 && typ == thriftTypeI32:
+					e.scale, err = t.readInt()
+				case id == 2 && typ == thriftTypeI32:
+					e.precision, err = t.readInt()
+				default:
+					err = t.skip(typ)
+				}
+				return err
+			})
+		case parquetLogicalInteger:
+			return t.readStruct(func(id int16, typ byte) error {
+				switch {
+				case id == 1 && typ == thriftTypeByte:
+					b, err := t.r.ReadByte()
+					e.bitWidth = int(int8(b))
+					return err
+				case id == 2 && (typ == thriftTypeBooleanTrue || typ == thriftTypeBooleanFalse):
+					e.signed = typ == thriftTypeBooleanTrue
+					return nil
+				}
+				return t.skip(typ)
+			})
+		}
+
+		return t.skip(typ)
+	})
+}
+
+// readStruct calls f for each field of a struct. f must consume the field's value.
+func (t *thriftReader) readStruct(f func(id int16, typ byte) error) error {
+	var id int16
+
+	for {
+		b, err := t.r.ReadByte()
+		if err != nil {
+			return err
+		}
+
+		typ := b & 0x0f
+		if typ == thriftTypeStop {
+			return nil
+		}
+
+		if delta := int16(b >> 4); delta != 0 {
+			id += delta
+		} else {
+			v, err := t.readVarint()
+			if err != nil {
+				return err
+			}
+			id = int16(zigzag(v))
+		}
+
+		if err := f(id, typ); err != nil {
+			return err
+		}
+	}
+}
+
+func (t *thriftReader) readListHeader() (int, byte, error) {
+	b, err := t.r.ReadByte()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	n := int(b >> 4)
+	if n == 15 {
+		v, err := t.readVarint()
+		if err != nil {
+			return 0, 0, err
+		}
+		if v > uint64(t.r.Len()) {
+			return 0, 0, errors.New("invalid list size")
+		}
+		n = int(v)
+	}
+
+	return n, b & 0x0f, nil
+}
+
+func (t *thriftReader) readVarint() (uint64, error) {
+	return binary.ReadUvarint(t.r)
+}
+
+func (t *thriftReader) readInt() (int, error) {
+	v, err := t.readVarint()
+	if err != nil {
+		return 0, err
+	}
+
+	i := zigzag(v)
+	if i < math.MinInt32 || i > math.MaxInt32 {
+		return 0, errors.New("integer out of range")
+	}
+
+	return int(i), nil
+}
+
+func (t *thriftReader) readBinary() ([]byte, error) {
+	n, err := t.readVarint()
+	if err != nil {
+		return nil, err
+	}
+	if n > uint64(t.r.Len()) {
+		return nil, errors.New("invalid binary length")
+	}
+
+	b := make([]byte, n)
+	_, err = t.r.Read(b)
+
+	return b, err
+}
+
+// skip consumes a value of the specified type.
+func (t *thriftReader) skip(typ byte) error {
+	var err error
+
+	switch typ {
+	case thriftTypeBooleanTrue, thriftTypeBooleanFalse:
+	case thriftTypeByte:
+		_, err = t.r.ReadByte()
+	case thriftTypeI16, thriftTypeI32, thriftTypeI64:
+		_, err = t.readVarint()
+	case thriftTypeDouble:
+		_, err = t.r.Seek(8, 1)
+	case thriftTypeBinary:
+		_, err = t.readBinary()
+	case thriftTypeList, thriftTypeSet:
+		var n int
+		var elemType byte
+		n, elemType, err = t.readListHeader()
+		for i := 0; err == nil && i < n; i++ {
+			if elemType == thriftTypeBooleanTrue || elemType == thriftTypeBooleanFalse {
+				// Booleans in lists are single bytes.
+				_, err = t.r.ReadByte()
+			} else {
+				err = t.skip(elemType)
+			}
+		}
+	case thriftTypeMap:
+		var n uint64
+		n, err = t.readVarint()
+		if err == nil && n > 0 {
+			var b byte
+			b, err = t.r.ReadByte()
+			for i := uint64(0); err == nil && i < n; i++ {
+				if err = t.skip(b >> 4); err == nil {
+					err = t.skip(b & 0x0f)
+				}
+			}
+		}
+	case thriftTypeStruct:
+		err = t.readStruct(func(_ int16, typ byte) error { return t.skip(typ) })
+	default:
+		err = fmt.Errorf("unsupported Thrift type %d", typ)
+	}
+
+	return err
+}
+
+func zigzag(v uint64) int64 {
+	return int64(v>>1) ^ -int64(v&1)
+}
@@ -0,0 +1,381 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package catalogschema
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Protobuf wire types.
+const (
+	protoWireVarint  = 0
+	protoWireFixed64 = 1
+	protoWireBytes   = 2
+	protoWireFixed32 = 5
+)
+
+// FieldDescriptorProto.Type values.
+const (
+	protoTypeDouble   = 1
+	protoTypeFloat    = 2
+	protoTypeInt64    = 3
+	protoTypeUint64   = 4
+	protoTypeInt32    = 5
+	protoTypeFixed64  = 6
+	protoTypeFixed32  = 7
+	protoTypeBool     = 8
+	protoTypeString   = 9
+	protoTypeGroup    = 10
+	protoTypeMessage  = 11
+	protoTypeBytes    = 12
+	protoTypeUint32   = 13
+	protoTypeEnum     = 14
+	protoTypeSfixed32 = 15
+	protoTypeSfixed64 = 16
+	protoTypeSint32   = 17
+	protoTypeSint64   = 18
+
+	protoLabelRepeated = 3
+)
+
+var protoScalarTypes = map[int]string{
+	protoTypeBool:     "boolean",
+	protoTypeBytes:    "binary",
+	protoTypeDouble:   "double",
+	protoTypeEnum:     "string",
+	protoTypeFixed32:  "bigint",
+	protoTypeFloat:    "float",
+	protoTypeInt32:    "int",
+	protoTypeInt64:    "bigint",
+	protoTypeSfixed32: "int",
+	protoTypeSfixed64: "bigint",
+	protoTypeSint32:   "int",
+	protoTypeSint64:   "bigint",
+	protoTypeString:   "string",
+	protoTypeUint32:   "bigint",
+}
+
+// protoWellKnownTypes maps well-known message types to Glue types.
+// Types mapped to "" have no Glue equivalent and are converted to strings.
+var protoWellKnownTypes = map[string]string{
+	"google.protobuf.Any":         "",
+	"google.protobuf.BoolValue":   "boolean",
+	"google.protobuf.BytesValue":  "binary",
+	"google.protobuf.DoubleValue": "double",
+	"google.protobuf.FloatValue":  "float",
+	"google.protobuf.Int32Value":  "int",
+	"google.protobuf.Int64Value":  "bigint",
+	"google.protobuf.ListValue":   "",
+	"google.protobuf.StringValue": "string",
+	"google.protobuf.Struct":      "",
+	"google.protobuf.Timestamp":   "timestamp",
+	"google.protobuf.UInt32Value": "bigint",
+	"google.protobuf.Value":       "",
+}
+
+type protoMessage struct {
+	fields   []protoField
+	mapEntry bool
+	name     string
+}
+
+type protoField struct {
+	label    int
+	name     string
+	typ      int
+	typeName string
+}
+
+type protobufConverter struct {
+	converter
+	// converting are the messages being converted, used to detect recursion.
+	converting map[string]bool
+	messages   map[string]*protoMessage
+}
+
+// FromProtobuf converts a message in a serialized FileDescriptorSet, as written by `protoc --include_imports --descriptor_set_out`, into columns.
+// messageName is the fully-qualified name of the message, e.g. `example.v1.Order`.
+func FromProtobuf(descriptorSet []byte, messageName string) (*Schema, error) {
+	c := &protobufConverter{
+		converting: map[string]bool{},
+		messages:   map[string]*protoMessage{},
+	}
+
+	err := readProto(descriptorSet, func(num, wireType int, v uint64, b []byte) error {
+		if num == 1 && wireType == protoWireBytes { // file
+			return c.readFile(b)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("parsing Protobuf descriptor set: %w", err)
+	}
+
+	name := strings.TrimPrefix(messageName, ".")
+	m, ok := c.messages[name]
+	if !ok {
+		return nil, fmt.Errorf("message %q not found in the Protobuf descriptor set", messageName)
+	}
+
+	c.converting[name] = true
+	fields, err := c.convertFields(m, "")
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("message %q must have at least one field", messageName)
+	}
+
+	schema := &Schema{}
+	for _, f := range fields {
+		schema.Columns = append(schema.Columns, Column{Name: f.name, Type: f.typ})
+	}
+	schema.Warnings = c.warnings
+
+	return schema, nil
+}
+
+// readFile reads a FileDescriptorProto.
+func (c *protobufConverter) readFile(b []byte) error {
+	var pkg string
+	var messages [][]byte
+
+	err := readProto(b, func(num, wireType int, v uint64, b []byte) error {
+		switch {
+		case num == 2 && wireType == protoWireBytes: // package
+			pkg = string(b)
+		case num == 4 && wireType == protoWireBytes: // message_type
+			messages = append(messages, b)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, b := range messages {
+		if err := c.readMessage(b, pkg); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// readMessage reads a DescriptorProto and its nested types.
+func (c *protobufConverter) readMessage(b []byte, scope string) error {
+	m := &protoMessage{}
+	var nested [][]byte
+
+	err := readProto(b, func(num, wireType int, v uint64, b []byte) error {
+		if wireType != protoWireBytes {
+			return nil
+		}
+
+		switch num {
+		case 1: // name
+			m.name = string(b)
+		case 2: // field
+			f, err := readField(b)
+			if err != nil {
+				return err
+			}
+			m.fields = append(m.fields, f)
+		case 3: // nested_type
+			nested = append(nested, b)
+		case 7: // options
+			return readProto(b, func(num, wireType int, v uint64, b []byte) error {
+				if num == 7 && wireType == protoWireVarint { // map_entry
+					m.mapEntry = v != 0
+				}
+				return nil
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if scope != "" {
+		m.name = scope + "." + m.name
+	}
+	c.messages[m.name] = m
+
+	for _, b := range nested {
+		if err := c.readMessage(b, m.name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// readField reads a FieldDescriptorProto.
+func readField(b []byte) (protoField, error) {
+	var f protoField
+
+	err := readProto(b, func(num, wireType int, v uint64, b []byte) error {
+		switch {
+		case num == 1 && wireType == protoWireBytes:
+			f.name = string(b)
+		case num == 4 && wireType == protoWireVarint:
+			f.label = int(v)
+		case num == 5 && wireType == protoWireVarint:
+			f.typ = int(v)
+		case num == 6 && wireType == protoWireBytes:
+			f.typeName = strings.TrimPrefix(string(b), ".")
+		}
+		return nil
+	})
+
+	return f, err
+}
+
+func (c *protobufConverter) convertFields(m *protoMessage, path string) ([]structField, error) {
+	var fields []structField
+
+	for _, f := range m.fields {
+		fieldPath := joinPath(path, f.name)
+
+		typ, err := c.convertField(f, fieldPath)
+		if err != nil {
+			return nil, err
+		}
+		if typ == "" {
+			continue
+		}
+		fields = append(fields, structField{name: f.name, typ: typ})
+	}
+
+	return fields, nil
+}
+
+// convertField returns the Glue type of a field, or "" if the field is an empty message and is omitted.
+func (c *protobufConverter) convertField(f protoField, path string) (string, error) {
+	if f.typ != protoTypeMessage && f.typ != protoTypeGroup {
+		typ, ok := protoScalarTypes[f.typ]
+		switch {
+		case f.typ == protoTypeUint64 || f.typ == protoTypeFixed64:
+			c.warnf(path, "unsigned 64-bit integer converted to bigint")
+			typ = "bigint"
+		case !ok:
+			return "", fmt.Errorf("field %q: unsupported Protobuf type %d", path, f.typ)
+		}
+		if f.label == protoLabelRepeated {
+			typ = arrayType(typ)
+		}
+		return typ, nil
+	}
+
+	if typ, ok := protoWellKnownTypes[f.typeName]; ok {
+		if typ == "" {
+			c.warnf(path, "%s converted to string", f.typeName)
+			typ = "string"
+		}
+		if f.label == protoLabelRepeated {
+			typ = arrayType(typ)
+		}
+		return typ, nil
+	}
+
+	m, ok := c.messages[f.typeName]
+	if !ok {
+		return "", fmt.Errorf("field %q: message %q not found in the Protobuf descriptor set, which must include imports", path, f.typeName)
+	}
+
+	if m.mapEntry {
+		var key, value string
+		for _, entry := range m.fields {
+			typ, err := c.convertField(entry, path)
+			if err != nil {
+				return "", err
+			}
+			switch entry.name {
+			case "key":
+				key = typ
+			case "value":
+				value = typ
+			}
+		}
+		if key == "" || value == "" {
+			return "", fmt.Errorf("field %q: invalid map entry %q", path, m.name)
+		}
+		return mapType(key, value), nil
+	}
+
+	if c.converting[m.name] {
+		return "", fmt.Errorf("field %q: recursive message %q cannot be represented in the Glue Data Catalog", path, m.name)
+	}
+	c.converting[m.name] = true
+	defer delete(c.converting, m.name)
+
+	fields, err := c.convertFields(m, path)
+	if err != nil {
+		return "", err
+	}
+	if len(fields) == 0 {
+		c.warnf(path, "message %s without fields omitted", m.name)
+		return "", nil
+	}
+
+	typ := structType(fields)
+	if f.label == protoLabelRepeated {
+		typ = arrayType(typ)
+	}
+
+	return typ, nil
+}
+
+// readProto calls f for each field in a serialized Protobuf message.
+// For varint and fixed-width fields v is the value; for length-delimited fields b is the content.
+func readProto(b []byte, f func(num, wireType int, v uint64, b []byte) error) error {
+	for len(b) > 0 {
+		key, n := binary.Uvarint(b)
+		if n <= 0 {
+			return errors.New("invalid field key")
+		}
+		b = b[n:]
+
+		num, wireType := int(key>>3), int(key&7)
+		var v uint64
+		var content []byte
+
+		switch wireType {
+		case protoWireVarint:
+			v, n = binary.Uvarint(b)
+			if n <= 0 {
+				return fmt.Errorf("field %d: invalid varint", num)
+			}
+			b = b[n:]
+		case protoWireFixed64:
+			if len(b) < 8 {
+				return fmt.Errorf("field %d: truncated fixed64", num)
+			}
+			v, b = binary.LittleEndian.Uint64(b), b[8:]
+		case protoWireBytes:
+			l, n := binary.Uvarint(b)
+			if n <= 0 || l > uint64(len(b)-n) {
+				return fmt.Errorf("field %d: invalid length", num)
+			}
+			content, b = b[n:n+int(l)], b[n+int(l):]
+		case protoWireFixed32:
+			if len(b) < 4 {
+				return fmt.Errorf("field %d: truncated fixed32", num)
+			}
+			v, b = uint64(binary.LittleEndian.Uint32(b)), b[4:]
+		default:
+			return fmt.Errorf("field %d: unsupported wire type %d", num, wireType)
+		}
+
+		if err := f(num, wireType, v, content); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
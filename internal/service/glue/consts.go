@@ -14,6 +14,22 @@ const (
 	devEndpointStatusTerminating  = "TERMINATING"
 )
 
+const (
+	catalogTableSchemaFormatAvro       = "AVRO"
+	catalogTableSchemaFormatJSONSchema = "JSON"
+	catalogTableSchemaFormatParquet    = "PARQUET"
+	catalogTableSchemaFormatProtobuf   = "PROTOBUF"
+)
+
+func catalogTableSchemaFormat_Values() []string {
+	return []string{
+		catalogTableSchemaFormatAvro,
+		catalogTableSchemaFormatJSONSchema,
+		catalogTableSchemaFormatParquet,
+		catalogTableSchemaFormatProtobuf,
+	}
+}
+
 const (
 	propagationTimeout = 2 * time.Minute
 )
@@ -5,6 +5,7 @@ package glue
 
 import (
 	"context"
+	"slices"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/glue"
@@ -204,6 +205,48 @@ func FindSchemaVersionByID(ctx context.Context, conn *glue.Client, id string) (*
 	return output, nil
 }
 
+// findAvailableSchemaVersionsByID returns the available versions of the Schema corresponding to the specified ID,
+// oldest first.
+func findAvailableSchemaVersionsByID(ctx context.Context, conn *glue.Client, id string) ([]*glue.GetSchemaVersionOutput, error) {
+	input := &glue.ListSchemaVersionsInput{
+		SchemaId: createSchemaID(id),
+	}
+	var versionNumbers []int64
+
+	pages := glue.NewListSchemaVersionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Schemas {
+			if v.Status == awstypes.SchemaVersionStatusAvailable {
+				versionNumbers = append(versionNumbers, aws.ToInt64(v.VersionNumber))
+			}
+		}
+	}
+
+	slices.Sort(versionNumbers)
+
+	var output []*glue.GetSchemaVersionOutput
+	for _, versionNumber := range versionNumbers {
+		v, err := conn.GetSchemaVersion(ctx, &glue.GetSchemaVersionInput{
+			SchemaId: createSchemaID(id),
+			SchemaVersionNumber: &awstypes.SchemaVersionNumber{
+				VersionNumber: aws.Int64(versionNumber),
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, v)
+	}
+
+	return output, nil
+}
+
 // FindPartitionByValues returns the Partition corresponding to the specified Partition Values.
 func FindPartitionByValues(ctx context.Context, conn *glue.Client, id string) (*awstypes.Partition, error) {
 	catalogID, dbName, tableName, values, err := readPartitionID(id)
@@ -5,6 +5,7 @@ package glue
 
 import (
 	"context"
+	"fmt"
 	"log"
 
 	"github.com/YakDriver/regexache"
@@ -12,12 +13,14 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/glue"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/glue/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/glue/schemacompat"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
@@ -35,7 +38,10 @@ func ResourceSchema() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourceSchemaCustomizeDiff,
+		),
 
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
@@ -264,3 +270,50 @@ func resourceSchemaDelete(ctx context.Context, d *schema.ResourceData, meta inte
 
 	return diags
 }
+
+// resourceSchemaCustomizeDiff checks a changed schema_definition against the registered versions of the schema
+// under its compatibility mode, so that incompatible versions are reported at plan time rather than rejected by the
+// registry part way through an apply.
+func resourceSchemaCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("schema_definition") || !d.NewValueKnown("schema_definition") || d.HasChange("data_format") {
+		return nil
+	}
+
+	dataFormat, compatibility := d.Get("data_format").(string), d.Get("compatibility").(string)
+	if !schemacompat.Supported(dataFormat, compatibility) {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).GlueClient(ctx)
+
+	var versions []*glue.GetSchemaVersionOutput
+	if schemacompat.CheckAll(compatibility) {
+		output, err := findAvailableSchemaVersionsByID(ctx, conn, d.Id())
+		if err != nil {
+			return fmt.Errorf("reading Glue Schema (%s) versions: %w", d.Id(), err)
+		}
+
+		versions = output
+	} else {
+		output, err := FindSchemaVersionByID(ctx, conn, d.Id())
+		if err != nil {
+			return fmt.Errorf("reading Glue Schema Definition (%s): %w", d.Id(), err)
+		}
+
+		versions = append(versions, output)
+	}
+
+	previous := make([]schemacompat.Version, 0, len(versions))
+	for _, v := range versions {
+		previous = append(previous, schemacompat.Version{
+			Definition: aws.ToString(v.SchemaDefinition),
+			Number:     aws.ToInt64(v.VersionNumber),
+		})
+	}
+
+	if err := schemacompat.Check(dataFormat, compatibility, d.Get("schema_definition").(string), previous); err != nil {
+		return fmt.Errorf("schema_definition: %w", err)
+	}
+
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/glue"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/glue/types"
@@ -281,6 +282,41 @@ func TestAccGlueSchema_schemaDefUpdated(t *testing.T) {
 	})
 }
 
+func TestAccGlueSchema_schemaDefIncompatible(t *testing.T) {
+	ctx := acctest.Context(t)
+	var schema glue.GetSchemaOutput
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_glue_schema.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckSchema(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.GlueServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSchemaDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSchemaConfig_compatibilityDefinition(rName, "BACKWARD", `{"type": "record", "name": "r1", "fields": [{"name": "f1", "type": "int"}]}`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchemaExists(ctx, resourceName, &schema),
+					resource.TestCheckResourceAttr(resourceName, "latest_schema_version", "1"),
+				),
+			},
+			{
+				Config:      testAccSchemaConfig_compatibilityDefinition(rName, "BACKWARD", `{"type": "record", "name": "r1", "fields": [{"name": "f1", "type": "int"}, {"name": "f2", "type": "string"}]}`),
+				ExpectError: regexache.MustCompile(`not BACKWARD compatible with version 1`),
+			},
+			{
+				Config: testAccSchemaConfig_compatibilityDefinition(rName, "BACKWARD", `{"type": "record", "name": "r1", "fields": [{"name": "f1", "type": "long"}, {"name": "f2", "type": "string", "default": ""}]}`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSchemaExists(ctx, resourceName, &schema),
+					resource.TestCheckResourceAttr(resourceName, "latest_schema_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccGlueSchema_disappears(t *testing.T) {
 	ctx := acctest.Context(t)
 	var schema glue.GetSchemaOutput
@@ -515,3 +551,15 @@ resource "aws_glue_schema" "test" {
 }
 `, rName)
 }
+
+func testAccSchemaConfig_compatibilityDefinition(rName, compat, definition string) string {
+	return testAccSchemaBase(rName) + fmt.Sprintf(`
+resource "aws_glue_schema" "test" {
+  schema_name       = %[1]q
+  registry_arn      = aws_glue_registry.test.arn
+  data_format       = "AVRO"
+  compatibility     = %[2]q
+  schema_definition = %[3]q
+}
+`, rName, compat, definition)
+}
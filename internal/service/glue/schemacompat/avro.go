@@ -0,0 +1,347 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schemacompat
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// avroType is a parsed Avro type. Named types are shared, so recursive types form cycles.
+type avroType struct {
+	aliases     []string
+	branches    []*avroType // union
+	enumDefault string
+	fields      []*avroField // record
+	items       *avroType    // array
+	kind        string
+	name        string // full name of a named type
+	size        int    // fixed
+	symbols     []string
+	values      *avroType // map
+}
+
+type avroField struct {
+	aliases    []string
+	hasDefault bool
+	name       string
+	typ        *avroType
+}
+
+type avroSchemaJSON struct {
+	Aliases   []string        `json:"aliases"`
+	Default   json.RawMessage `json:"default"`
+	Fields    []avroFieldJSON `json:"fields"`
+	Items     json.RawMessage `json:"items"`
+	Name      string          `json:"name"`
+	Namespace string          `json:"namespace"`
+	Size      int             `json:"size"`
+	Symbols   []string        `json:"symbols"`
+	Type      json.RawMessage `json:"type"`
+	Values    json.RawMessage `json:"values"`
+}
+
+type avroFieldJSON struct {
+	Aliases []string        `json:"aliases"`
+	Default json.RawMessage `json:"default"`
+	Name    string          `json:"name"`
+	Type    json.RawMessage `json:"type"`
+}
+
+var avroPrimitiveTypes = []string{"boolean", "bytes", "double", "float", "int", "long", "null", "string"}
+
+// avroPromotions maps writer types to the reader types that they can be promoted to.
+var avroPromotions = map[string][]string{
+	"bytes":  {"string"},
+	"float":  {"double"},
+	"int":    {"double", "float", "long"},
+	"long":   {"double", "float"},
+	"string": {"bytes"},
+}
+
+type avroParser struct {
+	named map[string]*avroType
+}
+
+func parseAvro(definition string) (*avroType, error) {
+	p := &avroParser{named: map[string]*avroType{}}
+
+	t, err := p.parse(json.RawMessage(definition), "")
+	if err != nil {
+		return nil, fmt.Errorf("parsing Avro schema: %w", err)
+	}
+
+	return t, nil
+}
+
+func (p *avroParser) parse(raw json.RawMessage, namespace string) (*avroType, error) {
+	switch s := strings.TrimSpace(string(raw)); {
+	case strings.HasPrefix(s, `"`):
+		var name string
+		if err := json.Unmarshal(raw, &name); err != nil {
+			return nil, err
+		}
+		return p.lookup(name, namespace)
+	case strings.HasPrefix(s, "["):
+		var raws []json.RawMessage
+		if err := json.Unmarshal(raw, &raws); err != nil {
+			return nil, err
+		}
+		t := &avroType{kind: "union"}
+		for _, raw := range raws {
+			branch, err := p.parse(raw, namespace)
+			if err != nil {
+				return nil, err
+			}
+			t.branches = append(t.branches, branch)
+		}
+		return t, nil
+	case strings.HasPrefix(s, "{"):
+		var schema avroSchemaJSON
+		if err := json.Unmarshal(raw, &schema); err != nil {
+			return nil, err
+		}
+		return p.parseComplex(&schema, namespace)
+	}
+
+	return nil, fmt.Errorf("invalid Avro type %s", raw)
+}
+
+func (p *avroParser) lookup(name, namespace string) (*avroType, error) {
+	if slices.Contains(avroPrimitiveTypes, name) {
+		return &avroType{kind: name}, nil
+	}
+
+	if !strings.Contains(name, ".") && namespace != "" {
+		if t, ok := p.named[namespace+"."+name]; ok {
+			return t, nil
+		}
+	}
+	if t, ok := p.named[name]; ok {
+		return t, nil
+	}
+
+	return nil, fmt.Errorf("unknown Avro type %q", name)
+}
+
+func (p *avroParser) parseComplex(schema *avroSchemaJSON, namespace string) (*avroType, error) {
+	var kind string
+	if err := json.Unmarshal(schema.Type, &kind); err != nil {
+		return p.parse(schema.Type, namespace)
+	}
+
+	switch kind {
+	case "array":
+		items, err := p.parse(schema.Items, namespace)
+		if err != nil {
+			return nil, err
+		}
+		return &avroType{kind: kind, items: items}, nil
+	case "map":
+		values, err := p.parse(schema.Values, namespace)
+		if err != nil {
+			return nil, err
+		}
+		return &avroType{kind: kind, values: values}, nil
+	case "enum", "error", "fixed", "record":
+	default:
+		// A primitive type, possibly with a logical type.
+		return p.lookup(kind, namespace)
+	}
+
+	name := schema.Name
+	switch i := strings.LastIndexByte(name, '.'); {
+	case name == "":
+		return nil, fmt.Errorf("%s must have a name", kind)
+	case i >= 0:
+		namespace = name[:i]
+	case schema.Namespace != "":
+		namespace = schema.Namespace
+		name = namespace + "." + name
+	case namespace != "":
+		name = namespace + "." + name
+	}
+
+	if kind == "error" {
+		kind = "record"
+	}
+	t := &avroType{aliases: schema.Aliases, kind: kind, name: name, size: schema.Size, symbols: schema.Symbols}
+	p.named[name] = t
+
+	switch kind {
+	case "enum":
+		if len(schema.Default) > 0 {
+			if err := json.Unmarshal(schema.Default, &t.enumDefault); err != nil {
+				return nil, fmt.Errorf("enum %q: invalid default %s", name, schema.Default)
+			}
+		}
+	case "record":
+		for _, f := range schema.Fields {
+			typ, err := p.parse(f.Type, namespace)
+			if err != nil {
+				return nil, fmt.Errorf("field %q: %w", f.Name, err)
+			}
+			t.fields = append(t.fields, &avroField{
+				aliases:    f.Aliases,
+				hasDefault: len(f.Default) > 0,
+				name:       f.Name,
+				typ:        typ,
+			})
+		}
+	}
+
+	return t, nil
+}
+
+// shortName returns the unqualified name of a named type.
+func shortName(name string) string {
+	return name[strings.LastIndexByte(name, '.')+1:]
+}
+
+// namesMatch reports whether a reader named type matches a writer named type, by name or by one of the reader's aliases.
+func namesMatch(reader, writer *avroType) bool {
+	if shortName(reader.name) == shortName(writer.name) {
+		return true
+	}
+
+	return slices.ContainsFunc(reader.aliases, func(alias string) bool { return shortName(alias) == shortName(writer.name) })
+}
+
+// checkAvro returns the reasons why data written with the writer schema can't be read with the reader schema,
+// following the Avro schema resolution rules.
+// See https://avro.apache.org/docs/1.11.1/specification/#schema-resolution.
+func checkAvro(reader, writer string) ([]string, error) {
+	r, err := parseAvro(reader)
+	if err != nil {
+		return nil, err
+	}
+	w, err := parseAvro(writer)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &avroChecker{checked: map[[2]*avroType]bool{}}
+	c.check(r, w, "")
+
+	return c.issues, nil
+}
+
+type avroChecker struct {
+	// checked holds the pairs of named types already checked, so that recursive types terminate.
+	checked map[[2]*avroType]bool
+	issues
+}
+
+// branch returns the reader union branch that data of the writer type resolves to: the first branch of the same
+// type (and name, for named types), otherwise the first branch the writer type can be promoted to.
+func branch(reader []*avroType, writer *avroType) *avroType {
+	for _, b := range reader {
+		if b.kind == writer.kind && (b.name == "" || namesMatch(b, writer)) {
+			return b
+		}
+	}
+	for _, b := range reader {
+		if slices.Contains(avroPromotions[writer.kind], b.kind) {
+			return b
+		}
+	}
+
+	return nil
+}
+
+func (c *avroChecker) check(reader, writer *avroType, path string) {
+	if reader.name != "" && writer.name != "" {
+		pair := [2]*avroType{reader, writer}
+		if c.checked[pair] {
+			return
+		}
+		c.checked[pair] = true
+	}
+
+	if writer.kind == "union" {
+		// Every branch of the writer's union must be readable.
+		for _, branch := range writer.branches {
+			c.check(reader, branch, path)
+		}
+		return
+	}
+
+	if reader.kind == "union" {
+		if b := branch(reader.branches, writer); b != nil {
+			c.check(b, writer, path)
+		} else {
+			c.addf(path, "reader union has no branch that can read writer type %s", writer.describe())
+		}
+		return
+	}
+
+	if reader.kind != writer.kind {
+		if !slices.Contains(avroPromotions[writer.kind], reader.kind) {
+			c.addf(path, "writer type %s can't be read as %s", writer.describe(), reader.describe())
+		}
+		return
+	}
+
+	switch reader.kind {
+	case "array":
+		c.check(reader.items, writer.items, path)
+	case "map":
+		c.check(reader.values, writer.values, path)
+	case "enum":
+		if !namesMatch(reader, writer) {
+			c.addf(path, "enum name changed from %q to %q", writer.name, reader.name)
+			return
+		}
+		if reader.enumDefault != "" {
+			return
+		}
+		for _, symbol := range writer.symbols {
+			if !slices.Contains(reader.symbols, symbol) {
+				c.addf(path, "reader enum %q has no symbol %q and no default", reader.name, symbol)
+			}
+		}
+	case "fixed":
+		if !namesMatch(reader, writer) {
+			c.addf(path, "fixed name changed from %q to %q", writer.name, reader.name)
+		} else if reader.size != writer.size {
+			c.addf(path, "fixed size changed from %d to %d", writer.size, reader.size)
+		}
+	case "record":
+		if !namesMatch(reader, writer) {
+			c.addf(path, "record name changed from %q to %q", writer.name, reader.name)
+			return
+		}
+		for _, rf := range reader.fields {
+			fieldPath := joinPath(path, rf.name)
+			if wf := writer.field(rf); wf != nil {
+				c.check(rf.typ, wf.typ, fieldPath)
+			} else if !rf.hasDefault {
+				c.addf(fieldPath, "reader field is missing from the writer schema and has no default")
+			}
+		}
+	}
+}
+
+// field returns the writer's field that matches a reader field by name or by one of the reader field's aliases.
+func (t *avroType) field(reader *avroField) *avroField {
+	for _, name := range append([]string{reader.name}, reader.aliases...) {
+		for _, f := range t.fields {
+			if f.name == name {
+				return f
+			}
+		}
+	}
+
+	return nil
+}
+
+func (t *avroType) describe() string {
+	if t.name != "" {
+		return fmt.Sprintf("%s %q", t.kind, t.name)
+	}
+
+	return t.kind
+}
@@ -0,0 +1,371 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schemacompat
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+type jsonSchema struct {
+	AdditionalProperties json.RawMessage            `json:"additionalProperties"`
+	AnyOf                []json.RawMessage          `json:"anyOf"`
+	Enum                 []json.RawMessage          `json:"enum"`
+	Items                json.RawMessage            `json:"items"`
+	MaxItems             *float64                   `json:"maxItems"`
+	MaxLength            *float64                   `json:"maxLength"`
+	Maximum              *float64                   `json:"maximum"`
+	MinItems             *float64                   `json:"minItems"`
+	MinLength            *float64                   `json:"minLength"`
+	Minimum              *float64                   `json:"minimum"`
+	OneOf                []json.RawMessage          `json:"oneOf"`
+	Pattern              string                     `json:"pattern"`
+	Properties           map[string]json.RawMessage `json:"properties"`
+	Ref                  string                     `json:"$ref"`
+	Required             []string                   `json:"required"`
+	Type                 json.RawMessage            `json:"type"`
+}
+
+// jsonDocument is a JSON Schema document, against which local references are resolved.
+type jsonDocument struct {
+	root json.RawMessage
+}
+
+// resolve follows references and parses a subschema. It returns nil for the schemas true and {}, which accept any value.
+func (d *jsonDocument) resolve(raw json.RawMessage) (*jsonSchema, []string, bool, error) {
+	var refs []string
+
+	for {
+		switch strings.TrimSpace(string(raw)) {
+		case "true", "{}":
+			return nil, refs, true, nil
+		case "false":
+			return nil, refs, false, nil
+		}
+
+		var schema jsonSchema
+		if err := json.Unmarshal(raw, &schema); err != nil {
+			return nil, nil, false, fmt.Errorf("parsing JSON Schema: %w", err)
+		}
+		if schema.Ref == "" {
+			return &schema, refs, true, nil
+		}
+		if slices.Contains(refs, schema.Ref) {
+			return nil, nil, false, fmt.Errorf("parsing JSON Schema: reference %q refers to itself", schema.Ref)
+		}
+
+		target, err := d.lookup(schema.Ref)
+		if err != nil {
+			return nil, nil, false, err
+		}
+		refs = append(refs, schema.Ref)
+		raw = target
+	}
+}
+
+// lookup returns the subschema identified by a local reference such as #/$defs/name.
+func (d *jsonDocument) lookup(ref string) (json.RawMessage, error) {
+	pointer, ok := strings.CutPrefix(ref, "#")
+	if !ok {
+		return nil, fmt.Errorf("parsing JSON Schema: only local references are supported, got %q", ref)
+	}
+
+	raw := d.root
+	if pointer == "" {
+		return raw, nil
+	}
+
+	for _, token := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+		token = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+
+		var next json.RawMessage
+		var object map[string]json.RawMessage
+		var array []json.RawMessage
+		if json.Unmarshal(raw, &object) == nil {
+			next = object[token]
+		} else if i, err := strconv.Atoi(token); err == nil && json.Unmarshal(raw, &array) == nil && i >= 0 && i < len(array) {
+			next = array[i]
+		}
+		if next == nil {
+			return nil, fmt.Errorf("parsing JSON Schema: reference %q not found", ref)
+		}
+
+		raw = next
+	}
+
+	return raw, nil
+}
+
+// checkJSONSchema returns the reasons why data valid against the writer schema isn't valid against the reader schema.
+// Changes that reject previously valid data are reported: removed types and enum values, newly required properties,
+// properties disallowed by additionalProperties and narrowed numeric, length and item count limits.
+func checkJSONSchema(reader, writer string) ([]string, error) {
+	c := &jsonChecker{
+		checked: map[[2]string]bool{},
+		reader:  &jsonDocument{root: json.RawMessage(reader)},
+		writer:  &jsonDocument{root: json.RawMessage(writer)},
+	}
+
+	// Report invalid JSON even in parts of the schemas that the check doesn't reach.
+	for _, d := range []*jsonDocument{c.reader, c.writer} {
+		if !json.Valid(d.root) {
+			return nil, errors.New("parsing JSON Schema: invalid JSON")
+		}
+	}
+
+	if err := c.check(c.reader.root, c.writer.root, ""); err != nil {
+		return nil, err
+	}
+
+	return c.issues, nil
+}
+
+type jsonChecker struct {
+	// checked holds the pairs of references already checked, so that recursive schemas terminate.
+	checked map[[2]string]bool
+	issues
+	reader, writer *jsonDocument
+}
+
+// matches reports whether the reader subschema accepts all values of the writer subschema, without recording issues.
+func (c *jsonChecker) matches(reader, writer json.RawMessage) (bool, error) {
+	probe := &jsonChecker{checked: map[[2]string]bool{}, reader: c.reader, writer: c.writer}
+	err := probe.check(reader, writer, "")
+
+	return len(probe.issues) == 0, err
+}
+
+func (c *jsonChecker) check(readerRaw, writerRaw json.RawMessage, path string) error {
+	reader, readerRefs, readerAcceptsAll, err := c.reader.resolve(readerRaw)
+	if err != nil {
+		return err
+	}
+	writer, writerRefs, writerAcceptsAny, err := c.writer.resolve(writerRaw)
+	if err != nil {
+		return err
+	}
+
+	if len(readerRefs) > 0 && len(writerRefs) > 0 {
+		pair := [2]string{readerRefs[len(readerRefs)-1], writerRefs[len(writerRefs)-1]}
+		if c.checked[pair] {
+			return nil
+		}
+		c.checked[pair] = true
+	}
+
+	switch {
+	case reader == nil && readerAcceptsAll:
+		return nil
+	case writer == nil && !writerAcceptsAny:
+		return nil
+	case reader == nil:
+		c.addf(path, "schema no longer accepts any value")
+		return nil
+	}
+
+	if alternatives := slices.Concat(writer.OneOf, writer.AnyOf); len(alternatives) > 0 {
+		// Every alternative of the writer must be accepted.
+		for _, raw := range alternatives {
+			if err := c.check(readerRaw, raw, path); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if alternatives := slices.Concat(reader.OneOf, reader.AnyOf); len(alternatives) > 0 {
+		for _, raw := range alternatives {
+			ok, err := c.matches(raw, writerRaw)
+			if err != nil || ok {
+				return err
+			}
+		}
+		c.addf(path, "no oneOf or anyOf alternative accepts all previously valid values")
+		return nil
+	}
+
+	if writer == nil {
+		// The writer accepts any value.
+		writer = &jsonSchema{}
+	}
+
+	readerTypes, writerTypes := reader.types(), writer.types()
+	if len(readerTypes) > 0 {
+		if len(writerTypes) == 0 {
+			c.addf(path, "type restricted to %s", strings.Join(readerTypes, ", "))
+		}
+		for _, t := range writerTypes {
+			if !slices.Contains(readerTypes, t) && (t != "integer" || !slices.Contains(readerTypes, "number")) {
+				c.addf(path, "type %s is no longer allowed", t)
+			}
+		}
+	}
+
+	c.checkEnum(reader, writer, path)
+	c.checkLimits(reader, writer, path)
+
+	if slices.Contains(readerTypes, "object") || len(reader.Properties) > 0 {
+		if err := c.checkObject(reader, writer, path); err != nil {
+			return err
+		}
+	}
+
+	if isSchema(reader.Items) && isSchema(writer.Items) {
+		if err := c.check(reader.Items, writer.Items, path+"[]"); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *jsonChecker) checkEnum(reader, writer *jsonSchema, path string) {
+	if len(reader.Enum) == 0 {
+		return
+	}
+	if len(writer.Enum) == 0 {
+		c.addf(path, "values restricted to an enum")
+		return
+	}
+
+	for _, v := range writer.Enum {
+		if !slices.ContainsFunc(reader.Enum, func(r json.RawMessage) bool { return jsonEqual(r, v) }) {
+			c.addf(path, "enum value %s was removed", compactJSON(v))
+		}
+	}
+}
+
+func (c *jsonChecker) checkLimits(reader, writer *jsonSchema, path string) {
+	lower := func(name string, r, w *float64) {
+		if r != nil && (w == nil || *r < *w) {
+			c.addf(path, "%s lowered to %s", name, strconv.FormatFloat(*r, 'f', -1, 64))
+		}
+	}
+	raise := func(name string, r, w *float64) {
+		if r != nil && (w == nil || *r > *w) {
+			c.addf(path, "%s raised to %s", name, strconv.FormatFloat(*r, 'f', -1, 64))
+		}
+	}
+
+	lower("maximum", reader.Maximum, writer.Maximum)
+	raise("minimum", reader.Minimum, writer.Minimum)
+	lower("maxLength", reader.MaxLength, writer.MaxLength)
+	raise("minLength", reader.MinLength, writer.MinLength)
+	lower("maxItems", reader.MaxItems, writer.MaxItems)
+	raise("minItems", reader.MinItems, writer.MinItems)
+
+	if reader.Pattern != "" && reader.Pattern != writer.Pattern {
+		c.addf(path, "pattern changed to %q", reader.Pattern)
+	}
+}
+
+func (c *jsonChecker) checkObject(reader, writer *jsonSchema, path string) error {
+	for _, name := range reader.Required {
+		if !slices.Contains(writer.Required, name) {
+			c.addf(joinPath(path, name), "property is now required")
+		}
+	}
+
+	readerClosed := strings.TrimSpace(string(reader.AdditionalProperties)) == "false"
+	writerClosed := strings.TrimSpace(string(writer.AdditionalProperties)) == "false"
+	if readerClosed && !writerClosed {
+		c.addf(path, "additional properties are no longer allowed")
+	}
+
+	for _, name := range sortedKeys(writer.Properties) {
+		propertyPath := joinPath(path, name)
+
+		if r, ok := reader.Properties[name]; ok {
+			if err := c.check(r, writer.Properties[name], propertyPath); err != nil {
+				return err
+			}
+			continue
+		}
+
+		switch {
+		case readerClosed:
+			c.addf(propertyPath, "property was removed and additional properties are not allowed")
+		case isSchema(reader.AdditionalProperties):
+			if err := c.check(reader.AdditionalProperties, writer.Properties[name], propertyPath); err != nil {
+				return err
+			}
+		}
+	}
+
+	if isSchema(writer.AdditionalProperties) {
+		// Properties added to the reader may have been written as additional properties.
+		for _, name := range sortedKeys(reader.Properties) {
+			if _, ok := writer.Properties[name]; !ok {
+				if err := c.check(reader.Properties[name], writer.AdditionalProperties, joinPath(path, name)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// types returns the schema's types. A schema without a type has no types, unless its keywords imply one.
+func (s *jsonSchema) types() []string {
+	var types []string
+
+	switch raw := strings.TrimSpace(string(s.Type)); {
+	case strings.HasPrefix(raw, "["):
+		// An invalid type is treated as no type.
+		if json.Unmarshal(s.Type, &types) != nil {
+			return nil
+		}
+	case raw != "":
+		var t string
+		if json.Unmarshal(s.Type, &t) == nil {
+			types = []string{t}
+		}
+	case len(s.Properties) > 0:
+		types = []string{"object"}
+	case len(s.Items) > 0:
+		types = []string{"array"}
+	}
+
+	return types
+}
+
+// isSchema reports whether raw is a schema object rather than absent or a boolean.
+func isSchema(raw json.RawMessage) bool {
+	return strings.HasPrefix(strings.TrimSpace(string(raw)), "{")
+}
+
+func sortedKeys(m map[string]json.RawMessage) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	return keys
+}
+
+func compactJSON(raw json.RawMessage) string {
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, raw); err != nil {
+		return string(raw)
+	}
+
+	return buf.String()
+}
+
+func jsonEqual(a, b json.RawMessage) bool {
+	var x, y any
+	if json.Unmarshal(a, &x) != nil || json.Unmarshal(b, &y) != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(x, y)
+}
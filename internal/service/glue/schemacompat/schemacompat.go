@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package schemacompat checks the compatibility of Glue Schema Registry schema versions, as the registry does
+// when a new version is registered.
+//
+// Avro and JSON Schema definitions are supported. For the BACKWARD modes, the new schema must be able to read
+// data written with previous versions; for the FORWARD modes, previous versions must be able to read data written
+// with the new schema; the FULL modes require both. Modes without the _ALL suffix only check the latest version.
+//
+// See https://docs.aws.amazon.com/glue/latest/dg/schema-registry.html#schema-registry-compatibility.
+package schemacompat
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Compatibility modes.
+const (
+	Backward    = "BACKWARD"
+	BackwardAll = "BACKWARD_ALL"
+	Disabled    = "DISABLED"
+	Forward     = "FORWARD"
+	ForwardAll  = "FORWARD_ALL"
+	Full        = "FULL"
+	FullAll     = "FULL_ALL"
+	None        = "NONE"
+)
+
+// Data formats.
+const (
+	FormatAvro     = "AVRO"
+	FormatJSON     = "JSON"
+	FormatProtobuf = "PROTOBUF"
+)
+
+// Version is a registered schema version.
+type Version struct {
+	Definition string
+	Number     int64
+}
+
+// Supported reports whether versions of the specified data format are checked under the specified compatibility mode.
+func Supported(dataFormat, compatibility string) bool {
+	switch compatibility {
+	case None, Disabled, "":
+		return false
+	}
+
+	return dataFormat == FormatAvro || dataFormat == FormatJSON
+}
+
+// CheckAll reports whether all previous versions, rather than only the latest, are checked under the specified compatibility mode.
+func CheckAll(compatibility string) bool {
+	return strings.HasSuffix(compatibility, "_ALL")
+}
+
+// Check returns an error if definition isn't compatible with the previous versions of a schema, ordered oldest first.
+func Check(dataFormat, compatibility, definition string, previous []Version) error {
+	if !Supported(dataFormat, compatibility) || len(previous) == 0 {
+		return nil
+	}
+
+	if !CheckAll(compatibility) {
+		previous = previous[len(previous)-1:]
+	}
+
+	var check checker
+	switch dataFormat {
+	case FormatAvro:
+		check = checkAvro
+	case FormatJSON:
+		check = checkJSONSchema
+	}
+
+	mode := strings.TrimSuffix(compatibility, "_ALL")
+	var errs []error
+
+	for _, v := range previous {
+		var reasons []string
+
+		if mode == Backward || mode == Full {
+			issues, err := check(definition, v.Definition)
+			if err != nil {
+				return err
+			}
+			if len(issues) > 0 {
+				reasons = append(reasons, fmt.Sprintf("the new schema can't read data written with version %d: %s", v.Number, strings.Join(issues, "; ")))
+			}
+		}
+		if mode == Forward || mode == Full {
+			issues, err := check(v.Definition, definition)
+			if err != nil {
+				return err
+			}
+			if len(issues) > 0 {
+				reasons = append(reasons, fmt.Sprintf("version %d can't read data written with the new schema: %s", v.Number, strings.Join(issues, "; ")))
+			}
+		}
+
+		if len(reasons) > 0 {
+			errs = append(errs, fmt.Errorf("not %s compatible with version %d: %s", compatibility, v.Number, strings.Join(reasons, "; ")))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// checker returns the reasons why a reader schema can't read data written with a writer schema.
+type checker func(reader, writer string) ([]string, error)
+
+// issues accumulates the incompatibilities found by a check.
+type issues []string
+
+func (is *issues) addf(path, format string, a ...any) {
+	msg := fmt.Sprintf(format, a...)
+	if path != "" {
+		msg = fmt.Sprintf("field %q: %s", path, msg)
+	}
+	*is = append(*is, msg)
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
@@ -0,0 +1,308 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schemacompat
+
+import (
+	"strings"
+	"testing"
+)
+
+const (
+	avroV1 = `{"type": "record", "name": "Order", "namespace": "example", "fields": [
+		{"name": "id", "type": "string"},
+		{"name": "quantity", "type": "int"},
+		{"name": "status", "type": {"type": "enum", "name": "Status", "symbols": ["NEW", "SHIPPED"]}}
+	]}`
+	// avroAddedOptional adds a field with a default and widens quantity.
+	avroAddedOptional = `{"type": "record", "name": "Order", "namespace": "example", "fields": [
+		{"name": "id", "type": "string"},
+		{"name": "quantity", "type": "long"},
+		{"name": "status", "type": {"type": "enum", "name": "Status", "symbols": ["NEW", "SHIPPED", "CANCELLED"]}},
+		{"name": "note", "type": ["null", "string"], "default": null}
+	]}`
+	// avroAddedRequired adds a field without a default.
+	avroAddedRequired = `{"type": "record", "name": "Order", "namespace": "example", "fields": [
+		{"name": "id", "type": "string"},
+		{"name": "quantity", "type": "int"},
+		{"name": "status", "type": {"type": "enum", "name": "Status", "symbols": ["NEW", "SHIPPED"]}},
+		{"name": "customer", "type": "string"}
+	]}`
+	// avroRemovedField removes a field without a default.
+	avroRemovedField = `{"type": "record", "name": "Order", "namespace": "example", "fields": [
+		{"name": "id", "type": "string"},
+		{"name": "status", "type": {"type": "enum", "name": "Status", "symbols": ["NEW", "SHIPPED"]}}
+	]}`
+	// avroRenamedField renames id, keeping the old name as an alias.
+	avroRenamedField = `{"type": "record", "name": "Order", "namespace": "example", "fields": [
+		{"name": "order_id", "type": "string", "aliases": ["id"]},
+		{"name": "quantity", "type": "int"},
+		{"name": "status", "type": {"type": "enum", "name": "Status", "symbols": ["NEW", "SHIPPED"]}}
+	]}`
+	avroRecursive = `{"type": "record", "name": "Node", "fields": [
+		{"name": "value", "type": "int"},
+		{"name": "next", "type": ["null", "Node"], "default": null}
+	]}`
+	avroRecursiveWidened = `{"type": "record", "name": "Node", "fields": [
+		{"name": "value", "type": "long"},
+		{"name": "next", "type": ["null", "Node"], "default": null}
+	]}`
+
+	jsonV1 = `{
+		"type": "object",
+		"properties": {
+			"id": {"type": "integer"},
+			"status": {"enum": ["new", "shipped"]},
+			"tags": {"type": "array", "items": {"type": "string", "maxLength": 20}}
+		},
+		"required": ["id"]
+	}`
+	// jsonRelaxed widens id, adds an enum value and an optional property, and relaxes the tag length.
+	jsonRelaxed = `{
+		"type": "object",
+		"properties": {
+			"id": {"type": "number"},
+			"status": {"enum": ["new", "shipped", "cancelled"]},
+			"tags": {"type": "array", "items": {"type": "string", "maxLength": 50}},
+			"note": {"type": "string"}
+		},
+		"required": ["id"]
+	}`
+	// jsonRestricted requires a new property, removes an enum value and narrows id and tags.
+	jsonRestricted = `{
+		"type": "object",
+		"properties": {
+			"id": {"type": "integer", "minimum": 1},
+			"status": {"enum": ["new"]},
+			"tags": {"type": "array", "items": {"type": "string", "maxLength": 10}},
+			"customer": {"type": "string"}
+		},
+		"required": ["id", "customer"]
+	}`
+	jsonClosed = `{
+		"type": "object",
+		"properties": {"id": {"type": "integer"}},
+		"additionalProperties": false
+	}`
+	jsonRefs = `{
+		"$defs": {"node": {"type": "object", "properties": {"value": {"type": "integer"}, "next": {"$ref": "#/$defs/node"}}}},
+		"$ref": "#/$defs/node"
+	}`
+	jsonRefsWidened = `{
+		"$defs": {"node": {"type": "object", "properties": {"value": {"type": "number"}, "next": {"$ref": "#/$defs/node"}}}},
+		"$ref": "#/$defs/node"
+	}`
+)
+
+func TestCheck(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		dataFormat    string
+		compatibility string
+		definition    string
+		previous      []string
+		wantErr       string
+	}{
+		"none": {
+			dataFormat:    FormatAvro,
+			compatibility: None,
+			definition:    avroRemovedField,
+			previous:      []string{avroAddedRequired},
+		},
+		"protobuf": {
+			dataFormat:    FormatProtobuf,
+			compatibility: Backward,
+			definition:    "syntax = \"proto3\";",
+			previous:      []string{"message Old {}"},
+		},
+		"no previous versions": {
+			dataFormat:    FormatAvro,
+			compatibility: Full,
+			definition:    avroV1,
+		},
+		"avro backward added optional field": {
+			dataFormat:    FormatAvro,
+			compatibility: Backward,
+			definition:    avroAddedOptional,
+			previous:      []string{avroV1},
+		},
+		"avro backward added required field": {
+			dataFormat:    FormatAvro,
+			compatibility: Backward,
+			definition:    avroAddedRequired,
+			previous:      []string{avroV1},
+			wantErr:       `not BACKWARD compatible with version 1: the new schema can't read data written with version 1: field "customer": reader field is missing from the writer schema and has no default`,
+		},
+		"avro backward removed field": {
+			dataFormat:    FormatAvro,
+			compatibility: Backward,
+			definition:    avroRemovedField,
+			previous:      []string{avroV1},
+		},
+		"avro backward renamed field": {
+			dataFormat:    FormatAvro,
+			compatibility: Backward,
+			definition:    avroRenamedField,
+			previous:      []string{avroV1},
+		},
+		"avro backward narrowed type": {
+			dataFormat:    FormatAvro,
+			compatibility: Backward,
+			definition:    avroV1,
+			previous:      []string{avroAddedOptional},
+			wantErr:       `field "quantity": writer type long can't be read as int`,
+		},
+		"avro forward removed field": {
+			dataFormat:    FormatAvro,
+			compatibility: Forward,
+			definition:    avroRemovedField,
+			previous:      []string{avroV1},
+			wantErr:       `version 1 can't read data written with the new schema: field "quantity": reader field is missing from the writer schema and has no default`,
+		},
+		"avro forward added enum symbol": {
+			dataFormat:    FormatAvro,
+			compatibility: Forward,
+			definition:    avroAddedOptional,
+			previous:      []string{avroV1},
+			wantErr:       `reader enum "example.Status" has no symbol "CANCELLED" and no default`,
+		},
+		"avro forward added required field": {
+			dataFormat:    FormatAvro,
+			compatibility: Forward,
+			definition:    avroAddedRequired,
+			previous:      []string{avroV1},
+		},
+		"avro full": {
+			dataFormat:    FormatAvro,
+			compatibility: Full,
+			definition:    avroAddedRequired,
+			previous:      []string{avroV1},
+			wantErr:       "not FULL compatible with version 1",
+		},
+		"avro backward checks only latest": {
+			dataFormat:    FormatAvro,
+			compatibility: Backward,
+			definition:    avroRemovedField,
+			previous:      []string{avroAddedRequired, avroV1},
+		},
+		"avro backward all": {
+			dataFormat:    FormatAvro,
+			compatibility: BackwardAll,
+			definition:    avroAddedRequired,
+			previous:      []string{avroAddedRequired, avroV1},
+			wantErr:       "not BACKWARD_ALL compatible with version 2",
+		},
+		"avro record renamed": {
+			dataFormat:    FormatAvro,
+			compatibility: Backward,
+			definition:    strings.Replace(avroV1, `"name": "Order"`, `"name": "Purchase"`, 1),
+			previous:      []string{avroV1},
+			wantErr:       `record name changed from "example.Order" to "example.Purchase"`,
+		},
+		"avro union": {
+			dataFormat:    FormatAvro,
+			compatibility: Backward,
+			definition:    `{"type": "record", "name": "r", "fields": [{"name": "a", "type": ["null", "long"]}]}`,
+			previous:      []string{`{"type": "record", "name": "r", "fields": [{"name": "a", "type": ["null", "string"]}]}`},
+			wantErr:       `field "a": reader union has no branch that can read writer type string`,
+		},
+		"avro recursive": {
+			dataFormat:    FormatAvro,
+			compatibility: Backward,
+			definition:    avroRecursiveWidened,
+			previous:      []string{avroRecursive},
+		},
+		"avro recursive forward": {
+			dataFormat:    FormatAvro,
+			compatibility: Forward,
+			definition:    avroRecursiveWidened,
+			previous:      []string{avroRecursive},
+			wantErr:       `field "value": writer type long can't be read as int`,
+		},
+		"avro invalid": {
+			dataFormat:    FormatAvro,
+			compatibility: Backward,
+			definition:    `{"type": "record", "name": "r", "fields": [{"name": "a", "type": "Missing"}]}`,
+			previous:      []string{avroV1},
+			wantErr:       `parsing Avro schema: field "a": unknown Avro type "Missing"`,
+		},
+		"json backward relaxed": {
+			dataFormat:    FormatJSON,
+			compatibility: Backward,
+			definition:    jsonRelaxed,
+			previous:      []string{jsonV1},
+		},
+		"json backward restricted": {
+			dataFormat:    FormatJSON,
+			compatibility: Backward,
+			definition:    jsonRestricted,
+			previous:      []string{jsonV1},
+			wantErr:       `field "customer": property is now required; field "id": minimum raised to 1; field "status": enum value "shipped" was removed; field "tags[]": maxLength lowered to 10`,
+		},
+		"json forward relaxed": {
+			dataFormat:    FormatJSON,
+			compatibility: Forward,
+			definition:    jsonRelaxed,
+			previous:      []string{jsonV1},
+			wantErr:       `field "id": type number is no longer allowed; field "status": enum value "cancelled" was removed; field "tags[]": maxLength lowered to 20`,
+		},
+		"json forward restricted": {
+			dataFormat:    FormatJSON,
+			compatibility: Forward,
+			definition:    jsonRestricted,
+			previous:      []string{jsonV1},
+		},
+		"json closed": {
+			dataFormat:    FormatJSON,
+			compatibility: Backward,
+			definition:    jsonClosed,
+			previous:      []string{jsonV1},
+			wantErr:       `additional properties are no longer allowed; field "status": property was removed and additional properties are not allowed`,
+		},
+		"json references": {
+			dataFormat:    FormatJSON,
+			compatibility: FullAll,
+			definition:    jsonRefsWidened,
+			previous:      []string{jsonRefs},
+			wantErr:       `version 1 can't read data written with the new schema: field "value": type number is no longer allowed`,
+		},
+		"json type alternatives": {
+			dataFormat:    FormatJSON,
+			compatibility: Backward,
+			definition:    `{"properties": {"a": {"oneOf": [{"type": "string"}, {"type": "integer"}]}}}`,
+			previous:      []string{`{"properties": {"a": {"type": "string"}}}`},
+		},
+		"json invalid": {
+			dataFormat:    FormatJSON,
+			compatibility: Backward,
+			definition:    `{"type": "object"`,
+			previous:      []string{jsonV1},
+			wantErr:       "parsing JSON Schema: invalid JSON",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var previous []Version
+			for i, v := range testCase.previous {
+				previous = append(previous, Version{Definition: v, Number: int64(i + 1)})
+			}
+
+			err := Check(testCase.dataFormat, testCase.compatibility, testCase.definition, previous)
+
+			if testCase.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), testCase.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", testCase.wantErr, err)
+			}
+		})
+	}
+}
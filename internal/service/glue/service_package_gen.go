@@ -41,6 +41,11 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_glue_catalog_table",
 			Name:     "Catalog Table",
 		},
+		{
+			Factory:  DataSourceCatalogTableSchema,
+			TypeName: "aws_glue_catalog_table_schema",
+			Name:     "Catalog Table Schema",
+		},
 		{
 			Factory:  DataSourceConnection,
 			TypeName: "aws_glue_connection",
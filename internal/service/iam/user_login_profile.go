@@ -15,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -22,15 +23,19 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 // @SDKResource("aws_iam_user_login_profile", name="User Login Profile")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/iam;iam.GetLoginProfileOutput")
+// @Testing(writeOnlyAttribute="password_wo")
 func resourceUserLoginProfile() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceUserLoginProfileCreate,
 		ReadWithoutTimeout:   resourceUserLoginProfileRead,
+		UpdateWithoutTimeout: resourceUserLoginProfileUpdate,
 		DeleteWithoutTimeout: resourceUserLoginProfileDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -48,9 +53,10 @@ func resourceUserLoginProfile() *schema.Resource {
 				ForceNew: true,
 			},
 			"pgp_key": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"password_wo"},
 			},
 			"password_reset_required": {
 				Type:     schema.TypeBool,
@@ -79,6 +85,19 @@ func resourceUserLoginProfile() *schema.Resource {
 				Computed:  true,
 				Sensitive: true,
 			},
+			"password_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				WriteOnly:     true,
+				ValidateFunc:  validation.StringLenBetween(1, 128),
+				ConflictsWith: []string{"pgp_key"},
+			},
+			"password_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"password_wo"},
+			},
 		},
 	}
 }
@@ -142,10 +161,21 @@ func resourceUserLoginProfileCreate(ctx context.Context, d *schema.ResourceData,
 	conn := meta.(*conns.AWSClient).IAMClient(ctx)
 	username := d.Get("user").(string)
 
-	passwordLength := d.Get("password_length").(int)
-	initialPassword, err := GeneratePassword(passwordLength)
-	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "creating IAM User Login Profile for %q: %s", username, err)
+	// get write-only value from configuration
+	passwordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("password_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	initialPassword := passwordWO
+	if initialPassword == "" {
+		passwordLength := d.Get("password_length").(int)
+		v, err := GeneratePassword(passwordLength)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating IAM User Login Profile for %q: %s", username, err)
+		}
+		initialPassword = v
 	}
 
 	request := &iam.CreateLoginProfileInput{
@@ -174,7 +204,7 @@ func resourceUserLoginProfileCreate(ctx context.Context, d *schema.ResourceData,
 
 		d.Set("key_fingerprint", fingerprint)
 		d.Set("encrypted_password", encrypted)
-	} else {
+	} else if passwordWO == "" {
 		d.Set(names.AttrPassword, initialPassword)
 	}
 
@@ -229,6 +259,34 @@ func resourceUserLoginProfileRead(ctx context.Context, d *schema.ResourceData, m
 	return diags
 }
 
+func resourceUserLoginProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMClient(ctx)
+
+	if d.HasChange("password_wo_version") {
+		passwordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("password_wo"))
+		diags = append(diags, di...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if passwordWO != "" {
+			input := &iam.UpdateLoginProfileInput{
+				Password: aws.String(passwordWO),
+				UserName: aws.String(d.Id()),
+			}
+
+			_, err := conn.UpdateLoginProfile(ctx, input)
+
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating IAM User Login Profile (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
+	return append(diags, resourceUserLoginProfileRead(ctx, d, meta)...)
+}
+
 func resourceUserLoginProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).IAMClient(ctx)
@@ -2,6 +2,7 @@
 // SPDX-License-Identifier: MPL-2.0
 
 //go:generate go run ../../generate/servicepackage/main.go
+//go:generate go run ../../generate/basictests/main.go
 //go:generate go run ../../generate/tags/main.go -ServiceTagsMap -ListTags -UpdateTags -KVTValues
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
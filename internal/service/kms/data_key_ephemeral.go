@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	epNameDataKey = "Data Key"
+)
+
+// @EphemeralResource(aws_kms_data_key, name="Data Key")
+func newEphemeralDataKey(_ context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &ephemeralDataKey{}, nil
+}
+
+type ephemeralDataKey struct {
+	framework.EphemeralResourceWithConfigure
+}
+
+func (e *ephemeralDataKey) Metadata(_ context.Context, _ ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = "aws_kms_data_key"
+}
+
+func (e *ephemeralDataKey) Schema(ctx context.Context, _ ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"ciphertext_blob": schema.StringAttribute{
+				Computed: true,
+			},
+			"context": schema.MapAttribute{
+				CustomType: fwtypes.MapOfStringType,
+				Optional:   true,
+			},
+			"grant_tokens": schema.ListAttribute{
+				CustomType: fwtypes.ListOfStringType,
+				Optional:   true,
+			},
+			"key_arn": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrKeyID: schema.StringAttribute{
+				Required: true,
+			},
+			"key_spec": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.DataKeySpec](),
+				Optional:   true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("number_of_bytes")),
+				},
+			},
+			"number_of_bytes": schema.Int32Attribute{
+				Optional: true,
+				Validators: []validator.Int32{
+					int32validator.Between(1, 1024),
+				},
+			},
+			"plaintext": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			"without_plaintext": schema.BoolAttribute{
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (e *ephemeralDataKey) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data epDataKeyData
+	conn := e.Meta().KMSClient(ctx)
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if data.WithoutPlaintext.ValueBool() {
+		input := kms.GenerateDataKeyWithoutPlaintextInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		input.EncryptionContext = fwflex.ExpandFrameworkStringValueMap(ctx, data.Context)
+		if input.KeySpec == "" && input.NumberOfBytes == nil {
+			input.KeySpec = awstypes.DataKeySpecAes256
+		}
+
+		output, err := conn.GenerateDataKeyWithoutPlaintext(ctx, &input)
+		if err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.KMS, create.ErrActionOpening, epNameDataKey, data.KeyID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		data.CiphertextBlob = fwflex.StringValueToFramework(ctx, itypes.Base64Encode(output.CiphertextBlob))
+		data.KeyARN = fwflex.StringToFramework(ctx, output.KeyId)
+		data.Plaintext = types.StringNull()
+	} else {
+		input := kms.GenerateDataKeyInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		input.EncryptionContext = fwflex.ExpandFrameworkStringValueMap(ctx, data.Context)
+		if input.KeySpec == "" && input.NumberOfBytes == nil {
+			input.KeySpec = awstypes.DataKeySpecAes256
+		}
+
+		output, err := conn.GenerateDataKey(ctx, &input)
+		if err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.KMS, create.ErrActionOpening, epNameDataKey, data.KeyID.ValueString(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		data.CiphertextBlob = fwflex.StringValueToFramework(ctx, itypes.Base64Encode(output.CiphertextBlob))
+		data.KeyARN = fwflex.StringToFramework(ctx, output.KeyId)
+		data.Plaintext = fwflex.StringValueToFramework(ctx, itypes.Base64Encode(output.Plaintext))
+	}
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type epDataKeyData struct {
+	CiphertextBlob   types.String                             `tfsdk:"ciphertext_blob"`
+	Context          fwtypes.MapValueOf[types.String]         `tfsdk:"context"`
+	GrantTokens      fwtypes.ListValueOf[types.String]        `tfsdk:"grant_tokens"`
+	KeyARN           types.String                             `tfsdk:"key_arn"`
+	KeyID            types.String                             `tfsdk:"key_id"`
+	KeySpec          fwtypes.StringEnum[awstypes.DataKeySpec] `tfsdk:"key_spec"`
+	NumberOfBytes    types.Int32                              `tfsdk:"number_of_bytes"`
+	Plaintext        types.String                             `tfsdk:"plaintext"`
+	WithoutPlaintext types.Bool                               `tfsdk:"without_plaintext"`
+}
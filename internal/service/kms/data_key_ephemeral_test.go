@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccKMSDataKeyEphemeral_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.KMSServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataKeyEphemeralResourceConfig_basic(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("ciphertext_blob"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("key_arn"), knownvalue.NotNull()),
+					// 32 bytes, base64-encoded.
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("plaintext"), knownvalue.StringRegexp(regexache.MustCompile(`^[0-9A-Za-z+/]{43}=$`))),
+				},
+			},
+		},
+	})
+}
+
+func TestAccKMSDataKeyEphemeral_withoutPlaintext(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.KMSServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataKeyEphemeralResourceConfig_withoutPlaintext(rName),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("ciphertext_blob"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue(echoResourceName, dataPath.AtMapKey("plaintext"), knownvalue.Null()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccKMSDataKeyEphemeral_envelopeEncryption(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	echoResourceName := "echo.test"
+	dataPath := tfjsonpath.New("data")
+	plaintext := "my-plaintext-string"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.KMSServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		ProtoV6ProviderFactories: acctest.ProtoV6ProviderFactories(ctx, acctest.ProviderNameEcho),
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataKeyEphemeralResourceConfig_envelopeEncryption(rName, plaintext),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(echoResourceName, dataPath, knownvalue.StringExact(plaintext)),
+				},
+			},
+		},
+	})
+}
+
+func testAccDataKeyEphemeralResourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+  enable_key_rotation     = true
+}
+`, rName)
+}
+
+func testAccDataKeyEphemeralResourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_kms_data_key.test"),
+		testAccDataKeyEphemeralResourceConfig_base(rName),
+		`
+ephemeral "aws_kms_data_key" "test" {
+  key_id = aws_kms_key.test.key_id
+
+  context = {
+    foo = "bar"
+  }
+}
+`)
+}
+
+func testAccDataKeyEphemeralResourceConfig_withoutPlaintext(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("ephemeral.aws_kms_data_key.test"),
+		testAccDataKeyEphemeralResourceConfig_base(rName),
+		`
+ephemeral "aws_kms_data_key" "test" {
+  key_id            = aws_kms_key.test.arn
+  key_spec          = "AES_128"
+  without_plaintext = true
+}
+`)
+}
+
+func testAccDataKeyEphemeralResourceConfig_envelopeEncryption(rName, plaintext string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigWithEchoProvider("local.plaintext"),
+		testAccDataKeyEphemeralResourceConfig_base(rName),
+		fmt.Sprintf(`
+ephemeral "aws_kms_data_key" "test" {
+  key_id = aws_kms_key.test.key_id
+}
+
+locals {
+  ciphertext = provider::aws::envelope_encrypt(ephemeral.aws_kms_data_key.test.plaintext, %[1]q)
+  plaintext  = provider::aws::envelope_decrypt(ephemeral.aws_kms_data_key.test.plaintext, local.ciphertext)
+}
+`, plaintext))
+}
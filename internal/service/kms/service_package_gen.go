@@ -16,6 +16,11 @@ type servicePackage struct{}
 
 func (p *servicePackage) EphemeralResources(ctx context.Context) []*types.ServicePackageEphemeralResource {
 	return []*types.ServicePackageEphemeralResource{
+		{
+			Factory:  newEphemeralDataKey,
+			TypeName: "aws_kms_data_key",
+			Name:     "Data Key",
+		},
 		{
 			Factory:  newEphemeralSecrets,
 			TypeName: "aws_kms_secrets",
@@ -0,0 +1,44 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_lightsail_database" "test" {
+  relational_database_name   = var.rName
+  availability_zone          = data.aws_availability_zones.available.names[0]
+  master_database_name       = "testdatabasename"
+  master_password_wo         = var.secret
+  master_password_wo_version = var.secret_version
+  master_username            = "test"
+  blueprint_id               = "mysql_8_0"
+  bundle_id                  = "micro_2_0"
+  apply_immediately          = true
+  skip_final_snapshot        = true
+}
+
+# acctest.ConfigAvailableAZsNoOptIn()
+data "aws_availability_zones" "available" {
+  state = "available"
+
+  filter {
+    name   = "opt-in-status"
+    values = ["opt-in-not-required"]
+  }
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+
+variable "secret" {
+  description = "Value of the write-only attribute"
+  type        = string
+  nullable    = false
+  sensitive   = true
+}
+
+variable "secret_version" {
+  description = "Version of the write-only attribute"
+  type        = number
+  nullable    = false
+}
@@ -19,42 +19,6 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-func TestAccLogsAnomalyDetector_basic(t *testing.T) {
-	ctx := acctest.Context(t)
-	var v cloudwatchlogs.GetLogAnomalyDetectorOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	resourceName := "aws_cloudwatch_log_anomaly_detector.test"
-
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck: func() {
-			acctest.PreCheck(ctx, t)
-		},
-		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckAnomalyDetectorDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccAnomalyDetectorConfig_basic(rName),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckAnomalyDetectorExists(ctx, resourceName, &v),
-					resource.TestCheckResourceAttrSet(resourceName, "detector_name"),
-					resource.TestCheckResourceAttr(resourceName, "evaluation_frequency", "TEN_MIN"),
-					resource.TestCheckResourceAttr(resourceName, "anomaly_visibility_time", "7"),
-					resource.TestCheckResourceAttrSet(resourceName, "log_group_arn_list.#"),
-				),
-			},
-			{
-				ResourceName:                         resourceName,
-				ImportState:                          true,
-				ImportStateVerify:                    true,
-				ImportStateIdFunc:                    testAccAnomalyDetectorImportStateIDFunc(resourceName),
-				ImportStateVerifyIdentifierAttribute: names.AttrARN,
-				ImportStateVerifyIgnore:              []string{names.AttrEnabled},
-			},
-		},
-	})
-}
-
 func TestAccLogsAnomalyDetector_update(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v cloudwatchlogs.GetLogAnomalyDetectorOutput
@@ -112,32 +76,6 @@ func TestAccLogsAnomalyDetector_update(t *testing.T) {
 	})
 }
 
-func TestAccLogsAnomalyDetector_disappears(t *testing.T) {
-	ctx := acctest.Context(t)
-	var v cloudwatchlogs.GetLogAnomalyDetectorOutput
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-	resourceName := "aws_cloudwatch_log_anomaly_detector.test"
-
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck: func() {
-			acctest.PreCheck(ctx, t)
-		},
-		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckAnomalyDetectorDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccAnomalyDetectorConfig_basic(rName),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckAnomalyDetectorExists(ctx, resourceName, &v),
-					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tflogs.ResourceAnomalyDetector, resourceName),
-				),
-				ExpectNonEmptyPlan: true,
-			},
-		},
-	})
-}
-
 func testAccCheckAnomalyDetectorDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).LogsClient(ctx)
@@ -196,23 +134,6 @@ func testAccAnomalyDetectorImportStateIDFunc(n string) resource.ImportStateIdFun
 	}
 }
 
-func testAccAnomalyDetectorConfig_basic(rName string) string {
-	return fmt.Sprintf(`
-resource "aws_cloudwatch_log_group" "test" {
-  count = 2
-  name  = "%[1]s-${count.index}"
-}
-
-resource "aws_cloudwatch_log_anomaly_detector" "test" {
-  detector_name           = %[1]q
-  log_group_arn_list      = [aws_cloudwatch_log_group.test[0].arn]
-  anomaly_visibility_time = 7
-  evaluation_frequency    = "TEN_MIN"
-  enabled                 = false
-}
-`, rName)
-}
-
 func testAccAnomalyDetectorConfig_update(rName string, ef string, enabled string, avt int64) string {
 	return fmt.Sprintf(`
 resource "aws_cloudwatch_log_group" "test" {
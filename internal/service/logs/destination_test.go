@@ -8,7 +8,6 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -20,63 +19,6 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-func TestAccLogsDestination_basic(t *testing.T) {
-	ctx := acctest.Context(t)
-	var destination types.Destination
-	resourceName := "aws_cloudwatch_log_destination.test"
-	streamResourceName := "aws_kinesis_stream.test.0"
-	roleResourceName := "aws_iam_role.test.0"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckDestinationDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccDestinationConfig_basic(rName),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckDestinationExists(ctx, resourceName, &destination),
-					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "logs", regexache.MustCompile(`destination:.+`)),
-					resource.TestCheckResourceAttrPair(resourceName, names.AttrRoleARN, roleResourceName, names.AttrARN),
-					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
-					resource.TestCheckResourceAttrPair(resourceName, names.AttrTargetARN, streamResourceName, names.AttrARN),
-				),
-			},
-			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
-			},
-		},
-	})
-}
-
-func TestAccLogsDestination_disappears(t *testing.T) {
-	ctx := acctest.Context(t)
-	var destination types.Destination
-	resourceName := "aws_cloudwatch_log_destination.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckDestinationDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccDestinationConfig_basic(rName),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckDestinationExists(ctx, resourceName, &destination),
-					acctest.CheckResourceDisappears(ctx, acctest.Provider, tflogs.ResourceDestination(), resourceName),
-				),
-				ExpectNonEmptyPlan: true,
-			},
-		},
-	})
-}
-
 func TestAccLogsDestination_update(t *testing.T) {
 	ctx := acctest.Context(t)
 	var destination types.Destination
@@ -270,18 +212,6 @@ resource "aws_iam_role_policy" "test" {
 `, rName, n)
 }
 
-func testAccDestinationConfig_basic(rName string) string {
-	return acctest.ConfigCompose(testAccDestinationConfig_base(rName, 1), fmt.Sprintf(`
-resource "aws_cloudwatch_log_destination" "test" {
-  name       = %[1]q
-  target_arn = aws_kinesis_stream.test[0].arn
-  role_arn   = aws_iam_role.test[0].arn
-
-  depends_on = [aws_iam_role_policy.test[0]]
-}
-`, rName))
-}
-
 func testAccDestinationConfig_update(rName string, idx int) string {
 	return acctest.ConfigCompose(testAccDestinationConfig_base(rName, 2), fmt.Sprintf(`
 resource "aws_cloudwatch_log_destination" "test" {
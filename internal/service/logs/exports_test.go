@@ -40,6 +40,8 @@ var (
 	FindSubscriptionFilterByTwoPartKey                     = findSubscriptionFilterByTwoPartKey
 
 	TrimLogGroupARNWildcardSuffix          = trimLogGroupARNWildcardSuffix
+	ValidLogFilterPattern                  = validLogFilterPattern
+	ValidLogsInsightsQuery                 = validLogsInsightsQuery
 	ValidLogGroupName                      = validLogGroupName
 	ValidLogGroupNamePrefix                = validLogGroupNamePrefix
 	ValidLogMetricFilterName               = validLogMetricFilterName
@@ -0,0 +1,236 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package filterpattern
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ellipsis matches any number of fields in a space-delimited pattern.
+const ellipsis = "..."
+
+type delimitedComparison struct {
+	field string
+	op    string
+	value value
+}
+
+// delimitedField is a field in a space-delimited pattern with an optional condition,
+// expressed as alternatives of comparisons that must all hold.
+type delimitedField struct {
+	condition [][]delimitedComparison
+	name      string
+}
+
+type delimitedMatcher struct {
+	fields []delimitedField
+}
+
+func (m *delimitedMatcher) matches(event string) bool {
+	return m.match(splitFields(event), 0, make(map[string]string))
+}
+
+// match aligns the pattern's fields, starting at the i-th, with the log event's fields.
+// An ellipsis can absorb any number of fields, so alignments are tried until one satisfies every condition.
+func (m *delimitedMatcher) match(fields []string, i int, values map[string]string) bool {
+	if i == len(m.fields) {
+		return len(fields) == 0 && m.evaluate(values)
+	}
+
+	if m.fields[i].name == ellipsis {
+		for n := 0; n <= len(fields); n++ {
+			if m.match(fields[n:], i+1, values) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(fields) == 0 {
+		return false
+	}
+
+	values[m.fields[i].name] = fields[0]
+	defer delete(values, m.fields[i].name)
+
+	return m.match(fields[1:], i+1, values)
+}
+
+func (m *delimitedMatcher) evaluate(values map[string]string) bool {
+	for _, f := range m.fields {
+		if len(f.condition) == 0 {
+			continue
+		}
+
+		var ok bool
+		for _, and := range f.condition {
+			ok = true
+			for _, c := range and {
+				if !c.value.compare(c.op, values[c.field]) {
+					ok = false
+					break
+				}
+			}
+			if ok {
+				break
+			}
+		}
+		if !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// splitFields splits a log event into space-delimited fields.
+// Text enclosed in double quotes or square brackets is a single field.
+func splitFields(event string) []string {
+	var fields []string
+
+	for i := 0; i < len(event); {
+		switch c := event[i]; c {
+		case ' ', '\t', '\r', '\n':
+			i++
+			continue
+		case '"', '[':
+			end := byte('"')
+			if c == '[' {
+				end = ']'
+			}
+			if j := strings.IndexByte(event[i+1:], end); j >= 0 {
+				fields = append(fields, event[i+1:i+1+j])
+				i += j + 2
+				continue
+			}
+		}
+
+		j := i
+		for j < len(event) && event[j] != ' ' && event[j] != '\t' && event[j] != '\r' && event[j] != '\n' {
+			j++
+		}
+		fields = append(fields, event[i:j])
+		i = j
+	}
+
+	return fields
+}
+
+func parseSpaceDelimitedPattern(s string) (matcher, int, error) {
+	tokens, err := tokenize(s, 0)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	p := &parser{tokens: tokens}
+	if err := p.expect(tokenPunctuation, "["); err != nil {
+		return nil, 0, err
+	}
+
+	m := &delimitedMatcher{}
+	names := make(map[string]bool)
+
+	for {
+		t := p.next()
+		if t.kind != tokenWord {
+			return nil, 0, fmt.Errorf("at offset %d: expected a field name or %q, got %s", t.offset, ellipsis, t)
+		}
+
+		if t.value == ellipsis {
+			m.fields = append(m.fields, delimitedField{name: ellipsis})
+		} else {
+			if !isFieldName(t.value) {
+				return nil, 0, fmt.Errorf("at offset %d: invalid field name %s", t.offset, t)
+			}
+			if names[t.value] {
+				return nil, 0, fmt.Errorf("at offset %d: duplicate field name %s", t.offset, t)
+			}
+			names[t.value] = true
+
+			f := delimitedField{name: t.value}
+			if op := p.peek(); op.kind == tokenOperator && isComparisonOperator(op.value) {
+				if f.condition, err = p.parseDelimitedCondition(t.value); err != nil {
+					return nil, 0, err
+				}
+			}
+			m.fields = append(m.fields, f)
+		}
+
+		if p.accept(tokenPunctuation, "]") {
+			break
+		}
+		if err := p.expect(tokenPunctuation, ","); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	if t := p.peek(); t.kind != tokenEOF {
+		return nil, 0, fmt.Errorf("at offset %d: unexpected %s after the closing bracket", t.offset, t)
+	}
+
+	// Conditions can refer to any field in the pattern.
+	for _, f := range m.fields {
+		for _, and := range f.condition {
+			for _, c := range and {
+				if !names[c.field] {
+					return nil, 0, fmt.Errorf("condition on field %q refers to undefined field %q", f.name, c.field)
+				}
+			}
+		}
+	}
+
+	return m, p.regexes, nil
+}
+
+// parseDelimitedCondition parses a field's condition, e.g. `status = 404` or `w1 = ERROR || w1 = WARN`.
+// The name of the field has already been consumed.
+func (p *parser) parseDelimitedCondition(field string) ([][]delimitedComparison, error) {
+	var or [][]delimitedComparison
+	var and []delimitedComparison
+
+	for {
+		op := p.next()
+		if op.kind != tokenOperator || !isComparisonOperator(op.value) {
+			return nil, fmt.Errorf("at offset %d: expected a comparison operator after %q, got %s", op.offset, field, op)
+		}
+
+		v, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		if err := checkOperator(op, v); err != nil {
+			return nil, err
+		}
+		and = append(and, delimitedComparison{field: field, op: op.value, value: v})
+
+		switch {
+		case p.accept(tokenOperator, "&&"):
+		case p.accept(tokenOperator, "||"):
+			or = append(or, and)
+			and = nil
+		default:
+			return append(or, and), nil
+		}
+
+		t := p.next()
+		if t.kind != tokenWord || !isFieldName(t.value) {
+			return nil, fmt.Errorf("at offset %d: expected a field name, got %s", t.offset, t)
+		}
+		field = t.value
+	}
+}
+
+func isFieldName(s string) bool {
+	for i, r := range s {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r == '_':
+		case i > 0 && r >= '0' && r <= '9':
+		default:
+			return false
+		}
+	}
+
+	return s != ""
+}
@@ -0,0 +1,273 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package filterpattern parses and evaluates CloudWatch Logs filter patterns, as used by
+// metric filters and subscription filters.
+//
+// Three forms are supported:
+//   - terms in unstructured log events, e.g. `ERROR -Exiting` or `?ERROR ?WARN`
+//   - JSON log events, e.g. `{ ($.statusCode = 4*) && ($.latency > 500) }`
+//   - space-delimited log events, e.g. `[ip, user, ..., status_code = 404, bytes]`
+//
+// Regular expressions, e.g. `%ERROR|WARN%`, can be used as terms and as values.
+//
+// See https://docs.aws.amazon.com/AmazonCloudWatch/latest/logs/FilterAndPatternSyntax.html.
+package filterpattern
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	// maxRegexes is the maximum number of regular expressions in a filter pattern.
+	maxRegexes = 2
+	// maxRegexLength is the maximum length of a regular expression, excluding the enclosing percent signs.
+	maxRegexLength = 512
+)
+
+// Kind is the form of a filter pattern.
+type Kind int
+
+const (
+	KindAll Kind = iota
+	KindTerms
+	KindJSON
+	KindSpaceDelimited
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindAll:
+		return "all"
+	case KindTerms:
+		return "terms"
+	case KindJSON:
+		return "JSON"
+	case KindSpaceDelimited:
+		return "space-delimited"
+	}
+
+	return fmt.Sprintf("Kind(%d)", int(k))
+}
+
+// Pattern is a parsed filter pattern.
+type Pattern struct {
+	kind    Kind
+	matcher matcher
+}
+
+type matcher interface {
+	matches(event string) bool
+}
+
+// Parse parses a filter pattern. An empty pattern matches every log event.
+func Parse(pattern string) (*Pattern, error) {
+	s := strings.TrimSpace(pattern)
+
+	if s == "" {
+		return &Pattern{kind: KindAll}, nil
+	}
+
+	var (
+		p = &Pattern{}
+		m matcher
+		n int
+		e error
+	)
+	switch s[0] {
+	case '{':
+		p.kind = KindJSON
+		m, n, e = parseJSONPattern(s)
+	case '[':
+		p.kind = KindSpaceDelimited
+		m, n, e = parseSpaceDelimitedPattern(s)
+	default:
+		p.kind = KindTerms
+		m, n, e = parseTerms(s)
+	}
+
+	if e != nil {
+		return nil, e
+	}
+	if n > maxRegexes {
+		return nil, fmt.Errorf("a filter pattern can contain at most %d regular expressions, got %d", maxRegexes, n)
+	}
+
+	p.matcher = m
+
+	return p, nil
+}
+
+// Validate checks that pattern is a valid filter pattern.
+func Validate(pattern string) error {
+	_, err := Parse(pattern)
+
+	return err
+}
+
+// Kind returns the form of the pattern.
+func (p *Pattern) Kind() Kind {
+	return p.kind
+}
+
+// Matches reports whether the log event matches the pattern.
+func (p *Pattern) Matches(event string) bool {
+	if p.matcher == nil {
+		return true
+	}
+
+	return p.matcher.matches(event)
+}
+
+// compileRegex compiles a regular expression from a filter pattern.
+// The service supports a subset of regular expression syntax; constructs
+// outside of that subset are rejected even if Go could compile them.
+func compileRegex(expr string, offset int) (*regexp.Regexp, error) {
+	if expr == "" {
+		return nil, fmt.Errorf("at offset %d: regular expression must not be empty", offset)
+	}
+	if len(expr) > maxRegexLength {
+		return nil, fmt.Errorf("at offset %d: regular expression must be at most %d characters", offset, maxRegexLength)
+	}
+	if strings.Contains(expr, "(?") {
+		return nil, fmt.Errorf("at offset %d: regular expression flags, non-capturing groups and lookarounds are not supported", offset)
+	}
+	for i := 0; i < len(expr)-1; i++ {
+		if expr[i] == '\\' {
+			if c := expr[i+1]; c >= '1' && c <= '9' {
+				return nil, fmt.Errorf("at offset %d: regular expression backreferences are not supported", offset)
+			}
+			i++
+		}
+	}
+
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, fmt.Errorf("at offset %d: invalid regular expression: %w", offset, err)
+	}
+
+	return re, nil
+}
+
+// wildcardMatch reports whether s matches pattern, in which '*' matches any sequence of characters.
+func wildcardMatch(pattern, s string) bool {
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == s
+	}
+
+	if !strings.HasPrefix(s, parts[0]) {
+		return false
+	}
+	s = s[len(parts[0]):]
+
+	last := parts[len(parts)-1]
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(s, part)
+		if i < 0 {
+			return false
+		}
+		s = s[i+len(part):]
+	}
+
+	return len(s) >= len(last) && strings.HasSuffix(s, last)
+}
+
+// value is the right-hand side of a comparison in a JSON or space-delimited pattern.
+type value struct {
+	number   *float64
+	regex    *regexp.Regexp
+	str      string
+	wildcard bool
+}
+
+func newValue(t token) (value, int, error) {
+	switch t.kind {
+	case tokenRegex:
+		re, err := compileRegex(t.value, t.offset)
+		if err != nil {
+			return value{}, 0, err
+		}
+		return value{regex: re}, 1, nil
+	case tokenString:
+		return value{str: t.value, wildcard: strings.Contains(t.value, "*")}, 0, nil
+	case tokenWord:
+		v := value{str: t.value, wildcard: strings.Contains(t.value, "*")}
+		if f, err := strconv.ParseFloat(t.value, 64); err == nil {
+			v.number = &f
+		}
+		return v, 0, nil
+	}
+
+	return value{}, 0, fmt.Errorf("at offset %d: expected a value, got %s", t.offset, t)
+}
+
+// compare compares a field from a log event with the value.
+// Numeric operators require both sides to be numbers.
+func (v value) compare(op string, field string) bool {
+	if v.regex != nil {
+		return v.regex.MatchString(field) == (op == "=")
+	}
+
+	if v.number != nil {
+		if f, err := strconv.ParseFloat(field, 64); err == nil {
+			return compareNumbers(op, f, *v.number)
+		}
+		if op != "=" && op != "!=" {
+			return false
+		}
+	}
+
+	var equal bool
+	if v.wildcard {
+		equal = wildcardMatch(v.str, field)
+	} else {
+		equal = v.str == field
+	}
+
+	return equal == (op == "=")
+}
+
+func compareNumbers(op string, a, b float64) bool {
+	switch op {
+	case "=":
+		return a == b
+	case "!=":
+		return a != b
+	case "<":
+		return a < b
+	case "<=":
+		return a <= b
+	case ">":
+		return a > b
+	case ">=":
+		return a >= b
+	}
+
+	return false
+}
+
+func isComparisonOperator(s string) bool {
+	switch s {
+	case "=", "!=", "<", "<=", ">", ">=":
+		return true
+	}
+
+	return false
+}
+
+// checkOperator checks that the operator can be used with the value.
+func checkOperator(op token, v value) error {
+	if op.value == "=" || op.value == "!=" {
+		return nil
+	}
+
+	if v.number == nil {
+		return fmt.Errorf("at offset %d: operator %q requires a numeric value", op.offset, op.value)
+	}
+
+	return nil
+}
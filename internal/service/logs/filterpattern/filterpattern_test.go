@@ -0,0 +1,276 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package filterpattern
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		pattern  string
+		wantKind Kind
+		wantErr  string
+	}{
+		"empty": {
+			pattern:  "  ",
+			wantKind: KindAll,
+		},
+		"term": {
+			pattern:  "ERROR",
+			wantKind: KindTerms,
+		},
+		"terms": {
+			pattern:  `ERROR -"Exiting now" %timed? out%`,
+			wantKind: KindTerms,
+		},
+		"optional terms": {
+			pattern:  `?ERROR ?"Failed to process"`,
+			wantKind: KindTerms,
+		},
+		"mixed optional terms": {
+			pattern: "?ERROR WARN",
+			wantErr: "terms prefixed with ? cannot be combined with other terms",
+		},
+		"dangling prefix": {
+			pattern: "ERROR - WARN",
+			wantErr: `at offset 6: "-" must be followed by a term`,
+		},
+		"unterminated quote": {
+			pattern: `"ERROR`,
+			wantErr: "unterminated quoted string",
+		},
+		"unquoted percent": {
+			pattern: "100%",
+			wantErr: "must be enclosed in double quotes",
+		},
+		"too many regexes": {
+			pattern: "%a% %b% %c%",
+			wantErr: "at most 2 regular expressions, got 3",
+		},
+		"invalid regex": {
+			pattern: "%[a-%",
+			wantErr: "invalid regular expression",
+		},
+		"regex lookahead": {
+			pattern: "%a(?=b)%",
+			wantErr: "lookarounds are not supported",
+		},
+		"regex backreference": {
+			pattern: `%(a)\1%`,
+			wantErr: "backreferences are not supported",
+		},
+		"json": {
+			pattern:  `{ ($.eventType = "UpdateTrail") || ($.latency > 500 && $.user.name = admin*) }`,
+			wantKind: KindJSON,
+		},
+		"json checks": {
+			pattern:  `{ $.a IS TRUE && $.b IS NULL && $.c NOT EXISTS && $.d[0].e = %^x% }`,
+			wantKind: KindJSON,
+		},
+		"json missing brace": {
+			pattern: `{ $.a = 1`,
+			wantErr: `expected "}", got end of pattern`,
+		},
+		"json trailing": {
+			pattern: `{ $.a = 1 } x`,
+			wantErr: `unexpected "x" after the closing brace`,
+		},
+		"json no selector": {
+			pattern: `{ a = 1 }`,
+			wantErr: "expected a property selector such as $.name",
+		},
+		"json invalid selector": {
+			pattern: `{ $a = 1 }`,
+			wantErr: "expected . or [ after $",
+		},
+		"json invalid index": {
+			pattern: `{ $.a[x] = 1 }`,
+			wantErr: "array index must be a non-negative integer",
+		},
+		"json numeric operator with string": {
+			pattern: `{ $.a > "x" }`,
+			wantErr: `operator ">" requires a numeric value`,
+		},
+		"json invalid IS": {
+			pattern: `{ $.a IS EMPTY }`,
+			wantErr: "expected TRUE, FALSE or NULL after IS",
+		},
+		"json missing operator": {
+			pattern: `{ $.a 1 }`,
+			wantErr: "expected a comparison operator, IS or NOT EXISTS",
+		},
+		"json unbalanced parentheses": {
+			pattern: `{ ($.a = 1 }`,
+			wantErr: `expected ")"`,
+		},
+		"space-delimited": {
+			pattern:  `[ip, user, ..., status_code = 4* || status_code = 5*, bytes > 1000]`,
+			wantKind: KindSpaceDelimited,
+		},
+		"space-delimited single field": {
+			pattern:  "[TEST] ",
+			wantKind: KindSpaceDelimited,
+		},
+		"space-delimited empty": {
+			pattern: "[]",
+			wantErr: `expected a field name or "...", got "]"`,
+		},
+		"space-delimited duplicate field": {
+			pattern: "[a, b, a]",
+			wantErr: `duplicate field name "a"`,
+		},
+		"space-delimited invalid field name": {
+			pattern: "[1a]",
+			wantErr: `invalid field name "1a"`,
+		},
+		"space-delimited undefined field": {
+			pattern: "[a = 1 || b = 2]",
+			wantErr: `condition on field "a" refers to undefined field "b"`,
+		},
+		"space-delimited missing comma": {
+			pattern: "[a b]",
+			wantErr: `expected ",", got "b"`,
+		},
+		"space-delimited missing value": {
+			pattern: "[a =]",
+			wantErr: `expected a value, got "]"`,
+		},
+		"unexpected character": {
+			pattern: "[a ! 1]",
+			wantErr: `unexpected character '!'`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			p, err := Parse(testCase.pattern)
+
+			if testCase.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", testCase.wantErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := p.Kind(); got != testCase.wantKind {
+				t.Errorf("Kind() = %s, want %s", got, testCase.wantKind)
+			}
+		})
+	}
+}
+
+func TestPatternMatches(t *testing.T) {
+	t.Parallel()
+
+	const (
+		accessLog = `127.0.0.1 - frank [10/Oct/2000:13:25:15 -0700] "GET /apache_pb.gif HTTP/1.0" 404 1534`
+		jsonLog   = `{"eventType": "UpdateTrail", "latency": 750, "user": {"name": "admin-1", "groups": ["ops", "dev"]}, "ok": true, "error": null}`
+		textLog   = `2024-01-01T00:00:00Z ERROR Failed to process request: timed out`
+	)
+
+	testCases := map[string]struct {
+		pattern string
+		event   string
+		want    bool
+	}{
+		"empty":                  {pattern: "", event: textLog, want: true},
+		"term":                   {pattern: "ERROR", event: textLog, want: true},
+		"term case sensitive":    {pattern: "error", event: textLog, want: false},
+		"all terms":              {pattern: "ERROR request", event: textLog, want: true},
+		"all terms mismatch":     {pattern: "ERROR response", event: textLog, want: false},
+		"quoted term":            {pattern: `"Failed to process"`, event: textLog, want: true},
+		"optional terms":         {pattern: "?WARN ?ERROR", event: textLog, want: true},
+		"optional terms none":    {pattern: "?WARN ?DEBUG", event: textLog, want: false},
+		"excluded term":          {pattern: "ERROR -timed", event: textLog, want: false},
+		"excluded quoted term":   {pattern: `ERROR -"retrying"`, event: textLog, want: true},
+		"regex term":             {pattern: "%ERROR|WARN%", event: textLog, want: true},
+		"regex term mismatch":    {pattern: `%^\d+ ERROR%`, event: textLog, want: false},
+		"json equals":            {pattern: `{ $.eventType = "UpdateTrail" }`, event: jsonLog, want: true},
+		"json unquoted":          {pattern: `{ $.eventType = UpdateTrail }`, event: jsonLog, want: true},
+		"json not equals":        {pattern: `{ $.eventType != "UpdateTrail" }`, event: jsonLog, want: false},
+		"json wildcard":          {pattern: `{ $.user.name = "admin*" }`, event: jsonLog, want: true},
+		"json numeric":           {pattern: `{ $.latency > 500 }`, event: jsonLog, want: true},
+		"json numeric mismatch":  {pattern: `{ $.latency <= 500 }`, event: jsonLog, want: false},
+		"json numeric equals":    {pattern: `{ $.latency = 750.0 }`, event: jsonLog, want: true},
+		"json array index":       {pattern: `{ $.user.groups[1] = "dev" }`, event: jsonLog, want: true},
+		"json regex":             {pattern: `{ $.user.name = %^admin-[0-9]+$% }`, event: jsonLog, want: true},
+		"json is true":           {pattern: `{ $.ok IS TRUE }`, event: jsonLog, want: true},
+		"json is false":          {pattern: `{ $.ok IS FALSE }`, event: jsonLog, want: false},
+		"json is null":           {pattern: `{ $.error IS NULL }`, event: jsonLog, want: true},
+		"json not exists":        {pattern: `{ $.missing NOT EXISTS }`, event: jsonLog, want: true},
+		"json exists":            {pattern: `{ $.eventType NOT EXISTS }`, event: jsonLog, want: false},
+		"json missing property":  {pattern: `{ $.missing != "x" }`, event: jsonLog, want: false},
+		"json object value":      {pattern: `{ $.user = "x" }`, event: jsonLog, want: false},
+		"json and":               {pattern: `{ $.latency > 500 && $.eventType = "DeleteTrail" }`, event: jsonLog, want: false},
+		"json or":                {pattern: `{ $.latency > 1000 || $.eventType = "UpdateTrail" }`, event: jsonLog, want: true},
+		"json precedence":        {pattern: `{ $.ok IS FALSE && $.latency > 1 || $.latency < 1000 }`, event: jsonLog, want: true},
+		"json parentheses":       {pattern: `{ $.ok IS FALSE && ($.latency > 1 || $.latency < 1000) }`, event: jsonLog, want: false},
+		"json not json":          {pattern: `{ $.eventType = "UpdateTrail" }`, event: textLog, want: false},
+		"delimited":              {pattern: "[ip, identity, user, timestamp, request, status, bytes]", event: accessLog, want: true},
+		"delimited field count":  {pattern: "[ip, identity, user, timestamp, request, status]", event: accessLog, want: false},
+		"delimited condition":    {pattern: "[ip, identity, user, timestamp, request, status = 404, bytes > 1000]", event: accessLog, want: true},
+		"delimited wildcard":     {pattern: "[ip, identity, user, timestamp, request = *.gif*, status = 4*, bytes]", event: accessLog, want: true},
+		"delimited mismatch":     {pattern: "[ip, identity, user, timestamp, request, status = 200, bytes]", event: accessLog, want: false},
+		"delimited or":           {pattern: "[ip, identity, user, timestamp, request, status = 200 || status = 404, bytes]", event: accessLog, want: true},
+		"delimited and":          {pattern: "[ip, identity, user, timestamp, request, status >= 400 && status < 500, bytes]", event: accessLog, want: true},
+		"delimited ellipsis":     {pattern: "[ip, ..., status = 404, bytes]", event: accessLog, want: true},
+		"delimited ellipsis end": {pattern: "[ip = 127.*, ...]", event: accessLog, want: true},
+		"delimited regex":        {pattern: `[..., request = %^GET %, status, bytes]`, event: accessLog, want: true},
+		"delimited bracketed":    {pattern: "[ip, identity, user = frank, timestamp = 10/Oct/2000*, ...]", event: accessLog, want: true},
+		"delimited other field":  {pattern: "[ip, identity, user, timestamp, request, status, bytes = 1534 && status = 404]", event: accessLog, want: true},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			p, err := Parse(testCase.pattern)
+			if err != nil {
+				t.Fatalf("parsing pattern: %s", err)
+			}
+
+			if got := p.Matches(testCase.event); got != testCase.want {
+				t.Errorf("Matches() = %t, want %t", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestWildcardMatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		pattern, s string
+		want       bool
+	}{
+		"exact":          {pattern: "abc", s: "abc", want: true},
+		"exact mismatch": {pattern: "abc", s: "abcd", want: false},
+		"prefix":         {pattern: "ab*", s: "abcd", want: true},
+		"suffix":         {pattern: "*cd", s: "abcd", want: true},
+		"infix":          {pattern: "a*d", s: "abcd", want: true},
+		"multiple":       {pattern: "*b*d*", s: "abcd", want: true},
+		"overlap":        {pattern: "ab*bc", s: "abc", want: false},
+		"only star":      {pattern: "*", s: "", want: true},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := wildcardMatch(testCase.pattern, testCase.s); got != testCase.want {
+				t.Errorf("wildcardMatch(%q, %q) = %t, want %t", testCase.pattern, testCase.s, got, testCase.want)
+			}
+		})
+	}
+}
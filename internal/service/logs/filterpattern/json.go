@@ -0,0 +1,298 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package filterpattern
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// jsonExpression is a boolean expression over the properties of a JSON log event.
+type jsonExpression interface {
+	eval(event any) bool
+}
+
+type jsonAnd []jsonExpression
+
+func (e jsonAnd) eval(event any) bool {
+	for _, v := range e {
+		if !v.eval(event) {
+			return false
+		}
+	}
+	return true
+}
+
+type jsonOr []jsonExpression
+
+func (e jsonOr) eval(event any) bool {
+	for _, v := range e {
+		if v.eval(event) {
+			return true
+		}
+	}
+	return false
+}
+
+// selectorSegment is a property name or, if index is non-negative, an array index.
+type selectorSegment struct {
+	index int
+	name  string
+}
+
+type selector []selectorSegment
+
+func (s selector) resolve(event any) (any, bool) {
+	v := event
+
+	for _, segment := range s {
+		if segment.index >= 0 {
+			a, ok := v.([]any)
+			if !ok || segment.index >= len(a) {
+				return nil, false
+			}
+			v = a[segment.index]
+			continue
+		}
+
+		m, ok := v.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		v, ok = m[segment.name]
+		if !ok {
+			return nil, false
+		}
+	}
+
+	return v, true
+}
+
+type jsonComparison struct {
+	op       string
+	selector selector
+	value    value
+}
+
+func (e *jsonComparison) eval(event any) bool {
+	v, ok := e.selector.resolve(event)
+	if !ok {
+		return false
+	}
+
+	var field string
+	switch v := v.(type) {
+	case string:
+		field = v
+	case float64:
+		field = strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		field = strconv.FormatBool(v)
+	default:
+		// Objects, arrays and null can't be compared with a value.
+		return false
+	}
+
+	return e.value.compare(e.op, field)
+}
+
+// jsonCheck is an IS TRUE, IS FALSE, IS NULL or NOT EXISTS check.
+type jsonCheck struct {
+	check    string
+	selector selector
+}
+
+func (e *jsonCheck) eval(event any) bool {
+	v, ok := e.selector.resolve(event)
+
+	switch e.check {
+	case "EXISTS":
+		return !ok
+	case "NULL":
+		return ok && v == nil
+	case "TRUE":
+		return ok && v == true
+	case "FALSE":
+		return ok && v == false
+	}
+
+	return false
+}
+
+type jsonMatcher struct {
+	expression jsonExpression
+}
+
+func (m *jsonMatcher) matches(event string) bool {
+	var v any
+	if err := json.Unmarshal([]byte(event), &v); err != nil {
+		return false
+	}
+
+	return m.expression.eval(v)
+}
+
+func parseJSONPattern(s string) (matcher, int, error) {
+	tokens, err := tokenize(s, 0)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	p := &parser{tokens: tokens}
+	if err := p.expect(tokenPunctuation, "{"); err != nil {
+		return nil, 0, err
+	}
+
+	expression, err := p.parseJSONOr()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if err := p.expect(tokenPunctuation, "}"); err != nil {
+		return nil, 0, err
+	}
+	if t := p.peek(); t.kind != tokenEOF {
+		return nil, 0, fmt.Errorf("at offset %d: unexpected %s after the closing brace", t.offset, t)
+	}
+
+	return &jsonMatcher{expression: expression}, p.regexes, nil
+}
+
+func (p *parser) parseJSONOr() (jsonExpression, error) {
+	var or jsonOr
+
+	for {
+		and, err := p.parseJSONAnd()
+		if err != nil {
+			return nil, err
+		}
+		or = append(or, and)
+
+		if !p.accept(tokenOperator, "||") {
+			break
+		}
+	}
+
+	if len(or) == 1 {
+		return or[0], nil
+	}
+	return or, nil
+}
+
+func (p *parser) parseJSONAnd() (jsonExpression, error) {
+	var and jsonAnd
+
+	for {
+		e, err := p.parseJSONPrimary()
+		if err != nil {
+			return nil, err
+		}
+		and = append(and, e)
+
+		if !p.accept(tokenOperator, "&&") {
+			break
+		}
+	}
+
+	if len(and) == 1 {
+		return and[0], nil
+	}
+	return and, nil
+}
+
+func (p *parser) parseJSONPrimary() (jsonExpression, error) {
+	if p.accept(tokenPunctuation, "(") {
+		e, err := p.parseJSONOr()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(tokenPunctuation, ")"); err != nil {
+			return nil, err
+		}
+		return e, nil
+	}
+
+	t := p.next()
+	if t.kind != tokenSelector {
+		return nil, fmt.Errorf("at offset %d: expected a property selector such as $.name, got %s", t.offset, t)
+	}
+
+	sel, err := parseSelector(t)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.accept(tokenWord, "IS") {
+		check := p.next()
+		switch check.value {
+		case "TRUE", "FALSE", "NULL":
+			return &jsonCheck{check: check.value, selector: sel}, nil
+		}
+		return nil, fmt.Errorf("at offset %d: expected TRUE, FALSE or NULL after IS, got %s", check.offset, check)
+	}
+
+	if p.accept(tokenWord, "NOT") {
+		if err := p.expect(tokenWord, "EXISTS"); err != nil {
+			return nil, err
+		}
+		return &jsonCheck{check: "EXISTS", selector: sel}, nil
+	}
+
+	op := p.next()
+	if op.kind != tokenOperator || !isComparisonOperator(op.value) {
+		return nil, fmt.Errorf("at offset %d: expected a comparison operator, IS or NOT EXISTS after %s, got %s", op.offset, t, op)
+	}
+
+	v, err := p.parseValue()
+	if err != nil {
+		return nil, err
+	}
+	if err := checkOperator(op, v); err != nil {
+		return nil, err
+	}
+
+	return &jsonComparison{op: op.value, selector: sel, value: v}, nil
+}
+
+// parseSelector parses a property selector such as $.a.b[0].c.
+func parseSelector(t token) (selector, error) {
+	s, ok := strings.CutPrefix(t.value, "$")
+	if !ok || s == "" {
+		return nil, fmt.Errorf("at offset %d: invalid property selector %s", t.offset, t)
+	}
+
+	var sel selector
+	for s != "" {
+		switch s[0] {
+		case '.':
+			s = s[1:]
+			n := strings.IndexAny(s, ".[")
+			if n < 0 {
+				n = len(s)
+			}
+			if n == 0 {
+				return nil, fmt.Errorf("at offset %d: invalid property selector %s: empty property name", t.offset, t)
+			}
+			sel = append(sel, selectorSegment{index: -1, name: s[:n]})
+			s = s[n:]
+		case '[':
+			n := strings.IndexByte(s, ']')
+			if n < 0 {
+				return nil, fmt.Errorf("at offset %d: invalid property selector %s: missing ]", t.offset, t)
+			}
+			index, err := strconv.Atoi(s[1:n])
+			if err != nil || index < 0 {
+				return nil, fmt.Errorf("at offset %d: invalid property selector %s: array index must be a non-negative integer", t.offset, t)
+			}
+			sel = append(sel, selectorSegment{index: index})
+			s = s[n+1:]
+		default:
+			return nil, fmt.Errorf("at offset %d: invalid property selector %s: expected . or [ after $", t.offset, t)
+		}
+	}
+
+	return sel, nil
+}
@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package filterpattern
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenOperator
+	tokenPunctuation
+	tokenRegex
+	tokenSelector
+	tokenString
+	tokenWord
+)
+
+type token struct {
+	kind   tokenKind
+	offset int
+	value  string
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokenEOF:
+		return "end of pattern"
+	case tokenRegex:
+		return "%" + t.value + "%"
+	}
+
+	return strconv.Quote(t.value)
+}
+
+// wordTerminators are the characters that end an unquoted word in JSON and space-delimited patterns.
+const wordTerminators = " \t\r\n(){}[],=!<>&|\"%"
+
+// tokenize splits the body of a JSON or space-delimited pattern into tokens.
+func tokenize(s string, base int) ([]token, error) {
+	var tokens []token
+
+	for i := 0; i < len(s); {
+		c := s[i]
+		start := i
+
+		switch {
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+			i++
+			continue
+		case c == '"':
+			str, n, err := readQuoted(s[i:], base+i)
+			if err != nil {
+				return nil, err
+			}
+			i += n
+			tokens = append(tokens, token{kind: tokenString, offset: base + start, value: str})
+		case c == '%':
+			expr, n, err := readRegex(s[i:], base+i)
+			if err != nil {
+				return nil, err
+			}
+			i += n
+			tokens = append(tokens, token{kind: tokenRegex, offset: base + start, value: expr})
+		case strings.HasPrefix(s[i:], "!=") || strings.HasPrefix(s[i:], "<=") || strings.HasPrefix(s[i:], ">=") ||
+			strings.HasPrefix(s[i:], "&&") || strings.HasPrefix(s[i:], "||"):
+			i += 2
+			tokens = append(tokens, token{kind: tokenOperator, offset: base + start, value: s[start:i]})
+		case c == '=' || c == '<' || c == '>':
+			i++
+			tokens = append(tokens, token{kind: tokenOperator, offset: base + start, value: s[start:i]})
+		case strings.IndexByte("(){}[],", c) >= 0:
+			i++
+			tokens = append(tokens, token{kind: tokenPunctuation, offset: base + start, value: s[start:i]})
+		case c == '$':
+			// Selectors can contain array indexes, e.g. $.a[0].b.
+			for i < len(s) && (strings.IndexByte(wordTerminators, s[i]) < 0 || s[i] == '[' || s[i] == ']') {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenSelector, offset: base + start, value: s[start:i]})
+		case c == '!' || c == '&' || c == '|':
+			return nil, fmt.Errorf("at offset %d: unexpected character %q", base+i, c)
+		default:
+			for i < len(s) && strings.IndexByte(wordTerminators, s[i]) < 0 {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenWord, offset: base + start, value: s[start:i]})
+		}
+	}
+
+	return append(tokens, token{kind: tokenEOF, offset: base + len(s)}), nil
+}
+
+// readQuoted reads a double-quoted string at the start of s and returns its unescaped value and length.
+func readQuoted(s string, offset int) (string, int, error) {
+	var sb strings.Builder
+
+	for i := 1; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '\\':
+			if i+1 < len(s) {
+				i++
+				sb.WriteByte(s[i])
+			}
+		case '"':
+			return sb.String(), i + 1, nil
+		default:
+			sb.WriteByte(c)
+		}
+	}
+
+	return "", 0, fmt.Errorf("at offset %d: unterminated quoted string", offset)
+}
+
+// readRegex reads a regular expression enclosed in percent signs at the start of s and returns it and its length.
+// A percent sign in the regular expression is escaped with a backslash.
+func readRegex(s string, offset int) (string, int, error) {
+	var sb strings.Builder
+
+	for i := 1; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '\\' && i+1 < len(s) && s[i+1] == '%':
+			i++
+			sb.WriteByte('%')
+		case c == '\\' && i+1 < len(s):
+			sb.WriteByte(c)
+			i++
+			sb.WriteByte(s[i])
+		case c == '%':
+			return sb.String(), i + 1, nil
+		default:
+			sb.WriteByte(c)
+		}
+	}
+
+	return "", 0, fmt.Errorf("at offset %d: unterminated regular expression", offset)
+}
+
+type parser struct {
+	pos     int
+	regexes int
+	tokens  []token
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) accept(kind tokenKind, value string) bool {
+	if t := p.peek(); t.kind == kind && t.value == value {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) expect(kind tokenKind, value string) error {
+	if !p.accept(kind, value) {
+		t := p.peek()
+		return fmt.Errorf("at offset %d: expected %q, got %s", t.offset, value, t)
+	}
+	return nil
+}
+
+func (p *parser) parseValue() (value, error) {
+	v, n, err := newValue(p.next())
+	p.regexes += n
+
+	return v, err
+}
@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package filterpattern
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+type termMode int
+
+const (
+	termRequired termMode = iota
+	termOptional          // ?term
+	termExcluded          // -term
+)
+
+type term struct {
+	mode  termMode
+	regex *regexp.Regexp
+	text  string
+}
+
+func (t term) matches(event string) bool {
+	if t.regex != nil {
+		return t.regex.MatchString(event)
+	}
+
+	return strings.Contains(event, t.text)
+}
+
+// termsMatcher matches unstructured log events.
+// Either every required term must match and no excluded term may match, or, for ?terms, any term must match.
+type termsMatcher struct {
+	terms []term
+}
+
+func (m *termsMatcher) matches(event string) bool {
+	var optional, matchedOptional bool
+
+	for _, t := range m.terms {
+		switch t.mode {
+		case termRequired:
+			if !t.matches(event) {
+				return false
+			}
+		case termExcluded:
+			if t.matches(event) {
+				return false
+			}
+		case termOptional:
+			optional = true
+			matchedOptional = matchedOptional || t.matches(event)
+		}
+	}
+
+	return !optional || matchedOptional
+}
+
+func parseTerms(s string) (matcher, int, error) {
+	m := &termsMatcher{}
+	var regexes int
+
+	for i := 0; i < len(s); {
+		if c := s[i]; c == ' ' || c == '\t' || c == '\r' || c == '\n' {
+			i++
+			continue
+		}
+
+		start := i
+		t := term{}
+
+		switch s[i] {
+		case '?':
+			t.mode = termOptional
+			i++
+		case '-':
+			t.mode = termExcluded
+			i++
+		}
+
+		if i >= len(s) || s[i] == ' ' {
+			return nil, 0, fmt.Errorf("at offset %d: %q must be followed by a term", start, s[start:start+1])
+		}
+
+		switch s[i] {
+		case '"':
+			text, n, err := readQuoted(s[i:], i)
+			if err != nil {
+				return nil, 0, err
+			}
+			if text == "" {
+				return nil, 0, fmt.Errorf("at offset %d: term must not be empty", i)
+			}
+			t.text = text
+			i += n
+		case '%':
+			expr, n, err := readRegex(s[i:], i)
+			if err != nil {
+				return nil, 0, err
+			}
+			re, err := compileRegex(expr, i)
+			if err != nil {
+				return nil, 0, err
+			}
+			t.regex = re
+			regexes++
+			i += n
+		default:
+			j := i
+			for j < len(s) && s[j] != ' ' && s[j] != '\t' && s[j] != '\r' && s[j] != '\n' {
+				j++
+			}
+			if strings.ContainsAny(s[i:j], `"%`) {
+				return nil, 0, fmt.Errorf("at offset %d: term %q containing quotes or percent signs must be enclosed in double quotes", i, s[i:j])
+			}
+			t.text = s[i:j]
+			i = j
+		}
+
+		m.terms = append(m.terms, t)
+	}
+
+	var optional, other bool
+	for _, t := range m.terms {
+		if t.mode == termOptional {
+			optional = true
+		} else {
+			other = true
+		}
+	}
+	if optional && other {
+		return nil, 0, errors.New("terms prefixed with ? cannot be combined with other terms")
+	}
+
+	return m, regexes, nil
+}
@@ -19,46 +19,6 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-func TestAccLogsGroup_basic(t *testing.T) {
-	ctx := acctest.Context(t)
-	var v types.LogGroup
-	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
-	resourceName := "aws_cloudwatch_log_group.test"
-
-	expectedLogGroupClass := "STANDARD"
-	if acctest.Partition() != endpoints.AwsPartitionID {
-		expectedLogGroupClass = ""
-	}
-
-	acctest.ParallelTest(ctx, t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckLogGroupDestroy(ctx, t),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccGroupConfig_basic(rName),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckLogGroupExists(ctx, t, resourceName, &v),
-					acctest.CheckResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "logs", fmt.Sprintf("log-group:%s", rName)),
-					resource.TestCheckResourceAttr(resourceName, names.AttrKMSKeyID, ""),
-					resource.TestCheckResourceAttr(resourceName, "log_group_class", expectedLogGroupClass),
-					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
-					resource.TestCheckResourceAttr(resourceName, names.AttrNamePrefix, ""),
-					resource.TestCheckResourceAttr(resourceName, "retention_in_days", "0"),
-					resource.TestCheckResourceAttr(resourceName, names.AttrSkipDestroy, acctest.CtFalse),
-					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
-				),
-			},
-			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
-			},
-		},
-	})
-}
-
 func TestAccLogsGroup_nameGenerate(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v types.LogGroup
@@ -115,30 +75,6 @@ func TestAccLogsGroup_namePrefix(t *testing.T) {
 	})
 }
 
-func TestAccLogsGroup_disappears(t *testing.T) {
-	ctx := acctest.Context(t)
-	var v types.LogGroup
-	rName := acctest.RandomWithPrefix(t, acctest.ResourcePrefix)
-	resourceName := "aws_cloudwatch_log_group.test"
-
-	acctest.ParallelTest(ctx, t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.LogsServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckLogGroupDestroy(ctx, t),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccGroupConfig_basic(rName),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckLogGroupExists(ctx, t, resourceName, &v),
-					acctest.CheckResourceDisappears(ctx, acctest.Provider, tflogs.ResourceGroup(), resourceName),
-				),
-				ExpectNonEmptyPlan: true,
-			},
-		},
-	})
-}
-
 func TestAccLogsGroup_kmsKey(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v types.LogGroup
@@ -0,0 +1,219 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package insightsquery
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	// maxLimit is the maximum number of log events that a query can return.
+	maxLimit = 10000
+)
+
+type commandParser func(p *parser, name token) error
+
+// commands are the Logs Insights commands, keyed by lowercase name.
+// Commands whose parser is nil are accepted without validating their arguments.
+var commands = map[string]commandParser{
+	"anomaly":     nil,
+	"dedup":       (*parser).parseDedup,
+	"diff":        nil,
+	"display":     (*parser).parseFields,
+	"fields":      (*parser).parseFields,
+	"filter":      (*parser).parseFilter,
+	"filterindex": nil,
+	"limit":       (*parser).parseLimit,
+	"lookup":      nil,
+	"parse":       (*parser).parseParse,
+	"pattern":     nil,
+	"sort":        (*parser).parseSort,
+	"source":      nil,
+	"stats":       (*parser).parseStats,
+	"unmask":      nil,
+	"unnest":      nil,
+}
+
+// parseCommand parses a command and returns its lowercase name.
+func (p *parser) parseCommand() (string, error) {
+	t := p.next()
+	if t.kind != tokenIdentifier {
+		return "", unexpected(t, "a command")
+	}
+
+	name := lowerValue(t)
+	parse, ok := commands[name]
+	if !ok {
+		return "", fmt.Errorf("at offset %d: unknown command %q", t.offset, t.value)
+	}
+
+	if parse == nil {
+		for !p.atCommandEnd() {
+			p.next()
+		}
+		return name, nil
+	}
+
+	if p.atCommandEnd() {
+		return "", fmt.Errorf("at offset %d: %s command requires arguments", t.offset, name)
+	}
+
+	if err := parse(p, t); err != nil {
+		return "", err
+	}
+
+	return name, p.expectCommandEnd()
+}
+
+// parseFields parses "fields expr [as alias], ...".
+func (p *parser) parseFields(token) error {
+	for {
+		if _, err := p.parseExpr(); err != nil {
+			return err
+		}
+		if err := p.parseAlias(); err != nil {
+			return err
+		}
+
+		if !p.accept(tokenPunctuation, ",") {
+			return nil
+		}
+	}
+}
+
+// parseFilter parses "filter expr".
+func (p *parser) parseFilter(token) error {
+	_, err := p.parseExpr()
+
+	return err
+}
+
+// parseStats parses "stats aggregate [as alias], ... [by expr [as alias], ...]".
+func (p *parser) parseStats(token) error {
+	defer func() { p.context = aggregateNotAllowed }()
+
+	p.context = aggregateAllowed
+	for {
+		t := p.peek()
+		e, err := p.parseExpr()
+		if err != nil {
+			return err
+		}
+		if !e.aggregate {
+			return fmt.Errorf("at offset %d: stats expression must use an aggregate function such as count() or avg()", t.offset)
+		}
+		if err := p.parseAlias(); err != nil {
+			return err
+		}
+
+		if !p.accept(tokenPunctuation, ",") {
+			break
+		}
+	}
+
+	if !p.accept(tokenIdentifier, "by") {
+		return nil
+	}
+
+	p.context = aggregateInBy
+	for {
+		if _, err := p.parseExpr(); err != nil {
+			return err
+		}
+		if err := p.parseAlias(); err != nil {
+			return err
+		}
+
+		if !p.accept(tokenPunctuation, ",") {
+			return nil
+		}
+	}
+}
+
+// parseSort parses "sort expr [asc|desc], ...".
+func (p *parser) parseSort(token) error {
+	for {
+		if _, err := p.parseExpr(); err != nil {
+			return err
+		}
+		if !p.accept(tokenIdentifier, "asc") {
+			p.accept(tokenIdentifier, "desc")
+		}
+
+		if !p.accept(tokenPunctuation, ",") {
+			return nil
+		}
+	}
+}
+
+// parseLimit parses "limit n".
+func (p *parser) parseLimit(token) error {
+	t := p.next()
+	if n, err := strconv.Atoi(t.value); t.kind != tokenNumber || err != nil || n < 1 || n > maxLimit {
+		return fmt.Errorf("at offset %d: limit must be an integer between 1 and %d, got %s", t.offset, maxLimit, t)
+	}
+
+	return nil
+}
+
+// parseDedup parses "dedup field, ...".
+func (p *parser) parseDedup(token) error {
+	for {
+		if _, err := p.parseField(); err != nil {
+			return err
+		}
+
+		if !p.accept(tokenPunctuation, ",") {
+			return nil
+		}
+	}
+}
+
+// namedGroupRegex matches a named capturing group in a regular expression.
+var namedGroupRegex = regexp.MustCompile(`\(\?P?<[A-Za-z_][A-Za-z0-9_]*>`)
+
+// parseParse parses "parse [field] 'glob' as name, ..." or "parse [field] /regex/".
+// Each * in a glob extracts a field, and a regular expression extracts a field for each named capturing group.
+func (p *parser) parseParse(token) error {
+	if t := p.peek(); t.kind == tokenIdentifier {
+		if _, err := p.parseField(); err != nil {
+			return err
+		}
+	}
+
+	t := p.next()
+	switch t.kind {
+	case tokenString:
+		if _, err := p.expect(tokenIdentifier, "as"); err != nil {
+			return err
+		}
+
+		var names int
+		for {
+			if _, err := p.parseField(); err != nil {
+				return err
+			}
+			names++
+
+			if !p.accept(tokenPunctuation, ",") {
+				break
+			}
+		}
+
+		if wildcards := strings.Count(t.value, "*"); wildcards != names {
+			return fmt.Errorf("at offset %d: parse pattern %s has %d wildcards but %d field names", t.offset, t, wildcards, names)
+		}
+	case tokenRegex:
+		if !namedGroupRegex.MatchString(t.value) {
+			return fmt.Errorf("at offset %d: parse regular expression %s must contain a named capturing group such as (?<name>...)", t.offset, t)
+		}
+	default:
+		return unexpected(t, "a quoted pattern or a regular expression")
+	}
+
+	return nil
+}
@@ -0,0 +1,308 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package insightsquery
+
+import (
+	"fmt"
+)
+
+// exprInfo describes a parsed expression.
+type exprInfo struct {
+	// aggregate is whether the expression contains an aggregate function.
+	aggregate bool
+}
+
+func (e exprInfo) merge(other exprInfo) exprInfo {
+	return exprInfo{aggregate: e.aggregate || other.aggregate}
+}
+
+// parseExpr parses an expression. From lowest to highest precedence, the operators are
+//
+//	or
+//	and
+//	not
+//	= != < <= > >= =~ like in
+//	+ -
+//	* / %
+//	^
+//	unary -
+func (p *parser) parseExpr() (exprInfo, error) {
+	return p.parseOr()
+}
+
+func (p *parser) parseOr() (exprInfo, error) {
+	e, err := p.parseAnd()
+	if err != nil {
+		return e, err
+	}
+
+	for p.accept(tokenIdentifier, "or") {
+		rhs, err := p.parseAnd()
+		if err != nil {
+			return e, err
+		}
+		e = e.merge(rhs)
+	}
+
+	return e, nil
+}
+
+func (p *parser) parseAnd() (exprInfo, error) {
+	e, err := p.parseNot()
+	if err != nil {
+		return e, err
+	}
+
+	for p.accept(tokenIdentifier, "and") {
+		rhs, err := p.parseNot()
+		if err != nil {
+			return e, err
+		}
+		e = e.merge(rhs)
+	}
+
+	return e, nil
+}
+
+func (p *parser) parseNot() (exprInfo, error) {
+	if p.accept(tokenIdentifier, "not") {
+		return p.parseNot()
+	}
+
+	return p.parseComparison()
+}
+
+func (p *parser) parseComparison() (exprInfo, error) {
+	e, err := p.parseAdditive()
+	if err != nil {
+		return e, err
+	}
+
+	t := p.peek()
+	switch {
+	case t.kind == tokenOperator && t.value == "=~":
+		p.next()
+		return e, p.parsePattern()
+	case t.is(tokenIdentifier, "like"):
+		p.next()
+		return e, p.parsePattern()
+	case t.is(tokenIdentifier, "in"):
+		p.next()
+		return e, p.parseList()
+	case t.is(tokenIdentifier, "not"):
+		p.next()
+		switch t := p.next(); {
+		case t.is(tokenIdentifier, "like"):
+			return e, p.parsePattern()
+		case t.is(tokenIdentifier, "in"):
+			return e, p.parseList()
+		default:
+			return e, unexpected(t, `"like" or "in" after "not"`)
+		}
+	case t.kind == tokenOperator && isComparisonOperator(t.value):
+		p.next()
+		rhs, err := p.parseAdditive()
+		if err != nil {
+			return e, err
+		}
+		return e.merge(rhs), nil
+	}
+
+	return e, nil
+}
+
+func isComparisonOperator(s string) bool {
+	switch s {
+	case "=", "!=", "<", "<=", ">", ">=":
+		return true
+	}
+
+	return false
+}
+
+// parsePattern parses the right-hand side of like or =~, which is a regular expression or a string.
+func (p *parser) parsePattern() error {
+	if t := p.next(); t.kind != tokenRegex && t.kind != tokenString {
+		return unexpected(t, "a regular expression or a string")
+	}
+
+	return nil
+}
+
+// parseList parses the right-hand side of in, e.g. ["a", "b"].
+func (p *parser) parseList() error {
+	if _, err := p.expect(tokenPunctuation, "["); err != nil {
+		return err
+	}
+
+	if p.accept(tokenPunctuation, "]") {
+		return nil
+	}
+
+	for {
+		if t := p.next(); t.kind != tokenNumber && t.kind != tokenString {
+			return unexpected(t, "a number or a string")
+		}
+
+		if p.accept(tokenPunctuation, "]") {
+			return nil
+		}
+		if _, err := p.expect(tokenPunctuation, ","); err != nil {
+			return err
+		}
+	}
+}
+
+func (p *parser) parseAdditive() (exprInfo, error) {
+	e, err := p.parseMultiplicative()
+	if err != nil {
+		return e, err
+	}
+
+	for p.peek().is(tokenOperator, "+") || p.peek().is(tokenOperator, "-") {
+		p.next()
+		rhs, err := p.parseMultiplicative()
+		if err != nil {
+			return e, err
+		}
+		e = e.merge(rhs)
+	}
+
+	return e, nil
+}
+
+func (p *parser) parseMultiplicative() (exprInfo, error) {
+	e, err := p.parsePower()
+	if err != nil {
+		return e, err
+	}
+
+	for p.peek().is(tokenOperator, "*") || p.peek().is(tokenOperator, "/") || p.peek().is(tokenOperator, "%") {
+		p.next()
+		rhs, err := p.parsePower()
+		if err != nil {
+			return e, err
+		}
+		e = e.merge(rhs)
+	}
+
+	return e, nil
+}
+
+func (p *parser) parsePower() (exprInfo, error) {
+	e, err := p.parseUnary()
+	if err != nil {
+		return e, err
+	}
+
+	if p.accept(tokenOperator, "^") {
+		rhs, err := p.parsePower()
+		if err != nil {
+			return e, err
+		}
+		e = e.merge(rhs)
+	}
+
+	return e, nil
+}
+
+func (p *parser) parseUnary() (exprInfo, error) {
+	if p.accept(tokenOperator, "-") {
+		return p.parseUnary()
+	}
+
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (exprInfo, error) {
+	t := p.peek()
+
+	switch t.kind {
+	case tokenDuration, tokenNumber, tokenString:
+		p.next()
+		return exprInfo{}, nil
+	case tokenPunctuation:
+		if t.value == "(" {
+			p.next()
+			e, err := p.parseExpr()
+			if err != nil {
+				return e, err
+			}
+			_, err = p.expect(tokenPunctuation, ")")
+			return e, err
+		}
+	case tokenIdentifier:
+		if isKeyword(t.value) {
+			break
+		}
+		p.next()
+		if p.peek().is(tokenPunctuation, "(") {
+			return p.parseCall(t)
+		}
+		return exprInfo{}, nil
+	}
+
+	return exprInfo{}, unexpected(t, "an expression")
+}
+
+// parseCall parses the arguments of a call to the function named by t.
+func (p *parser) parseCall(t token) (exprInfo, error) {
+	f, ok := functions[lowerValue(t)]
+	if !ok {
+		return exprInfo{}, fmt.Errorf("at offset %d: unknown function %q", t.offset, t.value)
+	}
+
+	if f.aggregate {
+		switch p.context {
+		case aggregateNotAllowed:
+			return exprInfo{}, fmt.Errorf("at offset %d: aggregate function %q can only be used in the stats command", t.offset, t.value)
+		case aggregateInBy:
+			return exprInfo{}, fmt.Errorf("at offset %d: aggregate function %q cannot be used in the by clause of the stats command", t.offset, t.value)
+		case aggregateNested:
+			return exprInfo{}, fmt.Errorf("at offset %d: aggregate function %q cannot be nested in another aggregate function", t.offset, t.value)
+		}
+
+		context := p.context
+		p.context = aggregateNested
+		defer func() { p.context = context }()
+	}
+
+	p.next() // (
+
+	var args int
+	switch {
+	case p.accept(tokenPunctuation, ")"):
+	case f.name == "count" && p.peek().is(tokenOperator, "*") && p.peekAt(1).is(tokenPunctuation, ")"):
+		// count(*)
+		p.pos += 2
+		args = 1
+	default:
+		for {
+			if f.name == "bin" {
+				// bin's argument is a time period, e.g. bin(5m).
+				if arg := p.peek(); arg.kind != tokenDuration {
+					return exprInfo{}, fmt.Errorf("at offset %d: bin requires a time period such as 5m, got %s", arg.offset, arg)
+				}
+			}
+
+			if _, err := p.parseExpr(); err != nil {
+				return exprInfo{}, err
+			}
+			args++
+
+			if p.accept(tokenPunctuation, ")") {
+				break
+			}
+			if _, err := p.expect(tokenPunctuation, ","); err != nil {
+				return exprInfo{}, err
+			}
+		}
+	}
+
+	if args < f.minArgs || (f.maxArgs != unlimited && args > f.maxArgs) {
+		return exprInfo{}, fmt.Errorf("at offset %d: function %q takes %s, got %d", t.offset, t.value, f.arity(), args)
+	}
+
+	return exprInfo{aggregate: f.aggregate}, nil
+}
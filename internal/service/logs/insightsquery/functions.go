@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package insightsquery
+
+import (
+	"fmt"
+	"strings"
+)
+
+// unlimited is the maximum number of arguments of a variadic function.
+const unlimited = -1
+
+type function struct {
+	// aggregate functions can only be used in the stats command.
+	aggregate bool
+	maxArgs   int
+	minArgs   int
+	name      string
+}
+
+// functions is the catalogue of Logs Insights functions, keyed by lowercase name.
+// See https://docs.aws.amazon.com/AmazonCloudWatch/latest/logs/CWL_QuerySyntax-operations-functions.html.
+var functions = catalogue(
+	// Aggregation functions.
+	function{name: "avg", aggregate: true, minArgs: 1, maxArgs: 1},
+	function{name: "count", aggregate: true, minArgs: 0, maxArgs: 1},
+	function{name: "count_distinct", aggregate: true, minArgs: 1, maxArgs: 1},
+	function{name: "earliest", aggregate: true, minArgs: 1, maxArgs: 1},
+	function{name: "latest", aggregate: true, minArgs: 1, maxArgs: 1},
+	function{name: "max", aggregate: true, minArgs: 1, maxArgs: 1},
+	function{name: "min", aggregate: true, minArgs: 1, maxArgs: 1},
+	function{name: "pct", aggregate: true, minArgs: 2, maxArgs: 2},
+	function{name: "sortsFirst", aggregate: true, minArgs: 1, maxArgs: 1},
+	function{name: "sortsLast", aggregate: true, minArgs: 1, maxArgs: 1},
+	function{name: "stddev", aggregate: true, minArgs: 1, maxArgs: 1},
+	function{name: "sum", aggregate: true, minArgs: 1, maxArgs: 1},
+
+	// Arithmetic functions.
+	function{name: "abs", minArgs: 1, maxArgs: 1},
+	function{name: "ceil", minArgs: 1, maxArgs: 1},
+	function{name: "floor", minArgs: 1, maxArgs: 1},
+	function{name: "greatest", minArgs: 1, maxArgs: unlimited},
+	function{name: "least", minArgs: 1, maxArgs: unlimited},
+	function{name: "log", minArgs: 1, maxArgs: 1},
+	function{name: "sqrt", minArgs: 1, maxArgs: 1},
+
+	// Datetime functions.
+	function{name: "bin", minArgs: 1, maxArgs: 1},
+	function{name: "dateceil", minArgs: 2, maxArgs: 2},
+	function{name: "datefloor", minArgs: 2, maxArgs: 2},
+	function{name: "fromMillis", minArgs: 1, maxArgs: 1},
+	function{name: "toMillis", minArgs: 1, maxArgs: 1},
+
+	// General functions.
+	function{name: "coalesce", minArgs: 1, maxArgs: unlimited},
+	function{name: "ispresent", minArgs: 1, maxArgs: 1},
+
+	// IP address functions.
+	function{name: "isIpInSubnet", minArgs: 2, maxArgs: 2},
+	function{name: "isIpv4InSubnet", minArgs: 2, maxArgs: 2},
+	function{name: "isIpv6InSubnet", minArgs: 2, maxArgs: 2},
+	function{name: "isValidIp", minArgs: 1, maxArgs: 1},
+	function{name: "isValidIpV4", minArgs: 1, maxArgs: 1},
+	function{name: "isValidIpV6", minArgs: 1, maxArgs: 1},
+
+	// JSON functions.
+	function{name: "jsonParse", minArgs: 1, maxArgs: 1},
+	function{name: "jsonStringify", minArgs: 1, maxArgs: 1},
+
+	// String functions.
+	function{name: "concat", minArgs: 1, maxArgs: unlimited},
+	function{name: "isblank", minArgs: 1, maxArgs: 1},
+	function{name: "isempty", minArgs: 1, maxArgs: 1},
+	function{name: "ltrim", minArgs: 1, maxArgs: 2},
+	function{name: "replace", minArgs: 3, maxArgs: 3},
+	function{name: "rtrim", minArgs: 1, maxArgs: 2},
+	function{name: "strcontains", minArgs: 2, maxArgs: 2},
+	function{name: "strlen", minArgs: 1, maxArgs: 1},
+	function{name: "substr", minArgs: 2, maxArgs: 3},
+	function{name: "tolower", minArgs: 1, maxArgs: 1},
+	function{name: "toupper", minArgs: 1, maxArgs: 1},
+	function{name: "trim", minArgs: 1, maxArgs: 2},
+)
+
+func catalogue(fs ...function) map[string]function {
+	m := make(map[string]function, len(fs))
+
+	for _, f := range fs {
+		m[strings.ToLower(f.name)] = f
+	}
+
+	return m
+}
+
+// arity describes the number of arguments that the function takes.
+func (f function) arity() string {
+	switch {
+	case f.maxArgs == unlimited:
+		return fmt.Sprintf("at least %s", arguments(f.minArgs))
+	case f.minArgs == f.maxArgs:
+		return arguments(f.minArgs)
+	default:
+		return fmt.Sprintf("%d to %s", f.minArgs, arguments(f.maxArgs))
+	}
+}
+
+func arguments(n int) string {
+	if n == 1 {
+		return "1 argument"
+	}
+
+	return fmt.Sprintf("%d arguments", n)
+}
@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package insightsquery parses CloudWatch Logs Insights queries, as used by query definitions
+// and dashboard log widgets.
+//
+// A query is a sequence of commands separated by pipes, e.g.
+//
+//	fields @timestamp, @message
+//	| filter @message like /ERROR/
+//	| stats count(*) as errors by bin(5m)
+//	| sort errors desc
+//	| limit 20
+//
+// The fields, display, filter, stats, sort, limit, parse and dedup commands and the function catalogue are
+// validated. Other commands, such as pattern and unnest, are accepted without validating their arguments.
+//
+// See https://docs.aws.amazon.com/AmazonCloudWatch/latest/logs/CWL_QuerySyntax.html.
+package insightsquery
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Query is a parsed Logs Insights query.
+type Query struct {
+	commands []string
+}
+
+// Commands returns the names of the query's commands, in lowercase and in order.
+func (q *Query) Commands() []string {
+	return q.commands
+}
+
+// Parse parses a Logs Insights query.
+func Parse(query string) (*Query, error) {
+	tokens, err := tokenize(query)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+	if p.peek().kind == tokenEOF {
+		return nil, errors.New("query must not be empty")
+	}
+
+	q := &Query{}
+
+	for {
+		name, err := p.parseCommand()
+		if err != nil {
+			return nil, err
+		}
+		q.commands = append(q.commands, name)
+
+		if p.peek().kind == tokenEOF {
+			return q, nil
+		}
+		if _, err := p.expect(tokenPipe, "|"); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// Validate returns an error if the query isn't a valid Logs Insights query.
+func Validate(query string) error {
+	_, err := Parse(query)
+
+	return err
+}
+
+type parser struct {
+	// context is where aggregate functions may appear.
+	context aggregateContext
+	pos     int
+	tokens  []token
+}
+
+type aggregateContext int
+
+const (
+	aggregateNotAllowed aggregateContext = iota
+	aggregateAllowed                     // stats expressions
+	aggregateInBy                        // stats ... by expressions
+	aggregateNested                      // aggregate function arguments
+)
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+// peekAt returns the token n positions ahead.
+func (p *parser) peekAt(n int) token {
+	if i := p.pos + n; i < len(p.tokens) {
+		return p.tokens[i]
+	}
+
+	return p.tokens[len(p.tokens)-1]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+
+	return t
+}
+
+// accept consumes the next token if it is the specified keyword or operator.
+func (p *parser) accept(kind tokenKind, value string) bool {
+	if p.peek().is(kind, value) {
+		p.pos++
+		return true
+	}
+
+	return false
+}
+
+func (p *parser) expect(kind tokenKind, value string) (token, error) {
+	t := p.peek()
+	if !t.is(kind, value) {
+		return t, unexpected(t, fmt.Sprintf("%q", value))
+	}
+	p.pos++
+
+	return t, nil
+}
+
+// atCommandEnd reports whether the current command has no more tokens.
+func (p *parser) atCommandEnd() bool {
+	kind := p.peek().kind
+
+	return kind == tokenPipe || kind == tokenEOF
+}
+
+func (p *parser) expectCommandEnd() error {
+	if !p.atCommandEnd() {
+		return unexpected(p.peek(), `"|" or end of query`)
+	}
+
+	return nil
+}
+
+// parseField parses a field name.
+func (p *parser) parseField() (token, error) {
+	t := p.peek()
+	if t.kind != tokenIdentifier || isKeyword(t.value) {
+		return t, unexpected(t, "a field name")
+	}
+	p.pos++
+
+	return t, nil
+}
+
+// parseAlias parses an optional "as name" clause.
+func (p *parser) parseAlias() error {
+	if !p.accept(tokenIdentifier, "as") {
+		return nil
+	}
+
+	_, err := p.parseField()
+
+	return err
+}
+
+func unexpected(t token, want string) error {
+	return fmt.Errorf("at offset %d: expected %s, got %s", t.offset, want, t)
+}
+
+func lowerValue(t token) string {
+	return strings.ToLower(t.value)
+}
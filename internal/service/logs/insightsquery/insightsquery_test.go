@@ -0,0 +1,287 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package insightsquery
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		query        string
+		wantCommands []string
+		wantErr      string
+	}{
+		"empty": {
+			query:   " # nothing to see here\n",
+			wantErr: "query must not be empty",
+		},
+		"fields": {
+			query:        "fields @timestamp, @message",
+			wantCommands: []string{"fields"},
+		},
+		"pipeline": {
+			query: `fields @timestamp, @message
+| filter @message like /ERROR/
+| stats count(*) as errors by bin(5m)
+| sort errors desc
+| limit 20`,
+			wantCommands: []string{"fields", "filter", "stats", "sort", "limit"},
+		},
+		"case-insensitive": {
+			query:        "FIELDS @message | STATS COUNT(*) BY bin(1h)",
+			wantCommands: []string{"fields", "stats"},
+		},
+		"comments": {
+			query:        "fields @message # the raw event\n| limit 5",
+			wantCommands: []string{"fields", "limit"},
+		},
+		"source": {
+			query:        "SOURCE 'log-group-1' | SOURCE 'log-group-2' | fields @message",
+			wantCommands: []string{"source", "source", "fields"},
+		},
+		"unvalidated command": {
+			query:        "filter @message like /ERROR/ | pattern @message",
+			wantCommands: []string{"filter", "pattern"},
+		},
+		"fields expressions": {
+			query:        "fields concat(a, '-', b) as ab, abs(x - y) * 2 as diff, `quoted field`",
+			wantCommands: []string{"fields"},
+		},
+		"display": {
+			query:        "display @timestamp, @message",
+			wantCommands: []string{"display"},
+		},
+		"filter operators": {
+			query:        `filter (status >= 500 and status != 503) or not ispresent(status) or latency / 1000 > 2`,
+			wantCommands: []string{"filter"},
+		},
+		"filter like string": {
+			query:        `filter @message not like "DEBUG"`,
+			wantCommands: []string{"filter"},
+		},
+		"filter regex match": {
+			query:        `filter @logStream =~ /^prod-/`,
+			wantCommands: []string{"filter"},
+		},
+		"filter in": {
+			query:        `filter status in [500, 502, "503"] and method not in ["GET"]`,
+			wantCommands: []string{"filter"},
+		},
+		"filter ip": {
+			query:        `filter isIpv4InSubnet(srcAddr, "10.0.0.0/8")`,
+			wantCommands: []string{"filter"},
+		},
+		"stats": {
+			query:        "stats avg(latency) as avg, pct(latency, 99), count_distinct(user), sum(bytes) / count(*) by host, bin(30s)",
+			wantCommands: []string{"stats"},
+		},
+		"stats datefloor": {
+			query:        "stats count() by datefloor(@timestamp, 1h)",
+			wantCommands: []string{"stats"},
+		},
+		"parse glob": {
+			query:        `parse @message "user=* action=*" as user, action`,
+			wantCommands: []string{"parse"},
+		},
+		"parse regex": {
+			query:        `parse @message /user=(?<user>\S+)/ | stats count(*) by user`,
+			wantCommands: []string{"parse", "stats"},
+		},
+		"parse regex with slash": {
+			query:        `parse /path=(?<path>[a-z\/]+)/`,
+			wantCommands: []string{"parse"},
+		},
+		"dedup": {
+			query:        "dedup server, severity",
+			wantCommands: []string{"dedup"},
+		},
+		"sort": {
+			query:        "sort @timestamp desc, @logStream asc, x",
+			wantCommands: []string{"sort"},
+		},
+		"unknown command": {
+			query:   "fields @message | flter @message like /x/",
+			wantErr: `at offset 18: unknown command "flter"`,
+		},
+		"missing command": {
+			query:   "fields @message | | limit 5",
+			wantErr: `at offset 18: expected a command, got "|"`,
+		},
+		"trailing pipe": {
+			query:   "fields @message |",
+			wantErr: "expected a command, got end of query",
+		},
+		"missing arguments": {
+			query:   "fields",
+			wantErr: "fields command requires arguments",
+		},
+		"unknown function": {
+			query:   "fields tolowercase(@message)",
+			wantErr: `at offset 7: unknown function "tolowercase"`,
+		},
+		"too few arguments": {
+			query:   "fields replace(@message, 'a')",
+			wantErr: `function "replace" takes 3 arguments, got 2`,
+		},
+		"too many arguments": {
+			query:   "fields trim(a, 'b', 'c')",
+			wantErr: `function "trim" takes 1 to 2 arguments, got 3`,
+		},
+		"variadic without arguments": {
+			query:   "fields coalesce()",
+			wantErr: `function "coalesce" takes at least 1 argument, got 0`,
+		},
+		"invalid time unit": {
+			query:   "stats count(*) by bin(5mins)",
+			wantErr: `invalid time unit "mins" in "5mins"`,
+		},
+		"bin without period": {
+			query:   "stats count(*) by bin(@timestamp)",
+			wantErr: `bin requires a time period such as 5m, got "@timestamp"`,
+		},
+		"aggregate outside stats": {
+			query:   "filter count(*) > 1",
+			wantErr: `aggregate function "count" can only be used in the stats command`,
+		},
+		"aggregate in by": {
+			query:   "stats count(*) by max(x)",
+			wantErr: `aggregate function "max" cannot be used in the by clause`,
+		},
+		"nested aggregate": {
+			query:   "stats avg(max(x))",
+			wantErr: `aggregate function "max" cannot be nested in another aggregate function`,
+		},
+		"stats without aggregate": {
+			query:   "stats count(*), latency",
+			wantErr: "at offset 16: stats expression must use an aggregate function",
+		},
+		"missing by": {
+			query:   "stats count(*) bin(5m)",
+			wantErr: `expected "|" or end of query, got "bin"`,
+		},
+		"limit zero": {
+			query:   "limit 0",
+			wantErr: "limit must be an integer between 1 and 10000",
+		},
+		"limit too large": {
+			query:   "limit 10001",
+			wantErr: "limit must be an integer between 1 and 10000",
+		},
+		"limit not integer": {
+			query:   "limit 2.5",
+			wantErr: "limit must be an integer between 1 and 10000",
+		},
+		"parse wildcards": {
+			query:   `parse @message "user=* action=*" as user`,
+			wantErr: "has 2 wildcards but 1 field names",
+		},
+		"parse missing as": {
+			query:   `parse @message "user=*"`,
+			wantErr: `expected "as", got end of query`,
+		},
+		"parse regex without named group": {
+			query:   `parse @message /user=(\S+)/`,
+			wantErr: "must contain a named capturing group",
+		},
+		"like without pattern": {
+			query:   "filter @message like ERROR",
+			wantErr: `expected a regular expression or a string, got "ERROR"`,
+		},
+		"not without like or in": {
+			query:   "filter status not 200",
+			wantErr: `expected "like" or "in" after "not", got "200"`,
+		},
+		"unbalanced parentheses": {
+			query:   "filter (a = 1",
+			wantErr: `expected ")", got end of query`,
+		},
+		"keyword as field": {
+			query:   "fields as",
+			wantErr: `expected an expression, got "as"`,
+		},
+		"missing alias": {
+			query:   "fields a as",
+			wantErr: "expected a field name, got end of query",
+		},
+		"unterminated string": {
+			query:   `filter a = "b`,
+			wantErr: "unterminated quoted string",
+		},
+		"unterminated regex": {
+			query:   `filter a like /b`,
+			wantErr: "unterminated regular expression",
+		},
+		"unexpected character": {
+			query:   "filter a ; b",
+			wantErr: "unexpected character ';'",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			q, err := Parse(testCase.query)
+
+			if testCase.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", testCase.wantErr, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got := q.Commands(); !slices.Equal(got, testCase.wantCommands) {
+				t.Errorf("Commands() = %v, want %v", got, testCase.wantCommands)
+			}
+		})
+	}
+}
+
+func TestTokenizeRegexOrDivision(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		query string
+		want  []tokenKind
+	}{
+		"division":       {query: "fields a / 2", want: []tokenKind{tokenIdentifier, tokenIdentifier, tokenOperator, tokenNumber}},
+		"like regex":     {query: "filter a like /b/", want: []tokenKind{tokenIdentifier, tokenIdentifier, tokenIdentifier, tokenRegex}},
+		"parse regex":    {query: "parse @message /(?<a>b)/", want: []tokenKind{tokenIdentifier, tokenIdentifier, tokenRegex}},
+		"call division":  {query: "fields abs(a) / 2", want: []tokenKind{tokenIdentifier, tokenIdentifier, tokenPunctuation, tokenIdentifier, tokenPunctuation, tokenOperator, tokenNumber}},
+		"operator regex": {query: "filter a =~ /b/", want: []tokenKind{tokenIdentifier, tokenIdentifier, tokenOperator, tokenRegex}},
+		"duration":       {query: "bin(5m)", want: []tokenKind{tokenIdentifier, tokenPunctuation, tokenDuration, tokenPunctuation}},
+		"quoted field":   {query: "fields `a b`", want: []tokenKind{tokenIdentifier, tokenIdentifier}},
+		"nested field":   {query: "fields a.b.0.c", want: []tokenKind{tokenIdentifier, tokenIdentifier}},
+		"single quotes":  {query: "filter a = 'b'", want: []tokenKind{tokenIdentifier, tokenIdentifier, tokenOperator, tokenString}},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			tokens, err := tokenize(testCase.query)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var got []tokenKind
+			for _, t := range tokens[:len(tokens)-1] {
+				got = append(got, t.kind)
+			}
+
+			if !slices.Equal(got, testCase.want) {
+				t.Errorf("token kinds = %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,254 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package insightsquery
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenDuration
+	tokenIdentifier
+	tokenNumber
+	tokenOperator
+	tokenPipe
+	tokenPunctuation
+	tokenRegex
+	tokenString
+)
+
+type token struct {
+	kind   tokenKind
+	offset int
+	value  string
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokenEOF:
+		return "end of query"
+	case tokenPipe:
+		return `"|"`
+	case tokenRegex:
+		return "/" + t.value + "/"
+	}
+
+	return strconv.Quote(t.value)
+}
+
+// is reports whether the token is the specified keyword or operator. Keywords are case-insensitive.
+func (t token) is(kind tokenKind, value string) bool {
+	return t.kind == kind && strings.EqualFold(t.value, value)
+}
+
+// keywords are the identifiers that are operators or clauses rather than field names.
+var keywords = map[string]bool{
+	"and":  true,
+	"as":   true,
+	"asc":  true,
+	"by":   true,
+	"desc": true,
+	"in":   true,
+	"like": true,
+	"not":  true,
+	"or":   true,
+}
+
+func isKeyword(s string) bool {
+	return keywords[strings.ToLower(s)]
+}
+
+// regexAllowed reports whether a '/' following tokens starts a regular expression rather than being division.
+// A regular expression can't follow an operand, except for the field that a parse command extracts from.
+func regexAllowed(tokens []token) bool {
+	n := len(tokens)
+	if n == 0 {
+		return true
+	}
+
+	switch t := tokens[n-1]; t.kind {
+	case tokenDuration, tokenNumber, tokenRegex, tokenString:
+		return false
+	case tokenPunctuation:
+		return t.value != ")" && t.value != "]"
+	case tokenIdentifier:
+		if isKeyword(t.value) || isCommandPosition(tokens, n-1) {
+			return true
+		}
+		// parse <field> /regex/
+		return n >= 2 && tokens[n-2].is(tokenIdentifier, "parse") && isCommandPosition(tokens, n-2)
+	}
+
+	return true
+}
+
+// isCommandPosition reports whether the i-th token is the name of a command.
+func isCommandPosition(tokens []token, i int) bool {
+	return i == 0 || tokens[i-1].kind == tokenPipe
+}
+
+// timeUnits are the units of time that can follow a number, e.g. 5m.
+var timeUnits = map[string]bool{
+	"ms": true,
+	"s":  true,
+	"m":  true,
+	"h":  true,
+	"d":  true,
+	"w":  true,
+	"mo": true,
+	"q":  true,
+	"y":  true,
+}
+
+// tokenize splits a query into tokens.
+func tokenize(s string) ([]token, error) {
+	var tokens []token
+
+	for i := 0; i < len(s); {
+		c := s[i]
+		start := i
+
+		switch {
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+			i++
+			continue
+		case c == '#':
+			// Comments run to the end of the line.
+			for i < len(s) && s[i] != '\n' {
+				i++
+			}
+			continue
+		case c == '"' || c == '\'':
+			str, n, err := readQuoted(s[i:], i)
+			if err != nil {
+				return nil, err
+			}
+			i += n
+			tokens = append(tokens, token{kind: tokenString, offset: start, value: str})
+		case c == '`':
+			n := strings.IndexByte(s[i+1:], '`')
+			if n < 0 {
+				return nil, fmt.Errorf("at offset %d: unterminated quoted field name", start)
+			}
+			i += n + 2
+			tokens = append(tokens, token{kind: tokenIdentifier, offset: start, value: s[start+1 : i-1]})
+		case c == '/' && regexAllowed(tokens):
+			expr, n, err := readRegex(s[i:], i)
+			if err != nil {
+				return nil, err
+			}
+			i += n
+			tokens = append(tokens, token{kind: tokenRegex, offset: start, value: expr})
+		case c == '|':
+			i++
+			tokens = append(tokens, token{kind: tokenPipe, offset: start, value: "|"})
+		case strings.HasPrefix(s[i:], "!=") || strings.HasPrefix(s[i:], "<=") || strings.HasPrefix(s[i:], ">=") || strings.HasPrefix(s[i:], "=~"):
+			i += 2
+			tokens = append(tokens, token{kind: tokenOperator, offset: start, value: s[start:i]})
+		case strings.IndexByte("=<>+-*/%^", c) >= 0:
+			i++
+			tokens = append(tokens, token{kind: tokenOperator, offset: start, value: s[start:i]})
+		case strings.IndexByte("()[],:", c) >= 0:
+			i++
+			tokens = append(tokens, token{kind: tokenPunctuation, offset: start, value: s[start:i]})
+		case isDigit(c):
+			for i < len(s) && (isDigit(s[i]) || s[i] == '.') {
+				i++
+			}
+			if _, err := strconv.ParseFloat(s[start:i], 64); err != nil {
+				return nil, fmt.Errorf("at offset %d: invalid number %q", start, s[start:i])
+			}
+			if i < len(s) && isLetter(s[i]) {
+				j := i
+				for j < len(s) && isLetter(s[j]) {
+					j++
+				}
+				if unit := s[i:j]; !timeUnits[unit] {
+					return nil, fmt.Errorf("at offset %d: invalid time unit %q in %q, expected one of ms, s, m, h, d, w, mo, q or y", i, unit, s[start:j])
+				}
+				i = j
+				tokens = append(tokens, token{kind: tokenDuration, offset: start, value: s[start:i]})
+				continue
+			}
+			tokens = append(tokens, token{kind: tokenNumber, offset: start, value: s[start:i]})
+		case isIdentifierStart(c):
+			for i < len(s) && isIdentifierPart(s[i]) {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenIdentifier, offset: start, value: s[start:i]})
+		default:
+			return nil, fmt.Errorf("at offset %d: unexpected character %q", start, c)
+		}
+	}
+
+	return append(tokens, token{kind: tokenEOF, offset: len(s)}), nil
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isLetter(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isIdentifierStart(c byte) bool {
+	return isLetter(c) || c == '_' || c == '@' || c == '$'
+}
+
+// isIdentifierPart reports whether c can appear in a field name. Nested JSON fields are separated by dots, e.g. a.b.0.c.
+func isIdentifierPart(c byte) bool {
+	return isIdentifierStart(c) || isDigit(c) || c == '.'
+}
+
+// readQuoted reads a single- or double-quoted string at the start of s and returns its unescaped value and length.
+func readQuoted(s string, offset int) (string, int, error) {
+	var sb strings.Builder
+	quote := s[0]
+
+	for i := 1; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '\\':
+			if i+1 < len(s) {
+				i++
+				sb.WriteByte(s[i])
+			}
+		case quote:
+			return sb.String(), i + 1, nil
+		default:
+			sb.WriteByte(c)
+		}
+	}
+
+	return "", 0, fmt.Errorf("at offset %d: unterminated quoted string", offset)
+}
+
+// readRegex reads a regular expression enclosed in slashes at the start of s and returns it and its length.
+// A slash in the regular expression is escaped with a backslash.
+func readRegex(s string, offset int) (string, int, error) {
+	var sb strings.Builder
+
+	for i := 1; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '\\' && i+1 < len(s) && s[i+1] == '/':
+			i++
+			sb.WriteByte('/')
+		case c == '\\' && i+1 < len(s):
+			sb.WriteByte(c)
+			i++
+			sb.WriteByte(s[i])
+		case c == '/':
+			return sb.String(), i + 1, nil
+		default:
+			sb.WriteByte(c)
+		}
+	}
+
+	return "", 0, fmt.Errorf("at offset %d: unterminated regular expression", offset)
+}
@@ -94,9 +94,12 @@ func resourceMetricFilter() *schema.Resource {
 				ValidateFunc: validLogMetricFilterName,
 			},
 			"pattern": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringLenBetween(0, 1024),
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(0, 1024),
+					validLogFilterPattern,
+				),
 				StateFunc: func(v interface{}) string {
 					s, ok := v.(string)
 					if !ok {
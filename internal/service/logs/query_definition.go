@@ -60,8 +60,9 @@ func resourceQueryDefinition() *schema.Resource {
 				Computed: true,
 			},
 			"query_string": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validLogsInsightsQuery,
 			},
 		},
 	}
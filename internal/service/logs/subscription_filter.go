@@ -55,9 +55,12 @@ func resourceSubscriptionFilter() *schema.Resource {
 				ValidateDiagFunc: enum.Validate[awstypes.Distribution](),
 			},
 			"filter_pattern": {
-				Type:         schema.TypeString,
-				Required:     true,
-				ValidateFunc: validation.StringLenBetween(0, 1024),
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(0, 1024),
+					validLogFilterPattern,
+				),
 			},
 			names.AttrLogGroupName: {
 				Type:     schema.TypeString,
@@ -7,6 +7,8 @@ import (
 	"fmt"
 
 	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/logs/filterpattern"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/logs/insightsquery"
 )
 
 func validLogGroupName(v interface{}, k string) (ws []string, errors []error) {
@@ -101,3 +103,23 @@ func validLogStreamName(v interface{}, k string) (ws []string, errors []error) {
 
 	return
 }
+
+func validLogFilterPattern(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if err := filterpattern.Validate(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q isn't a valid filter pattern: %w", k, err))
+	}
+
+	return
+}
+
+func validLogsInsightsQuery(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+
+	if err := insightsquery.Validate(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q isn't a valid Logs Insights query: %w", k, err))
+	}
+
+	return
+}
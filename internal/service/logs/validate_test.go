@@ -12,6 +12,73 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+func TestValidLogFilterPattern(t *testing.T) {
+	t.Parallel()
+
+	validPatterns := []string{
+		"",
+		"ERROR",
+		`?ERROR ?"Task timed out"`,
+		"[TEST]",
+		"[ip, user, ..., status_code = 4*, bytes > 1000]",
+		`{ $.d1 = "OK" }`,
+		`{ ($.errorCode = "AccessDenied") || ($.latency > 500) }`,
+		"%ERROR|WARN%",
+	}
+	for _, v := range validPatterns {
+		_, errors := tflogs.ValidLogFilterPattern(v, "pattern")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid filter pattern: %q", v, errors)
+		}
+	}
+
+	invalidPatterns := []string{
+		`{ $.d1 = "OK"`,
+		"[ip, user, ip]",
+		"?ERROR WARN",
+		`{ $.latency > "slow" }`,
+		"%a% %b% %c%",
+	}
+	for _, v := range invalidPatterns {
+		_, errors := tflogs.ValidLogFilterPattern(v, "pattern")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid filter pattern", v)
+		}
+	}
+}
+
+func TestValidLogsInsightsQuery(t *testing.T) {
+	t.Parallel()
+
+	validQueries := []string{
+		"fields @timestamp, @message | sort @timestamp desc | limit 20",
+		"filter @message like /ERROR/ | stats count(*) as errors by bin(5m)",
+		`parse @message "user=* action=*" as user, action | dedup user`,
+		"SOURCE 'log-group' | fields @message",
+	}
+	for _, v := range validQueries {
+		_, errors := tflogs.ValidLogsInsightsQuery(v, "query_string")
+		if len(errors) != 0 {
+			t.Fatalf("%q should be a valid Logs Insights query: %q", v, errors)
+		}
+	}
+
+	invalidQueries := []string{
+		"",
+		"stats count(*) by bin(5mins)",
+		"fields @message | flter @message like /x/",
+		"fields tolowercase(@message)",
+		"filter count(*) > 1",
+		"limit 0",
+	}
+	for _, v := range invalidQueries {
+		_, errors := tflogs.ValidLogsInsightsQuery(v, "query_string")
+		if len(errors) == 0 {
+			t.Fatalf("%q should be an invalid Logs Insights query", v)
+		}
+	}
+}
+
 func TestValidLogGroupName(t *testing.T) {
 	t.Parallel()
 
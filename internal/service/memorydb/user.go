@@ -11,6 +11,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/memorydb"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/memorydb/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -28,6 +29,7 @@ import (
 
 // @SDKResource("aws_memorydb_user", name="User")
 // @Tags(identifierAttribute="arn")
+// @Testing(writeOnlyAttribute="authentication_mode.0.passwords_wo")
 func resourceUser() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceUserCreate,
@@ -65,8 +67,27 @@ func resourceUser() *schema.Resource {
 								Type:         schema.TypeString,
 								ValidateFunc: validation.StringLenBetween(16, 128),
 							},
-							Set:       schema.HashString,
-							Sensitive: true,
+							Set:           schema.HashString,
+							Sensitive:     true,
+							ConflictsWith: []string{"authentication_mode.0.passwords_wo"},
+						},
+						"passwords_wo": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MinItems: 1,
+							MaxItems: 2,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(16, 128),
+							},
+							Sensitive:     true,
+							WriteOnly:     true,
+							ConflictsWith: []string{"authentication_mode.0.passwords"},
+						},
+						"passwords_wo_version": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							RequiredWith: []string{"authentication_mode.0.passwords_wo"},
 						},
 						"password_count": {
 							Type:     schema.TypeInt,
@@ -93,6 +114,10 @@ func resourceUser() *schema.Resource {
 				ValidateFunc: validateResourceName(userNameMaxLength),
 			},
 		},
+
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			validation.PreferWriteOnlyAttribute(cty.GetAttrPath("authentication_mode").IndexInt(0).GetAttr("passwords"), cty.GetAttrPath("authentication_mode").IndexInt(0).GetAttr("passwords_wo")),
+		},
 	}
 }
 
@@ -111,6 +136,17 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta interf
 		input.AuthenticationMode = expandAuthenticationMode(v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	// get write-only value from configuration
+	passwordsWO, di := flex.GetWriteOnlyStringListValue(d, cty.GetAttrPath("authentication_mode").IndexInt(0).GetAttr("passwords_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if len(passwordsWO) > 0 && input.AuthenticationMode != nil {
+		input.AuthenticationMode.Passwords = passwordsWO
+	}
+
 	_, err := conn.CreateUser(ctx, input)
 
 	if err != nil {
@@ -142,9 +178,10 @@ func resourceUserRead(ctx context.Context, d *schema.ResourceData, meta interfac
 	d.Set(names.AttrARN, user.ARN)
 	if v := user.Authentication; v != nil {
 		tfMap := map[string]interface{}{
-			"passwords":      d.Get("authentication_mode.0.passwords"),
-			"password_count": aws.ToInt32(v.PasswordCount),
-			names.AttrType:   v.Type,
+			"passwords":            d.Get("authentication_mode.0.passwords"),
+			"passwords_wo_version": d.Get("authentication_mode.0.passwords_wo_version"),
+			"password_count":       aws.ToInt32(v.PasswordCount),
+			names.AttrType:         v.Type,
 		}
 
 		if err := d.Set("authentication_mode", []interface{}{tfMap}); err != nil {
@@ -174,6 +211,23 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 			input.AuthenticationMode = expandAuthenticationMode(v.([]interface{})[0].(map[string]interface{}))
 		}
 
+		if d.HasChange("authentication_mode.0.passwords_wo_version") {
+			passwordsWO, di := flex.GetWriteOnlyStringListValue(d, cty.GetAttrPath("authentication_mode").IndexInt(0).GetAttr("passwords_wo"))
+			diags = append(diags, di...)
+			if diags.HasError() {
+				return diags
+			}
+
+			if len(passwordsWO) > 0 && input.AuthenticationMode != nil {
+				input.AuthenticationMode.Passwords = passwordsWO
+			}
+		}
+
+		// Write-only passwords are only sent when their version changes.
+		if v := input.AuthenticationMode; v != nil && v.Type == awstypes.InputAuthenticationTypePassword && len(v.Passwords) == 0 {
+			input.AuthenticationMode = nil
+		}
+
 		_, err := conn.UpdateUser(ctx, input)
 
 		if err != nil {
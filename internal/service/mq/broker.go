@@ -18,6 +18,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/mq"
 	"github.com/aws/aws-sdk-go-v2/service/mq/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
@@ -41,6 +42,9 @@ import (
 
 // @SDKResource("aws_mq_broker", name="Broker")
 // @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/mq;mq.DescribeBrokerOutput")
+// @Testing(preCheck=true)
+// @Testing(writeOnlyAttribute="user_passwords_wo")
 func resourceBroker() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceBrokerCreate,
@@ -355,7 +359,7 @@ func resourceBroker() *schema.Resource {
 						},
 						names.AttrPassword: {
 							Type:         schema.TypeString,
-							Required:     true,
+							Optional:     true,
 							Sensitive:    true,
 							ValidateFunc: ValidBrokerPassword,
 						},
@@ -372,6 +376,22 @@ func resourceBroker() *schema.Resource {
 					},
 				},
 			},
+			"user_passwords_wo": {
+				Type:      schema.TypeMap,
+				Optional:  true,
+				Sensitive: true,
+				WriteOnly: true,
+				Elem:      &schema.Schema{Type: schema.TypeString},
+				ValidateDiagFunc: validation.AllDiag(
+					validation.MapValueLenBetween(12, 250),
+					validation.MapValueMatch(regexache.MustCompile(`^[^,]*$`), "must not contain commas"),
+				),
+			},
+			"user_passwords_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"user_passwords_wo"},
+			},
 		},
 
 		CustomizeDiff: customdiff.All(
@@ -410,6 +430,24 @@ func resourceBrokerCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		Users:                   expandUsers(d.Get("user").(*schema.Set).List()),
 	}
 
+	// get write-only value from configuration
+	userPasswordsWO, di := flex.GetWriteOnlyStringMapValue(d, cty.GetAttrPath("user_passwords_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	for i, user := range input.Users {
+		username := aws.ToString(user.Username)
+		if v, ok := userPasswordsWO[username]; ok {
+			input.Users[i].Password = aws.String(v)
+		}
+
+		if aws.ToString(input.Users[i].Password) == "" {
+			return sdkdiag.AppendErrorf(diags, `creating MQ Broker (%s): user %q: one of "password" or "user_passwords_wo" is required`, name, username)
+		}
+	}
+
 	if v, ok := d.GetOk("authentication_strategy"); ok {
 		input.AuthenticationStrategy = types.AuthenticationStrategy(v.(string))
 	}
@@ -577,13 +615,20 @@ func resourceBrokerUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		requiresReboot = true
 	}
 
-	if d.HasChange("user") {
+	if d.HasChanges("user", "user_passwords_wo_version") {
 		o, n := d.GetChange("user")
+
+		userPasswordsWO, di := flex.GetWriteOnlyStringMapValue(d, cty.GetAttrPath("user_passwords_wo"))
+		diags = append(diags, di...)
+		if diags.HasError() {
+			return diags
+		}
+
 		var err error
 		// d.HasChange("user") always reports a change when running resourceBrokerUpdate
 		// updateBrokerUsers needs to be called to know if changes to user are actually made
 		var usersUpdated bool
-		usersUpdated, err = updateBrokerUsers(ctx, conn, d.Id(), o.(*schema.Set).List(), n.(*schema.Set).List())
+		usersUpdated, err = updateBrokerUsers(ctx, conn, d.Id(), o.(*schema.Set).List(), n.(*schema.Set).List(), userPasswordsWO, d.HasChange("user_passwords_wo_version"))
 
 		if err != nil {
 			return sdkdiag.AppendErrorf(diags, "updating MQ Broker (%s) users: %s", d.Id(), err)
@@ -806,7 +851,7 @@ func resourceUserHash(v interface{}) int {
 	return create.StringHashcode(buf.String())
 }
 
-func updateBrokerUsers(ctx context.Context, conn *mq.Client, id string, oldUsers, newUsers []interface{}) (bool, error) {
+func updateBrokerUsers(ctx context.Context, conn *mq.Client, id string, oldUsers, newUsers []interface{}, passwordsWO map[string]string, rotatePasswordsWO bool) (bool, error) {
 	// If there are any user creates/deletes/updates, updatedUsers will be set to true
 	updatedUsers := false
 
@@ -815,6 +860,38 @@ func updateBrokerUsers(ctx context.Context, conn *mq.Client, id string, oldUsers
 		return updatedUsers, err
 	}
 
+	// Users' write-only passwords are set on creation and on update,
+	// and are rotated for all users when their version changes.
+	changed := make(map[string]bool)
+	for _, c := range createL {
+		username := aws.ToString(c.Username)
+		if v, ok := passwordsWO[username]; ok {
+			c.Password = aws.String(v)
+		}
+		changed[username] = true
+	}
+	for _, u := range updateL {
+		username := aws.ToString(u.Username)
+		if v, ok := passwordsWO[username]; ok {
+			u.Password = aws.String(v)
+		} else if aws.ToString(u.Password) == "" {
+			u.Password = nil
+		}
+		changed[username] = true
+	}
+	if rotatePasswordsWO {
+		for _, nu := range newUsers {
+			username := nu.(map[string]interface{})[names.AttrUsername].(string)
+			if v, ok := passwordsWO[username]; ok && !changed[username] {
+				updateL = append(updateL, &mq.UpdateUserInput{
+					BrokerId: aws.String(id),
+					Password: aws.String(v),
+					Username: aws.String(username),
+				})
+			}
+		}
+	}
+
 	for _, c := range createL {
 		_, err := conn.CreateUser(ctx, c)
 		updatedUsers = true
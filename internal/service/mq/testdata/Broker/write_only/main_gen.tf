@@ -0,0 +1,49 @@
+# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "aws_mq_broker" "test" {
+  broker_name             = var.rName
+  engine_type             = "ActiveMQ"
+  engine_version          = "5.18"
+  host_instance_type      = "mq.t3.micro"
+  security_groups         = [aws_security_group.test.id]
+  authentication_strategy = "simple"
+  storage_type            = "efs"
+  apply_immediately       = true
+
+  user {
+    username = "Test"
+  }
+
+  user_passwords_wo = {
+    Test = var.secret
+  }
+  user_passwords_wo_version = var.secret_version
+}
+
+resource "aws_security_group" "test" {
+  name = var.rName
+
+  tags = {
+    Name = var.rName
+  }
+}
+
+variable "rName" {
+  description = "Name for resource"
+  type        = string
+  nullable    = false
+}
+
+variable "secret" {
+  description = "Value of the write-only attribute"
+  type        = string
+  nullable    = false
+  sensitive   = true
+}
+
+variable "secret_version" {
+  description = "Version of the write-only attribute"
+  type        = number
+  nullable    = false
+}
@@ -35,6 +35,7 @@ import (
 
 // @FrameworkResource("aws_networkmonitor_monitor", name="Monitor")
 // @Tags(identifierAttribute="arn")
+// @Testing(basicTest=true)
 func newMonitorResource(context.Context) (resource.ResourceWithConfigure, error) {
 	return &monitorResource{}, nil
 }
@@ -10,10 +10,7 @@ import (
 
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
-	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
-	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
-	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	tfnetworkmonitor "github.com/hashicorp/terraform-provider-aws/internal/service/networkmonitor"
@@ -21,70 +18,6 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-func TestAccNetworkMonitorMonitor_basic(t *testing.T) {
-	ctx := acctest.Context(t)
-	resourceName := "aws_networkmonitor_monitor.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkMonitorServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckMonitorDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccMonitorConfig_basic(rName),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckMonitorExists(ctx, resourceName),
-					resource.TestCheckResourceAttr(resourceName, "aggregation_period", "60"),
-					acctest.CheckResourceAttrRegionalARNFormat(ctx, resourceName, names.AttrARN, "networkmonitor", "monitor/{monitor_name}"),
-					resource.TestCheckResourceAttrPair(resourceName, names.AttrID, resourceName, "monitor_name"),
-					resource.TestCheckResourceAttr(resourceName, "monitor_name", rName),
-				),
-				ConfigStateChecks: []statecheck.StateCheck{
-					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTags), knownvalue.Null()),
-					statecheck.ExpectKnownValue(resourceName, tfjsonpath.New(names.AttrTagsAll), knownvalue.MapExact(map[string]knownvalue.Check{})),
-				},
-			},
-			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
-			},
-			{
-				Config: testAccMonitorConfig_aggregationPeriod(rName, 30),
-				Check: resource.ComposeTestCheckFunc(
-					testAccCheckMonitorExists(ctx, resourceName),
-					resource.TestCheckResourceAttr(resourceName, "aggregation_period", "30"),
-				),
-			},
-		},
-	})
-}
-
-func TestAccNetworkMonitorMonitor_disappears(t *testing.T) {
-	ctx := acctest.Context(t)
-	resourceName := "aws_networkmonitor_monitor.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.NetworkMonitorServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckMonitorDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccMonitorConfig_basic(rName),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckMonitorExists(ctx, resourceName),
-					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfnetworkmonitor.ResourceMonitor, resourceName),
-				),
-				ExpectNonEmptyPlan: true,
-			},
-		},
-	})
-}
-
 func TestAccNetworkMonitorMonitor_aggregationPeriod(t *testing.T) {
 	ctx := acctest.Context(t)
 	resourceName := "aws_networkmonitor_monitor.test"
@@ -160,14 +93,6 @@ func testAccCheckMonitorExists(ctx context.Context, n string) resource.TestCheck
 	}
 }
 
-func testAccMonitorConfig_basic(rName string) string {
-	return fmt.Sprintf(`
-resource "aws_networkmonitor_monitor" "test" {
-  monitor_name = %[1]q
-}
-`, rName)
-}
-
 func testAccMonitorConfig_aggregationPeriod(rName string, aggregation int) string {
 	return fmt.Sprintf(`
 resource "aws_networkmonitor_monitor" "test" {
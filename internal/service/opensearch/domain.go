@@ -15,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/opensearch"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/opensearch/types"
 	awspolicy "github.com/hashicorp/awspolicyequivalence"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
@@ -33,8 +34,17 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+var (
+	masterUserOptionsPath    = cty.GetAttrPath("advanced_security_options").IndexInt(0).GetAttr("master_user_options").IndexInt(0)
+	masterUserPasswordPath   = masterUserOptionsPath.GetAttr("master_user_password")
+	masterUserPasswordWOPath = masterUserOptionsPath.GetAttr("master_user_password_wo")
+)
+
 // @SDKResource("aws_opensearch_domain", name="Domain")
 // @Tags(identifierAttribute="id")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/opensearch/types;awstypes;awstypes.DomainStatus")
+// @Testing(generator="testAccRandomDomainName()")
+// @Testing(writeOnlyAttribute="advanced_security_options.0.master_user_options.0.master_user_password_wo")
 func resourceDomain() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceDomainCreate,
@@ -104,6 +114,10 @@ func resourceDomain() *schema.Resource {
 			verify.SetTagsDiff,
 		),
 
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			validation.PreferWriteOnlyAttribute(masterUserPasswordPath, masterUserPasswordWOPath),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"access_policies": {
 				Type:             schema.TypeString,
@@ -159,9 +173,22 @@ func resourceDomain() *schema.Resource {
 										Optional: true,
 									},
 									"master_user_password": {
-										Type:      schema.TypeString,
-										Optional:  true,
-										Sensitive: true,
+										Type:          schema.TypeString,
+										Optional:      true,
+										Sensitive:     true,
+										ConflictsWith: []string{"advanced_security_options.0.master_user_options.0.master_user_password_wo"},
+									},
+									"master_user_password_wo": {
+										Type:          schema.TypeString,
+										Optional:      true,
+										Sensitive:     true,
+										WriteOnly:     true,
+										ConflictsWith: []string{"advanced_security_options.0.master_user_options.0.master_user_password"},
+									},
+									"master_user_password_wo_version": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										RequiredWith: []string{"advanced_security_options.0.master_user_options.0.master_user_password_wo"},
 									},
 								},
 							},
@@ -690,6 +717,19 @@ func resourceDomainCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		input.AdvancedSecurityOptions = expandAdvancedSecurityOptions(v.([]interface{}))
 	}
 
+	// get write-only value from configuration
+	if input.AdvancedSecurityOptions != nil && input.AdvancedSecurityOptions.MasterUserOptions != nil {
+		masterUserPasswordWO, di := flex.GetWriteOnlyStringValue(d, masterUserPasswordWOPath)
+		diags = append(diags, di...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if masterUserPasswordWO != "" {
+			input.AdvancedSecurityOptions.MasterUserOptions.MasterUserPassword = aws.String(masterUserPasswordWO)
+		}
+	}
+
 	if v, ok := d.GetOk("auto_tune_options"); ok && len(v.([]interface{})) > 0 {
 		input.AutoTuneOptions = expandAutoTuneOptionsInput(v.([]interface{})[0].(map[string]interface{}))
 	}
@@ -1008,6 +1048,18 @@ func resourceDomainUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 
 		if d.HasChange("advanced_security_options") {
 			input.AdvancedSecurityOptions = expandAdvancedSecurityOptions(d.Get("advanced_security_options").([]interface{}))
+
+			if input.AdvancedSecurityOptions.MasterUserOptions != nil {
+				masterUserPasswordWO, di := flex.GetWriteOnlyStringValue(d, masterUserPasswordWOPath)
+				diags = append(diags, di...)
+				if diags.HasError() {
+					return diags
+				}
+
+				if masterUserPasswordWO != "" {
+					input.AdvancedSecurityOptions.MasterUserOptions.MasterUserPassword = aws.String(masterUserPasswordWO)
+				}
+			}
 		}
 
 		if d.HasChange("auto_tune_options") {
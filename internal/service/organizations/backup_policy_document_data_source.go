@@ -0,0 +1,713 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package organizations
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/schedule"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	backupPolicySection = "plans"
+)
+
+// @SDKDataSource("aws_organizations_backup_policy_document", name="Backup Policy Document")
+func dataSourceBackupPolicyDocument() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceBackupPolicyDocumentRead,
+
+		SchemaFunc: func() map[string]*schema.Schema {
+			lifecycleSchema := func() *schema.Schema {
+				return &schema.Schema{
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"delete_after_days": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntAtLeast(1),
+							},
+							"move_to_cold_storage_after_days": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntAtLeast(1),
+							},
+							"opt_in_to_archive_for_supported_resources": {
+								Type:     schema.TypeBool,
+								Optional: true,
+							},
+						},
+					},
+				}
+			}
+
+			s := map[string]*schema.Schema{
+				"plan": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"advanced_backup_setting": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"backup_options": {
+											Type:     schema.TypeMap,
+											Required: true,
+											Elem: &schema.Schema{
+												Type: schema.TypeString,
+											},
+										},
+										names.AttrResourceType: {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+									},
+								},
+							},
+							"backup_plan_tags": {
+								Type:     schema.TypeMap,
+								Optional: true,
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+							},
+							names.AttrName: {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"regions": {
+								Type:     schema.TypeSet,
+								Optional: true,
+								Elem: &schema.Schema{
+									Type:         schema.TypeString,
+									ValidateFunc: verify.ValidRegionName,
+								},
+							},
+							names.AttrRule: {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"complete_backup_window_minutes": {
+											Type:         schema.TypeInt,
+											Optional:     true,
+											ValidateFunc: validation.IntAtLeast(1),
+										},
+										"copy_action": {
+											Type:     schema.TypeList,
+											Optional: true,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"lifecycle": lifecycleSchema(),
+													"target_backup_vault_arn": {
+														Type:     schema.TypeString,
+														Required: true,
+													},
+												},
+											},
+										},
+										"enable_continuous_backup": {
+											Type:     schema.TypeBool,
+											Optional: true,
+										},
+										"lifecycle": lifecycleSchema(),
+										names.AttrName: {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+										"recovery_point_tags": {
+											Type:     schema.TypeMap,
+											Optional: true,
+											Elem: &schema.Schema{
+												Type: schema.TypeString,
+											},
+										},
+										names.AttrScheduleExpression: {
+											Type:         schema.TypeString,
+											Optional:     true,
+											ValidateFunc: verify.ValidScheduleExpression(schedule.TypeCron),
+										},
+										"schedule_expression_timezone": {
+											Type:     schema.TypeString,
+											Optional: true,
+										},
+										"start_backup_window_minutes": {
+											Type:         schema.TypeInt,
+											Optional:     true,
+											ValidateFunc: validation.IntAtLeast(1),
+										},
+										"target_backup_vault_name": {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+									},
+								},
+							},
+							"selection_resource": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										names.AttrIAMRoleARN: {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+										names.AttrName: {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+										"not_resource_types": {
+											Type:     schema.TypeSet,
+											Optional: true,
+											Elem: &schema.Schema{
+												Type: schema.TypeString,
+											},
+										},
+										"resource_types": {
+											Type:     schema.TypeSet,
+											Required: true,
+											Elem: &schema.Schema{
+												Type: schema.TypeString,
+											},
+										},
+									},
+								},
+							},
+							"selection_tag": {
+								Type:     schema.TypeList,
+								Optional: true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										names.AttrIAMRoleARN: {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+										names.AttrKey: {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+										names.AttrName: {
+											Type:     schema.TypeString,
+											Required: true,
+										},
+										names.AttrValues: {
+											Type:     schema.TypeSet,
+											Required: true,
+											Elem: &schema.Schema{
+												Type: schema.TypeString,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			}
+			maps.Copy(s, policyDocumentOutputSchema())
+
+			return s
+		},
+	}
+}
+
+func dataSourceBackupPolicyDocumentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	plans, err := expandBackupPolicyPlans(d.Get("plan").([]interface{}))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "writing Organizations Backup Policy Document: %s", err)
+	}
+
+	doc, err := mergeManagementPolicyDocuments(d.Get("source_policy_documents").([]interface{}), managementPolicyDocument{backupPolicySection: plans}, d.Get("override_policy_documents").([]interface{}))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "writing Organizations Backup Policy Document: %s", err)
+	}
+
+	if err := setPolicyDocument(d, doc, validateBackupPolicyDocument); err != nil {
+		return sdkdiag.AppendErrorf(diags, "writing Organizations Backup Policy Document: %s", err)
+	}
+
+	return diags
+}
+
+func expandBackupPolicyPlans(tfList []interface{}) (map[string]any, error) {
+	apiObjects := make(map[string]any)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name := tfMap[names.AttrName].(string)
+		if _, ok := apiObjects[name]; ok {
+			return nil, fmt.Errorf("duplicate plan name (%s)", name)
+		}
+
+		apiObject := make(map[string]any)
+
+		if v, ok := tfMap["advanced_backup_setting"].([]interface{}); ok && len(v) > 0 {
+			settings := make(map[string]any)
+			for _, tfMapRaw := range v {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+				if !ok {
+					continue
+				}
+
+				options := make(map[string]any)
+				for k, v := range tfMap["backup_options"].(map[string]interface{}) {
+					options[k] = managementPolicyAssign(v)
+				}
+				settings[strings.ToLower(tfMap[names.AttrResourceType].(string))] = options
+			}
+			apiObject["advanced_backup_settings"] = settings
+		}
+
+		if v, ok := tfMap["backup_plan_tags"].(map[string]interface{}); ok && len(v) > 0 {
+			apiObject["backup_plan_tags"] = expandBackupPolicyTags(v)
+		}
+
+		if v, ok := tfMap["regions"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject["regions"] = managementPolicyAssign(slices.Sorted(slices.Values(flex.ExpandStringValueSet(v))))
+		}
+
+		if v, ok := tfMap[names.AttrRule].([]interface{}); ok && len(v) > 0 {
+			rules, err := expandBackupPolicyRules(v)
+			if err != nil {
+				return nil, fmt.Errorf("plan (%s): %w", name, err)
+			}
+			apiObject["rules"] = rules
+		}
+
+		selections := make(map[string]any)
+
+		if v, ok := tfMap["selection_resource"].([]interface{}); ok && len(v) > 0 {
+			resources := make(map[string]any)
+			for _, tfMapRaw := range v {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+				if !ok {
+					continue
+				}
+
+				selectionName := tfMap[names.AttrName].(string)
+				if _, ok := resources[selectionName]; ok {
+					return nil, fmt.Errorf("plan (%s): duplicate resource selection name (%s)", name, selectionName)
+				}
+
+				selection := map[string]any{
+					"iam_role_arn":   managementPolicyAssign(tfMap[names.AttrIAMRoleARN].(string)),
+					"resource_types": managementPolicyAssign(slices.Sorted(slices.Values(flex.ExpandStringValueSet(tfMap["resource_types"].(*schema.Set))))),
+				}
+
+				if v, ok := tfMap["not_resource_types"].(*schema.Set); ok && v.Len() > 0 {
+					selection["not_resource_types"] = managementPolicyAssign(slices.Sorted(slices.Values(flex.ExpandStringValueSet(v))))
+				}
+
+				resources[selectionName] = selection
+			}
+			selections["resources"] = resources
+		}
+
+		if v, ok := tfMap["selection_tag"].([]interface{}); ok && len(v) > 0 {
+			tags := make(map[string]any)
+			for _, tfMapRaw := range v {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+				if !ok {
+					continue
+				}
+
+				selectionName := tfMap[names.AttrName].(string)
+				if _, ok := tags[selectionName]; ok {
+					return nil, fmt.Errorf("plan (%s): duplicate tag selection name (%s)", name, selectionName)
+				}
+
+				tags[selectionName] = map[string]any{
+					"iam_role_arn": managementPolicyAssign(tfMap[names.AttrIAMRoleARN].(string)),
+					"tag_key":      managementPolicyAssign(tfMap[names.AttrKey].(string)),
+					"tag_value":    managementPolicyAssign(slices.Sorted(slices.Values(flex.ExpandStringValueSet(tfMap[names.AttrValues].(*schema.Set))))),
+				}
+			}
+			selections["tags"] = tags
+		}
+
+		if len(selections) > 0 {
+			apiObject["selections"] = selections
+		}
+
+		apiObjects[name] = apiObject
+	}
+
+	return apiObjects, nil
+}
+
+func expandBackupPolicyRules(tfList []interface{}) (map[string]any, error) {
+	apiObjects := make(map[string]any)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name := tfMap[names.AttrName].(string)
+		if _, ok := apiObjects[name]; ok {
+			return nil, fmt.Errorf("duplicate rule name (%s)", name)
+		}
+
+		apiObject := map[string]any{
+			"target_backup_vault_name": managementPolicyAssign(tfMap["target_backup_vault_name"].(string)),
+		}
+
+		if v, ok := tfMap["complete_backup_window_minutes"].(int); ok && v > 0 {
+			apiObject["complete_backup_window_minutes"] = managementPolicyAssign(strconv.Itoa(v))
+		}
+
+		if v, ok := tfMap["copy_action"].([]interface{}); ok && len(v) > 0 {
+			copyActions := make(map[string]any)
+			for _, tfMapRaw := range v {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+				if !ok {
+					continue
+				}
+
+				targetBackupVaultARN := tfMap["target_backup_vault_arn"].(string)
+				copyAction := map[string]any{
+					"target_backup_vault_arn": managementPolicyAssign(targetBackupVaultARN),
+				}
+
+				if v, ok := tfMap["lifecycle"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+					copyAction["lifecycle"] = expandBackupPolicyLifecycle(v[0].(map[string]interface{}))
+				}
+
+				// Copy actions are keyed by the target backup vault ARN.
+				copyActions[targetBackupVaultARN] = copyAction
+			}
+			apiObject["copy_actions"] = copyActions
+		}
+
+		if v, ok := tfMap["enable_continuous_backup"].(bool); ok && v {
+			apiObject["enable_continuous_backup"] = managementPolicyAssign(v)
+		}
+
+		if v, ok := tfMap["lifecycle"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject["lifecycle"] = expandBackupPolicyLifecycle(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["recovery_point_tags"].(map[string]interface{}); ok && len(v) > 0 {
+			apiObject["recovery_point_tags"] = expandBackupPolicyTags(v)
+		}
+
+		if v, ok := tfMap[names.AttrScheduleExpression].(string); ok && v != "" {
+			apiObject[names.AttrScheduleExpression] = managementPolicyAssign(v)
+		}
+
+		if v, ok := tfMap["schedule_expression_timezone"].(string); ok && v != "" {
+			apiObject["schedule_expression_timezone"] = managementPolicyAssign(v)
+		}
+
+		if v, ok := tfMap["start_backup_window_minutes"].(int); ok && v > 0 {
+			apiObject["start_backup_window_minutes"] = managementPolicyAssign(strconv.Itoa(v))
+		}
+
+		apiObjects[name] = apiObject
+	}
+
+	return apiObjects, nil
+}
+
+func expandBackupPolicyLifecycle(tfMap map[string]interface{}) map[string]any {
+	apiObject := make(map[string]any)
+
+	if v, ok := tfMap["delete_after_days"].(int); ok && v > 0 {
+		apiObject["delete_after_days"] = managementPolicyAssign(strconv.Itoa(v))
+	}
+
+	if v, ok := tfMap["move_to_cold_storage_after_days"].(int); ok && v > 0 {
+		apiObject["move_to_cold_storage_after_days"] = managementPolicyAssign(strconv.Itoa(v))
+	}
+
+	if v, ok := tfMap["opt_in_to_archive_for_supported_resources"].(bool); ok && v {
+		apiObject["opt_in_to_archive_for_supported_resources"] = managementPolicyAssign(strconv.FormatBool(v))
+	}
+
+	return apiObject
+}
+
+// expandBackupPolicyTags returns backup plan or recovery point tag entries keyed by the lowercase tag key.
+func expandBackupPolicyTags(tfMap map[string]interface{}) map[string]any {
+	apiObject := make(map[string]any)
+
+	for k, v := range tfMap {
+		apiObject[strings.ToLower(k)] = map[string]any{
+			"tag_key":   managementPolicyAssign(k),
+			"tag_value": managementPolicyAssign(v),
+		}
+	}
+
+	return apiObject
+}
+
+// validateBackupPolicyDocument validates a minified backup policy document.
+// Elements not described in the backup policy syntax are not validated, as new elements are added as AWS Backup features are released.
+// See https://docs.aws.amazon.com/organizations/latest/userguide/orgs_manage_policies_backup_syntax.html.
+func validateBackupPolicyDocument(content string) error {
+	plans, err := managementPolicyRoot(content, backupPolicySection)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(plans)) {
+		path := backupPolicySection + "." + name
+
+		plan, err := managementPolicyObject(path, plans[name])
+		if err != nil {
+			return err
+		}
+
+		if err := validateManagementPolicyValues(path, plan, map[string]managementPolicyValueElement{
+			"regions": {typ: managementPolicyValueTypeStringList},
+		}); err != nil {
+			return err
+		}
+
+		if v, ok := plan["rules"]; ok {
+			rules, err := managementPolicyObject(path+".rules", v)
+			if err != nil {
+				return err
+			}
+
+			for _, name := range slices.Sorted(maps.Keys(rules)) {
+				if err := validateBackupPolicyRule(path+".rules."+name, rules[name]); err != nil {
+					return err
+				}
+			}
+		}
+
+		if v, ok := plan["selections"]; ok {
+			if err := validateBackupPolicySelections(path+".selections", v); err != nil {
+				return err
+			}
+		}
+
+		if v, ok := plan["advanced_backup_settings"]; ok {
+			settings, err := managementPolicyObject(path+".advanced_backup_settings", v)
+			if err != nil {
+				return err
+			}
+
+			for _, resourceType := range slices.Sorted(maps.Keys(settings)) {
+				path := path + ".advanced_backup_settings." + resourceType
+
+				options, err := managementPolicyObject(path, settings[resourceType])
+				if err != nil {
+					return err
+				}
+
+				for _, k := range slices.Sorted(maps.Keys(options)) {
+					if k == policyOperatorOperatorsAllowedForChildPolicies {
+						continue
+					}
+
+					if err := validateManagementPolicyValue(path+"."+k, options[k], managementPolicyValueTypeString, nil); err != nil {
+						return err
+					}
+				}
+			}
+		}
+
+		if v, ok := plan["backup_plan_tags"]; ok {
+			if err := validateBackupPolicyTags(path+".backup_plan_tags", v); err != nil {
+				return err
+			}
+		}
+	}
+
+	return validatePolicyDocumentSize(content, backupPolicyMaxSize)
+}
+
+func validateBackupPolicyRule(path string, v any) error {
+	rule, err := managementPolicyObject(path, v)
+	if err != nil {
+		return err
+	}
+
+	if err := validateManagementPolicyValues(path, rule, map[string]managementPolicyValueElement{
+		"complete_backup_window_minutes": {typ: managementPolicyValueTypeInteger},
+		"enable_continuous_backup":       {typ: managementPolicyValueTypeBool},
+		names.AttrScheduleExpression: {typ: managementPolicyValueTypeString, validate: func(s string) error {
+			_, err := schedule.ParseAllowed(s, schedule.TypeCron)
+			return err
+		}},
+		"schedule_expression_timezone": {typ: managementPolicyValueTypeString},
+		"start_backup_window_minutes":  {typ: managementPolicyValueTypeInteger},
+		"target_backup_vault_name":     {typ: managementPolicyValueTypeString},
+	}); err != nil {
+		return err
+	}
+
+	if v, ok := rule["lifecycle"]; ok {
+		if err := validateBackupPolicyLifecycle(path+".lifecycle", v); err != nil {
+			return err
+		}
+	}
+
+	if v, ok := rule["copy_actions"]; ok {
+		copyActions, err := managementPolicyObject(path+".copy_actions", v)
+		if err != nil {
+			return err
+		}
+
+		for _, k := range slices.Sorted(maps.Keys(copyActions)) {
+			path := path + ".copy_actions." + k
+
+			copyAction, err := managementPolicyObject(path, copyActions[k], "lifecycle", "target_backup_vault_arn")
+			if err != nil {
+				return err
+			}
+
+			if err := validateManagementPolicyValues(path, copyAction, map[string]managementPolicyValueElement{
+				"target_backup_vault_arn": {typ: managementPolicyValueTypeString, validate: validateBackupPolicyARN},
+			}); err != nil {
+				return err
+			}
+
+			if v, ok := copyAction["lifecycle"]; ok {
+				if err := validateBackupPolicyLifecycle(path+".lifecycle", v); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	if v, ok := rule["recovery_point_tags"]; ok {
+		if err := validateBackupPolicyTags(path+".recovery_point_tags", v); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateBackupPolicyLifecycle(path string, v any) error {
+	lifecycle, err := managementPolicyObject(path, v, "delete_after_days", "move_to_cold_storage_after_days", "opt_in_to_archive_for_supported_resources")
+	if err != nil {
+		return err
+	}
+
+	return validateManagementPolicyValues(path, lifecycle, map[string]managementPolicyValueElement{
+		"delete_after_days":                         {typ: managementPolicyValueTypeInteger},
+		"move_to_cold_storage_after_days":           {typ: managementPolicyValueTypeInteger},
+		"opt_in_to_archive_for_supported_resources": {typ: managementPolicyValueTypeBool},
+	})
+}
+
+func validateBackupPolicySelections(path string, v any) error {
+	selections, err := managementPolicyObject(path, v, "resources", "tags")
+	if err != nil {
+		return err
+	}
+
+	if v, ok := selections["resources"]; ok {
+		resources, err := managementPolicyObject(path+".resources", v)
+		if err != nil {
+			return err
+		}
+
+		for _, k := range slices.Sorted(maps.Keys(resources)) {
+			path := path + ".resources." + k
+
+			selection, err := managementPolicyObject(path, resources[k])
+			if err != nil {
+				return err
+			}
+
+			if err := validateManagementPolicyValues(path, selection, map[string]managementPolicyValueElement{
+				"iam_role_arn":       {typ: managementPolicyValueTypeString, validate: validateBackupPolicyARN},
+				"not_resource_types": {typ: managementPolicyValueTypeStringList},
+				"resource_types":     {typ: managementPolicyValueTypeStringList},
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	if v, ok := selections["tags"]; ok {
+		tags, err := managementPolicyObject(path+".tags", v)
+		if err != nil {
+			return err
+		}
+
+		for _, k := range slices.Sorted(maps.Keys(tags)) {
+			path := path + ".tags." + k
+
+			selection, err := managementPolicyObject(path, tags[k], "iam_role_arn", "tag_key", "tag_value")
+			if err != nil {
+				return err
+			}
+
+			if err := validateManagementPolicyValues(path, selection, map[string]managementPolicyValueElement{
+				"iam_role_arn": {typ: managementPolicyValueTypeString, validate: validateBackupPolicyARN},
+				"tag_key":      {typ: managementPolicyValueTypeString},
+				"tag_value":    {typ: managementPolicyValueTypeStringList},
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateBackupPolicyTags(path string, v any) error {
+	tags, err := managementPolicyObject(path, v)
+	if err != nil {
+		return err
+	}
+
+	for _, k := range slices.Sorted(maps.Keys(tags)) {
+		path := path + "." + k
+
+		tag, err := managementPolicyObject(path, tags[k], "tag_key", "tag_value")
+		if err != nil {
+			return err
+		}
+
+		if err := validateManagementPolicyValues(path, tag, map[string]managementPolicyValueElement{
+			"tag_key":   {typ: managementPolicyValueTypeString},
+			"tag_value": {typ: managementPolicyValueTypeString},
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateBackupPolicyARN validates an ARN, which may contain the `$account` variable.
+func validateBackupPolicyARN(s string) error {
+	if !arn.IsARN(s) {
+		return fmt.Errorf("%q is not a valid ARN", s)
+	}
+
+	return nil
+}
@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package organizations_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tforganizations "github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestValidateBackupPolicyDocument(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		content string
+		wantErr string
+	}{
+		"valid": {
+			content: `{"plans":{"p":{"regions":{"@@append":["us-east-1"]},"rules":{"daily":{"schedule_expression":{"@@assign":"cron(0 5 ? * * *)"},"start_backup_window_minutes":{"@@assign":"60"},"enable_continuous_backup":{"@@assign":false},"target_backup_vault_name":{"@@assign":"Default"},"lifecycle":{"delete_after_days":{"@@assign":"30"},"opt_in_to_archive_for_supported_resources":{"@@assign":"false"}},"copy_actions":{"arn:aws:backup:us-west-2:$account:backup-vault:Default":{"target_backup_vault_arn":{"@@assign":"arn:aws:backup:us-west-2:$account:backup-vault:Default"}}}}},"selections":{"tags":{"all":{"iam_role_arn":{"@@assign":"arn:aws:iam::$account:role/Backup"},"tag_key":{"@@assign":"backup"},"tag_value":{"@@assign":["true"]}}}},"advanced_backup_settings":{"ec2":{"windows_vss":{"@@assign":"enabled"}}},"backup_plan_tags":{"stage":{"tag_key":{"@@assign":"Stage"},"tag_value":{"@@assign":"Beta"}}}}}}`,
+		},
+		"unknown rule element": {
+			content: `{"plans":{"p":{"rules":{"daily":{"index_actions":{"resource_types":{"@@assign":["EBS"]}}}}}}}`,
+		},
+		"integer value": {
+			content: `{"plans":{"p":{"rules":{"daily":{"start_backup_window_minutes":{"@@assign":60}}}}}}`,
+			wantErr: `plans.p.rules.daily.start_backup_window_minutes.@@assign: expected a non-negative integer string`,
+		},
+		"invalid schedule expression": {
+			content: `{"plans":{"p":{"rules":{"daily":{"schedule_expression":{"@@assign":"rate(1 day)"}}}}}}`,
+			wantErr: "plans.p.rules.daily.schedule_expression.@@assign:",
+		},
+		"unsupported lifecycle element": {
+			content: `{"plans":{"p":{"rules":{"daily":{"lifecycle":{"delete_after":{"@@assign":"30"}}}}}}}`,
+			wantErr: `plans.p.rules.daily.lifecycle: unsupported element "delete_after"`,
+		},
+		"append scalar": {
+			content: `{"plans":{"p":{"rules":{"daily":{"target_backup_vault_name":{"@@append":"Default"}}}}}}`,
+			wantErr: "operator @@append is only supported for list values",
+		},
+		"unsupported selection type": {
+			content: `{"plans":{"p":{"selections":{"tag":{}}}}}`,
+			wantErr: `plans.p.selections: unsupported element "tag"`,
+		},
+		"invalid role ARN": {
+			content: `{"plans":{"p":{"selections":{"resources":{"all":{"iam_role_arn":{"@@assign":"Backup"},"resource_types":{"@@assign":["*"]}}}}}}}`,
+			wantErr: `"Backup" is not a valid ARN`,
+		},
+		"unsupported top-level element": {
+			content: `{"plan":{}}`,
+			wantErr: `unsupported policy element "plan", expected "plans"`,
+		},
+		"too large": {
+			content: `{"plans":{"p":{"regions":{"@@assign":["` + strings.Repeat(strings.Repeat("a", 100)+`","`, 100) + `a"]}}}}`,
+			wantErr: "exceeding the maximum size of 10000 characters",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tforganizations.ValidateBackupPolicyDocument(testCase.content)
+
+			if testCase.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), testCase.wantErr) {
+				t.Errorf("expected error containing %q, got %v", testCase.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestAccOrganizationsBackupPolicyDocumentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_organizations_backup_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OrganizationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBackupPolicyDocumentDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, names.AttrJSON, testAccBackupPolicyDocumentDataSourceExpectedJSON_basic),
+					resource.TestCheckResourceAttr(dataSourceName, "minified_json", testAccBackupPolicyDocumentDataSourceExpectedJSON_basic),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOrganizationsBackupPolicyDocumentDataSource_duplicateSourcePlan(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OrganizationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccBackupPolicyDocumentDataSourceConfig_duplicateSourcePlan,
+				ExpectError: regexache.MustCompile(`merging source document 1: duplicate plans key \(PII_backup_plan\)`),
+			},
+		},
+	})
+}
+
+const testAccBackupPolicyDocumentDataSourceExpectedJSON_basic = `{"plans":{"PII_backup_plan":{"backup_plan_tags":{"stage":{"tag_key":{"@@assign":"Stage"},"tag_value":{"@@assign":"Beta"}}},"regions":{"@@assign":["eu-west-1","us-east-1"]},"rules":{"Hourly":{"copy_actions":{"arn:aws:backup:us-west-2:$account:backup-vault:secondary":{"lifecycle":{"delete_after_days":{"@@assign":"120"}},"target_backup_vault_arn":{"@@assign":"arn:aws:backup:us-west-2:$account:backup-vault:secondary"}}},"lifecycle":{"delete_after_days":{"@@assign":"270"},"move_to_cold_storage_after_days":{"@@assign":"180"}},"schedule_expression":{"@@assign":"cron(0 5/1 ? * * *)"},"start_backup_window_minutes":{"@@assign":"480"},"target_backup_vault_name":{"@@assign":"FortKnox"}}},"selections":{"tags":{"datatype":{"iam_role_arn":{"@@assign":"arn:aws:iam::$account:role/MyIamRole"},"tag_key":{"@@assign":"dataType"},"tag_value":{"@@assign":["PII","RED"]}}}}}}}`
+
+const testAccBackupPolicyDocumentDataSourceConfig_basic = `
+data "aws_organizations_backup_policy_document" "test" {
+  plan {
+    name    = "PII_backup_plan"
+    regions = ["us-east-1", "eu-west-1"]
+
+    rule {
+      name                        = "Hourly"
+      schedule_expression         = "cron(0 5/1 ? * * *)"
+      start_backup_window_minutes = 480
+      target_backup_vault_name    = "FortKnox"
+
+      lifecycle {
+        delete_after_days               = 270
+        move_to_cold_storage_after_days = 180
+      }
+
+      copy_action {
+        target_backup_vault_arn = "arn:aws:backup:us-west-2:$account:backup-vault:secondary"
+
+        lifecycle {
+          delete_after_days = 120
+        }
+      }
+    }
+
+    selection_tag {
+      name         = "datatype"
+      iam_role_arn = "arn:aws:iam::$account:role/MyIamRole"
+      key          = "dataType"
+      values       = ["RED", "PII"]
+    }
+
+    backup_plan_tags = {
+      Stage = "Beta"
+    }
+  }
+}
+`
+
+const testAccBackupPolicyDocumentDataSourceConfig_duplicateSourcePlan = `
+data "aws_organizations_backup_policy_document" "source" {
+  plan {
+    name    = "PII_backup_plan"
+    regions = ["us-east-1"]
+  }
+}
+
+data "aws_organizations_backup_policy_document" "test" {
+  source_policy_documents = [
+    data.aws_organizations_backup_policy_document.source.json,
+    data.aws_organizations_backup_policy_document.source.json,
+  ]
+}
+`
@@ -10,3 +10,12 @@ import (
 const (
 	organizationFinalizationTimeout = 4 * time.Minute
 )
+
+// Maximum policy document sizes, in characters.
+// See https://docs.aws.amazon.com/organizations/latest/userguide/orgs_reference_limits.html#min-max-values.
+const (
+	backupPolicyMaxSize          = 10000
+	resourceControlPolicyMaxSize = 5120
+	serviceControlPolicyMaxSize  = 5120
+	tagPolicyMaxSize             = 10000
+)
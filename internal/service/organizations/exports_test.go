@@ -18,4 +18,9 @@ var (
 	FindPolicyAttachmentByTwoPartKey = findPolicyAttachmentByTwoPartKey
 	FindPolicyByID                   = findPolicyByID
 	FindResourcePolicy               = findResourcePolicy
+
+	ValidateBackupPolicyDocument          = validateBackupPolicyDocument
+	ValidateResourceControlPolicyDocument = validateResourceControlPolicyDocument
+	ValidateServiceControlPolicyDocument  = validateServiceControlPolicyDocument
+	ValidateTagPolicyDocument             = validateTagPolicyDocument
 )
@@ -0,0 +1,663 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package organizations
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Helpers shared by the Organizations policy document data sources.
+
+const (
+	policyDocumentVersion = "2012-10-17"
+)
+
+var policyDocumentVarReplacer = strings.NewReplacer("&{", "${")
+
+func policyDocumentsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type:         schema.TypeString,
+			ValidateFunc: validation.StringIsJSON,
+		},
+	}
+}
+
+func policyDocumentOutputSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		names.AttrJSON: {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"minified_json": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"override_policy_documents": policyDocumentsSchema(),
+		"source_policy_documents":   policyDocumentsSchema(),
+	}
+}
+
+// marshalPolicyDocument returns the indented and minified JSON representations of a policy document.
+// HTML characters are not escaped so that the length of the minified document is the size counted by AWS.
+func marshalPolicyDocument(v any) (string, string, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+
+	if err := enc.Encode(v); err != nil {
+		return "", "", err
+	}
+
+	minified := bytes.TrimSuffix(buf.Bytes(), []byte("\n"))
+
+	var indented bytes.Buffer
+	if err := json.Indent(&indented, minified, "", "  "); err != nil {
+		return "", "", err
+	}
+
+	return indented.String(), string(minified), nil
+}
+
+// setPolicyDocument validates the policy document and sets the data source's JSON outputs.
+func setPolicyDocument(d *schema.ResourceData, v any, validate func(string) error) error {
+	jsonString, minifiedJSONString, err := marshalPolicyDocument(v)
+	if err != nil {
+		return fmt.Errorf("formatting JSON: %w", err)
+	}
+
+	if err := validate(minifiedJSONString); err != nil {
+		return err
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
+	d.Set(names.AttrJSON, jsonString)
+	d.Set("minified_json", minifiedJSONString)
+
+	return nil
+}
+
+func validatePolicyDocumentSize(content string, maxSize int) error {
+	if n := utf8.RuneCountInString(content); n > maxSize {
+		return fmt.Errorf("minified policy document is %d characters, exceeding the maximum size of %d characters", n, maxSize)
+	}
+
+	return nil
+}
+
+// Statement-based (authorization) policies: service control policies and resource control policies.
+
+func policyStatementStringSetSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Schema{
+			Type: schema.TypeString,
+		},
+	}
+}
+
+func policyStatementConditionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"test": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				names.AttrValues: {
+					Type:     schema.TypeList,
+					Required: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
+				"variable": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func expandPolicyStatements(tfList []any) ([]*tfiam.IAMPolicyStatement, error) {
+	apiObjects := make([]*tfiam.IAMPolicyStatement, 0, len(tfList))
+	sids := make(map[string]struct{})
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		apiObject := &tfiam.IAMPolicyStatement{
+			Effect: tfMap["effect"].(string),
+		}
+
+		if v, ok := tfMap["sid"].(string); ok && v != "" {
+			if _, ok := sids[v]; ok {
+				return nil, fmt.Errorf("duplicate Sid (%s). Remove the Sid or ensure the Sid is unique.", v)
+			}
+			sids[v] = struct{}{}
+			apiObject.Sid = v
+		}
+
+		if v, ok := tfMap[names.AttrActions].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Actions = expandPolicyStatementStrings(v.List())
+		}
+
+		if v, ok := tfMap["not_actions"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.NotActions = expandPolicyStatementStrings(v.List())
+		}
+
+		if v, ok := tfMap[names.AttrResources].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Resources = expandPolicyStatementStrings(v.List())
+		}
+
+		if v, ok := tfMap["not_resources"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.NotResources = expandPolicyStatementStrings(v.List())
+		}
+
+		if v, ok := tfMap[names.AttrCondition].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Conditions = expandPolicyStatementConditions(v.List())
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects, nil
+}
+
+// expandPolicyStatementStrings returns a single string or a sorted list of strings, replacing `&{` with `${`.
+func expandPolicyStatementStrings(tfList []any) any {
+	if len(tfList) == 1 {
+		return policyDocumentVarReplacer.Replace(tfList[0].(string))
+	}
+
+	apiObject := make([]string, len(tfList))
+	for i, v := range tfList {
+		apiObject[i] = policyDocumentVarReplacer.Replace(v.(string))
+	}
+	slices.Sort(apiObject)
+
+	return apiObject
+}
+
+func expandPolicyStatementConditions(tfList []any) tfiam.IAMPolicyStatementConditionSet {
+	apiObjects := make(tfiam.IAMPolicyStatementConditionSet, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		values := make([]string, 0)
+		for _, v := range tfMap[names.AttrValues].([]any) {
+			v, _ := v.(string)
+			values = append(values, policyDocumentVarReplacer.Replace(v))
+		}
+
+		apiObject := tfiam.IAMPolicyStatementCondition{
+			Test:     tfMap["test"].(string),
+			Variable: tfMap["variable"].(string),
+			Values:   values,
+		}
+
+		if len(values) == 1 {
+			apiObject.Values = values[0]
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+// mergePolicyStatementDocuments merges the source documents, the configured statements and the override documents, in that order.
+// Statement IDs must be unique across source documents. Statements with the same Sid are replaced by later statements.
+func mergePolicyStatementDocuments(sourceDocuments []any, statements []*tfiam.IAMPolicyStatement, overrideDocuments []any) (*tfiam.IAMPolicyDoc, error) {
+	mergedDoc := &tfiam.IAMPolicyDoc{}
+	sids := make(map[string]struct{})
+
+	for i, v := range sourceDocuments {
+		v, ok := v.(string)
+		if !ok || v == "" {
+			continue
+		}
+
+		sourceDoc := &tfiam.IAMPolicyDoc{}
+		if err := json.Unmarshal([]byte(v), sourceDoc); err != nil {
+			return nil, fmt.Errorf("merging source document %d: %w", i, err)
+		}
+
+		for j, statement := range sourceDoc.Statements {
+			if statement.Sid == "" {
+				continue
+			}
+
+			if _, ok := sids[statement.Sid]; ok {
+				return nil, fmt.Errorf("merging source document %d: duplicate Sid (%s) in source_policy_documents (statement %d). Remove the Sid or ensure Sids are unique.", i, statement.Sid, j)
+			}
+			sids[statement.Sid] = struct{}{}
+		}
+
+		mergedDoc.Merge(sourceDoc)
+	}
+
+	mergedDoc.Merge(&tfiam.IAMPolicyDoc{
+		Version:    policyDocumentVersion,
+		Statements: statements,
+	})
+
+	for i, v := range overrideDocuments {
+		v, ok := v.(string)
+		if !ok || v == "" {
+			continue
+		}
+
+		overrideDoc := &tfiam.IAMPolicyDoc{}
+		if err := json.Unmarshal([]byte(v), overrideDoc); err != nil {
+			return nil, fmt.Errorf("merging override document %d: %w", i, err)
+		}
+
+		mergedDoc.Merge(overrideDoc)
+	}
+
+	return mergedDoc, nil
+}
+
+// policyStatementGrammar describes the statement grammar of an authorization policy type.
+type policyStatementGrammar struct {
+	// elements are the supported statement elements.
+	elements []string
+	// effects are the supported values of the Effect element.
+	effects []string
+	// actionRequired is true if the Action element is required.
+	// Otherwise exactly one of the Action and NotAction elements is required.
+	actionRequired bool
+	// principalRequired is true if statements must apply to all principals (`"Principal": "*"`).
+	principalRequired bool
+}
+
+func validatePolicyStatementDocument(content string, grammar policyStatementGrammar) error {
+	var doc map[string]any
+	if err := json.Unmarshal([]byte(content), &doc); err != nil {
+		return err
+	}
+
+	for _, k := range slices.Sorted(maps.Keys(doc)) {
+		switch k {
+		case "Id", "Statement", "Version":
+		default:
+			return fmt.Errorf("unsupported policy element %q", k)
+		}
+	}
+
+	if v := doc["Version"]; v != policyDocumentVersion {
+		return fmt.Errorf("policy Version must be %q", policyDocumentVersion)
+	}
+
+	var statements []any
+	switch v := doc["Statement"].(type) {
+	case map[string]any:
+		statements = []any{v}
+	case []any:
+		statements = v
+	}
+
+	if len(statements) == 0 {
+		return fmt.Errorf("policy must contain at least one Statement")
+	}
+
+	for i, v := range statements {
+		statement, ok := v.(map[string]any)
+		if !ok {
+			return fmt.Errorf("statement %d: expected an object", i)
+		}
+
+		name := fmt.Sprintf("statement %d", i)
+		if v, ok := statement["Sid"].(string); ok && v != "" {
+			name = fmt.Sprintf("%s (%s)", name, v)
+		}
+
+		for _, k := range slices.Sorted(maps.Keys(statement)) {
+			if !slices.Contains(grammar.elements, k) {
+				return fmt.Errorf("%s: unsupported element %q", name, k)
+			}
+		}
+
+		if v, ok := statement["Effect"].(string); !ok || !slices.Contains(grammar.effects, v) {
+			return fmt.Errorf("%s: Effect must be one of %s", name, strings.Join(grammar.effects, ", "))
+		}
+
+		_, hasAction := statement["Action"]
+		_, hasNotAction := statement["NotAction"]
+		switch {
+		case grammar.actionRequired && !hasAction:
+			return fmt.Errorf("%s: Action is required", name)
+		case hasAction == hasNotAction:
+			return fmt.Errorf("%s: exactly one of Action or NotAction is required", name)
+		}
+
+		if _, ok := statement["Resource"]; ok {
+			if _, ok := statement["NotResource"]; ok {
+				return fmt.Errorf("%s: only one of Resource or NotResource can be specified", name)
+			}
+		}
+
+		if grammar.principalRequired {
+			if v, ok := statement["Principal"].(string); !ok || v != "*" {
+				return fmt.Errorf(`%s: Principal must be "*"`, name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Management policies: tag policies and backup policies.
+// See https://docs.aws.amazon.com/organizations/latest/userguide/orgs_manage_policies_inheritance_mgmt.html.
+
+const (
+	policyOperatorAppend                           = "@@append"
+	policyOperatorAssign                           = "@@assign"
+	policyOperatorOperatorsAllowedForChildPolicies = "@@operators_allowed_for_child_policies"
+	policyOperatorRemove                           = "@@remove"
+
+	// Values of the child control operator.
+	policyOperatorAll  = "@@all"
+	policyOperatorNone = "@@none"
+)
+
+func policyOperatorChildControlValues() []string {
+	return []string{
+		policyOperatorAll,
+		policyOperatorAppend,
+		policyOperatorAssign,
+		policyOperatorNone,
+		policyOperatorRemove,
+	}
+}
+
+// managementPolicyDocument is a management policy document.
+// Each top-level section (e.g. "tags" or "plans") maps policy keys to their definitions.
+type managementPolicyDocument map[string]map[string]any
+
+// merge merges another document into the document entry by entry.
+// If unique is true an entry defined in both documents is an error, otherwise the other document's entry replaces the existing entry.
+func (doc managementPolicyDocument) merge(other managementPolicyDocument, unique bool) error {
+	for _, section := range slices.Sorted(maps.Keys(other)) {
+		entries := other[section]
+		if len(entries) == 0 {
+			continue
+		}
+
+		if doc[section] == nil {
+			doc[section] = make(map[string]any)
+		}
+
+		for _, k := range slices.Sorted(maps.Keys(entries)) {
+			if _, ok := doc[section][k]; ok && unique {
+				return fmt.Errorf("duplicate %s key (%s). Remove the key or ensure keys are unique.", section, k)
+			}
+			doc[section][k] = entries[k]
+		}
+	}
+
+	return nil
+}
+
+// mergeManagementPolicyDocuments merges the source documents, the configured document and the override documents, in that order.
+// Keys must be unique across source documents. Keys defined in later documents replace earlier definitions.
+func mergeManagementPolicyDocuments(sourceDocuments []any, doc managementPolicyDocument, overrideDocuments []any) (managementPolicyDocument, error) {
+	mergedDoc := make(managementPolicyDocument)
+
+	for i, v := range sourceDocuments {
+		v, ok := v.(string)
+		if !ok || v == "" {
+			continue
+		}
+
+		var sourceDoc managementPolicyDocument
+		if err := json.Unmarshal([]byte(v), &sourceDoc); err != nil {
+			return nil, fmt.Errorf("merging source document %d: %w", i, err)
+		}
+
+		if err := mergedDoc.merge(sourceDoc, true); err != nil {
+			return nil, fmt.Errorf("merging source document %d: %w", i, err)
+		}
+	}
+
+	if err := mergedDoc.merge(doc, false); err != nil {
+		return nil, err
+	}
+
+	for i, v := range overrideDocuments {
+		v, ok := v.(string)
+		if !ok || v == "" {
+			continue
+		}
+
+		var overrideDoc managementPolicyDocument
+		if err := json.Unmarshal([]byte(v), &overrideDoc); err != nil {
+			return nil, fmt.Errorf("merging override document %d: %w", i, err)
+		}
+
+		if err := mergedDoc.merge(overrideDoc, false); err != nil {
+			return nil, err
+		}
+	}
+
+	return mergedDoc, nil
+}
+
+func managementPolicyAssign(v any) map[string]any {
+	return map[string]any{
+		policyOperatorAssign: v,
+	}
+}
+
+// managementPolicyRoot parses a management policy document, returning the entries of its single top-level section.
+func managementPolicyRoot(content, section string) (map[string]any, error) {
+	var doc map[string]any
+	if err := json.Unmarshal([]byte(content), &doc); err != nil {
+		return nil, err
+	}
+
+	for _, k := range slices.Sorted(maps.Keys(doc)) {
+		if k != section {
+			return nil, fmt.Errorf("unsupported policy element %q, expected %q", k, section)
+		}
+	}
+
+	if v, ok := doc[section]; ok {
+		return managementPolicyObject(section, v)
+	}
+
+	return nil, nil
+}
+
+// managementPolicyObject returns a management policy object element.
+// The child control operator is validated. If elements are specified, all other elements are unsupported.
+func managementPolicyObject(path string, v any, elements ...string) (map[string]any, error) {
+	tfMap, ok := v.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%s: expected an object", path)
+	}
+
+	for _, k := range slices.Sorted(maps.Keys(tfMap)) {
+		switch {
+		case k == policyOperatorOperatorsAllowedForChildPolicies:
+			if err := validateManagementPolicyChildControlOperator(path, tfMap[k]); err != nil {
+				return nil, err
+			}
+		case strings.HasPrefix(k, "@@"):
+			return nil, fmt.Errorf("%s: operator %s is not supported here", path, k)
+		case len(elements) > 0 && !slices.Contains(elements, k):
+			return nil, fmt.Errorf("%s: unsupported element %q, expected one of %s", path, k, strings.Join(elements, ", "))
+		}
+	}
+
+	return tfMap, nil
+}
+
+func validateManagementPolicyChildControlOperator(path string, v any) error {
+	path = path + "." + policyOperatorOperatorsAllowedForChildPolicies
+
+	values, ok := v.([]any)
+	if !ok {
+		return fmt.Errorf("%s: expected a list of operators", path)
+	}
+
+	for _, v := range values {
+		v, ok := v.(string)
+		if !ok || !slices.Contains(policyOperatorChildControlValues(), v) {
+			return fmt.Errorf("%s: unsupported value %v, expected one of %s", path, v, strings.Join(policyOperatorChildControlValues(), ", "))
+		}
+
+		if (v == policyOperatorAll || v == policyOperatorNone) && len(values) > 1 {
+			return fmt.Errorf("%s: %s cannot be combined with other operators", path, v)
+		}
+	}
+
+	return nil
+}
+
+type managementPolicyValueType int
+
+const (
+	managementPolicyValueTypeString managementPolicyValueType = iota
+	managementPolicyValueTypeStringList
+	// Integers are represented as strings, e.g. "180".
+	managementPolicyValueTypeInteger
+	managementPolicyValueTypeBool
+)
+
+// validateManagementPolicyValue validates a management policy value element, an object containing only operators, e.g. `{"@@assign": ["a", "b"]}`.
+// Only list values support the @@append and @@remove operators. validate is called for each value.
+func validateManagementPolicyValue(path string, v any, typ managementPolicyValueType, validate func(string) error) error {
+	tfMap, ok := v.(map[string]any)
+	if !ok {
+		return fmt.Errorf("%s: expected an object of operators, e.g. {%q: ...}", path, policyOperatorAssign)
+	}
+
+	for _, k := range slices.Sorted(maps.Keys(tfMap)) {
+		switch k {
+		case policyOperatorOperatorsAllowedForChildPolicies:
+			if err := validateManagementPolicyChildControlOperator(path, tfMap[k]); err != nil {
+				return err
+			}
+			continue
+		case policyOperatorAssign:
+		case policyOperatorAppend, policyOperatorRemove:
+			if typ != managementPolicyValueTypeStringList {
+				return fmt.Errorf("%s: operator %s is only supported for list values", path, k)
+			}
+		default:
+			return fmt.Errorf("%s: unsupported element %q, expected an operator", path, k)
+		}
+
+		values, err := managementPolicyValues(path+"."+k, tfMap[k], typ)
+		if err != nil {
+			return err
+		}
+
+		if validate == nil {
+			continue
+		}
+
+		for _, v := range values {
+			if err := validate(v); err != nil {
+				return fmt.Errorf("%s.%s: %w", path, k, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func managementPolicyValues(path string, v any, typ managementPolicyValueType) ([]string, error) {
+	switch typ {
+	case managementPolicyValueTypeString:
+		if v, ok := v.(string); ok {
+			return []string{v}, nil
+		}
+		return nil, fmt.Errorf("%s: expected a string", path)
+
+	case managementPolicyValueTypeStringList:
+		switch v := v.(type) {
+		case string:
+			return []string{v}, nil
+		case []any:
+			values := make([]string, len(v))
+			for i, v := range v {
+				v, ok := v.(string)
+				if !ok {
+					return nil, fmt.Errorf("%s: expected a list of strings", path)
+				}
+				values[i] = v
+			}
+			return values, nil
+		}
+		return nil, fmt.Errorf("%s: expected a string or a list of strings", path)
+
+	case managementPolicyValueTypeInteger:
+		if v, ok := v.(string); ok {
+			if n, err := strconv.Atoi(v); err == nil && n >= 0 {
+				return []string{v}, nil
+			}
+		}
+		return nil, fmt.Errorf("%s: expected a non-negative integer string, e.g. \"30\"", path)
+
+	case managementPolicyValueTypeBool:
+		switch v := v.(type) {
+		case bool:
+			return []string{strconv.FormatBool(v)}, nil
+		case string:
+			if v == "true" || v == "false" {
+				return []string{v}, nil
+			}
+		}
+		return nil, fmt.Errorf("%s: expected a boolean", path)
+	}
+
+	return nil, fmt.Errorf("%s: unsupported value type", path)
+}
+
+type managementPolicyValueElement struct {
+	typ      managementPolicyValueType
+	validate func(string) error
+}
+
+// validateManagementPolicyValues validates the value elements present in a management policy object.
+func validateManagementPolicyValues(path string, tfMap map[string]any, elements map[string]managementPolicyValueElement) error {
+	for _, k := range slices.Sorted(maps.Keys(elements)) {
+		if v, ok := tfMap[k]; ok {
+			if err := validateManagementPolicyValue(path+"."+k, v, elements[k].typ, elements[k].validate); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package organizations
+
+import (
+	"context"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_organizations_resource_control_policy_document", name="Resource Control Policy Document")
+func dataSourceResourceControlPolicyDocument() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceResourceControlPolicyDocumentRead,
+
+		SchemaFunc: func() map[string]*schema.Schema {
+			s := map[string]*schema.Schema{
+				"statement": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							names.AttrActions: {
+								Type:     schema.TypeSet,
+								Required: true,
+								Elem: &schema.Schema{
+									Type: schema.TypeString,
+								},
+							},
+							names.AttrCondition: policyStatementConditionSchema(),
+							"effect": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Default:      "Deny",
+								ValidateFunc: validation.StringInSlice(resourceControlPolicyGrammar().effects, false),
+							},
+							"not_resources":     policyStatementStringSetSchema(),
+							names.AttrResources: policyStatementStringSetSchema(),
+							"sid": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+						},
+					},
+				},
+			}
+			maps.Copy(s, policyDocumentOutputSchema())
+
+			return s
+		},
+	}
+}
+
+func dataSourceResourceControlPolicyDocumentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	statements, err := expandPolicyStatements(d.Get("statement").([]interface{}))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "writing Organizations Resource Control Policy Document: %s", err)
+	}
+
+	// Resource control policies apply to all principals.
+	for _, statement := range statements {
+		statement.Principals = tfiam.IAMPolicyStatementPrincipalSet{{Type: "*", Identifiers: "*"}}
+	}
+
+	doc, err := mergePolicyStatementDocuments(d.Get("source_policy_documents").([]interface{}), statements, d.Get("override_policy_documents").([]interface{}))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "writing Organizations Resource Control Policy Document: %s", err)
+	}
+
+	if err := setPolicyDocument(d, doc, validateResourceControlPolicyDocument); err != nil {
+		return sdkdiag.AppendErrorf(diags, "writing Organizations Resource Control Policy Document: %s", err)
+	}
+
+	return diags
+}
+
+// See https://docs.aws.amazon.com/organizations/latest/userguide/orgs_manage_policies_rcps_syntax.html.
+func resourceControlPolicyGrammar() policyStatementGrammar {
+	return policyStatementGrammar{
+		elements:          []string{"Action", "Condition", "Effect", "NotResource", "Principal", "Resource", "Sid"},
+		effects:           []string{"Deny"},
+		actionRequired:    true,
+		principalRequired: true,
+	}
+}
+
+// validateResourceControlPolicyDocument validates a minified resource control policy document.
+func validateResourceControlPolicyDocument(content string) error {
+	if err := validatePolicyStatementDocument(content, resourceControlPolicyGrammar()); err != nil {
+		return err
+	}
+
+	return validatePolicyDocumentSize(content, resourceControlPolicyMaxSize)
+}
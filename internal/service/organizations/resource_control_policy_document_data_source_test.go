@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package organizations_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tforganizations "github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestValidateResourceControlPolicyDocument(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		content string
+		wantErr string
+	}{
+		"valid": {
+			content: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Principal":"*","Action":["s3:*","sqs:*"],"Resource":"*","Condition":{"BoolIfExists":{"aws:SecureTransport":"false"}}}]}`,
+		},
+		"allow": {
+			content: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Principal":"*","Action":"s3:*","Resource":"*"}]}`,
+			wantErr: "statement 0: Effect must be one of Deny",
+		},
+		"no principal": {
+			content: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:*","Resource":"*"}]}`,
+			wantErr: `statement 0: Principal must be "*"`,
+		},
+		"specific principal": {
+			content: `{"Version":"2012-10-17","Statement":[{"Sid":"A","Effect":"Deny","Principal":{"AWS":"123456789012"},"Action":"s3:*","Resource":"*"}]}`,
+			wantErr: `statement 0 (A): Principal must be "*"`,
+		},
+		"not action": {
+			content: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Principal":"*","NotAction":"s3:*","Resource":"*"}]}`,
+			wantErr: `statement 0: unsupported element "NotAction"`,
+		},
+		"not principal": {
+			content: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","NotPrincipal":"*","Action":"s3:*","Resource":"*"}]}`,
+			wantErr: `statement 0: unsupported element "NotPrincipal"`,
+		},
+		"no action": {
+			content: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Principal":"*","Resource":"*"}]}`,
+			wantErr: "statement 0: Action is required",
+		},
+		"too large": {
+			content: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Principal":"*","Action":"s3:*","Resource":"` + strings.Repeat("a", 5120) + `"}]}`,
+			wantErr: "exceeding the maximum size of 5120 characters",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tforganizations.ValidateResourceControlPolicyDocument(testCase.content)
+
+			if testCase.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), testCase.wantErr) {
+				t.Errorf("expected error containing %q, got %v", testCase.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestAccOrganizationsResourceControlPolicyDocumentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_organizations_resource_control_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OrganizationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccResourceControlPolicyDocumentDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, names.AttrJSON, testAccResourceControlPolicyDocumentDataSourceExpectedJSON_basic),
+					resource.TestCheckResourceAttr(dataSourceName, "minified_json", testAccResourceControlPolicyDocumentDataSourceExpectedJSON_basic),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOrganizationsResourceControlPolicyDocumentDataSource_invalidSource(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OrganizationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccResourceControlPolicyDocumentDataSourceConfig_invalidSource,
+				ExpectError: regexache.MustCompile(`statement 0 \(AllowAll\): Effect must be one of Deny`),
+			},
+		},
+	})
+}
+
+const testAccResourceControlPolicyDocumentDataSourceExpectedJSON_basic = `{"Version":"2012-10-17","Statement":[{"Sid":"EnforceSecureTransport","Effect":"Deny","Action":["s3:*","sqs:*"],"Resource":"*","Principal":"*","Condition":{"BoolIfExists":{"aws:SecureTransport":"false"}}}]}`
+
+const testAccResourceControlPolicyDocumentDataSourceConfig_basic = `
+data "aws_organizations_resource_control_policy_document" "test" {
+  statement {
+    sid       = "EnforceSecureTransport"
+    actions   = ["s3:*", "sqs:*"]
+    resources = ["*"]
+
+    condition {
+      test     = "BoolIfExists"
+      variable = "aws:SecureTransport"
+      values   = ["false"]
+    }
+  }
+}
+`
+
+const testAccResourceControlPolicyDocumentDataSourceConfig_invalidSource = `
+data "aws_organizations_resource_control_policy_document" "test" {
+  source_policy_documents = [jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid       = "AllowAll"
+      Effect    = "Allow"
+      Principal = "*"
+      Action    = "*"
+      Resource  = "*"
+    }]
+  })]
+}
+`
@@ -0,0 +1,90 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package organizations
+
+import (
+	"context"
+	"maps"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_organizations_service_control_policy_document", name="Service Control Policy Document")
+func dataSourceServiceControlPolicyDocument() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceServiceControlPolicyDocumentRead,
+
+		SchemaFunc: func() map[string]*schema.Schema {
+			s := map[string]*schema.Schema{
+				"statement": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							names.AttrActions:   policyStatementStringSetSchema(),
+							names.AttrCondition: policyStatementConditionSchema(),
+							"effect": {
+								Type:         schema.TypeString,
+								Optional:     true,
+								Default:      "Allow",
+								ValidateFunc: validation.StringInSlice(serviceControlPolicyGrammar().effects, false),
+							},
+							"not_actions":       policyStatementStringSetSchema(),
+							"not_resources":     policyStatementStringSetSchema(),
+							names.AttrResources: policyStatementStringSetSchema(),
+							"sid": {
+								Type:     schema.TypeString,
+								Optional: true,
+							},
+						},
+					},
+				},
+			}
+			maps.Copy(s, policyDocumentOutputSchema())
+
+			return s
+		},
+	}
+}
+
+func dataSourceServiceControlPolicyDocumentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	statements, err := expandPolicyStatements(d.Get("statement").([]interface{}))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "writing Organizations Service Control Policy Document: %s", err)
+	}
+
+	doc, err := mergePolicyStatementDocuments(d.Get("source_policy_documents").([]interface{}), statements, d.Get("override_policy_documents").([]interface{}))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "writing Organizations Service Control Policy Document: %s", err)
+	}
+
+	if err := setPolicyDocument(d, doc, validateServiceControlPolicyDocument); err != nil {
+		return sdkdiag.AppendErrorf(diags, "writing Organizations Service Control Policy Document: %s", err)
+	}
+
+	return diags
+}
+
+// See https://docs.aws.amazon.com/organizations/latest/userguide/orgs_manage_policies_scps_syntax.html.
+func serviceControlPolicyGrammar() policyStatementGrammar {
+	return policyStatementGrammar{
+		elements: []string{"Action", "Condition", "Effect", "NotAction", "NotResource", "Resource", "Sid"},
+		effects:  []string{"Allow", "Deny"},
+	}
+}
+
+// validateServiceControlPolicyDocument validates a minified service control policy document.
+func validateServiceControlPolicyDocument(content string) error {
+	if err := validatePolicyStatementDocument(content, serviceControlPolicyGrammar()); err != nil {
+		return err
+	}
+
+	return validatePolicyDocumentSize(content, serviceControlPolicyMaxSize)
+}
@@ -0,0 +1,245 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package organizations_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tforganizations "github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestValidateServiceControlPolicyDocument(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		content string
+		wantErr string
+	}{
+		"valid": {
+			content: `{"Version":"2012-10-17","Statement":[{"Sid":"DenyLeave","Effect":"Deny","Action":"organizations:LeaveOrganization","Resource":"*"}]}`,
+		},
+		"single statement object": {
+			content: `{"Version":"2012-10-17","Statement":{"Effect":"Allow","NotAction":["iam:*"],"NotResource":"*"}}`,
+		},
+		"unsupported version": {
+			content: `{"Version":"2008-10-17","Statement":[{"Effect":"Deny","Action":"s3:*"}]}`,
+			wantErr: `policy Version must be "2012-10-17"`,
+		},
+		"no statements": {
+			content: `{"Version":"2012-10-17","Statement":[]}`,
+			wantErr: "policy must contain at least one Statement",
+		},
+		"principal": {
+			content: `{"Version":"2012-10-17","Statement":[{"Sid":"A","Effect":"Deny","Action":"s3:*","Principal":"*"}]}`,
+			wantErr: `statement 0 (A): unsupported element "Principal"`,
+		},
+		"action and not action": {
+			content: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:*","NotAction":"iam:*"}]}`,
+			wantErr: "statement 0: exactly one of Action or NotAction is required",
+		},
+		"no action": {
+			content: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Resource":"*"}]}`,
+			wantErr: "statement 0: exactly one of Action or NotAction is required",
+		},
+		"resource and not resource": {
+			content: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:*","Resource":"*","NotResource":"*"}]}`,
+			wantErr: "statement 0: only one of Resource or NotResource can be specified",
+		},
+		"invalid effect": {
+			content: `{"Version":"2012-10-17","Statement":[{"Effect":"Audit","Action":"s3:*"}]}`,
+			wantErr: "statement 0: Effect must be one of Allow, Deny",
+		},
+		"too large": {
+			content: `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:*","Resource":"` + strings.Repeat("a", 5120) + `"}]}`,
+			wantErr: "exceeding the maximum size of 5120 characters",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tforganizations.ValidateServiceControlPolicyDocument(testCase.content)
+
+			if testCase.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), testCase.wantErr) {
+				t.Errorf("expected error containing %q, got %v", testCase.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestAccOrganizationsServiceControlPolicyDocumentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_organizations_service_control_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OrganizationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceControlPolicyDocumentDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, names.AttrJSON, testAccServiceControlPolicyDocumentDataSourceExpectedJSON_basic),
+					resource.TestCheckResourceAttr(dataSourceName, "minified_json", testAccServiceControlPolicyDocumentDataSourceExpectedJSON_basic),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOrganizationsServiceControlPolicyDocumentDataSource_sourceOverride(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_organizations_service_control_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OrganizationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceControlPolicyDocumentDataSourceConfig_sourceOverride,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "minified_json", `{"Version":"2012-10-17","Statement":[{"Sid":"DenyLeaveOrganization","Effect":"Deny","Action":["organizations:DeleteOrganization","organizations:LeaveOrganization"],"Resource":"*"},{"Sid":"DenyRoot","Effect":"Deny","Action":"*","Resource":"*","Condition":{"StringLike":{"aws:PrincipalArn":"arn:aws:iam::*:root"}}}]}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOrganizationsServiceControlPolicyDocumentDataSource_tooLarge(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OrganizationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccServiceControlPolicyDocumentDataSourceConfig_tooLarge,
+				ExpectError: regexache.MustCompile(`exceeding the maximum size of 5120 characters`),
+			},
+		},
+	})
+}
+
+func TestAccOrganizationsServiceControlPolicyDocumentDataSource_duplicateSourceSid(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OrganizationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccServiceControlPolicyDocumentDataSourceConfig_duplicateSourceSid,
+				ExpectError: regexache.MustCompile(`duplicate Sid \(DenyLeaveOrganization\) in source_policy_documents`),
+			},
+		},
+	})
+}
+
+const testAccServiceControlPolicyDocumentDataSourceExpectedJSON_basic = `{"Version":"2012-10-17","Statement":[{"Sid":"DenyLeaveOrganization","Effect":"Deny","Action":"organizations:LeaveOrganization","Resource":"*"},{"Sid":"DenyRegions","Effect":"Deny","NotAction":["iam:*","sts:*"],"Resource":"*","Condition":{"StringNotEquals":{"aws:RequestedRegion":["us-east-1","us-west-2"]}}}]}`
+
+const testAccServiceControlPolicyDocumentDataSourceConfig_basic = `
+data "aws_organizations_service_control_policy_document" "test" {
+  statement {
+    sid       = "DenyLeaveOrganization"
+    effect    = "Deny"
+    actions   = ["organizations:LeaveOrganization"]
+    resources = ["*"]
+  }
+
+  statement {
+    sid         = "DenyRegions"
+    effect      = "Deny"
+    not_actions = ["iam:*", "sts:*"]
+    resources   = ["*"]
+
+    condition {
+      test     = "StringNotEquals"
+      variable = "aws:RequestedRegion"
+      values   = ["us-east-1", "us-west-2"]
+    }
+  }
+}
+`
+
+const testAccServiceControlPolicyDocumentDataSourceConfig_sourceOverride = `
+data "aws_organizations_service_control_policy_document" "source" {
+  statement {
+    sid       = "DenyLeaveOrganization"
+    effect    = "Deny"
+    actions   = ["organizations:LeaveOrganization"]
+    resources = ["*"]
+  }
+}
+
+data "aws_organizations_service_control_policy_document" "override" {
+  statement {
+    sid       = "DenyLeaveOrganization"
+    effect    = "Deny"
+    actions   = ["organizations:LeaveOrganization", "organizations:DeleteOrganization"]
+    resources = ["*"]
+  }
+}
+
+data "aws_organizations_service_control_policy_document" "test" {
+  source_policy_documents   = [data.aws_organizations_service_control_policy_document.source.json]
+  override_policy_documents = [data.aws_organizations_service_control_policy_document.override.json]
+
+  statement {
+    sid       = "DenyRoot"
+    effect    = "Deny"
+    actions   = ["*"]
+    resources = ["*"]
+
+    condition {
+      test     = "StringLike"
+      variable = "aws:PrincipalArn"
+      values   = ["arn:aws:iam::*:root"]
+    }
+  }
+}
+`
+
+const testAccServiceControlPolicyDocumentDataSourceConfig_tooLarge = `
+data "aws_organizations_service_control_policy_document" "test" {
+  statement {
+    effect    = "Deny"
+    actions   = [for i in range(400) : "ec2:ExampleAction${i}"]
+    resources = ["*"]
+  }
+}
+`
+
+const testAccServiceControlPolicyDocumentDataSourceConfig_duplicateSourceSid = `
+data "aws_organizations_service_control_policy_document" "source" {
+  statement {
+    sid       = "DenyLeaveOrganization"
+    effect    = "Deny"
+    actions   = ["organizations:LeaveOrganization"]
+    resources = ["*"]
+  }
+}
+
+data "aws_organizations_service_control_policy_document" "test" {
+  source_policy_documents = [
+    data.aws_organizations_service_control_policy_document.source.json,
+    data.aws_organizations_service_control_policy_document.source.json,
+  ]
+}
+`
@@ -24,6 +24,11 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
+		{
+			Factory:  dataSourceBackupPolicyDocument,
+			TypeName: "aws_organizations_backup_policy_document",
+			Name:     "Backup Policy Document",
+		},
 		{
 			Factory:  dataSourceDelegatedAdministrators,
 			TypeName: "aws_organizations_delegated_administrators",
@@ -79,11 +84,26 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_organizations_policy",
 			Name:     "Policy",
 		},
+		{
+			Factory:  dataSourceResourceControlPolicyDocument,
+			TypeName: "aws_organizations_resource_control_policy_document",
+			Name:     "Resource Control Policy Document",
+		},
 		{
 			Factory:  dataSourceResourceTags,
 			TypeName: "aws_organizations_resource_tags",
 			Name:     "Resource Tags",
 		},
+		{
+			Factory:  dataSourceServiceControlPolicyDocument,
+			TypeName: "aws_organizations_service_control_policy_document",
+			Name:     "Service Control Policy Document",
+		},
+		{
+			Factory:  dataSourceTagPolicyDocument,
+			TypeName: "aws_organizations_tag_policy_document",
+			Name:     "Tag Policy Document",
+		},
 	}
 }
 
@@ -0,0 +1,201 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package organizations
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	tagPolicySection = "tags"
+)
+
+// Resource types in `enforced_for` and `report_required_tag_for`, e.g. `ec2:instance`, `secretsmanager:*` or `ec2:ALL_SUPPORTED`.
+var tagPolicyResourceTypeRegexp = regexache.MustCompile(`^[0-9a-z-]+:(\*|[0-9A-Za-z_-]+)$`)
+
+// @SDKDataSource("aws_organizations_tag_policy_document", name="Tag Policy Document")
+func dataSourceTagPolicyDocument() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceTagPolicyDocumentRead,
+
+		SchemaFunc: func() map[string]*schema.Schema {
+			s := map[string]*schema.Schema{
+				"tag": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"enforced_for": {
+								Type:     schema.TypeSet,
+								Optional: true,
+								Elem: &schema.Schema{
+									Type:         schema.TypeString,
+									ValidateFunc: validation.StringMatch(tagPolicyResourceTypeRegexp, "must be in the format service:resource_type"),
+								},
+							},
+							names.AttrKey: {
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringLenBetween(1, 128),
+							},
+							"operators_allowed_for_child_policies": {
+								Type:     schema.TypeSet,
+								Optional: true,
+								Elem: &schema.Schema{
+									Type:         schema.TypeString,
+									ValidateFunc: validation.StringInSlice(policyOperatorChildControlValues(), false),
+								},
+							},
+							"report_required_tag_for": {
+								Type:     schema.TypeSet,
+								Optional: true,
+								Elem: &schema.Schema{
+									Type:         schema.TypeString,
+									ValidateFunc: validation.StringMatch(tagPolicyResourceTypeRegexp, "must be in the format service:resource_type"),
+								},
+							},
+							names.AttrValues: {
+								Type:     schema.TypeSet,
+								Optional: true,
+								Elem: &schema.Schema{
+									Type:         schema.TypeString,
+									ValidateFunc: validation.StringLenBetween(0, 256),
+								},
+							},
+						},
+					},
+				},
+			}
+			maps.Copy(s, policyDocumentOutputSchema())
+
+			return s
+		},
+	}
+}
+
+func dataSourceTagPolicyDocumentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	tags, err := expandTagPolicyTags(d.Get("tag").([]interface{}))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "writing Organizations Tag Policy Document: %s", err)
+	}
+
+	doc, err := mergeManagementPolicyDocuments(d.Get("source_policy_documents").([]interface{}), managementPolicyDocument{tagPolicySection: tags}, d.Get("override_policy_documents").([]interface{}))
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "writing Organizations Tag Policy Document: %s", err)
+	}
+
+	if err := setPolicyDocument(d, doc, validateTagPolicyDocument); err != nil {
+		return sdkdiag.AppendErrorf(diags, "writing Organizations Tag Policy Document: %s", err)
+	}
+
+	return diags
+}
+
+// expandTagPolicyTags returns the tag policy entries keyed by the lowercase tag key.
+func expandTagPolicyTags(tfList []interface{}) (map[string]any, error) {
+	apiObjects := make(map[string]any)
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		tagKey := tfMap[names.AttrKey].(string)
+		policyKey := strings.ToLower(tagKey)
+		if _, ok := apiObjects[policyKey]; ok {
+			return nil, fmt.Errorf("duplicate tag key (%s)", tagKey)
+		}
+
+		apiObject := map[string]any{
+			"tag_key": managementPolicyAssign(tagKey),
+		}
+
+		if v, ok := tfMap["enforced_for"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject["enforced_for"] = managementPolicyAssign(slices.Sorted(slices.Values(flex.ExpandStringValueSet(v))))
+		}
+
+		if v, ok := tfMap["operators_allowed_for_child_policies"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject[policyOperatorOperatorsAllowedForChildPolicies] = slices.Sorted(slices.Values(flex.ExpandStringValueSet(v)))
+		}
+
+		if v, ok := tfMap["report_required_tag_for"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject["report_required_tag_for"] = managementPolicyAssign(slices.Sorted(slices.Values(flex.ExpandStringValueSet(v))))
+		}
+
+		if v, ok := tfMap[names.AttrValues].(*schema.Set); ok && v.Len() > 0 {
+			apiObject["tag_value"] = managementPolicyAssign(slices.Sorted(slices.Values(flex.ExpandStringValueSet(v))))
+		}
+
+		apiObjects[policyKey] = apiObject
+	}
+
+	return apiObjects, nil
+}
+
+// validateTagPolicyDocument validates a minified tag policy document.
+// See https://docs.aws.amazon.com/organizations/latest/userguide/orgs_manage_policies_example-tag-policies.html.
+func validateTagPolicyDocument(content string) error {
+	tags, err := managementPolicyRoot(content, tagPolicySection)
+	if err != nil {
+		return err
+	}
+
+	for _, policyKey := range slices.Sorted(maps.Keys(tags)) {
+		path := tagPolicySection + "." + policyKey
+
+		tag, err := managementPolicyObject(path, tags[policyKey], "enforced_for", "report_required_tag_for", "tag_key", "tag_value")
+		if err != nil {
+			return err
+		}
+
+		if err := validateManagementPolicyValues(path, tag, map[string]managementPolicyValueElement{
+			"enforced_for":            {typ: managementPolicyValueTypeStringList, validate: validateTagPolicyResourceType},
+			"report_required_tag_for": {typ: managementPolicyValueTypeStringList, validate: validateTagPolicyResourceType},
+			"tag_key": {typ: managementPolicyValueTypeString, validate: func(s string) error {
+				if n := utf8.RuneCountInString(s); n < 1 || n > 128 {
+					return errors.New("tag key must be between 1 and 128 characters")
+				}
+				if !strings.EqualFold(s, policyKey) {
+					return fmt.Errorf("tag key %q does not match policy key %q", s, policyKey)
+				}
+				return nil
+			}},
+			"tag_value": {typ: managementPolicyValueTypeStringList, validate: func(s string) error {
+				if utf8.RuneCountInString(s) > 256 {
+					return fmt.Errorf("tag value %q exceeds 256 characters", s)
+				}
+				return nil
+			}},
+		}); err != nil {
+			return err
+		}
+	}
+
+	return validatePolicyDocumentSize(content, tagPolicyMaxSize)
+}
+
+func validateTagPolicyResourceType(s string) error {
+	if !tagPolicyResourceTypeRegexp.MatchString(s) {
+		return fmt.Errorf("resource type %q must be in the format service:resource_type", s)
+	}
+
+	return nil
+}
@@ -0,0 +1,218 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package organizations_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tforganizations "github.com/hashicorp/terraform-provider-aws/internal/service/organizations"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestValidateTagPolicyDocument(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		content string
+		wantErr string
+	}{
+		"empty": {
+			content: `{}`,
+		},
+		"valid": {
+			content: `{"tags":{"costcenter":{"tag_key":{"@@assign":"CostCenter","@@operators_allowed_for_child_policies":["@@none"]},"tag_value":{"@@assign":["100","200*"]},"enforced_for":{"@@assign":["ec2:instance","secretsmanager:*"]}}}}`,
+		},
+		"append and remove": {
+			content: `{"tags":{"costcenter":{"tag_value":{"@@append":["300"],"@@remove":["100"]}}}}`,
+		},
+		"unsupported top-level element": {
+			content: `{"tag":{}}`,
+			wantErr: `unsupported policy element "tag", expected "tags"`,
+		},
+		"unsupported element": {
+			content: `{"tags":{"costcenter":{"tag_values":{"@@assign":["100"]}}}}`,
+			wantErr: `tags.costcenter: unsupported element "tag_values"`,
+		},
+		"tag key mismatch": {
+			content: `{"tags":{"costcenter":{"tag_key":{"@@assign":"Owner"}}}}`,
+			wantErr: `tags.costcenter.tag_key.@@assign: tag key "Owner" does not match policy key "costcenter"`,
+		},
+		"tag key append": {
+			content: `{"tags":{"costcenter":{"tag_key":{"@@append":"CostCenter"}}}}`,
+			wantErr: "tags.costcenter.tag_key: operator @@append is only supported for list values",
+		},
+		"misspelled operator": {
+			content: `{"tags":{"costcenter":{"tag_value":{"@@asign":["100"]}}}}`,
+			wantErr: `tags.costcenter.tag_value: unsupported element "@@asign", expected an operator`,
+		},
+		"missing operator": {
+			content: `{"tags":{"costcenter":{"tag_value":["100"]}}}`,
+			wantErr: "tags.costcenter.tag_value: expected an object of operators",
+		},
+		"value operator on tag": {
+			content: `{"tags":{"costcenter":{"@@assign":"CostCenter"}}}`,
+			wantErr: "tags.costcenter: operator @@assign is not supported here",
+		},
+		"invalid resource type": {
+			content: `{"tags":{"costcenter":{"enforced_for":{"@@assign":["ec2"]}}}}`,
+			wantErr: `resource type "ec2" must be in the format service:resource_type`,
+		},
+		"invalid child control operator": {
+			content: `{"tags":{"costcenter":{"@@operators_allowed_for_child_policies":["@@none","@@assign"]}}}`,
+			wantErr: "tags.costcenter.@@operators_allowed_for_child_policies: @@none cannot be combined with other operators",
+		},
+		"too large": {
+			content: `{"tags":{"costcenter":{"tag_value":{"@@assign":["` + strings.Repeat(strings.Repeat("a", 100)+`","`, 100) + `a"]}}}}`,
+			wantErr: "exceeding the maximum size of 10000 characters",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tforganizations.ValidateTagPolicyDocument(testCase.content)
+
+			if testCase.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), testCase.wantErr) {
+				t.Errorf("expected error containing %q, got %v", testCase.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestAccOrganizationsTagPolicyDocumentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_organizations_tag_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OrganizationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagPolicyDocumentDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrEquivalentJSON(dataSourceName, names.AttrJSON, testAccTagPolicyDocumentDataSourceExpectedJSON_basic),
+					resource.TestCheckResourceAttr(dataSourceName, "minified_json", testAccTagPolicyDocumentDataSourceExpectedJSON_basic),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOrganizationsTagPolicyDocumentDataSource_sourceOverride(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_organizations_tag_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OrganizationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagPolicyDocumentDataSourceConfig_sourceOverride,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "minified_json", `{"tags":{"costcenter":{"tag_key":{"@@assign":"CostCenter"},"tag_value":{"@@assign":["300"]}},"environment":{"tag_key":{"@@assign":"Environment"},"tag_value":{"@@assign":["prod","test"]}},"owner":{"tag_key":{"@@assign":"Owner"}}}}`),
+				),
+			},
+		},
+	})
+}
+
+func TestAccOrganizationsTagPolicyDocumentDataSource_invalidOverride(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.OrganizationsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccTagPolicyDocumentDataSourceConfig_invalidOverride,
+				ExpectError: regexache.MustCompile(`tags.costcenter.tag_value: unsupported element "@@replace", expected an operator`),
+			},
+		},
+	})
+}
+
+const testAccTagPolicyDocumentDataSourceExpectedJSON_basic = `{"tags":{"costcenter":{"enforced_for":{"@@assign":["ec2:instance","secretsmanager:*"]},"tag_key":{"@@assign":"CostCenter"},"tag_value":{"@@assign":["100","200"]}},"owner":{"@@operators_allowed_for_child_policies":["@@none"],"tag_key":{"@@assign":"Owner"}}}}`
+
+const testAccTagPolicyDocumentDataSourceConfig_basic = `
+data "aws_organizations_tag_policy_document" "test" {
+  tag {
+    key          = "CostCenter"
+    values       = ["200", "100"]
+    enforced_for = ["secretsmanager:*", "ec2:instance"]
+  }
+
+  tag {
+    key                                  = "Owner"
+    operators_allowed_for_child_policies = ["@@none"]
+  }
+}
+`
+
+const testAccTagPolicyDocumentDataSourceConfig_sourceOverride = `
+data "aws_organizations_tag_policy_document" "source" {
+  tag {
+    key    = "CostCenter"
+    values = ["100"]
+  }
+
+  tag {
+    key    = "Environment"
+    values = ["prod"]
+  }
+}
+
+data "aws_organizations_tag_policy_document" "override" {
+  tag {
+    key    = "Environment"
+    values = ["prod", "test"]
+  }
+}
+
+data "aws_organizations_tag_policy_document" "test" {
+  source_policy_documents   = [data.aws_organizations_tag_policy_document.source.json]
+  override_policy_documents = [data.aws_organizations_tag_policy_document.override.json]
+
+  tag {
+    key    = "CostCenter"
+    values = ["300"]
+  }
+
+  tag {
+    key = "Owner"
+  }
+}
+`
+
+const testAccTagPolicyDocumentDataSourceConfig_invalidOverride = `
+data "aws_organizations_tag_policy_document" "test" {
+  override_policy_documents = [jsonencode({
+    tags = {
+      costcenter = {
+        tag_value = {
+          "@@replace" = ["100"]
+        }
+      }
+    }
+  })]
+
+  tag {
+    key = "CostCenter"
+  }
+}
+`
@@ -4,6 +4,7 @@
 package pipes
 
 import (
+	"fmt"
 	"time"
 
 	"github.com/YakDriver/regexache"
@@ -13,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/eventpattern"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -169,9 +171,12 @@ func sourceParametersSchema() *schema.Schema {
 								Elem: &schema.Resource{
 									Schema: map[string]*schema.Schema{
 										"pattern": {
-											Type:         schema.TypeString,
-											Required:     true,
-											ValidateFunc: validation.StringLenBetween(1, 4096),
+											Type:     schema.TypeString,
+											Required: true,
+											ValidateFunc: validation.All(
+												validation.StringLenBetween(1, 4096),
+												validateEventPattern,
+											),
 										},
 									},
 								},
@@ -1680,3 +1685,17 @@ func flattenDeadLetterConfig(apiObject *types.DeadLetterConfig) map[string]inter
 
 	return tfMap
 }
+
+func validateEventPattern(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if err := eventpattern.Validate(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid event pattern: %w", k, err))
+	}
+
+	return
+}
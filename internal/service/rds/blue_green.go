@@ -10,8 +10,10 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
 	"github.com/aws/aws-sdk-go-v2/service/rds"
 	"github.com/aws/aws-sdk-go-v2/service/rds/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
@@ -63,10 +65,15 @@ func (o *blueGreenOrchestrator) waitForDeploymentAvailable(ctx context.Context,
 	return dep, nil
 }
 
-func (o *blueGreenOrchestrator) Switchover(ctx context.Context, identifier string, timeout time.Duration) (*types.BlueGreenDeployment, error) {
+// Switchover switches over the Blue/Green Deployment. If switchoverTimeout is non-zero,
+// it bounds how long RDS waits for replication to catch up before rolling the switchover back.
+func (o *blueGreenOrchestrator) Switchover(ctx context.Context, identifier string, switchoverTimeout, timeout time.Duration) (*types.BlueGreenDeployment, error) {
 	input := &rds.SwitchoverBlueGreenDeploymentInput{
 		BlueGreenDeploymentIdentifier: aws.String(identifier),
 	}
+	if switchoverTimeout > 0 {
+		input.SwitchoverTimeout = aws.Int32(int32(switchoverTimeout.Seconds()))
+	}
 	_, err := tfresource.RetryWhen(ctx, 10*time.Minute,
 		func() (interface{}, error) {
 			return o.conn.SwitchoverBlueGreenDeployment(ctx, input)
@@ -86,6 +93,39 @@ func (o *blueGreenOrchestrator) Switchover(ctx context.Context, identifier strin
 	return dep, nil
 }
 
+// DeleteDeployment deletes the Blue/Green Deployment. Unless the switchover has completed,
+// the Green environment is deleted along with it. It reports whether a deletion was started.
+func (o *blueGreenOrchestrator) DeleteDeployment(ctx context.Context, identifier string) (bool, error) {
+	dep, err := findBlueGreenDeploymentByID(ctx, o.conn, identifier)
+
+	if tfresource.NotFound(err) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	input := &rds.DeleteBlueGreenDeploymentInput{
+		BlueGreenDeploymentIdentifier: aws.String(identifier),
+	}
+	if aws.ToString(dep.Status) != "SWITCHOVER_COMPLETED" {
+		input.DeleteTarget = aws.Bool(true)
+	}
+
+	_, err = o.conn.DeleteBlueGreenDeployment(ctx, input)
+
+	if errs.IsA[*types.BlueGreenDeploymentNotFoundFault](err) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
 func (o *blueGreenOrchestrator) AddCleanupWaiter(f cleanupWaiterFunc) {
 	o.cleanupWaiters = append(o.cleanupWaiters, f)
 }
@@ -166,3 +206,227 @@ func (h *instanceHandler) modifyTarget(ctx context.Context, identifier string, d
 
 	return nil
 }
+
+type clusterHandler struct {
+	conn   *rds.Client
+	cwConn *cloudwatch.Client
+}
+
+func newClusterHandler(conn *rds.Client, cwConn *cloudwatch.Client) *clusterHandler {
+	return &clusterHandler{
+		conn:   conn,
+		cwConn: cwConn,
+	}
+}
+
+func (h *clusterHandler) precondition(ctx context.Context, d *schema.ResourceData, timeout time.Duration) error {
+	// Disabling deletion protection must happen on the Blue environment, as it is the one deleted after switchover.
+	if !d.HasChange(names.AttrDeletionProtection) {
+		return nil
+	}
+
+	input := &rds.ModifyDBClusterInput{
+		ApplyImmediately:    aws.Bool(true),
+		DBClusterIdentifier: aws.String(d.Id()),
+		DeletionProtection:  aws.Bool(d.Get(names.AttrDeletionProtection).(bool)),
+	}
+
+	if err := dbClusterModify(ctx, h.conn, input); err != nil {
+		return fmt.Errorf("setting pre-conditions: %s", err)
+	}
+
+	if _, err := waitDBClusterUpdated(ctx, h.conn, d.Id(), false, timeout); err != nil {
+		return fmt.Errorf("setting pre-conditions: waiting for completion: %s", err)
+	}
+
+	return nil
+}
+
+func (h *clusterHandler) createBlueGreenInput(d *schema.ResourceData) *rds.CreateBlueGreenDeploymentInput {
+	input := &rds.CreateBlueGreenDeploymentInput{
+		BlueGreenDeploymentName: aws.String(d.Id()),
+		Source:                  aws.String(d.Get(names.AttrARN).(string)),
+	}
+
+	if d.HasChange(names.AttrEngineVersion) {
+		input.TargetEngineVersion = aws.String(d.Get(names.AttrEngineVersion).(string))
+	}
+	if d.HasChange("db_cluster_parameter_group_name") {
+		input.TargetDBClusterParameterGroupName = aws.String(d.Get("db_cluster_parameter_group_name").(string))
+	}
+	if v, ok := d.GetOk("db_instance_parameter_group_name"); ok && d.HasChange("db_instance_parameter_group_name") {
+		input.TargetDBParameterGroupName = aws.String(v.(string))
+	}
+
+	return input
+}
+
+// waitTargetAvailable waits for the Green cluster and each of its instances to become available.
+func (h *clusterHandler) waitTargetAvailable(ctx context.Context, identifier string, timeout time.Duration) error {
+	deadline := tfresource.NewDeadline(timeout)
+
+	cluster, err := waitDBClusterAvailable(ctx, h.conn, identifier, false, deadline.Remaining())
+	if err != nil {
+		return err
+	}
+
+	for _, v := range cluster.DBClusterMembers {
+		if _, err := waitDBClusterInstanceAvailable(ctx, h.conn, aws.ToString(v.DBInstanceIdentifier), deadline.Remaining()); err != nil {
+			return fmt.Errorf("RDS Cluster Instance (%s): %s", aws.ToString(v.DBInstanceIdentifier), err)
+		}
+	}
+
+	return nil
+}
+
+func (h *clusterHandler) modifyTarget(ctx context.Context, identifier string, d *schema.ResourceData, timeout time.Duration, operation string) error {
+	// Engine version and parameter groups are applied when the Green environment is created,
+	// and deletion protection is applied to the Blue environment as a pre-condition.
+	if !d.HasChangesExcept(
+		names.AttrAllowMajorVersionUpgrade,
+		names.AttrApplyImmediately,
+		"blue_green_update",
+		"db_cluster_parameter_group_name",
+		"db_instance_parameter_group_name",
+		"delete_automated_backups",
+		names.AttrDeletionProtection,
+		names.AttrEngineVersion,
+		names.AttrFinalSnapshotIdentifier,
+		"global_cluster_identifier",
+		"iam_roles",
+		"replication_source_identifier",
+		"skip_final_snapshot",
+		names.AttrTags, names.AttrTagsAll,
+	) {
+		return nil
+	}
+
+	input := &rds.ModifyDBClusterInput{
+		ApplyImmediately:    aws.Bool(true),
+		DBClusterIdentifier: aws.String(identifier),
+	}
+
+	if diags := dbClusterPopulateModify(input, d); diags.HasError() {
+		return fmt.Errorf("populating modify input: %s", sdkdiag.DiagnosticsString(diags))
+	}
+
+	input.AllowMajorVersionUpgrade = nil
+	input.DBClusterParameterGroupName = nil
+	input.DBInstanceParameterGroupName = nil
+	input.DeletionProtection = nil
+	input.EngineVersion = nil
+
+	log.Printf("[DEBUG] %s: Updating Green environment", operation)
+
+	if err := dbClusterModify(ctx, h.conn, input); err != nil {
+		return fmt.Errorf("updating Green environment: %s", err)
+	}
+
+	if _, err := waitDBClusterUpdated(ctx, h.conn, identifier, true, timeout); err != nil {
+		return fmt.Errorf("updating Green environment: waiting for completion: %s", err)
+	}
+
+	return nil
+}
+
+// waitReplicaLag waits for binary log replication from the Blue environment to the Green environment to catch up,
+// so that the switchover is not rolled back for exceeding its timeout.
+func (h *clusterHandler) waitReplicaLag(ctx context.Context, identifier string, maxLag, timeout time.Duration) error {
+	if _, err := waitBlueGreenClusterReplicaLagWithin(ctx, h.cwConn, identifier, maxLag, timeout); err != nil {
+		return fmt.Errorf("waiting for Green environment replica lag: %s", err)
+	}
+
+	return nil
+}
+
+// waitReplicationSlotLag waits for logical replication from the Blue environment to the Green environment to catch up,
+// so that the switchover is not rolled back for exceeding its timeout.
+// Replication slot lag is published for the Blue environment, which holds the replication slots.
+func (h *clusterHandler) waitReplicationSlotLag(ctx context.Context, identifier string, maxLag int64, timeout time.Duration) error {
+	if _, err := waitBlueGreenClusterReplicationSlotLagWithin(ctx, h.cwConn, identifier, maxLag, timeout); err != nil {
+		return fmt.Errorf("waiting for Green environment replication slot lag: %s", err)
+	}
+
+	return nil
+}
+
+// deleteSource deletes the former Blue environment, along with its instances, once the switchover has completed.
+func (h *clusterHandler) deleteSource(ctx context.Context, identifier string, timeout time.Duration) error {
+	deadline := tfresource.NewDeadline(timeout)
+
+	cluster, err := findDBClusterByID(ctx, h.conn, identifier)
+	if err != nil {
+		return err
+	}
+
+	for _, v := range cluster.DBClusterMembers {
+		id := aws.ToString(v.DBInstanceIdentifier)
+		input := &rds.DeleteDBInstanceInput{
+			DBInstanceIdentifier: aws.String(id),
+		}
+
+		_, err := h.conn.DeleteDBInstance(ctx, input)
+
+		if errs.IsA[*types.DBInstanceNotFoundFault](err) {
+			continue
+		}
+
+		if err != nil && !errs.IsAErrorMessageContains[*types.InvalidDBInstanceStateFault](err, "is already being deleted") {
+			return fmt.Errorf("deleting RDS Cluster Instance (%s): %s", id, err)
+		}
+	}
+
+	for _, v := range cluster.DBClusterMembers {
+		id := aws.ToString(v.DBInstanceIdentifier)
+		if _, err := waitDBClusterInstanceDeleted(ctx, h.conn, id, deadline.Remaining()); err != nil {
+			return fmt.Errorf("waiting for RDS Cluster Instance (%s) delete: %s", id, err)
+		}
+	}
+
+	if aws.ToBool(cluster.DeletionProtection) {
+		input := &rds.ModifyDBClusterInput{
+			ApplyImmediately:    aws.Bool(true),
+			DBClusterIdentifier: aws.String(identifier),
+			DeletionProtection:  aws.Bool(false),
+		}
+
+		if err := dbClusterModify(ctx, h.conn, input); err != nil {
+			return fmt.Errorf("disabling deletion protection: %s", err)
+		}
+
+		if _, err := waitDBClusterUpdated(ctx, h.conn, identifier, false, deadline.Remaining()); err != nil {
+			return fmt.Errorf("disabling deletion protection: waiting for completion: %s", err)
+		}
+	}
+
+	input := &rds.DeleteDBClusterInput{
+		DBClusterIdentifier: aws.String(identifier),
+		SkipFinalSnapshot:   aws.Bool(true),
+	}
+
+	const (
+		retryTimeout = 5 * time.Minute
+	)
+	_, err = tfresource.RetryWhen(ctx, retryTimeout,
+		func() (any, error) {
+			return h.conn.DeleteDBCluster(ctx, input)
+		},
+		func(err error) (bool, error) {
+			if errs.IsAErrorMessageContains[*types.InvalidDBClusterStateFault](err, "is not currently in the available state") {
+				return true, err
+			}
+
+			if tfawserr.ErrMessageContains(err, errCodeInvalidParameterCombination, "disable deletion pro") {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if errs.IsA[*types.DBClusterNotFoundFault](err) {
+		return nil
+	}
+
+	return err
+}
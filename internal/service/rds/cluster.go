@@ -5,14 +5,18 @@ package rds
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cwtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
 	"github.com/aws/aws-sdk-go-v2/service/rds"
 	"github.com/aws/aws-sdk-go-v2/service/rds/types"
 	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
@@ -112,6 +116,35 @@ func resourceCluster() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.IntBetween(0, 259200),
 			},
+			"blue_green_update": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrEnabled: {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"max_replica_lag": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"max_replication_slot_lag": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+						"switchover_timeout": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      300,
+							ValidateFunc: validation.IntBetween(30, 3600),
+						},
+					},
+				},
+			},
 			names.AttrClusterIdentifier: {
 				Type:          schema.TypeString,
 				Optional:      true,
@@ -655,6 +688,35 @@ func resourceCluster() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			verify.SetTagsDiff,
+			func(_ context.Context, d *schema.ResourceDiff, meta any) error {
+				if !d.Get("blue_green_update.0.enabled").(bool) {
+					return nil
+				}
+
+				engine := d.Get(names.AttrEngine).(string)
+				if !slices.Contains(clusterValidBlueGreenEngines(), engine) {
+					return fmt.Errorf(`"blue_green_update.enabled" cannot be set when "engine" is %q.`, engine)
+				}
+
+				// Blue/Green updates of global cluster members and read replicas are not yet implemented.
+				if d.Get("global_cluster_identifier").(string) != "" {
+					return errors.New(`"blue_green_update.enabled" cannot be set when "global_cluster_identifier" is set.`)
+				}
+
+				if d.Get("replication_source_identifier").(string) != "" {
+					return errors.New(`"blue_green_update.enabled" cannot be set when "replication_source_identifier" is set.`)
+				}
+
+				// Aurora MySQL replicates to the Green environment using binary logs, Aurora PostgreSQL using logical replication.
+				if _, ok := d.GetOk("blue_green_update.0.max_replica_lag"); ok && engine != ClusterEngineAuroraMySQL {
+					return fmt.Errorf(`"blue_green_update.max_replica_lag" cannot be set when "engine" is %q.`, engine)
+				}
+
+				if _, ok := d.GetOk("blue_green_update.0.max_replication_slot_lag"); ok && engine != ClusterEngineAuroraPostgreSQL {
+					return fmt.Errorf(`"blue_green_update.max_replication_slot_lag" cannot be set when "engine" is %q.`, engine)
+				}
+				return nil
+			},
 			customdiff.ForceNewIf(names.AttrStorageType, func(_ context.Context, d *schema.ResourceDiff, meta interface{}) bool {
 				// Aurora supports mutation of the storage_type parameter, other engines do not
 				return !strings.HasPrefix(d.Get(names.AttrEngine).(string), "aurora")
@@ -1526,6 +1588,7 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 
 	if d.HasChangesExcept(
 		names.AttrAllowMajorVersionUpgrade,
+		"blue_green_update",
 		"delete_automated_backups",
 		names.AttrFinalSnapshotIdentifier,
 		"global_cluster_identifier",
@@ -1533,223 +1596,143 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 		"replication_source_identifier",
 		"skip_final_snapshot",
 		names.AttrTags, names.AttrTagsAll) {
-		applyImmediately := d.Get(names.AttrApplyImmediately).(bool)
-		input := &rds.ModifyDBClusterInput{
-			ApplyImmediately:    aws.Bool(applyImmediately),
-			DBClusterIdentifier: aws.String(d.Id()),
-		}
-
-		if d.HasChange(names.AttrAllocatedStorage) {
-			input.AllocatedStorage = aws.Int32(int32(d.Get(names.AttrAllocatedStorage).(int)))
-		}
+		// Only changes which would otherwise require a reboot or an in-place engine upgrade
+		// are worth the cost of standing up a Green environment.
+		if d.Get("blue_green_update.0.enabled").(bool) && d.HasChanges(
+			"db_cluster_parameter_group_name",
+			"db_instance_parameter_group_name",
+			names.AttrEngineVersion,
+		) {
+			deadline := tfresource.NewDeadline(d.Timeout(schema.TimeoutUpdate))
 
-		if v, ok := d.GetOk(names.AttrAllowMajorVersionUpgrade); ok {
-			input.AllowMajorVersionUpgrade = aws.Bool(v.(bool))
-		}
+			orchestrator := newBlueGreenOrchestrator(conn)
+			defer orchestrator.CleanUp(ctx)
 
-		if d.HasChange("backtrack_window") {
-			input.BacktrackWindow = aws.Int64(int64(d.Get("backtrack_window").(int)))
-		}
-
-		if d.HasChange("backup_retention_period") {
-			input.BackupRetentionPeriod = aws.Int32(int32(d.Get("backup_retention_period").(int)))
-		}
+			handler := newClusterHandler(conn, meta.(*conns.AWSClient).CloudWatchClient(ctx))
 
-		if d.HasChange("ca_certificate_identifier") {
-			input.CACertificateIdentifier = aws.String(d.Get("ca_certificate_identifier").(string))
-		}
-
-		if d.HasChange("copy_tags_to_snapshot") {
-			input.CopyTagsToSnapshot = aws.Bool(d.Get("copy_tags_to_snapshot").(bool))
-		}
-
-		if d.HasChange("db_cluster_instance_class") {
-			input.DBClusterInstanceClass = aws.String(d.Get("db_cluster_instance_class").(string))
-		}
-
-		if d.HasChange("db_cluster_parameter_group_name") {
-			input.DBClusterParameterGroupName = aws.String(d.Get("db_cluster_parameter_group_name").(string))
-		}
-
-		// DB instance parameter group name is not currently returned from the
-		// DescribeDBClusters API. This means there is no drift detection, so when
-		// set, the configured attribute should always be sent on modify.
-		// Except, this causes an error on a minor version upgrade, so it is
-		// removed during update retry, if necessary.
-		if v, ok := d.GetOk("db_instance_parameter_group_name"); ok || d.HasChange("db_instance_parameter_group_name") {
-			input.DBInstanceParameterGroupName = aws.String(v.(string))
-		}
-
-		if d.HasChange(names.AttrDeletionProtection) {
-			input.DeletionProtection = aws.Bool(d.Get(names.AttrDeletionProtection).(bool))
-		}
-
-		if d.HasChanges(names.AttrDomain, "domain_iam_role_name") {
-			input.Domain = aws.String(d.Get(names.AttrDomain).(string))
-			input.DomainIAMRoleName = aws.String(d.Get("domain_iam_role_name").(string))
-		}
-
-		if d.HasChange("enable_global_write_forwarding") {
-			input.EnableGlobalWriteForwarding = aws.Bool(d.Get("enable_global_write_forwarding").(bool))
-		}
-
-		// for provisioned and serverlessv2 (also "provisioned"), data api must be enabled using conn.EnableHttpEndpoint() as below
-		if d.HasChange("enable_http_endpoint") && d.Get("engine_mode").(string) != engineModeProvisioned {
-			input.EnableHttpEndpoint = aws.Bool(d.Get("enable_http_endpoint").(bool))
-		}
+			err := handler.precondition(ctx, d, deadline.Remaining())
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): %s", d.Id(), err)
+			}
 
-		if d.HasChange("enable_local_write_forwarding") {
-			input.EnableLocalWriteForwarding = aws.Bool(d.Get("enable_local_write_forwarding").(bool))
-		}
+			createIn := handler.createBlueGreenInput(d)
 
-		if d.HasChange("enabled_cloudwatch_logs_exports") {
-			o, n := d.GetChange("enabled_cloudwatch_logs_exports")
-			os, ns := o.(*schema.Set), n.(*schema.Set)
+			log.Printf("[DEBUG] Updating RDS Cluster (%s): Creating Blue/Green Deployment", d.Id())
 
-			input.CloudwatchLogsExportConfiguration = &types.CloudwatchLogsExportConfiguration{
-				DisableLogTypes: flex.ExpandStringValueSet(os.Difference(ns)),
-				EnableLogTypes:  flex.ExpandStringValueSet(ns.Difference(os)),
+			dep, err := orchestrator.CreateDeployment(ctx, createIn)
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): %s", d.Id(), err)
 			}
-		}
 
-		if d.HasChange(names.AttrEngineVersion) {
-			input.EngineVersion = aws.String(d.Get(names.AttrEngineVersion).(string))
-		}
+			deploymentIdentifier := aws.ToString(dep.BlueGreenDeploymentIdentifier)
+			defer func() {
+				log.Printf("[DEBUG] Updating RDS Cluster (%s): Deleting Blue/Green Deployment", d.Id())
 
-		// This can happen when updates are deferred (apply_immediately = false), and
-		// multiple applies occur before the maintenance window. In this case,
-		// continue sending the desired engine_version as part of the modify request.
-		if d.Get(names.AttrEngineVersion).(string) != d.Get("engine_version_actual").(string) {
-			input.EngineVersion = aws.String(d.Get(names.AttrEngineVersion).(string))
-		}
+				// Ensure that the Blue/Green Deployment, and the Green environment if the switchover did not complete, is always cleaned up.
+				deleted, err := orchestrator.DeleteDeployment(ctx, deploymentIdentifier)
 
-		if d.HasChange("iam_database_authentication_enabled") {
-			input.EnableIAMDatabaseAuthentication = aws.Bool(d.Get("iam_database_authentication_enabled").(bool))
-		}
+				if err != nil {
+					diags = sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): deleting Blue/Green Deployment: %s", d.Id(), err)
+					return
+				}
 
-		if d.HasChange(names.AttrIOPS) {
-			input.Iops = aws.Int32(int32(d.Get(names.AttrIOPS).(int)))
-		}
+				if !deleted {
+					return
+				}
 
-		if d.HasChange("manage_master_user_password") {
-			input.ManageMasterUserPassword = aws.Bool(d.Get("manage_master_user_password").(bool))
-		}
+				orchestrator.AddCleanupWaiter(func(ctx context.Context, conn *rds.Client, optFns ...tfresource.OptionsFunc) {
+					if _, err := waitBlueGreenDeploymentDeleted(ctx, conn, deploymentIdentifier, deadline.Remaining(), optFns...); err != nil {
+						diags = sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): deleting Blue/Green Deployment: waiting for completion: %s", d.Id(), err)
+					}
+				})
+			}()
 
-		if d.HasChange("master_password") {
-			if v, ok := d.GetOk("master_password"); ok {
-				input.MasterUserPassword = aws.String(v.(string))
+			dep, err = orchestrator.waitForDeploymentAvailable(ctx, deploymentIdentifier, deadline.Remaining())
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): %s", d.Id(), err)
 			}
-		}
 
-		if d.HasChange("master_password_wo_version") {
-			masterPasswordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("master_password_wo"))
-			diags = append(diags, di...)
-			if diags.HasError() {
-				return diags
+			targetARN, err := parseDBClusterARN(aws.ToString(dep.Target))
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): creating Blue/Green Deployment: waiting for Green environment: %s", d.Id(), err)
 			}
 
-			if masterPasswordWO != "" {
-				input.MasterUserPassword = aws.String(masterPasswordWO)
+			if err := handler.waitTargetAvailable(ctx, targetARN.Identifier, deadline.Remaining()); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): creating Blue/Green Deployment: waiting for Green environment: %s", d.Id(), err)
 			}
-		}
 
-		if d.HasChange("master_user_secret_kms_key_id") {
-			if v, ok := d.GetOk("master_user_secret_kms_key_id"); ok {
-				input.MasterUserSecretKmsKeyId = aws.String(v.(string))
+			if err := handler.modifyTarget(ctx, targetARN.Identifier, d, deadline.Remaining(), fmt.Sprintf("Updating RDS Cluster (%s)", d.Id())); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): %s", d.Id(), err)
 			}
-		}
 
-		if d.HasChange("monitoring_interval") {
-			input.MonitoringInterval = aws.Int32(int32(d.Get("monitoring_interval").(int)))
-		}
+			if v, ok := d.GetOk("blue_green_update.0.max_replica_lag"); ok {
+				log.Printf("[DEBUG] Updating RDS Cluster (%s): Waiting for Green environment replica lag", d.Id())
 
-		if d.HasChange("monitoring_role_arn") {
-			input.MonitoringRoleArn = aws.String(d.Get("monitoring_role_arn").(string))
-		}
-
-		if d.HasChange("network_type") {
-			input.NetworkType = aws.String(d.Get("network_type").(string))
-		}
-
-		if d.HasChange("performance_insights_enabled") {
-			input.EnablePerformanceInsights = aws.Bool(d.Get("performance_insights_enabled").(bool))
-		}
-
-		if d.HasChange("performance_insights_kms_key_id") {
-			input.PerformanceInsightsKMSKeyId = aws.String(d.Get("performance_insights_kms_key_id").(string))
-		}
-
-		if d.HasChange("performance_insights_retention_period") {
-			input.PerformanceInsightsRetentionPeriod = aws.Int32(int32(d.Get("performance_insights_retention_period").(int)))
-		}
-
-		if d.HasChange(names.AttrPort) {
-			input.Port = aws.Int32(int32(d.Get(names.AttrPort).(int)))
-		}
+				if err := handler.waitReplicaLag(ctx, targetARN.Identifier, time.Duration(v.(int))*time.Second, deadline.Remaining()); err != nil {
+					return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): %s", d.Id(), err)
+				}
+			}
 
-		if d.HasChange("preferred_backup_window") {
-			input.PreferredBackupWindow = aws.String(d.Get("preferred_backup_window").(string))
-		}
+			if v, ok := d.GetOk("blue_green_update.0.max_replication_slot_lag"); ok {
+				log.Printf("[DEBUG] Updating RDS Cluster (%s): Waiting for Green environment replication slot lag", d.Id())
 
-		if d.HasChange(names.AttrPreferredMaintenanceWindow) {
-			input.PreferredMaintenanceWindow = aws.String(d.Get(names.AttrPreferredMaintenanceWindow).(string))
-		}
+				sourceARN, err := parseDBClusterARN(aws.ToString(dep.Source))
+				if err != nil {
+					return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): waiting for Green environment replication slot lag: %s", d.Id(), err)
+				}
 
-		if d.HasChange("scaling_configuration") {
-			if v, ok := d.GetOk("scaling_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-				input.ScalingConfiguration = expandScalingConfiguration(v.([]interface{})[0].(map[string]interface{}))
+				if err := handler.waitReplicationSlotLag(ctx, sourceARN.Identifier, int64(v.(int)), deadline.Remaining()); err != nil {
+					return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): %s", d.Id(), err)
+				}
 			}
-		}
 
-		if d.HasChange("serverlessv2_scaling_configuration") {
-			if v, ok := d.GetOk("serverlessv2_scaling_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
-				input.ServerlessV2ScalingConfiguration = expandServerlessV2ScalingConfiguration(v.([]interface{})[0].(map[string]interface{}))
+			log.Printf("[DEBUG] Updating RDS Cluster (%s): Switching over Blue/Green Deployment", d.Id())
+
+			switchoverTimeout := time.Duration(d.Get("blue_green_update.0.switchover_timeout").(int)) * time.Second
+			dep, err = orchestrator.Switchover(ctx, deploymentIdentifier, switchoverTimeout, deadline.Remaining())
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): %s", d.Id(), err)
 			}
-		}
 
-		if d.HasChange(names.AttrStorageType) {
-			input.StorageType = aws.String(d.Get(names.AttrStorageType).(string))
-		}
+			log.Printf("[DEBUG] Updating RDS Cluster (%s): Deleting Blue/Green Deployment source", d.Id())
 
-		if d.HasChange(names.AttrVPCSecurityGroupIDs) {
-			if v, ok := d.GetOk(names.AttrVPCSecurityGroupIDs); ok && v.(*schema.Set).Len() > 0 {
-				input.VpcSecurityGroupIds = flex.ExpandStringValueSet(v.(*schema.Set))
-			} else {
-				input.VpcSecurityGroupIds = []string{}
+			sourceARN, err := parseDBClusterARN(aws.ToString(dep.Source))
+			if err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): deleting Blue/Green Deployment source: %s", d.Id(), err)
 			}
-		}
 
-		const (
-			timeout = 5 * time.Minute
-		)
-		_, err := tfresource.RetryWhen(ctx, timeout,
-			func() (interface{}, error) {
-				return conn.ModifyDBCluster(ctx, input)
-			},
-			func(err error) (bool, error) {
-				if tfawserr.ErrMessageContains(err, errCodeInvalidParameterValue, "IAM role ARN value is invalid or does not include the required permissions") {
-					return true, err
-				}
+			if err := handler.deleteSource(ctx, sourceARN.Identifier, deadline.Remaining()); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): deleting Blue/Green Deployment source: %s", d.Id(), err)
+			}
 
-				if errs.IsA[*types.InvalidDBClusterStateFault](err) {
-					return true, err
+			orchestrator.AddCleanupWaiter(func(ctx context.Context, conn *rds.Client, optFns ...tfresource.OptionsFunc) {
+				if _, err := waitDBClusterDeleted(ctx, conn, sourceARN.Identifier, deadline.Remaining()); err != nil {
+					diags = sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): deleting Blue/Green Deployment source: waiting for completion: %s", d.Id(), err)
 				}
+			})
 
-				if tfawserr.ErrMessageContains(err, errCodeInvalidParameterCombination, "db-instance-parameter-group-name can only be specified for a major") {
-					input.DBInstanceParameterGroupName = nil
-					return true, err
-				}
+			if diags.HasError() {
+				return diags
+			}
+		} else {
+			applyImmediately := d.Get(names.AttrApplyImmediately).(bool)
+			input := &rds.ModifyDBClusterInput{
+				ApplyImmediately:    aws.Bool(applyImmediately),
+				DBClusterIdentifier: aws.String(d.Id()),
+			}
 
-				return false, err
-			},
-		)
+			diags = append(diags, dbClusterPopulateModify(input, d)...)
+			if diags.HasError() {
+				return diags
+			}
 
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): %s", d.Id(), err)
-		}
+			if err := dbClusterModify(ctx, conn, input); err != nil {
+				return sdkdiag.AppendErrorf(diags, "updating RDS Cluster (%s): %s", d.Id(), err)
+			}
 
-		if _, err := waitDBClusterUpdated(ctx, conn, d.Id(), applyImmediately, d.Timeout(schema.TimeoutUpdate)); err != nil {
-			return sdkdiag.AppendErrorf(diags, "waiting for RDS Cluster (%s) update: %s", d.Id(), err)
+			if _, err := waitDBClusterUpdated(ctx, conn, d.Id(), applyImmediately, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				return sdkdiag.AppendErrorf(diags, "waiting for RDS Cluster (%s) update: %s", d.Id(), err)
+			}
 		}
 	}
 
@@ -1803,6 +1786,221 @@ func resourceClusterUpdate(ctx context.Context, d *schema.ResourceData, meta int
 	return append(diags, resourceClusterRead(ctx, d, meta)...)
 }
 
+func dbClusterPopulateModify(input *rds.ModifyDBClusterInput, d *schema.ResourceData) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if d.HasChange(names.AttrAllocatedStorage) {
+		input.AllocatedStorage = aws.Int32(int32(d.Get(names.AttrAllocatedStorage).(int)))
+	}
+
+	if v, ok := d.GetOk(names.AttrAllowMajorVersionUpgrade); ok {
+		input.AllowMajorVersionUpgrade = aws.Bool(v.(bool))
+	}
+
+	if d.HasChange("backtrack_window") {
+		input.BacktrackWindow = aws.Int64(int64(d.Get("backtrack_window").(int)))
+	}
+
+	if d.HasChange("backup_retention_period") {
+		input.BackupRetentionPeriod = aws.Int32(int32(d.Get("backup_retention_period").(int)))
+	}
+
+	if d.HasChange("ca_certificate_identifier") {
+		input.CACertificateIdentifier = aws.String(d.Get("ca_certificate_identifier").(string))
+	}
+
+	if d.HasChange("copy_tags_to_snapshot") {
+		input.CopyTagsToSnapshot = aws.Bool(d.Get("copy_tags_to_snapshot").(bool))
+	}
+
+	if d.HasChange("db_cluster_instance_class") {
+		input.DBClusterInstanceClass = aws.String(d.Get("db_cluster_instance_class").(string))
+	}
+
+	if d.HasChange("db_cluster_parameter_group_name") {
+		input.DBClusterParameterGroupName = aws.String(d.Get("db_cluster_parameter_group_name").(string))
+	}
+
+	// DB instance parameter group name is not currently returned from the
+	// DescribeDBClusters API. This means there is no drift detection, so when
+	// set, the configured attribute should always be sent on modify.
+	// Except, this causes an error on a minor version upgrade, so it is
+	// removed during update retry, if necessary.
+	if v, ok := d.GetOk("db_instance_parameter_group_name"); ok || d.HasChange("db_instance_parameter_group_name") {
+		input.DBInstanceParameterGroupName = aws.String(v.(string))
+	}
+
+	if d.HasChange(names.AttrDeletionProtection) {
+		input.DeletionProtection = aws.Bool(d.Get(names.AttrDeletionProtection).(bool))
+	}
+
+	if d.HasChanges(names.AttrDomain, "domain_iam_role_name") {
+		input.Domain = aws.String(d.Get(names.AttrDomain).(string))
+		input.DomainIAMRoleName = aws.String(d.Get("domain_iam_role_name").(string))
+	}
+
+	if d.HasChange("enable_global_write_forwarding") {
+		input.EnableGlobalWriteForwarding = aws.Bool(d.Get("enable_global_write_forwarding").(bool))
+	}
+
+	// for provisioned and serverlessv2 (also "provisioned"), data api must be enabled using conn.EnableHttpEndpoint() as below
+	if d.HasChange("enable_http_endpoint") && d.Get("engine_mode").(string) != engineModeProvisioned {
+		input.EnableHttpEndpoint = aws.Bool(d.Get("enable_http_endpoint").(bool))
+	}
+
+	if d.HasChange("enable_local_write_forwarding") {
+		input.EnableLocalWriteForwarding = aws.Bool(d.Get("enable_local_write_forwarding").(bool))
+	}
+
+	if d.HasChange("enabled_cloudwatch_logs_exports") {
+		o, n := d.GetChange("enabled_cloudwatch_logs_exports")
+		os, ns := o.(*schema.Set), n.(*schema.Set)
+
+		input.CloudwatchLogsExportConfiguration = &types.CloudwatchLogsExportConfiguration{
+			DisableLogTypes: flex.ExpandStringValueSet(os.Difference(ns)),
+			EnableLogTypes:  flex.ExpandStringValueSet(ns.Difference(os)),
+		}
+	}
+
+	if d.HasChange(names.AttrEngineVersion) {
+		input.EngineVersion = aws.String(d.Get(names.AttrEngineVersion).(string))
+	}
+
+	// This can happen when updates are deferred (apply_immediately = false), and
+	// multiple applies occur before the maintenance window. In this case,
+	// continue sending the desired engine_version as part of the modify request.
+	if d.Get(names.AttrEngineVersion).(string) != d.Get("engine_version_actual").(string) {
+		input.EngineVersion = aws.String(d.Get(names.AttrEngineVersion).(string))
+	}
+
+	if d.HasChange("iam_database_authentication_enabled") {
+		input.EnableIAMDatabaseAuthentication = aws.Bool(d.Get("iam_database_authentication_enabled").(bool))
+	}
+
+	if d.HasChange(names.AttrIOPS) {
+		input.Iops = aws.Int32(int32(d.Get(names.AttrIOPS).(int)))
+	}
+
+	if d.HasChange("manage_master_user_password") {
+		input.ManageMasterUserPassword = aws.Bool(d.Get("manage_master_user_password").(bool))
+	}
+
+	if d.HasChange("master_password") {
+		if v, ok := d.GetOk("master_password"); ok {
+			input.MasterUserPassword = aws.String(v.(string))
+		}
+	}
+
+	if d.HasChange("master_password_wo_version") {
+		masterPasswordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("master_password_wo"))
+		diags = append(diags, di...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if masterPasswordWO != "" {
+			input.MasterUserPassword = aws.String(masterPasswordWO)
+		}
+	}
+
+	if d.HasChange("master_user_secret_kms_key_id") {
+		if v, ok := d.GetOk("master_user_secret_kms_key_id"); ok {
+			input.MasterUserSecretKmsKeyId = aws.String(v.(string))
+		}
+	}
+
+	if d.HasChange("monitoring_interval") {
+		input.MonitoringInterval = aws.Int32(int32(d.Get("monitoring_interval").(int)))
+	}
+
+	if d.HasChange("monitoring_role_arn") {
+		input.MonitoringRoleArn = aws.String(d.Get("monitoring_role_arn").(string))
+	}
+
+	if d.HasChange("network_type") {
+		input.NetworkType = aws.String(d.Get("network_type").(string))
+	}
+
+	if d.HasChange("performance_insights_enabled") {
+		input.EnablePerformanceInsights = aws.Bool(d.Get("performance_insights_enabled").(bool))
+	}
+
+	if d.HasChange("performance_insights_kms_key_id") {
+		input.PerformanceInsightsKMSKeyId = aws.String(d.Get("performance_insights_kms_key_id").(string))
+	}
+
+	if d.HasChange("performance_insights_retention_period") {
+		input.PerformanceInsightsRetentionPeriod = aws.Int32(int32(d.Get("performance_insights_retention_period").(int)))
+	}
+
+	if d.HasChange(names.AttrPort) {
+		input.Port = aws.Int32(int32(d.Get(names.AttrPort).(int)))
+	}
+
+	if d.HasChange("preferred_backup_window") {
+		input.PreferredBackupWindow = aws.String(d.Get("preferred_backup_window").(string))
+	}
+
+	if d.HasChange(names.AttrPreferredMaintenanceWindow) {
+		input.PreferredMaintenanceWindow = aws.String(d.Get(names.AttrPreferredMaintenanceWindow).(string))
+	}
+
+	if d.HasChange("scaling_configuration") {
+		if v, ok := d.GetOk("scaling_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.ScalingConfiguration = expandScalingConfiguration(v.([]interface{})[0].(map[string]interface{}))
+		}
+	}
+
+	if d.HasChange("serverlessv2_scaling_configuration") {
+		if v, ok := d.GetOk("serverlessv2_scaling_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.ServerlessV2ScalingConfiguration = expandServerlessV2ScalingConfiguration(v.([]interface{})[0].(map[string]interface{}))
+		}
+	}
+
+	if d.HasChange(names.AttrStorageType) {
+		input.StorageType = aws.String(d.Get(names.AttrStorageType).(string))
+	}
+
+	if d.HasChange(names.AttrVPCSecurityGroupIDs) {
+		if v, ok := d.GetOk(names.AttrVPCSecurityGroupIDs); ok && v.(*schema.Set).Len() > 0 {
+			input.VpcSecurityGroupIds = flex.ExpandStringValueSet(v.(*schema.Set))
+		} else {
+			input.VpcSecurityGroupIds = []string{}
+		}
+	}
+
+	return diags
+}
+
+func dbClusterModify(ctx context.Context, conn *rds.Client, input *rds.ModifyDBClusterInput) error {
+	const (
+		timeout = 5 * time.Minute
+	)
+	_, err := tfresource.RetryWhen(ctx, timeout,
+		func() (interface{}, error) {
+			return conn.ModifyDBCluster(ctx, input)
+		},
+		func(err error) (bool, error) {
+			if tfawserr.ErrMessageContains(err, errCodeInvalidParameterValue, "IAM role ARN value is invalid or does not include the required permissions") {
+				return true, err
+			}
+
+			if errs.IsA[*types.InvalidDBClusterStateFault](err) {
+				return true, err
+			}
+
+			if tfawserr.ErrMessageContains(err, errCodeInvalidParameterCombination, "db-instance-parameter-group-name can only be specified for a major") {
+				input.DBInstanceParameterGroupName = nil
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	return err
+}
+
 func resourceClusterDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).RDSClient(ctx)
@@ -2317,3 +2515,149 @@ func flattenServerlessV2ScalingConfigurationInfo(apiObject *types.ServerlessV2Sc
 
 	return tfMap
 }
+
+// findClusterWriterMetric returns the most recent maximum of an AWS/RDS CloudWatch metric for a DB cluster's writer instance.
+func findClusterWriterMetric(ctx context.Context, conn *cloudwatch.Client, clusterID, metricName string) (float64, error) {
+	now := time.Now()
+	input := &cloudwatch.GetMetricDataInput{
+		EndTime: aws.Time(now),
+		MetricDataQueries: []cwtypes.MetricDataQuery{
+			{
+				Id: aws.String("lag"),
+				MetricStat: &cwtypes.MetricStat{
+					Metric: &cwtypes.Metric{
+						Dimensions: []cwtypes.Dimension{
+							{
+								Name:  aws.String("DBClusterIdentifier"),
+								Value: aws.String(clusterID),
+							},
+							{
+								Name:  aws.String("Role"),
+								Value: aws.String("WRITER"),
+							},
+						},
+						MetricName: aws.String(metricName),
+						Namespace:  aws.String("AWS/RDS"),
+					},
+					Period: aws.Int32(60),
+					Stat:   aws.String(string(cwtypes.StatisticMaximum)),
+				},
+			},
+		},
+		ScanBy:    cwtypes.ScanByTimestampDescending,
+		StartTime: aws.Time(now.Add(-5 * time.Minute)),
+	}
+
+	output, err := conn.GetMetricData(ctx, input)
+
+	if err != nil {
+		return 0, err
+	}
+
+	for _, v := range output.MetricDataResults {
+		if len(v.Values) > 0 {
+			return v.Values[0], nil
+		}
+	}
+
+	return 0, &retry.NotFoundError{
+		LastRequest: input,
+	}
+}
+
+const (
+	clusterMetricAuroraBinlogReplicaLag          = "AuroraBinlogReplicaLag"          // Seconds.
+	clusterMetricOldestLogicalReplicationSlotLag = "OldestLogicalReplicationSlotLag" // Bytes.
+)
+
+const (
+	clusterReplicaLagStatusCaughtUp = "caught-up"
+	clusterReplicaLagStatusLagging  = "lagging"
+	clusterReplicaLagStatusUnknown  = "unknown"
+)
+
+func statusClusterReplicaLag(ctx context.Context, conn *cloudwatch.Client, clusterID, metricName string, maxLag float64) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findClusterWriterMetric(ctx, conn, clusterID, metricName)
+
+		// The metric is only published once replication is running.
+		if tfresource.NotFound(err) {
+			return aws.Float64(-1), clusterReplicaLagStatusUnknown, nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if output > maxLag {
+			return aws.Float64(output), clusterReplicaLagStatusLagging, nil
+		}
+
+		return aws.Float64(output), clusterReplicaLagStatusCaughtUp, nil
+	}
+}
+
+func waitClusterReplicaLagWithin(ctx context.Context, conn *cloudwatch.Client, clusterID, metricName string, maxLag float64, timeout time.Duration) (float64, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   []string{clusterReplicaLagStatusLagging, clusterReplicaLagStatusUnknown},
+		Target:                    []string{clusterReplicaLagStatusCaughtUp},
+		Refresh:                   statusClusterReplicaLag(ctx, conn, clusterID, metricName, maxLag),
+		Timeout:                   timeout,
+		MinTimeout:                30 * time.Second,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*float64); ok {
+		if lag := aws.ToFloat64(output); lag > maxLag {
+			tfresource.SetLastError(err, fmt.Errorf("%s: %g", metricName, lag))
+		}
+
+		return aws.ToFloat64(output), err
+	}
+
+	return 0, err
+}
+
+// waitBlueGreenClusterReplicaLagWithin waits for the Green environment's binary log replica lag, in seconds, to be within maxLag.
+func waitBlueGreenClusterReplicaLagWithin(ctx context.Context, conn *cloudwatch.Client, clusterID string, maxLag, timeout time.Duration) (float64, error) {
+	return waitClusterReplicaLagWithin(ctx, conn, clusterID, clusterMetricAuroraBinlogReplicaLag, maxLag.Seconds(), timeout)
+}
+
+// waitBlueGreenClusterReplicationSlotLagWithin waits for the Blue environment's oldest logical replication slot lag, in bytes, to be within maxLag.
+func waitBlueGreenClusterReplicationSlotLagWithin(ctx context.Context, conn *cloudwatch.Client, clusterID string, maxLag int64, timeout time.Duration) (float64, error) {
+	return waitClusterReplicaLagWithin(ctx, conn, clusterID, clusterMetricOldestLogicalReplicationSlotLag, float64(maxLag), timeout)
+}
+
+func clusterValidBlueGreenEngines() []string {
+	return []string{
+		ClusterEngineAuroraMySQL,
+		ClusterEngineAuroraPostgreSQL,
+	}
+}
+
+type dbClusterARN struct {
+	arn.ARN
+	Identifier string
+}
+
+func parseDBClusterARN(s string) (dbClusterARN, error) {
+	arn, err := arn.Parse(s)
+	if err != nil {
+		return dbClusterARN{}, err
+	}
+
+	result := dbClusterARN{
+		ARN: arn,
+	}
+
+	re := regexache.MustCompile(`^cluster:([0-9a-z-]+)$`)
+	matches := re.FindStringSubmatch(arn.Resource)
+	if matches == nil || len(matches) != 2 {
+		return dbClusterARN{}, errors.New("DB Cluster ARN: invalid resource section")
+	}
+	result.Identifier = matches[1]
+
+	return result, nil
+}
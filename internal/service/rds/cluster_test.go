@@ -1737,6 +1737,83 @@ func TestAccRDSCluster_engineVersionWithPrimaryInstance(t *testing.T) {
 	})
 }
 
+func TestAccRDSCluster_BlueGreenDeployment_updateEngineVersion(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var v1, v2 types.DBCluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_rds_cluster.test"
+	dataSourceName := "data.aws_rds_engine_version.test"
+	dataSourceNameUpgrade := "data.aws_rds_engine_version.upgrade"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_BlueGreenDeployment_engineVersion(rName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &v1),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrEngineVersion, dataSourceName, names.AttrVersion),
+					resource.TestCheckResourceAttr(resourceName, "blue_green_update.0.enabled", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "blue_green_update.0.max_replication_slot_lag", "104857600"),
+					resource.TestCheckResourceAttr(resourceName, "blue_green_update.0.switchover_timeout", "600"),
+				),
+			},
+			{
+				Config: testAccClusterConfig_BlueGreenDeployment_engineVersion(rName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &v2),
+					testAccCheckClusterRecreated(&v1, &v2),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrEngineVersion, dataSourceNameUpgrade, names.AttrVersion),
+					resource.TestCheckResourceAttr(resourceName, names.AttrClusterIdentifier, rName),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRDSCluster_BlueGreenDeployment_maxReplicaLagUnsupportedEngine(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccClusterConfig_BlueGreenDeployment_replicaLag(rName, tfrds.ClusterEngineAuroraPostgreSQL, "max_replica_lag"),
+				ExpectError: regexache.MustCompile(`"blue_green_update.max_replica_lag" cannot be set when "engine" is "aurora-postgresql"`),
+			},
+		},
+	})
+}
+
+func TestAccRDSCluster_BlueGreenDeployment_maxReplicationSlotLagUnsupportedEngine(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RDSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccClusterConfig_BlueGreenDeployment_replicaLag(rName, tfrds.ClusterEngineAuroraMySQL, "max_replication_slot_lag"),
+				ExpectError: regexache.MustCompile(`"blue_green_update.max_replication_slot_lag" cannot be set when "engine" is "aurora-mysql"`),
+			},
+		},
+	})
+}
+
 func TestAccRDSCluster_GlobalClusterIdentifierEngineMode_global(t *testing.T) {
 	ctx := acctest.Context(t)
 	var dbCluster1 types.DBCluster
@@ -4458,6 +4535,88 @@ resource "aws_rds_cluster_instance" "test" {
 `, tfrds.ClusterEngineAuroraPostgreSQL, upgrade, rName, mainInstanceClasses)
 }
 
+func testAccClusterConfig_BlueGreenDeployment_engineVersion(rName string, upgrade bool) string {
+	return fmt.Sprintf(`
+data "aws_rds_engine_version" "test" {
+  engine                    = %[1]q
+  latest                    = true
+  preferred_upgrade_targets = [data.aws_rds_engine_version.upgrade.version_actual]
+}
+
+data "aws_rds_engine_version" "upgrade" {
+  engine = %[1]q
+}
+
+locals {
+  parameter_group_name = %[2]t ? data.aws_rds_engine_version.upgrade.parameter_group_family : data.aws_rds_engine_version.test.parameter_group_family
+  engine_version       = %[2]t ? data.aws_rds_engine_version.upgrade.version : data.aws_rds_engine_version.test.version
+}
+
+resource "aws_rds_cluster_parameter_group" "test" {
+  name   = "${%[3]q}-${replace(local.parameter_group_name, ".", "-")}"
+  family = local.parameter_group_name
+
+  parameter {
+    name         = "rds.logical_replication"
+    value        = "1"
+    apply_method = "pending-reboot"
+  }
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+
+resource "aws_rds_cluster" "test" {
+  cluster_identifier              = %[3]q
+  database_name                   = "test"
+  db_cluster_parameter_group_name = aws_rds_cluster_parameter_group.test.name
+  engine                          = data.aws_rds_engine_version.test.engine
+  engine_version                  = local.engine_version
+  master_password                 = "avoid-plaintext-passwords"
+  master_username                 = "tfacctest"
+  skip_final_snapshot             = true
+  apply_immediately               = true
+
+  blue_green_update {
+    enabled                  = true
+    max_replication_slot_lag = 104857600
+    switchover_timeout       = 600
+  }
+}
+
+data "aws_rds_orderable_db_instance" "test" {
+  engine                     = data.aws_rds_engine_version.test.engine
+  engine_version             = data.aws_rds_engine_version.test.version
+  preferred_instance_classes = [%[4]s]
+}
+
+resource "aws_rds_cluster_instance" "test" {
+  identifier         = %[3]q
+  cluster_identifier = aws_rds_cluster.test.cluster_identifier
+  engine             = aws_rds_cluster.test.engine
+  instance_class     = data.aws_rds_orderable_db_instance.test.instance_class
+}
+`, tfrds.ClusterEngineAuroraPostgreSQL, upgrade, rName, mainInstanceClasses)
+}
+
+func testAccClusterConfig_BlueGreenDeployment_replicaLag(rName, engine, lagAttribute string) string {
+	return fmt.Sprintf(`
+resource "aws_rds_cluster" "test" {
+  cluster_identifier  = %[1]q
+  engine              = %[2]q
+  master_password     = "avoid-plaintext-passwords"
+  master_username     = "tfacctest"
+  skip_final_snapshot = true
+
+  blue_green_update {
+    enabled = true
+    %[3]s = 5
+  }
+}
+`, rName, engine, lagAttribute)
+}
+
 func testAccClusterConfig_port(rName string, port int) string {
 	return fmt.Sprintf(`
 resource "aws_rds_cluster" "test" {
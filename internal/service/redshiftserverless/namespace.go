@@ -14,9 +14,11 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/redshiftserverless"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/redshiftserverless/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
@@ -30,6 +32,7 @@ import (
 
 // @SDKResource("aws_redshiftserverless_namespace", name="Namespace")
 // @Tags(identifierAttribute="arn")
+// @Testing(writeOnlyAttribute="admin_user_password_wo")
 func resourceNamespace() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceNamespaceCreate,
@@ -56,7 +59,19 @@ func resourceNamespace() *schema.Resource {
 				Type:          schema.TypeString,
 				Optional:      true,
 				Sensitive:     true,
-				ConflictsWith: []string{"manage_admin_password"},
+				ConflictsWith: []string{"admin_user_password_wo", "manage_admin_password"},
+			},
+			"admin_user_password_wo": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				WriteOnly:     true,
+				ConflictsWith: []string{"admin_user_password", "manage_admin_password"},
+			},
+			"admin_user_password_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"admin_user_password_wo"},
 			},
 			"admin_username": {
 				Type:      schema.TypeString,
@@ -105,7 +120,7 @@ func resourceNamespace() *schema.Resource {
 			"manage_admin_password": {
 				Type:          schema.TypeBool,
 				Optional:      true,
-				ConflictsWith: []string{"admin_user_password"},
+				ConflictsWith: []string{"admin_user_password", "admin_user_password_wo"},
 			},
 			"namespace_id": {
 				Type:     schema.TypeString,
@@ -121,6 +136,10 @@ func resourceNamespace() *schema.Resource {
 		},
 
 		CustomizeDiff: verify.SetTagsDiff,
+
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			validation.PreferWriteOnlyAttribute(cty.GetAttrPath("admin_user_password"), cty.GetAttrPath("admin_user_password_wo")),
+		},
 	}
 }
 
@@ -142,6 +161,17 @@ func resourceNamespaceCreate(ctx context.Context, d *schema.ResourceData, meta i
 		input.AdminUserPassword = aws.String(v.(string))
 	}
 
+	// get write-only value from configuration
+	adminUserPasswordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("admin_user_password_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if adminUserPasswordWO != "" {
+		input.AdminUserPassword = aws.String(adminUserPasswordWO)
+	}
+
 	if v, ok := d.GetOk("admin_username"); ok {
 		input.AdminUsername = aws.String(v.(string))
 	}
@@ -225,9 +255,19 @@ func resourceNamespaceUpdate(ctx context.Context, d *schema.ResourceData, meta i
 			input.AdminPasswordSecretKmsKeyId = aws.String(d.Get("admin_password_secret_kms_key_id").(string))
 		}
 
-		if d.HasChanges("admin_username", "admin_user_password") {
+		if d.HasChanges("admin_username", "admin_user_password", "admin_user_password_wo_version") {
 			input.AdminUsername = aws.String(d.Get("admin_username").(string))
 			input.AdminUserPassword = aws.String(d.Get("admin_user_password").(string))
+
+			adminUserPasswordWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("admin_user_password_wo"))
+			diags = append(diags, di...)
+			if diags.HasError() {
+				return diags
+			}
+
+			if adminUserPasswordWO != "" {
+				input.AdminUserPassword = aws.String(adminUserPasswordWO)
+			}
 		}
 
 		if d.HasChange("default_iam_role_arn") {
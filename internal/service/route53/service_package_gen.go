@@ -23,6 +23,11 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 			TypeName: "aws_route53_records",
 			Name:     "Records",
 		},
+		{
+			Factory:  newZoneFileDataSource,
+			TypeName: "aws_route53_zone_file",
+			Name:     "Zone File",
+		},
 		{
 			Factory:  newZonesDataSource,
 			TypeName: "aws_route53_zones",
@@ -43,6 +48,11 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 			TypeName: "aws_route53_cidr_location",
 			Name:     "CIDR Location",
 		},
+		{
+			Factory:  newZoneRecordsResource,
+			TypeName: "aws_route53_zone_records",
+			Name:     "Zone Records",
+		},
 	}
 }
 
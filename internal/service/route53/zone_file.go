@@ -0,0 +1,526 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+)
+
+// zoneRecordSet is a simple routing policy resource record set.
+type zoneRecordSet struct {
+	name          string // In the Route 53 API's representation without the trailing dot.
+	rrType        awstypes.RRType
+	setIdentifier string // Empty for simple routing policy record sets.
+	ttl           int64
+	values        []string // In the Route 53 API's representation, sorted.
+}
+
+func (rs zoneRecordSet) key() string {
+	if rs.setIdentifier != "" {
+		return rs.name + " " + string(rs.rrType) + " " + rs.setIdentifier
+	}
+
+	return rs.name + " " + string(rs.rrType)
+}
+
+func (rs zoneRecordSet) equal(o zoneRecordSet) bool {
+	return rs.key() == o.key() && rs.ttl == o.ttl && slices.Equal(rs.values, o.values)
+}
+
+// zoneFileLine is a logical line of a zone file, i.e. a line with any parenthesized continuation lines.
+type zoneFileLine struct {
+	number      int      // Line number of the first physical line.
+	blankOwner  bool     // Whether the line starts with whitespace.
+	tokens      []string // Quoted strings retain their quotes.
+	isDirective bool
+}
+
+// parseZoneFile parses an RFC 1035 master file (zone file) into resource record sets.
+// Owner names and domain names in RDATA that are relative are qualified with the current origin,
+// which is initially the specified zone name and can be changed by the `$ORIGIN` directive.
+// If the resource records of an RRset have different TTLs, the TTL of the first is used.
+func parseZoneFile(zoneFile, zoneName string) ([]zoneRecordSet, error) {
+	lines, err := lexZoneFile(zoneFile)
+	if err != nil {
+		return nil, err
+	}
+
+	origin := fqdn(zoneName)
+	var defaultTTL, lastTTL *int64
+	var owner string
+	var output []zoneRecordSet
+	index := make(map[string]int)
+
+	for _, line := range lines {
+		tokens := line.tokens
+
+		if line.isDirective {
+			switch directive := strings.ToUpper(tokens[0]); directive {
+			case "$ORIGIN":
+				if len(tokens) != 2 {
+					return nil, fmt.Errorf("line %d: $ORIGIN requires exactly one domain name", line.number)
+				}
+				origin = qualifyZoneFileName(tokens[1], origin)
+			case "$TTL":
+				if len(tokens) != 2 {
+					return nil, fmt.Errorf("line %d: $TTL requires exactly one TTL", line.number)
+				}
+				ttl, ok := parseZoneFileTTL(tokens[1])
+				if !ok {
+					return nil, fmt.Errorf("line %d: invalid TTL %q", line.number, tokens[1])
+				}
+				defaultTTL = &ttl
+			default:
+				return nil, fmt.Errorf("line %d: unsupported directive %s", line.number, directive)
+			}
+			continue
+		}
+
+		if !line.blankOwner {
+			owner = qualifyZoneFileName(tokens[0], origin)
+			tokens = tokens[1:]
+		} else if owner == "" {
+			return nil, fmt.Errorf("line %d: no owner name", line.number)
+		}
+
+		var ttl *int64
+		var rrType awstypes.RRType
+		for len(tokens) > 0 && rrType == "" {
+			token := tokens[0]
+			tokens = tokens[1:]
+
+			if v, ok := parseZoneFileTTL(token); ok && ttl == nil {
+				ttl = &v
+				continue
+			}
+
+			switch class := strings.ToUpper(token); class {
+			case "IN":
+				continue
+			case "CH", "CS", "HS":
+				return nil, fmt.Errorf("line %d: unsupported class %s", line.number, class)
+			}
+
+			rrType = awstypes.RRType(strings.ToUpper(token))
+			if !slices.Contains(enum.EnumValues[awstypes.RRType](), rrType) {
+				return nil, fmt.Errorf("line %d: unsupported record type %s", line.number, token)
+			}
+		}
+
+		if rrType == "" || len(tokens) == 0 {
+			return nil, fmt.Errorf("line %d: expected [owner] [TTL] [class] type RDATA", line.number)
+		}
+
+		switch {
+		case ttl != nil:
+			lastTTL = ttl
+		case defaultTTL != nil:
+			ttl = defaultTTL
+		case lastTTL != nil:
+			ttl = lastTTL
+		case rrType == awstypes.RRTypeSoa:
+			// The SOA record's TTL isn't used as Route 53 manages the SOA record.
+			ttl = new(int64)
+		default:
+			return nil, fmt.Errorf("line %d: no TTL specified and no $TTL directive", line.number)
+		}
+
+		value, err := zoneFileRDATA(rrType, tokens, origin)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line.number, err)
+		}
+
+		rs := zoneRecordSet{
+			name:   normalizeDomainName(owner),
+			rrType: rrType,
+			ttl:    aws.ToInt64(ttl),
+		}
+		if i, ok := index[rs.key()]; ok {
+			if !slices.Contains(output[i].values, value) {
+				output[i].values = append(output[i].values, value)
+			}
+		} else {
+			rs.values = []string{value}
+			index[rs.key()] = len(output)
+			output = append(output, rs)
+		}
+	}
+
+	for i := range output {
+		slices.Sort(output[i].values)
+	}
+
+	return output, nil
+}
+
+// lexZoneFile splits a zone file into logical lines of tokens, removing comments
+// and joining lines continued with parentheses.
+func lexZoneFile(zoneFile string) ([]zoneFileLine, error) {
+	var lines []zoneFileLine
+	var current *zoneFileLine
+	var token strings.Builder
+	inToken, inQuotes, escaped := false, false, false
+	depth := 0
+	number := 1
+
+	endToken := func() {
+		if inToken {
+			current.tokens = append(current.tokens, token.String())
+			token.Reset()
+			inToken = false
+		}
+	}
+	endLine := func() {
+		endToken()
+		if current != nil && len(current.tokens) > 0 {
+			current.isDirective = !current.blankOwner && strings.HasPrefix(current.tokens[0], "$")
+			lines = append(lines, *current)
+		}
+		current = nil
+	}
+
+	for i := 0; i < len(zoneFile); i++ {
+		ch := zoneFile[i]
+
+		if current == nil {
+			current = &zoneFileLine{
+				number:     number,
+				blankOwner: ch == ' ' || ch == '\t',
+			}
+		}
+
+		switch {
+		case escaped:
+			token.WriteByte(ch)
+			escaped = false
+			if ch == '\n' {
+				number++
+			}
+			continue
+		case ch == '\\':
+			token.WriteByte(ch)
+			inToken, escaped = true, true
+			continue
+		case inQuotes:
+			token.WriteByte(ch)
+			if ch == '"' {
+				inQuotes = false
+			}
+			if ch == '\n' {
+				number++
+			}
+			continue
+		}
+
+		switch ch {
+		case '"':
+			endToken()
+			token.WriteByte(ch)
+			inToken, inQuotes = true, true
+		case ';':
+			endToken()
+			for i+1 < len(zoneFile) && zoneFile[i+1] != '\n' {
+				i++
+			}
+		case '(':
+			endToken()
+			depth++
+		case ')':
+			endToken()
+			if depth == 0 {
+				return nil, fmt.Errorf("line %d: unbalanced parentheses", number)
+			}
+			depth--
+		case ' ', '\t', '\r':
+			endToken()
+		case '\n':
+			if depth == 0 {
+				endLine()
+			} else {
+				endToken()
+			}
+			number++
+		default:
+			token.WriteByte(ch)
+			inToken = true
+		}
+	}
+
+	if inQuotes {
+		return nil, fmt.Errorf("line %d: unterminated quoted string", number)
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("line %d: unbalanced parentheses", number)
+	}
+	if current != nil {
+		endLine()
+	}
+
+	return lines, nil
+}
+
+// qualifyZoneFileName returns the fully qualified form of a (possibly relative) domain name.
+func qualifyZoneFileName(name, origin string) string {
+	switch {
+	case name == "@":
+		return origin
+	case strings.HasSuffix(name, ".") && !strings.HasSuffix(name, `\.`):
+		return name
+	case origin == ".":
+		return name + "."
+	default:
+		return name + "." + origin
+	}
+}
+
+// parseZoneFileTTL parses a TTL in seconds or in BIND's unit format, e.g. `1h30m`.
+func parseZoneFileTTL(s string) (int64, bool) {
+	if v, err := strconv.ParseInt(s, 10, 32); err == nil {
+		return v, v >= 0
+	}
+
+	var ttl, n int64
+	digits := false
+	for _, ch := range strings.ToLower(s) {
+		if ch >= '0' && ch <= '9' {
+			n = n*10 + int64(ch-'0')
+			digits = true
+			continue
+		}
+
+		if !digits {
+			return 0, false
+		}
+
+		switch ch {
+		case 's':
+		case 'm':
+			n *= 60
+		case 'h':
+			n *= 60 * 60
+		case 'd':
+			n *= 24 * 60 * 60
+		case 'w':
+			n *= 7 * 24 * 60 * 60
+		default:
+			return 0, false
+		}
+		ttl += n
+		n, digits = 0, false
+	}
+
+	if digits {
+		return 0, false
+	}
+
+	return ttl, true
+}
+
+// zoneFileRDATA returns the Route 53 API representation of a record's RDATA.
+func zoneFileRDATA(rrType awstypes.RRType, tokens []string, origin string) (string, error) {
+	tokens = slices.Clone(tokens)
+
+	// Index of the domain name to be qualified.
+	nameIndex := -1
+	switch rrType {
+	case awstypes.RRTypeCname, awstypes.RRTypeNs, awstypes.RRTypePtr:
+		nameIndex = 0
+	case awstypes.RRTypeMx:
+		nameIndex = 1
+	case awstypes.RRTypeSrv:
+		nameIndex = 3
+	case awstypes.RRTypeTxt, awstypes.RRTypeSpf:
+		for i, token := range tokens {
+			if !strings.HasPrefix(token, `"`) {
+				tokens[i] = `"` + token + `"`
+			}
+		}
+	}
+
+	if nameIndex >= 0 {
+		if len(tokens) <= nameIndex {
+			return "", fmt.Errorf("%s record requires at least %d RDATA fields", rrType, nameIndex+1)
+		}
+		tokens[nameIndex] = qualifyZoneFileName(tokens[nameIndex], origin)
+	}
+
+	return strings.Join(tokens, " "), nil
+}
+
+// formatZoneFile renders a hosted zone's resource record sets as an RFC 1035 master file (zone file).
+// Record sets are ordered canonically (RFC 4034 section 6.1) by owner name, with the SOA and NS record sets first at each name.
+// Owner names are relative to the zone's origin.
+// Alias records and record sets with a routing policy other than simple can't be represented in a zone file,
+// so they are rendered as annotated comments, the latter grouped by name and type.
+func formatZoneFile(zoneName string, apiObjects []awstypes.ResourceRecordSet) string {
+	origin := fqdn(normalizeDomainName(zoneName))
+	apiObjects = slices.Clone(apiObjects)
+	slices.SortStableFunc(apiObjects, compareResourceRecordSets)
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "$ORIGIN %s\n", origin)
+
+	for i := 0; i < len(apiObjects); {
+		apiObject := apiObjects[i]
+		owner := zoneFileOwnerName(aws.ToString(apiObject.Name), origin)
+
+		if apiObject.SetIdentifier == nil {
+			i++
+
+			switch {
+			case apiObject.AliasTarget != nil:
+				fmt.Fprintf(&sb, "; %s\n", zoneFileAlias(owner, apiObject))
+			case apiObject.TrafficPolicyInstanceId != nil:
+				fmt.Fprintf(&sb, "; %s\tIN\t%s\t; traffic_policy_instance_id=%s\n", owner, apiObject.Type, aws.ToString(apiObject.TrafficPolicyInstanceId))
+			default:
+				for _, v := range zoneFileRecords(owner, apiObject) {
+					fmt.Fprintf(&sb, "%s\n", v)
+				}
+			}
+
+			continue
+		}
+
+		// Group the record sets with the same name and type.
+		fmt.Fprintf(&sb, "; BEGIN routing policy %s %s\n", owner, apiObject.Type)
+		for ; i < len(apiObjects) && apiObjects[i].SetIdentifier != nil && normalizeDomainName(apiObjects[i].Name) == normalizeDomainName(apiObject.Name) && apiObjects[i].Type == apiObject.Type; i++ {
+			v := apiObjects[i]
+			fmt.Fprintf(&sb, "; %s\n", zoneFileRoutingPolicy(v))
+			if v.AliasTarget != nil {
+				fmt.Fprintf(&sb, ";   %s\n", zoneFileAlias(owner, v))
+			} else {
+				for _, v := range zoneFileRecords(owner, v) {
+					fmt.Fprintf(&sb, ";   %s\n", v)
+				}
+			}
+		}
+		fmt.Fprintf(&sb, "; END routing policy %s %s\n", owner, apiObject.Type)
+	}
+
+	return sb.String()
+}
+
+// compareResourceRecordSets orders resource record sets by owner name, type and set identifier.
+func compareResourceRecordSets(a, b awstypes.ResourceRecordSet) int {
+	if v := compareZoneFileNames(normalizeDomainName(a.Name), normalizeDomainName(b.Name)); v != 0 {
+		return v
+	}
+
+	typeOrder := func(t awstypes.RRType) string {
+		switch t {
+		case awstypes.RRTypeSoa:
+			return "0"
+		case awstypes.RRTypeNs:
+			return "1"
+		default:
+			return "2" + string(t)
+		}
+	}
+	if v := strings.Compare(typeOrder(a.Type), typeOrder(b.Type)); v != 0 {
+		return v
+	}
+
+	// Simple routing policy record sets, with no set identifier, first.
+	return strings.Compare(aws.ToString(a.SetIdentifier), aws.ToString(b.SetIdentifier))
+}
+
+// compareZoneFileNames compares two normalized domain names in canonical DNS order, comparing labels from the root.
+func compareZoneFileNames(a, b string) int {
+	x, y := strings.Split(a, "."), strings.Split(b, ".")
+	slices.Reverse(x)
+	slices.Reverse(y)
+
+	return slices.Compare(x, y)
+}
+
+// zoneFileOwnerName returns an owner name relative to the origin.
+func zoneFileOwnerName(name, origin string) string {
+	name = fqdn(normalizeDomainName(name))
+
+	switch {
+	case name == origin:
+		name = "@"
+	case strings.HasSuffix(name, "."+origin):
+		name = strings.TrimSuffix(name, "."+origin)
+	}
+
+	// Route 53 returns the wildcard label in its escaped form.
+	if v, ok := strings.CutPrefix(name, `\052`); ok {
+		name = "*" + v
+	}
+
+	return name
+}
+
+// zoneFileRecords returns the zone file lines for a simple resource record set, one per value.
+func zoneFileRecords(owner string, apiObject awstypes.ResourceRecordSet) []string {
+	values := tfslices.ApplyToAll(apiObject.ResourceRecords, func(v awstypes.ResourceRecord) string {
+		return aws.ToString(v.Value)
+	})
+	slices.Sort(values)
+
+	return tfslices.ApplyToAll(values, func(v string) string {
+		return fmt.Sprintf("%s\t%d\tIN\t%s\t%s", owner, aws.ToInt64(apiObject.TTL), apiObject.Type, v)
+	})
+}
+
+// zoneFileAlias returns the annotation for an alias record.
+func zoneFileAlias(owner string, apiObject awstypes.ResourceRecordSet) string {
+	return fmt.Sprintf("%s\tIN\t%s\tALIAS %s ; hosted_zone_id=%s evaluate_target_health=%t",
+		owner,
+		apiObject.Type,
+		fqdn(aws.ToString(apiObject.AliasTarget.DNSName)),
+		aws.ToString(apiObject.AliasTarget.HostedZoneId),
+		apiObject.AliasTarget.EvaluateTargetHealth,
+	)
+}
+
+// zoneFileRoutingPolicy returns the annotation for a record set's routing policy.
+func zoneFileRoutingPolicy(apiObject awstypes.ResourceRecordSet) string {
+	fields := []string{fmt.Sprintf("set_identifier=%q", aws.ToString(apiObject.SetIdentifier))}
+	add := func(k, v string) {
+		if v != "" {
+			fields = append(fields, k+"="+v)
+		}
+	}
+
+	if v := apiObject.Weight; v != nil {
+		add("weight", strconv.FormatInt(aws.ToInt64(v), 10))
+	}
+	add("region", string(apiObject.Region))
+	add("failover", string(apiObject.Failover))
+	if v := apiObject.GeoLocation; v != nil {
+		add("continent_code", aws.ToString(v.ContinentCode))
+		add("country_code", aws.ToString(v.CountryCode))
+		add("subdivision_code", aws.ToString(v.SubdivisionCode))
+	}
+	if v := apiObject.GeoProximityLocation; v != nil {
+		add("aws_region", aws.ToString(v.AWSRegion))
+		add("local_zone_group", aws.ToString(v.LocalZoneGroup))
+		if v := v.Coordinates; v != nil {
+			add("coordinates", aws.ToString(v.Latitude)+","+aws.ToString(v.Longitude))
+		}
+		if v := v.Bias; v != nil {
+			add("bias", strconv.FormatInt(int64(aws.ToInt32(v)), 10))
+		}
+	}
+	if v := apiObject.CidrRoutingConfig; v != nil {
+		add("cidr_collection_id", aws.ToString(v.CollectionId))
+		add("cidr_location_name", aws.ToString(v.LocationName))
+	}
+	if v := apiObject.MultiValueAnswer; v != nil {
+		add("multivalue_answer", strconv.FormatBool(aws.ToBool(v)))
+	}
+	add("health_check_id", aws.ToString(apiObject.HealthCheckId))
+
+	return strings.Join(fields, " ")
+}
@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_route53_zone_file", name="Zone File")
+func newZoneFileDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &zoneFileDataSource{}, nil
+}
+
+type zoneFileDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*zoneFileDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_route53_zone_file"
+}
+
+func (d *zoneFileDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrName: schema.StringAttribute{
+				Computed: true,
+			},
+			"resource_record_set_count": schema.Int64Attribute{
+				Computed: true,
+			},
+			"zone_file": schema.StringAttribute{
+				Computed: true,
+			},
+			"zone_id": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (d *zoneFileDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data zoneFileDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().Route53Client(ctx)
+
+	hostedZoneID := cleanZoneID(fwflex.StringValueFromFramework(ctx, data.ZoneID))
+	zone, err := findHostedZoneByID(ctx, conn, hostedZoneID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Route 53 Hosted Zone (%s)", hostedZoneID), err.Error())
+
+		return
+	}
+
+	input := route53.ListResourceRecordSetsInput{
+		HostedZoneId: aws.String(hostedZoneID),
+	}
+
+	output, err := findResourceRecordSets(ctx, conn, &input, tfslices.PredicateTrue[*route53.ListResourceRecordSetsOutput](), tfslices.PredicateTrue[*awstypes.ResourceRecordSet]())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("listing Route 53 Records (%s)", hostedZoneID), err.Error())
+
+		return
+	}
+
+	zoneName := aws.ToString(zone.HostedZone.Name)
+	data.Name = types.StringValue(normalizeDomainName(zoneName))
+	data.ResourceRecordSetCount = types.Int64Value(int64(len(output)))
+	data.ZoneFile = types.StringValue(formatZoneFile(zoneName, output))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type zoneFileDataSourceModel struct {
+	Name                   types.String `tfsdk:"name"`
+	ResourceRecordSetCount types.Int64  `tfsdk:"resource_record_set_count"`
+	ZoneFile               types.String `tfsdk:"zone_file"`
+	ZoneID                 types.String `tfsdk:"zone_id"`
+}
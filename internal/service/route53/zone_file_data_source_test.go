@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRoute53ZoneFileDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_route53_zone_file.test"
+	zoneName := acctest.RandomDomain()
+	recordName := zoneName.RandomSubdomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckZoneDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneFileDataSourceConfig_basic(zoneName.String(), recordName.String()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrName, zoneName.String()),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_record_set_count", "5"),
+					resource.TestMatchResourceAttr(dataSourceName, "zone_file", regexache.MustCompile(fmt.Sprintf(`^\$ORIGIN %s\.\n@\t\d+\tIN\tSOA\t`, regexp.QuoteMeta(zoneName.String())))),
+					resource.TestMatchResourceAttr(dataSourceName, "zone_file", regexache.MustCompile(`\n; BEGIN routing policy weighted A\n; set_identifier="blue" weight=10\n;   weighted\t30\tIN\tA\t127.0.0.2\n`)),
+					resource.TestMatchResourceAttr(dataSourceName, "zone_file", regexache.MustCompile(fmt.Sprintf(`\n%s\t30\tIN\tA\t127.0.0.1\n`, regexp.QuoteMeta(strings.TrimSuffix(recordName.String(), "."+zoneName.String()))))),
+				),
+			},
+		},
+	})
+}
+
+func testAccZoneFileDataSourceConfig_basic(zName, rName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = "%[1]s."
+}
+
+resource "aws_route53_record" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+  name    = %[2]q
+  type    = "A"
+  ttl     = "30"
+  records = ["127.0.0.1"]
+}
+
+resource "aws_route53_record" "blue" {
+  zone_id        = aws_route53_zone.test.zone_id
+  name           = "weighted"
+  type           = "A"
+  ttl            = "30"
+  records        = ["127.0.0.2"]
+  set_identifier = "blue"
+
+  weighted_routing_policy {
+    weight = 10
+  }
+}
+
+resource "aws_route53_record" "green" {
+  zone_id        = aws_route53_zone.test.zone_id
+  name           = "weighted"
+  type           = "A"
+  ttl            = "30"
+  records        = ["127.0.0.3"]
+  set_identifier = "green"
+
+  weighted_routing_policy {
+    weight = 90
+  }
+}
+
+data "aws_route53_zone_file" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  depends_on = [
+    aws_route53_record.test,
+    aws_route53_record.blue,
+    aws_route53_record.green,
+  ]
+}
+`, zName, rName)
+}
@@ -0,0 +1,275 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestParseZoneFile(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		zoneFile      string
+		expected      []zoneRecordSet
+		expectedError bool
+	}{
+		"empty": {
+			zoneFile: "",
+		},
+		"comments only": {
+			zoneFile: "; nothing here\n\n   ; indented comment\n",
+		},
+		"origin and ttl": {
+			zoneFile: `
+$ORIGIN example.com.
+$TTL 1h
+@       IN  SOA ns1.example.com. hostmaster.example.com. (
+            2024010101 ; serial
+            7200       ; refresh
+            3600       ; retry
+            1209600    ; expire
+            300 )      ; minimum
+@           NS  ns1
+www     300 IN  A   192.0.2.1
+            IN  A   192.0.2.2
+Mail    IN  300 A   192.0.2.3
+@           MX  10 mail
+ftp         CNAME www
+`,
+			expected: []zoneRecordSet{
+				{name: "example.com", rrType: awstypes.RRTypeSoa, ttl: 3600, values: []string{"ns1.example.com. hostmaster.example.com. 2024010101 7200 3600 1209600 300"}},
+				{name: "example.com", rrType: awstypes.RRTypeNs, ttl: 3600, values: []string{"ns1.example.com."}},
+				{name: "www.example.com", rrType: awstypes.RRTypeA, ttl: 300, values: []string{"192.0.2.1", "192.0.2.2"}},
+				{name: "mail.example.com", rrType: awstypes.RRTypeA, ttl: 300, values: []string{"192.0.2.3"}},
+				{name: "example.com", rrType: awstypes.RRTypeMx, ttl: 3600, values: []string{"10 mail.example.com."}},
+				{name: "ftp.example.com", rrType: awstypes.RRTypeCname, ttl: 3600, values: []string{"www.example.com."}},
+			},
+		},
+		"zone name as origin": {
+			zoneFile: `
+www 60 A 192.0.2.1
+_sip._tcp 60 SRV 10 5 5060 sip
+*.dev 60 A 192.0.2.2
+`,
+			expected: []zoneRecordSet{
+				{name: "www.example.com", rrType: awstypes.RRTypeA, ttl: 60, values: []string{"192.0.2.1"}},
+				{name: "_sip._tcp.example.com", rrType: awstypes.RRTypeSrv, ttl: 60, values: []string{"10 5 5060 sip.example.com."}},
+				{name: `\052.dev.example.com`, rrType: awstypes.RRTypeA, ttl: 60, values: []string{"192.0.2.2"}},
+			},
+		},
+		"relative origin": {
+			zoneFile: `
+$TTL 300
+$ORIGIN sub
+www A 192.0.2.1
+`,
+			expected: []zoneRecordSet{
+				{name: "www.sub.example.com", rrType: awstypes.RRTypeA, ttl: 300, values: []string{"192.0.2.1"}},
+			},
+		},
+		"txt": {
+			zoneFile: `
+$TTL 300
+@ TXT "v=spf1 include:example.net ~all"
+@ TXT ( "part one;"
+        "part two" )
+@ TXT unquoted
+`,
+			expected: []zoneRecordSet{
+				{name: "example.com", rrType: awstypes.RRTypeTxt, ttl: 300, values: []string{
+					`"part one;" "part two"`,
+					`"unquoted"`,
+					`"v=spf1 include:example.net ~all"`,
+				}},
+			},
+		},
+		"last ttl": {
+			zoneFile: `
+a 120 A 192.0.2.1
+b A 192.0.2.2
+`,
+			expected: []zoneRecordSet{
+				{name: "a.example.com", rrType: awstypes.RRTypeA, ttl: 120, values: []string{"192.0.2.1"}},
+				{name: "b.example.com", rrType: awstypes.RRTypeA, ttl: 120, values: []string{"192.0.2.2"}},
+			},
+		},
+		"no ttl": {
+			zoneFile:      "www A 192.0.2.1\n",
+			expectedError: true,
+		},
+		"unsupported type": {
+			zoneFile:      "$TTL 300\nwww HINFO cpu os\n",
+			expectedError: true,
+		},
+		"unsupported directive": {
+			zoneFile:      "$INCLUDE other.zone\n",
+			expectedError: true,
+		},
+		"unsupported class": {
+			zoneFile:      "$TTL 300\nwww CH A 192.0.2.1\n",
+			expectedError: true,
+		},
+		"unbalanced parentheses": {
+			zoneFile:      "$TTL 300\nwww A ( 192.0.2.1\n",
+			expectedError: true,
+		},
+		"unterminated quote": {
+			zoneFile:      "$TTL 300\nwww TXT \"abc\n",
+			expectedError: true,
+		},
+		"no owner": {
+			zoneFile:      "$TTL 300\n  A 192.0.2.1\n",
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseZoneFile(testCase.zoneFile, "example.com")
+
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Fatalf("err %t, want %t (%v)", got, want, err)
+			}
+			if err != nil {
+				return
+			}
+
+			if diff := cmp.Diff(got, testCase.expected, cmp.AllowUnexported(zoneRecordSet{})); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestParseZoneFileTTL(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input    string
+		expected int64
+		ok       bool
+	}{
+		"seconds": {input: "300", expected: 300, ok: true},
+		"units":   {input: "1h30m", expected: 5400, ok: true},
+		"week":    {input: "1W", expected: 604800, ok: true},
+		"type":    {input: "A"},
+		"suffix":  {input: "1h30"},
+		"unknown": {input: "1y"},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := parseZoneFileTTL(testCase.input)
+
+			if ok != testCase.ok {
+				t.Fatalf("ok %t, want %t", ok, testCase.ok)
+			}
+			if got != testCase.expected {
+				t.Errorf("got %d, want %d", got, testCase.expected)
+			}
+		})
+	}
+}
+
+func TestFormatZoneFile(t *testing.T) {
+	t.Parallel()
+
+	apiObjects := []awstypes.ResourceRecordSet{
+		{
+			Name:            aws.String("www.example.com."),
+			Type:            awstypes.RRTypeA,
+			TTL:             aws.Int64(300),
+			ResourceRecords: []awstypes.ResourceRecord{{Value: aws.String("192.0.2.2")}, {Value: aws.String("192.0.2.1")}},
+		},
+		{
+			Name:            aws.String("example.com."),
+			Type:            awstypes.RRTypeNs,
+			TTL:             aws.Int64(172800),
+			ResourceRecords: []awstypes.ResourceRecord{{Value: aws.String("ns-1.awsdns-01.org.")}},
+		},
+		{
+			Name:            aws.String("example.com."),
+			Type:            awstypes.RRTypeTxt,
+			TTL:             aws.Int64(300),
+			ResourceRecords: []awstypes.ResourceRecord{{Value: aws.String(`"v=spf1 -all"`)}},
+		},
+		{
+			Name:            aws.String("example.com."),
+			Type:            awstypes.RRTypeSoa,
+			TTL:             aws.Int64(900),
+			ResourceRecords: []awstypes.ResourceRecord{{Value: aws.String("ns-1.awsdns-01.org. awsdns-hostmaster.amazon.com. 1 7200 900 1209600 86400")}},
+		},
+		{
+			Name: aws.String("app.example.com."),
+			Type: awstypes.RRTypeA,
+			AliasTarget: &awstypes.AliasTarget{
+				DNSName:              aws.String("dualstack.lb.us-west-2.elb.amazonaws.com"),
+				HostedZoneId:         aws.String("Z1H1FL5HABSF5"),
+				EvaluateTargetHealth: true,
+			},
+		},
+		{
+			Name:            aws.String("api.example.com."),
+			Type:            awstypes.RRTypeCname,
+			SetIdentifier:   aws.String("green"),
+			Weight:          aws.Int64(90),
+			TTL:             aws.Int64(60),
+			ResourceRecords: []awstypes.ResourceRecord{{Value: aws.String("green.example.net")}},
+		},
+		{
+			Name:            aws.String("api.example.com."),
+			Type:            awstypes.RRTypeCname,
+			SetIdentifier:   aws.String("blue"),
+			Weight:          aws.Int64(10),
+			TTL:             aws.Int64(60),
+			ResourceRecords: []awstypes.ResourceRecord{{Value: aws.String("blue.example.net")}},
+		},
+		{
+			Name:            aws.String(`\052.dev.example.com.`),
+			Type:            awstypes.RRTypeA,
+			TTL:             aws.Int64(60),
+			ResourceRecords: []awstypes.ResourceRecord{{Value: aws.String("192.0.2.3")}},
+		},
+	}
+
+	expected := `$ORIGIN example.com.
+@	900	IN	SOA	ns-1.awsdns-01.org. awsdns-hostmaster.amazon.com. 1 7200 900 1209600 86400
+@	172800	IN	NS	ns-1.awsdns-01.org.
+@	300	IN	TXT	"v=spf1 -all"
+; BEGIN routing policy api CNAME
+; set_identifier="blue" weight=10
+;   api	60	IN	CNAME	blue.example.net
+; set_identifier="green" weight=90
+;   api	60	IN	CNAME	green.example.net
+; END routing policy api CNAME
+; app	IN	A	ALIAS dualstack.lb.us-west-2.elb.amazonaws.com. ; hosted_zone_id=Z1H1FL5HABSF5 evaluate_target_health=true
+*.dev	60	IN	A	192.0.2.3
+www	300	IN	A	192.0.2.1
+www	300	IN	A	192.0.2.2
+`
+
+	got := formatZoneFile("example.com.", apiObjects)
+
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	// Only the simple routing policy record sets are parsed back.
+	recordSets, err := parseZoneFile(got, "example.com")
+	if err != nil {
+		t.Fatalf("parsing formatted zone file: %s", err)
+	}
+	if got, want := len(recordSets), 5; got != want {
+		t.Errorf("got %d record sets, want %d", got, want)
+	}
+}
@@ -0,0 +1,741 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_route53_zone_records", name="Zone Records")
+func newZoneRecordsResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &zoneRecordsResource{}
+
+	return r, nil
+}
+
+type zoneRecordsResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*zoneRecordsResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_route53_zone_records"
+}
+
+func (r *zoneRecordsResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"record_sets": schema.SetAttribute{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[zoneRecordSetModel](ctx),
+				Computed:   true,
+				ElementType: types.ObjectType{
+					AttrTypes: fwtypes.AttributeTypesMust[zoneRecordSetModel](ctx),
+				},
+			},
+			"remove_unmanaged_records": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"unmanaged_records": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"zone_file": schema.StringAttribute{
+				Optional: true,
+			},
+			"zone_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"zone_name": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"record": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[zoneRecordSetModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						"records": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Required:    true,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+							},
+						},
+						"ttl": schema.Int64Attribute{
+							Required: true,
+							Validators: []validator.Int64{
+								int64validator.Between(0, 2147483647),
+							},
+						},
+						names.AttrType: schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								enum.FrameworkValidate[awstypes.RRType](),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *zoneRecordsResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data zoneRecordsResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Route53Client(ctx)
+
+	zoneID := cleanZoneID(data.ZoneID.ValueString())
+	zone, err := findHostedZoneByID(ctx, conn, zoneID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Route 53 Hosted Zone (%s)", zoneID), err.Error())
+
+		return
+	}
+
+	zoneName := normalizeDomainName(zone.HostedZone.Name)
+
+	// The hosted zone's name isn't known at plan time.
+	if data.RecordSets.IsUnknown() {
+		recordSets, diags := expandZoneRecordsRecordSets(ctx, data, zoneName)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		data.RecordSets = fwtypes.NewSetNestedObjectValueOfValueSliceMust(ctx, recordSets)
+	}
+
+	unmanaged, err := reconcileZoneRecordSets(ctx, conn, zoneID, zoneName, nil, data)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Route 53 Zone Records (%s)", zoneID), err.Error())
+
+		return
+	}
+
+	if data.UnmanagedRecords.IsUnknown() {
+		data.UnmanagedRecords = fwtypes.ListOfString{ListValue: fwflex.FlattenFrameworkStringValueListLegacy(ctx, unmanaged)}
+	}
+	if n := len(unmanaged); n > 0 && !data.RemoveUnmanagedRecords.ValueBool() {
+		response.Diagnostics.AddWarning(
+			fmt.Sprintf("Route 53 Hosted Zone (%s) contains unmanaged records", zoneID),
+			fmt.Sprintf("%d record sets are not managed by this resource: %s. Set remove_unmanaged_records to true to remove them.", n, strings.Join(unmanaged, ", ")),
+		)
+	}
+	data.ID = types.StringValue(zoneID)
+	data.ZoneName = types.StringValue(zoneName)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *zoneRecordsResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data zoneRecordsResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Route53Client(ctx)
+
+	zoneID := data.ID.ValueString()
+	zone, err := findHostedZoneByID(ctx, conn, zoneID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Route 53 Hosted Zone (%s)", zoneID), err.Error())
+
+		return
+	}
+
+	zoneName := normalizeDomainName(zone.HostedZone.Name)
+	current, err := findZoneRecordSets(ctx, conn, zoneID, zoneName)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Route 53 Zone Records (%s)", zoneID), err.Error())
+
+		return
+	}
+
+	var recordSets []zoneRecordSetModel
+	if data.RecordSets.IsNull() {
+		// Import. All simple routing policy record sets are managed.
+		for _, k := range current.keys() {
+			if rs, ok := current.simple[k]; ok {
+				recordSets = append(recordSets, flattenZoneRecordSet(ctx, rs, rs.name))
+			}
+		}
+	} else {
+		prior, diags := data.RecordSets.ToSlice(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		for _, v := range prior {
+			old := expandZoneRecordSet(ctx, v, zoneName)
+			rs, ok := current.simple[old.key()]
+			switch {
+			case !ok:
+				// Deleted or replaced by a record set that can't be managed.
+			case rs.equal(old):
+				recordSets = append(recordSets, *v)
+			default:
+				recordSets = append(recordSets, flattenZoneRecordSet(ctx, rs, v.Name.ValueString()))
+			}
+		}
+	}
+
+	managed := make(map[string]bool)
+	for _, v := range recordSets {
+		managed[expandZoneRecordSet(ctx, &v, zoneName).key()] = true
+	}
+	var unmanaged []string
+	for _, k := range current.keys() {
+		if !managed[k] {
+			unmanaged = append(unmanaged, k)
+		}
+	}
+
+	if data.Record.IsNull() {
+		data.Record = fwtypes.NewSetNestedObjectValueOfValueSliceMust(ctx, []zoneRecordSetModel{})
+	}
+	data.RecordSets = fwtypes.NewSetNestedObjectValueOfValueSliceMust(ctx, recordSets)
+	if data.RemoveUnmanagedRecords.IsNull() {
+		data.RemoveUnmanagedRecords = types.BoolValue(false)
+	}
+	data.UnmanagedRecords = fwtypes.ListOfString{ListValue: fwflex.FlattenFrameworkStringValueListLegacy(ctx, unmanaged)}
+	data.ZoneID = types.StringValue(zoneID)
+	data.ZoneName = types.StringValue(zoneName)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *zoneRecordsResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new zoneRecordsResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Route53Client(ctx)
+
+	zoneID, zoneName := new.ID.ValueString(), old.ZoneName.ValueString()
+	prior, diags := old.RecordSets.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// The zone file or record blocks weren't known at plan time.
+	if new.RecordSets.IsUnknown() {
+		recordSets, diags := expandZoneRecordsRecordSets(ctx, new, zoneName)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		new.RecordSets = fwtypes.NewSetNestedObjectValueOfValueSliceMust(ctx, recordSets)
+	}
+
+	unmanaged, err := reconcileZoneRecordSets(ctx, conn, zoneID, zoneName, prior, new)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Route 53 Zone Records (%s)", zoneID), err.Error())
+
+		return
+	}
+
+	if new.UnmanagedRecords.IsUnknown() {
+		new.UnmanagedRecords = fwtypes.ListOfString{ListValue: fwflex.FlattenFrameworkStringValueListLegacy(ctx, unmanaged)}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *zoneRecordsResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data zoneRecordsResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().Route53Client(ctx)
+
+	zoneID := data.ID.ValueString()
+	prior, diags := data.RecordSets.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the managed record sets are deleted.
+	data.RecordSets = fwtypes.NewSetNestedObjectValueOfValueSliceMust(ctx, []zoneRecordSetModel{})
+	data.RemoveUnmanagedRecords = types.BoolValue(false)
+
+	_, err := reconcileZoneRecordSets(ctx, conn, zoneID, data.ZoneName.ValueString(), prior, data)
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Route 53 Zone Records (%s)", zoneID), err.Error())
+
+		return
+	}
+}
+
+func (r *zoneRecordsResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var config, plan, state zoneRecordsResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &config)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	if !request.State.Raw.IsNull() {
+		response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// The hosted zone's name is only known from prior state. On creation, or if the configuration isn't yet known,
+	// the managed record sets are determined during apply.
+	if plan.ZoneID.IsUnknown() || state.ZoneName.IsNull() || cleanZoneID(plan.ZoneID.ValueString()) != state.ID.ValueString() ||
+		config.ZoneFile.IsUnknown() || config.Record.IsUnknown() {
+		plan.RecordSets = fwtypes.NewSetNestedObjectValueOfUnknown[zoneRecordSetModel](ctx)
+		plan.UnmanagedRecords = fwtypes.ListOfString{ListValue: types.ListUnknown(types.StringType)}
+		response.Diagnostics.Append(response.Plan.Set(ctx, &plan)...)
+
+		return
+	}
+
+	zoneName := state.ZoneName.ValueString()
+	models, diags := expandZoneRecordsRecordSets(ctx, config, zoneName)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Only change the planned value if it differs semantically so as to preserve the prior state representation.
+	if v := fwtypes.NewSetNestedObjectValueOfValueSliceMust(ctx, models); !zoneRecordSetModelsEqual(ctx, v, state.RecordSets, zoneName) {
+		plan.RecordSets = v
+	} else {
+		plan.RecordSets = state.RecordSets
+	}
+
+	switch {
+	case plan.RemoveUnmanagedRecords.ValueBool():
+		plan.UnmanagedRecords = fwtypes.ListOfString{ListValue: fwflex.FlattenFrameworkStringValueListLegacy(ctx, []string{})}
+	case !state.UnmanagedRecords.IsNull() && !state.UnmanagedRecords.IsUnknown():
+		// Record sets that become managed are no longer reported.
+		desired, diags := plan.RecordSets.ToSlice(ctx)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		managed := make(map[string]bool)
+		for _, v := range desired {
+			managed[expandZoneRecordSet(ctx, v, zoneName).key()] = true
+		}
+		unmanaged := tfslices.Filter(fwflex.ExpandFrameworkStringValueList(ctx, state.UnmanagedRecords), func(k string) bool {
+			return !managed[k]
+		})
+		plan.UnmanagedRecords = fwtypes.ListOfString{ListValue: fwflex.FlattenFrameworkStringValueListLegacy(ctx, unmanaged)}
+	default:
+		plan.UnmanagedRecords = fwtypes.ListOfString{ListValue: types.ListUnknown(types.StringType)}
+	}
+
+	response.Diagnostics.Append(response.Plan.Set(ctx, &plan)...)
+}
+
+func (r *zoneRecordsResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data zoneRecordsResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// An absent set block is an empty set, not null.
+	if data.ZoneFile.IsNull() || data.Record.IsUnknown() || len(data.Record.Elements()) == 0 {
+		return
+	}
+
+	response.Diagnostics.AddAttributeError(
+		path.Root("zone_file"),
+		"Invalid Attribute Combination",
+		`Attribute "zone_file" cannot be specified when "record" is specified`,
+	)
+}
+
+// expandZoneRecordsRecordSets returns the record sets to manage, from either the record blocks or the zone file.
+func expandZoneRecordsRecordSets(ctx context.Context, data zoneRecordsResourceModel, zoneName string) ([]zoneRecordSetModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var models []zoneRecordSetModel
+
+	if !data.ZoneFile.IsNull() {
+		recordSets, err := parseZoneFile(data.ZoneFile.ValueString(), zoneName)
+
+		if err != nil {
+			diags.AddAttributeError(path.Root("zone_file"), "Invalid zone file", err.Error())
+
+			return nil, diags
+		}
+
+		for _, rs := range recordSets {
+			if isZoneRecordSetManagedByRoute53(rs, zoneName) {
+				continue
+			}
+			if n := normalizeDomainName(zoneName); rs.name != n && !strings.HasSuffix(rs.name, "."+n) {
+				diags.AddAttributeError(path.Root("zone_file"), "Invalid zone file", fmt.Sprintf("the %s record set is outside of zone %s", rs.key(), zoneName))
+				continue
+			}
+			models = append(models, flattenZoneRecordSet(ctx, rs, rs.name))
+		}
+	} else {
+		records, d := data.Record.ToSlice(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		for _, v := range records {
+			if rs := expandZoneRecordSet(ctx, v, zoneName); isZoneRecordSetManagedByRoute53(rs, zoneName) {
+				diags.AddAttributeError(path.Root("record"), "Invalid record set", fmt.Sprintf("the %s record set is managed by Route 53", rs.key()))
+				continue
+			}
+			models = append(models, *v)
+		}
+	}
+
+	return models, diags
+}
+
+type zoneRecordsResourceModel struct {
+	ID                     types.String                                       `tfsdk:"id"`
+	Record                 fwtypes.SetNestedObjectValueOf[zoneRecordSetModel] `tfsdk:"record"`
+	RecordSets             fwtypes.SetNestedObjectValueOf[zoneRecordSetModel] `tfsdk:"record_sets"`
+	RemoveUnmanagedRecords types.Bool                                         `tfsdk:"remove_unmanaged_records"`
+	UnmanagedRecords       fwtypes.ListOfString                               `tfsdk:"unmanaged_records"`
+	ZoneFile               types.String                                       `tfsdk:"zone_file"`
+	ZoneID                 types.String                                       `tfsdk:"zone_id"`
+	ZoneName               types.String                                       `tfsdk:"zone_name"`
+}
+
+type zoneRecordSetModel struct {
+	Name    types.String        `tfsdk:"name"`
+	Records fwtypes.SetOfString `tfsdk:"records"`
+	TTL     types.Int64         `tfsdk:"ttl"`
+	Type    types.String        `tfsdk:"type"`
+}
+
+// zoneRecordSets is the reconcilable content of a hosted zone.
+type zoneRecordSets struct {
+	// All record sets other than the SOA and apex NS records, keyed by name, type and any set identifier.
+	apiObjects map[string]awstypes.ResourceRecordSet
+	// Simple routing policy record sets, keyed by name and type. They have no set identifier.
+	simple map[string]zoneRecordSet
+}
+
+// keys returns the sorted keys of all record sets.
+func (z zoneRecordSets) keys() []string {
+	keys := make([]string, 0, len(z.apiObjects))
+	for k := range z.apiObjects {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	return keys
+}
+
+func findZoneRecordSets(ctx context.Context, conn *route53.Client, zoneID, zoneName string) (*zoneRecordSets, error) {
+	input := &route53.ListResourceRecordSetsInput{
+		HostedZoneId: aws.String(zoneID),
+	}
+
+	apiObjects, err := findResourceRecordSets(ctx, conn, input, tfslices.PredicateTrue[*route53.ListResourceRecordSetsOutput](), tfslices.PredicateTrue[*awstypes.ResourceRecordSet]())
+
+	if err != nil {
+		return nil, err
+	}
+
+	output := &zoneRecordSets{
+		apiObjects: make(map[string]awstypes.ResourceRecordSet),
+		simple:     make(map[string]zoneRecordSet),
+	}
+
+	for _, apiObject := range apiObjects {
+		rs := zoneRecordSet{
+			name:          normalizeDomainName(apiObject.Name),
+			rrType:        apiObject.Type,
+			setIdentifier: aws.ToString(apiObject.SetIdentifier),
+			ttl:           aws.ToInt64(apiObject.TTL),
+		}
+
+		if isZoneRecordSetManagedByRoute53(rs, zoneName) {
+			continue
+		}
+
+		key := rs.key()
+		output.apiObjects[key] = apiObject
+
+		if apiObject.SetIdentifier == nil && apiObject.AliasTarget == nil && apiObject.TrafficPolicyInstanceId == nil {
+			rs.values = tfslices.ApplyToAll(apiObject.ResourceRecords, func(v awstypes.ResourceRecord) string {
+				return aws.ToString(v.Value)
+			})
+			slices.Sort(rs.values)
+			output.simple[key] = rs
+		}
+	}
+
+	return output, nil
+}
+
+// isZoneRecordSetManagedByRoute53 returns whether the record set is the zone's SOA or apex NS record set.
+func isZoneRecordSetManagedByRoute53(rs zoneRecordSet, zoneName string) bool {
+	return rs.name == normalizeDomainName(zoneName) && (rs.rrType == awstypes.RRTypeSoa || rs.rrType == awstypes.RRTypeNs)
+}
+
+// reconcileZoneRecordSets makes the hosted zone's record sets match the desired state.
+// Record sets that were previously managed but are no longer desired are deleted, as are unmanaged record sets if requested.
+// The keys of the remaining unmanaged record sets are returned.
+func reconcileZoneRecordSets(ctx context.Context, conn *route53.Client, zoneID, zoneName string, prior []*zoneRecordSetModel, data zoneRecordsResourceModel) ([]string, error) {
+	current, err := findZoneRecordSets(ctx, conn, zoneID, zoneName)
+
+	if err != nil {
+		return nil, fmt.Errorf("reading Route 53 Hosted Zone (%s) resource record sets: %w", zoneID, err)
+	}
+
+	desired, diags := data.RecordSets.ToSlice(ctx)
+	if diags.HasError() {
+		return nil, fwdiag.DiagnosticsError(diags)
+	}
+
+	// Changes are grouped by record set name.
+	deletes, upserts := make(map[string][]awstypes.Change), make(map[string][]awstypes.Change)
+	keep := make(map[string]bool)
+	for _, v := range desired {
+		rs := expandZoneRecordSet(ctx, v, zoneName)
+		keep[rs.key()] = true
+
+		if old, ok := current.simple[rs.key()]; ok && old.equal(rs) {
+			continue
+		}
+
+		upserts[rs.name] = append(upserts[rs.name], awstypes.Change{
+			Action: awstypes.ChangeActionUpsert,
+			ResourceRecordSet: &awstypes.ResourceRecordSet{
+				Name: aws.String(rs.name),
+				ResourceRecords: tfslices.ApplyToAll(rs.values, func(v string) awstypes.ResourceRecord {
+					return awstypes.ResourceRecord{Value: aws.String(v)}
+				}),
+				TTL:  aws.Int64(rs.ttl),
+				Type: rs.rrType,
+			},
+		})
+	}
+
+	managed := make(map[string]bool)
+	for _, v := range prior {
+		managed[expandZoneRecordSet(ctx, v, zoneName).key()] = true
+	}
+
+	var unmanaged []string
+	for _, k := range current.keys() {
+		if keep[k] {
+			continue
+		}
+
+		if managed[k] || data.RemoveUnmanagedRecords.ValueBool() {
+			apiObject := current.apiObjects[k]
+			name := normalizeDomainName(apiObject.Name)
+			deletes[name] = append(deletes[name], awstypes.Change{
+				Action:            awstypes.ChangeActionDelete,
+				ResourceRecordSet: &apiObject,
+			})
+			continue
+		}
+
+		unmanaged = append(unmanaged, k)
+	}
+
+	// A record set name's deletions precede its upserts in the same change batch, e.g. so that a weighted record set
+	// can be replaced by a simple record set with the same name and type, or an A record set by a CNAME record set.
+	const (
+		chunkSize = 100
+	)
+	recordNames := tfmaps.Keys(deletes)
+	for name := range upserts {
+		if _, ok := deletes[name]; !ok {
+			recordNames = append(recordNames, name)
+		}
+	}
+	slices.Sort(recordNames)
+
+	var batches [][]awstypes.Change
+	for _, name := range recordNames {
+		changes := slices.Concat(deletes[name], upserts[name])
+
+		// A name's changes are never split across batches.
+		if n := len(batches); n == 0 || len(batches[n-1])+len(changes) > chunkSize {
+			batches = append(batches, changes)
+		} else {
+			batches[n-1] = append(batches[n-1], changes...)
+		}
+	}
+
+	for _, changes := range batches {
+		input := &route53.ChangeResourceRecordSetsInput{
+			ChangeBatch: &awstypes.ChangeBatch{
+				Changes: changes,
+				Comment: aws.String("Managed by Terraform"),
+			},
+			HostedZoneId: aws.String(zoneID),
+		}
+
+		output, err := conn.ChangeResourceRecordSets(ctx, input)
+
+		if v, ok := errs.As[*awstypes.InvalidChangeBatch](err); ok && len(v.Messages) > 0 {
+			err = fmt.Errorf("%s: %w", v.ErrorCode(), errors.Join(tfslices.ApplyToAll(v.Messages, errors.New)...))
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("changing Route 53 Hosted Zone (%s) resource record sets: %w", zoneID, err)
+		}
+
+		if output.ChangeInfo != nil {
+			if _, err := waitChangeInsync(ctx, conn, aws.ToString(output.ChangeInfo.Id)); err != nil {
+				return nil, fmt.Errorf("waiting for Route 53 Hosted Zone (%s) synchronize: %w", zoneID, err)
+			}
+		}
+	}
+
+	return unmanaged, nil
+}
+
+// expandZoneRecordSet returns the Route 53 API representation of a record set.
+func expandZoneRecordSet(ctx context.Context, data *zoneRecordSetModel, zoneName string) zoneRecordSet {
+	rrType := awstypes.RRType(strings.ToUpper(data.Type.ValueString()))
+	values := tfslices.ApplyToAll(expandResourceRecords(fwflex.ExpandFrameworkStringValueSet(ctx, data.Records), rrType), func(v awstypes.ResourceRecord) string {
+		return aws.ToString(v.Value)
+	})
+	slices.Sort(values)
+
+	return zoneRecordSet{
+		name:   normalizeDomainName(expandRecordName(data.Name.ValueString(), zoneName)),
+		rrType: rrType,
+		ttl:    data.TTL.ValueInt64(),
+		values: values,
+	}
+}
+
+// flattenZoneRecordSet returns the Terraform representation of a record set, using the specified name.
+func flattenZoneRecordSet(ctx context.Context, rs zoneRecordSet, name string) zoneRecordSetModel {
+	values := flattenResourceRecords(tfslices.ApplyToAll(rs.values, func(v string) awstypes.ResourceRecord {
+		return awstypes.ResourceRecord{Value: aws.String(v)}
+	}), rs.rrType)
+
+	return zoneRecordSetModel{
+		Name:    types.StringValue(name),
+		Records: fwtypes.SetOfString{SetValue: fwflex.FlattenFrameworkStringValueSetLegacy(ctx, values)},
+		TTL:     types.Int64Value(rs.ttl),
+		Type:    types.StringValue(string(rs.rrType)),
+	}
+}
+
+// zoneRecordSetModelsEqual returns whether two sets of record sets are semantically equal.
+func zoneRecordSetModelsEqual(ctx context.Context, x, y fwtypes.SetNestedObjectValueOf[zoneRecordSetModel], zoneName string) bool {
+	if x.IsNull() || x.IsUnknown() || y.IsNull() || y.IsUnknown() {
+		return false
+	}
+
+	expand := func(v fwtypes.SetNestedObjectValueOf[zoneRecordSetModel]) map[string]zoneRecordSet {
+		slice, _ := v.ToSlice(ctx)
+		m := make(map[string]zoneRecordSet, len(slice))
+		for _, v := range slice {
+			rs := expandZoneRecordSet(ctx, v, zoneName)
+			m[rs.key()] = rs
+		}
+		return m
+	}
+	a, b := expand(x), expand(y)
+
+	if len(a) != len(b) {
+		return false
+	}
+	for k, v := range a {
+		if w, ok := b[k]; !ok || !v.equal(w) {
+			return false
+		}
+	}
+
+	return true
+}
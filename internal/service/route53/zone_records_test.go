@@ -0,0 +1,306 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfroute53 "github.com/hashicorp/terraform-provider-aws/internal/service/route53"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRoute53ZoneRecords_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_route53_zone_records.test"
+	zoneName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckZoneRecordsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneRecordsConfig_basic(zoneName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckZoneRecordsExists(ctx, resourceName, "www."+zoneName, "A"),
+					testAccCheckZoneRecordsExists(ctx, resourceName, zoneName, "TXT"),
+					resource.TestCheckResourceAttr(resourceName, "record_sets.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record_sets.*", map[string]string{
+						names.AttrName: "www",
+						names.AttrType: "A",
+						"ttl":          "300",
+						"records.#":    "2",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "remove_unmanaged_records", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "unmanaged_records.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "zone_name", zoneName),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"record", "record_sets"},
+			},
+		},
+	})
+}
+
+func TestAccRoute53ZoneRecords_changeType(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_route53_zone_records.test"
+	zoneName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckZoneRecordsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneRecordsConfig_type(zoneName, "A", "192.0.2.1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckZoneRecordsExists(ctx, resourceName, "www."+zoneName, "A"),
+				),
+			},
+			{
+				// The A record set is deleted in the same change batch as, and before, the CNAME record set is created.
+				Config: testAccZoneRecordsConfig_type(zoneName, "CNAME", "example.com"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckZoneRecordsExists(ctx, resourceName, "www."+zoneName, "CNAME"),
+					resource.TestCheckResourceAttr(resourceName, "record_sets.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record_sets.*", map[string]string{
+						names.AttrName: "www",
+						names.AttrType: "CNAME",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53ZoneRecords_zoneFile(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_route53_zone_records.test"
+	zoneName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckZoneRecordsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneRecordsConfig_zoneFile(zoneName, "192.0.2.1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckZoneRecordsExists(ctx, resourceName, "www."+zoneName, "A"),
+					testAccCheckZoneRecordsExists(ctx, resourceName, "ftp."+zoneName, "CNAME"),
+					resource.TestCheckResourceAttr(resourceName, "record_sets.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record_sets.*", map[string]string{
+						names.AttrName: "www." + zoneName,
+						names.AttrType: "A",
+						"ttl":          "3600",
+						"records.#":    "1",
+						"records.0":    "192.0.2.1",
+					}),
+				),
+			},
+			{
+				Config: testAccZoneRecordsConfig_zoneFile(zoneName, "192.0.2.2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckZoneRecordsExists(ctx, resourceName, "www."+zoneName, "A"),
+					resource.TestCheckResourceAttr(resourceName, "record_sets.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "record_sets.*", map[string]string{
+						names.AttrName: "www." + zoneName,
+						names.AttrType: "A",
+						"records.0":    "192.0.2.2",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccRoute53ZoneRecords_removeUnmanagedRecords(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_route53_zone_records.test"
+	zoneName := acctest.RandomDomainName()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckZoneRecordsDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccZoneRecordsConfig_unmanaged(zoneName, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "unmanaged_records.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "unmanaged_records.0", fmt.Sprintf("unmanaged.%s A", zoneName)),
+				),
+			},
+			{
+				Config: testAccZoneRecordsConfig_unmanaged(zoneName, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "remove_unmanaged_records", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "unmanaged_records.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckZoneRecordsDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_route53_zone" {
+				continue
+			}
+
+			_, err := tfroute53.FindHostedZoneByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Route 53 Hosted Zone %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckZoneRecordsExists(ctx context.Context, n, recordName, recordType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).Route53Client(ctx)
+
+		_, _, err := tfroute53.FindResourceRecordSetByFourPartKey(ctx, conn, rs.Primary.ID, recordName, recordType, "")
+
+		return err
+	}
+}
+
+func testAccZoneRecordsConfig_basic(zoneName string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name          = %[1]q
+  force_destroy = true
+}
+
+resource "aws_route53_zone_records" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  record {
+    name    = "www"
+    type    = "A"
+    ttl     = 300
+    records = ["192.0.2.1", "192.0.2.2"]
+  }
+
+  record {
+    name    = %[1]q
+    type    = "TXT"
+    ttl     = 300
+    records = ["v=spf1 -all"]
+  }
+}
+`, zoneName)
+}
+
+func testAccZoneRecordsConfig_type(zoneName, recordType, record string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name          = %[1]q
+  force_destroy = true
+}
+
+resource "aws_route53_zone_records" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  record {
+    name    = "www"
+    type    = %[2]q
+    ttl     = 300
+    records = [%[3]q]
+  }
+}
+`, zoneName, recordType, record)
+}
+
+func testAccZoneRecordsConfig_zoneFile(zoneName, address string) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name          = %[1]q
+  force_destroy = true
+}
+
+resource "aws_route53_zone_records" "test" {
+  zone_id = aws_route53_zone.test.zone_id
+
+  zone_file = <<-EOT
+    $ORIGIN %[1]s.
+    $TTL 1h
+    @     IN SOA ns1.example.com. hostmaster.example.com. ( 1 7200 3600 1209600 300 )
+    @     IN NS  ns1.example.com.
+    www   IN A   %[2]s
+    ftp   IN CNAME www
+    @     IN MX  10 mail.example.com.
+  EOT
+}
+`, zoneName, address)
+}
+
+func testAccZoneRecordsConfig_unmanaged(zoneName string, removeUnmanagedRecords bool) string {
+	return fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name          = %[1]q
+  force_destroy = true
+}
+
+resource "aws_route53_record" "unmanaged" {
+  zone_id = aws_route53_zone.test.zone_id
+  name    = "unmanaged"
+  type    = "A"
+  ttl     = 300
+  records = ["192.0.2.9"]
+
+  lifecycle {
+    ignore_changes = all
+  }
+}
+
+resource "aws_route53_zone_records" "test" {
+  zone_id                  = aws_route53_zone.test.zone_id
+  remove_unmanaged_records = %[2]t
+
+  record {
+    name    = "www"
+    type    = "A"
+    ttl     = 300
+    records = ["192.0.2.1"]
+  }
+
+  depends_on = [aws_route53_record.unmanaged]
+}
+`, zoneName, removeUnmanagedRecords)
+}
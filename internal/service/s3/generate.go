@@ -3,6 +3,7 @@
 
 //go:generate go run ../../generate/tags/main.go -ServiceTagsSlice -TagsFunc=Tags -KeyValueTagsFunc=keyValueTags -GetTagsInFunc=getTagsIn -SetTagsOutFunc=setTagsOut
 //go:generate go run ../../generate/servicepackage/main.go
+//go:generate go run ../../generate/basictests/main.go
 //go:generate go run ../../generate/tagstests/main.go
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
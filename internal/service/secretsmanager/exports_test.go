@@ -6,11 +6,13 @@ package secretsmanager
 // Exports for use in tests only.
 var (
 	ResourceSecret         = resourceSecret
+	ResourceSecretKey      = resourceSecretKey
 	ResourceSecretPolicy   = resourceSecretPolicy
 	ResourceSecretRotation = resourceSecretRotation
 	ResourceSecretVersion  = resourceSecretVersion
 
 	FindSecretByID                = findSecretByID
+	FindSecretKeyByTwoPartKey     = findSecretKeyByTwoPartKey
 	FindSecretPolicyByID          = findSecretPolicyByID
 	FindSecretVersionByTwoPartKey = findSecretVersionByTwoPartKey
 )
@@ -0,0 +1,480 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_secretsmanager_secret_key", name="Secret Key")
+// @Testing(importIgnore="has_value_wo")
+func resourceSecretKey() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSecretKeyCreate,
+		ReadWithoutTimeout:   resourceSecretKeyRead,
+		UpdateWithoutTimeout: resourceSecretKeyUpdate,
+		DeleteWithoutTimeout: resourceSecretKeyDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+				d.Set("has_value_wo", false)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		ValidateRawResourceConfigFuncs: []schema.ValidateRawResourceConfigFunc{
+			validation.PreferWriteOnlyAttribute(cty.GetAttrPath(names.AttrValue), cty.GetAttrPath("value_wo")),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"has_value_wo": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			names.AttrKey: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"secret_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrValue: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{names.AttrValue, "value_wo"},
+			},
+			"value_wo": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				WriteOnly:    true,
+				ExactlyOneOf: []string{names.AttrValue, "value_wo"},
+				RequiredWith: []string{"value_wo_version"},
+			},
+			"value_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"value_wo"},
+			},
+			"version_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			customdiff.ComputedIf("version_id", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.HasChanges(names.AttrValue, "value_wo_version")
+			}),
+			customdiff.ComputedIf("has_value_wo", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
+				return diff.HasChange("value_wo_version")
+			}),
+		),
+	}
+}
+
+func resourceSecretKeyCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecretsManagerClient(ctx)
+
+	secretID, key := d.Get("secret_id").(string), d.Get(names.AttrKey).(string)
+	value := d.Get(names.AttrValue).(string)
+
+	valueWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("value_wo"))
+	diags = append(diags, di...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if valueWO != "" {
+		value = valueWO
+	}
+
+	resourceID := secretKeyCreateResourceID(secretID, key)
+
+	if err := putSecretKey(ctx, conn, secretID, key, value, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Secrets Manager Secret Key (%s): %s", resourceID, err)
+	}
+
+	d.SetId(resourceID)
+
+	_, err := tfresource.RetryWhenNotFound(ctx, PropagationTimeout, func() (interface{}, error) {
+		return findSecretKeyByTwoPartKey(ctx, conn, secretID, key)
+	})
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Secrets Manager Secret Key (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceSecretKeyRead(ctx, d, meta)...)
+}
+
+func resourceSecretKeyRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecretsManagerClient(ctx)
+
+	secretID, key, err := secretKeyParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	output, err := findSecretKeyByTwoPartKey(ctx, conn, secretID, key)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Secrets Manager Secret Key (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Secrets Manager Secret Key (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrKey, key)
+	d.Set("secret_id", secretID)
+	d.Set(names.AttrValue, output.value)
+	d.Set("version_id", output.versionID)
+
+	// unset value if the value is configured as write-only
+	hasWriteOnly := flex.HasWriteOnlyValue(d, "value_wo")
+	if rawConfig := d.GetRawConfig(); !rawConfig.IsNull() {
+		valueWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("value_wo"))
+		diags = append(diags, di...)
+		if diags.HasError() {
+			return diags
+		}
+
+		if valueWO != "" {
+			hasWriteOnly = true
+		}
+	}
+
+	if hasWriteOnly {
+		d.Set("has_value_wo", true)
+		d.Set(names.AttrValue, nil)
+	}
+
+	return diags
+}
+
+func resourceSecretKeyUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecretsManagerClient(ctx)
+
+	if d.HasChanges(names.AttrValue, "value_wo_version") {
+		secretID, key, err := secretKeyParseResourceID(d.Id())
+		if err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+
+		value := d.Get(names.AttrValue).(string)
+
+		if d.HasChange("value_wo_version") {
+			valueWO, di := flex.GetWriteOnlyStringValue(d, cty.GetAttrPath("value_wo"))
+			diags = append(diags, di...)
+			if diags.HasError() {
+				return diags
+			}
+
+			if valueWO != "" {
+				value = valueWO
+			}
+		}
+
+		if err := putSecretKey(ctx, conn, secretID, key, value, d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Secrets Manager Secret Key (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceSecretKeyRead(ctx, d, meta)...)
+}
+
+func resourceSecretKeyDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).SecretsManagerClient(ctx)
+
+	secretID, key, err := secretKeyParseResourceID(d.Id())
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	log.Printf("[DEBUG] Deleting Secrets Manager Secret Key: %s", d.Id())
+	err = modifySecretKeys(ctx, conn, secretID, d.Timeout(schema.TimeoutDelete), func(keys map[string]json.RawMessage) (bool, error) {
+		if _, ok := keys[key]; !ok {
+			return false, nil
+		}
+
+		delete(keys, key)
+
+		return true, nil
+	})
+
+	if tfresource.NotFound(err) ||
+		errs.IsAErrorMessageContains[*types.InvalidRequestException](err, "because it was deleted") ||
+		errs.IsAErrorMessageContains[*types.InvalidRequestException](err, "because it was marked for deletion") {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Secrets Manager Secret Key (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+const secretKeyIDSeparator = "|"
+
+func secretKeyCreateResourceID(secretID, key string) string {
+	parts := []string{secretID, key}
+	id := strings.Join(parts, secretKeyIDSeparator)
+
+	return id
+}
+
+// secretKeyParseResourceID parses a resource ID into its secret ID and key.
+// Secret names and ARNs can't contain the separator but keys can, so the ID is split at the first separator.
+func secretKeyParseResourceID(id string) (string, string, error) {
+	parts := strings.SplitN(id, secretKeyIDSeparator, 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%[1]s), expected SecretID%[2]sKey", id, secretKeyIDSeparator)
+	}
+
+	return parts[0], parts[1], nil
+}
+
+// errSecretVersionConflict is returned when the current version of a secret changes between reading and writing it.
+var errSecretVersionConflict = errors.New("secret's current version changed while it was being modified")
+
+// putSecretKey sets the value of a key in a JSON secret, leaving other keys untouched.
+func putSecretKey(ctx context.Context, conn *secretsmanager.Client, secretID, key, value string, timeout time.Duration) error {
+	v, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	return modifySecretKeys(ctx, conn, secretID, timeout, func(keys map[string]json.RawMessage) (bool, error) {
+		if old, ok := keys[key]; ok && secretKeyValue(old) == value {
+			return false, nil
+		}
+
+		keys[key] = v
+
+		return true, nil
+	})
+}
+
+// modifySecretKeys performs a read-modify-write of the key/value pairs in the current version of a JSON secret.
+// f reports whether it modified the keys. The new version only becomes current if the version that was read
+// is still current, otherwise the read-modify-write is retried until timeout expires.
+func modifySecretKeys(ctx context.Context, conn *secretsmanager.Client, secretID string, timeout time.Duration, f func(map[string]json.RawMessage) (bool, error)) error {
+	_, err := tfresource.RetryWhen(ctx, timeout,
+		func() (interface{}, error) {
+			return nil, modifySecretKeysOnce(ctx, conn, secretID, f)
+		},
+		func(err error) (bool, error) {
+			if errors.Is(err, errSecretVersionConflict) {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	return err
+}
+
+func modifySecretKeysOnce(ctx context.Context, conn *secretsmanager.Client, secretID string, f func(map[string]json.RawMessage) (bool, error)) error {
+	var currentVersionID string
+	keys := make(map[string]json.RawMessage)
+
+	output, err := findSecretVersionByStage(ctx, conn, secretID, secretVersionStageCurrent)
+
+	switch {
+	case tfresource.NotFound(err):
+		// A secret without any value is treated as an empty JSON object.
+		if _, err := findSecretByID(ctx, conn, secretID); err != nil {
+			return err
+		}
+	case err != nil:
+		return err
+	default:
+		currentVersionID = aws.ToString(output.VersionId)
+		if keys, err = expandSecretKeys(output); err != nil {
+			return err
+		}
+	}
+
+	modified, err := f(keys)
+	if err != nil {
+		return err
+	}
+
+	if !modified {
+		return nil
+	}
+
+	secretString, err := json.Marshal(keys)
+	if err != nil {
+		return err
+	}
+
+	// The client request token becomes the new version's ID.
+	// It is also used as a temporary staging label so that the new version isn't deprecated before it becomes current.
+	versionID := id.UniqueId()
+	input := &secretsmanager.PutSecretValueInput{
+		ClientRequestToken: aws.String(versionID),
+		SecretId:           aws.String(secretID),
+		SecretString:       aws.String(string(secretString)),
+	}
+
+	if currentVersionID != "" {
+		input.VersionStages = []string{versionID}
+	}
+
+	if _, err := conn.PutSecretValue(ctx, input); err != nil {
+		return fmt.Errorf("putting Secrets Manager Secret (%s) value: %w", secretID, err)
+	}
+
+	if currentVersionID == "" {
+		return nil
+	}
+
+	// Moving AWSCURRENT fails unless it's still attached to the version that was read.
+	_, err = conn.UpdateSecretVersionStage(ctx, &secretsmanager.UpdateSecretVersionStageInput{
+		MoveToVersionId:     aws.String(versionID),
+		RemoveFromVersionId: aws.String(currentVersionID),
+		SecretId:            aws.String(secretID),
+		VersionStage:        aws.String(secretVersionStageCurrent),
+	})
+
+	// Remove the temporary staging label whether or not the new version became current.
+	_, errRemove := conn.UpdateSecretVersionStage(ctx, &secretsmanager.UpdateSecretVersionStageInput{
+		RemoveFromVersionId: aws.String(versionID),
+		SecretId:            aws.String(secretID),
+		VersionStage:        aws.String(versionID),
+	})
+
+	if errs.IsA[*types.InvalidParameterException](err) {
+		return fmt.Errorf("%w: %w", errSecretVersionConflict, err)
+	}
+
+	if err != nil {
+		return fmt.Errorf("updating Secrets Manager Secret (%s) version (%s) stage (%s): %w", secretID, versionID, secretVersionStageCurrent, err)
+	}
+
+	if errRemove != nil {
+		log.Printf("[WARN] Removing Secrets Manager Secret (%s) version (%s) stage (%s): %s", secretID, versionID, versionID, errRemove)
+	}
+
+	return nil
+}
+
+// expandSecretKeys returns the key/value pairs of a JSON secret.
+// Values are kept as raw JSON so that keys not managed by Terraform are written back unchanged.
+func expandSecretKeys(output *secretsmanager.GetSecretValueOutput) (map[string]json.RawMessage, error) {
+	if output.SecretString == nil && output.SecretBinary != nil {
+		return nil, errors.New("secret value is binary, not a JSON object")
+	}
+
+	keys := make(map[string]json.RawMessage)
+
+	if v := strings.TrimSpace(aws.ToString(output.SecretString)); v != "" {
+		if err := json.Unmarshal([]byte(v), &keys); err != nil {
+			return nil, fmt.Errorf("secret value is not a JSON object: %w", err)
+		}
+	}
+
+	// "null" unmarshals to a nil map.
+	if keys == nil {
+		keys = make(map[string]json.RawMessage)
+	}
+
+	return keys, nil
+}
+
+// secretKeyValue returns a JSON value as a string. Values that aren't JSON strings are returned as JSON.
+func secretKeyValue(v json.RawMessage) string {
+	var s string
+	if err := json.Unmarshal(v, &s); err == nil {
+		return s
+	}
+
+	return string(v)
+}
+
+type secretKey struct {
+	value     string
+	versionID string
+}
+
+func findSecretVersionByStage(ctx context.Context, conn *secretsmanager.Client, secretID, stage string) (*secretsmanager.GetSecretValueOutput, error) {
+	input := &secretsmanager.GetSecretValueInput{
+		SecretId:     aws.String(secretID),
+		VersionStage: aws.String(stage),
+	}
+
+	return findSecretVersion(ctx, conn, input)
+}
+
+func findSecretKeyByTwoPartKey(ctx context.Context, conn *secretsmanager.Client, secretID, key string) (*secretKey, error) {
+	output, err := findSecretVersionByStage(ctx, conn, secretID, secretVersionStageCurrent)
+
+	if err != nil {
+		return nil, err
+	}
+
+	keys, err := expandSecretKeys(output)
+
+	if err != nil {
+		return nil, err
+	}
+
+	v, ok := keys[key]
+
+	if !ok {
+		return nil, &retry.NotFoundError{
+			Message: fmt.Sprintf("key %q not found in Secrets Manager Secret (%s)", key, secretID),
+		}
+	}
+
+	return &secretKey{
+		value:     secretKeyValue(v),
+		versionID: aws.ToString(output.VersionId),
+	}, nil
+}
@@ -0,0 +1,326 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package secretsmanager_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	tfcversion "github.com/hashicorp/go-version"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsecretsmanager "github.com/hashicorp/terraform-provider-aws/internal/service/secretsmanager"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccSecretsManagerSecretKey_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_secretsmanager_secret_key.test"
+	secretResourceName := "aws_secretsmanager_secret.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecretKeyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretKeyConfig_basic(rName, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecretKeyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrKey, "api_key"),
+					resource.TestCheckResourceAttrPair(resourceName, "secret_id", secretResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrValue, "value1"),
+					resource.TestCheckResourceAttrSet(resourceName, "version_id"),
+					testAccCheckSecretKeys(ctx, secretResourceName, map[string]string{"api_key": "value1"}),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"has_value_wo"},
+			},
+			{
+				Config: testAccSecretKeyConfig_basic(rName, "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecretKeyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrValue, "value2"),
+					testAccCheckSecretKeys(ctx, secretResourceName, map[string]string{"api_key": "value2"}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSecretsManagerSecretKey_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_secretsmanager_secret_key.test"
+	secretResourceName := "aws_secretsmanager_secret.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecretKeyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretKeyConfig_basic(rName, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecretKeyExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfsecretsmanager.ResourceSecretKey(), resourceName),
+					testAccCheckSecretKeys(ctx, secretResourceName, map[string]string{}),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSecretsManagerSecretKey_Disappears_secret(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_secretsmanager_secret_key.test"
+	secretResourceName := "aws_secretsmanager_secret.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecretKeyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretKeyConfig_basic(rName, "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecretKeyExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfsecretsmanager.ResourceSecret(), secretResourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSecretsManagerSecretKey_multipleKeys(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resource1Name := "aws_secretsmanager_secret_key.test1"
+	resource2Name := "aws_secretsmanager_secret_key.test2"
+	secretResourceName := "aws_secretsmanager_secret.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecretKeyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretKeyConfig_multipleKeys(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecretKeyExists(ctx, resource1Name),
+					testAccCheckSecretKeyExists(ctx, resource2Name),
+					testAccCheckSecretKeys(ctx, secretResourceName, map[string]string{
+						"api_key":   "key1",
+						"db_host":   "db.example.com",
+						"db_port":   "5432",
+						"unmanaged": "unchanged",
+					}),
+				),
+			},
+			{
+				Config: testAccSecretKeyConfig_unmanagedKey(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecretKeys(ctx, secretResourceName, map[string]string{
+						"db_port":   "5432",
+						"unmanaged": "unchanged",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSecretsManagerSecretKey_writeOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_secretsmanager_secret_key.test"
+	secretResourceName := "aws_secretsmanager_secret.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck: acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfcversion.Must(tfcversion.NewVersion("1.11.0"))),
+		},
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecretKeyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretKeyConfig_writeOnly(rName, "value1", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecretKeyExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "has_value_wo", acctest.CtTrue),
+					resource.TestCheckNoResourceAttr(resourceName, names.AttrValue),
+					testAccCheckSecretKeys(ctx, secretResourceName, map[string]string{"api_key": "value1"}),
+				),
+			},
+			{
+				Config: testAccSecretKeyConfig_writeOnly(rName, "value2", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecretKeyExists(ctx, resourceName),
+					testAccCheckSecretKeys(ctx, secretResourceName, map[string]string{"api_key": "value2"}),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSecretKeyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecretsManagerClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_secretsmanager_secret_key" {
+				continue
+			}
+
+			_, err := tfsecretsmanager.FindSecretKeyByTwoPartKey(ctx, conn, rs.Primary.Attributes["secret_id"], rs.Primary.Attributes[names.AttrKey])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Secrets Manager Secret Key %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSecretKeyExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecretsManagerClient(ctx)
+
+		_, err := tfsecretsmanager.FindSecretKeyByTwoPartKey(ctx, conn, rs.Primary.Attributes["secret_id"], rs.Primary.Attributes[names.AttrKey])
+
+		return err
+	}
+}
+
+func testAccCheckSecretKeys(ctx context.Context, n string, want map[string]string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SecretsManagerClient(ctx)
+
+		output, err := conn.GetSecretValue(ctx, &secretsmanager.GetSecretValueInput{
+			SecretId: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		var got map[string]any
+		if err := json.Unmarshal([]byte(aws.ToString(output.SecretString)), &got); err != nil {
+			return err
+		}
+
+		gotStrings := make(map[string]string, len(got))
+		for k, v := range got {
+			gotStrings[k] = fmt.Sprint(v)
+		}
+
+		if !maps.Equal(gotStrings, want) {
+			return fmt.Errorf("Secrets Manager Secret %s keys = %v, want %v", rs.Primary.ID, gotStrings, want)
+		}
+
+		return nil
+	}
+}
+
+func testAccSecretKeyConfig_basic(rName, value string) string {
+	return fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  name = %[1]q
+}
+
+resource "aws_secretsmanager_secret_key" "test" {
+  secret_id = aws_secretsmanager_secret.test.id
+  key       = "api_key"
+  value     = %[2]q
+}
+`, rName, value)
+}
+
+func testAccSecretKeyConfig_unmanagedKey(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  name = %[1]q
+}
+
+resource "aws_secretsmanager_secret_version" "test" {
+  secret_id = aws_secretsmanager_secret.test.id
+  secret_string = jsonencode({
+    db_port  = 5432
+    unmanaged = "unchanged"
+  })
+}
+`, rName)
+}
+
+func testAccSecretKeyConfig_multipleKeys(rName string) string {
+	return acctest.ConfigCompose(testAccSecretKeyConfig_unmanagedKey(rName), `
+resource "aws_secretsmanager_secret_key" "test1" {
+  secret_id = aws_secretsmanager_secret_version.test.secret_id
+  key       = "api_key"
+  value     = "key1"
+}
+
+resource "aws_secretsmanager_secret_key" "test2" {
+  secret_id = aws_secretsmanager_secret_version.test.secret_id
+  key       = "db_host"
+  value     = "db.example.com"
+}
+`)
+}
+
+func testAccSecretKeyConfig_writeOnly(rName, value string, version int) string {
+	return fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  name = %[1]q
+}
+
+resource "aws_secretsmanager_secret_key" "test" {
+  secret_id        = aws_secretsmanager_secret.test.id
+  key              = "api_key"
+  value_wo         = %[2]q
+  value_wo_version = %[3]d
+}
+`, rName, value, version)
+}
@@ -0,0 +1,579 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package asl parses Amazon States Language state machine definitions and reports
+// structural errors and semantic warnings without calling AWS.
+// See https://states-language.net/spec.html.
+package asl
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+type Severity string
+
+const (
+	SeverityError   Severity = "ERROR"
+	SeverityWarning Severity = "WARNING"
+)
+
+// Diagnostic is a single linter finding.
+type Diagnostic struct {
+	Severity Severity
+	// Location identifies the state, e.g. `state "Outer", branch 0, state "Inner"`.
+	// It is empty for findings about the state machine as a whole.
+	Location string
+	Message  string
+}
+
+func (d Diagnostic) Error() string {
+	if d.Location == "" {
+		return d.Message
+	}
+	return fmt.Sprintf("%s: %s", d.Location, d.Message)
+}
+
+// Diagnostics is a list of linter findings.
+type Diagnostics []Diagnostic
+
+// Errors returns the error findings.
+func (ds Diagnostics) Errors() Diagnostics {
+	return ds.filter(SeverityError)
+}
+
+// Warnings returns the warning findings.
+func (ds Diagnostics) Warnings() Diagnostics {
+	return ds.filter(SeverityWarning)
+}
+
+func (ds Diagnostics) filter(severity Severity) Diagnostics {
+	var filtered Diagnostics
+	for _, d := range ds {
+		if d.Severity == severity {
+			filtered = append(filtered, d)
+		}
+	}
+	return filtered
+}
+
+// Err joins the findings into a single error, or returns nil if there are none.
+func (ds Diagnostics) Err() error {
+	errs := make([]error, 0, len(ds))
+	for _, d := range ds {
+		errs = append(errs, d)
+	}
+	return errors.Join(errs...)
+}
+
+const (
+	queryLanguageJSONata  = "JSONata"
+	queryLanguageJSONPath = "JSONPath"
+
+	errorNameAll = "States.ALL"
+
+	stateNameLengthMax = 80
+)
+
+const (
+	stateTypeChoice   = "Choice"
+	stateTypeFail     = "Fail"
+	stateTypeMap      = "Map"
+	stateTypeParallel = "Parallel"
+	stateTypePass     = "Pass"
+	stateTypeSucceed  = "Succeed"
+	stateTypeTask     = "Task"
+	stateTypeWait     = "Wait"
+)
+
+var (
+	// predefinedErrorNames are the error names reserved by the States Language.
+	predefinedErrorNames = []string{
+		errorNameAll,
+		"States.BranchFailed",
+		"States.DataLimitExceeded",
+		"States.ExceedToleratedFailureThreshold",
+		"States.HeartbeatTimeout",
+		"States.Http.Socket",
+		"States.IntrinsicFailure",
+		"States.ItemReaderFailed",
+		"States.NoChoiceMatched",
+		"States.ParameterPathFailure",
+		"States.Permissions",
+		"States.QueryEvaluationError",
+		"States.ResultPathMatchFailure",
+		"States.ResultWriterFailed",
+		"States.Runtime",
+		"States.TaskFailed",
+		"States.Timeout",
+	}
+
+	// jsonPathFields can only be used by states whose query language is JSONPath.
+	jsonPathFields = []string{
+		"CausePath",
+		"ErrorPath",
+		"HeartbeatSecondsPath",
+		"InputPath",
+		"ItemsPath",
+		"MaxConcurrencyPath",
+		"OutputPath",
+		"Parameters",
+		"ResultPath",
+		"ResultSelector",
+		"SecondsPath",
+		"TimeoutSecondsPath",
+		"TimestampPath",
+		"ToleratedFailureCountPath",
+		"ToleratedFailurePercentagePath",
+	}
+
+	// jsonataFields can only be used by states whose query language is JSONata.
+	jsonataFields = []string{
+		"Arguments",
+		"Items",
+		"Output",
+	}
+)
+
+// Lint parses a state machine definition and returns its findings.
+func Lint(definition string) Diagnostics {
+	var v any
+	if err := json.Unmarshal([]byte(definition), &v); err != nil {
+		return Diagnostics{{Severity: SeverityError, Message: fmt.Sprintf("definition is not valid JSON: %s", err)}}
+	}
+
+	m, ok := v.(map[string]any)
+	if !ok {
+		return Diagnostics{{Severity: SeverityError, Message: "definition must be a JSON object"}}
+	}
+
+	l := &linter{
+		stateNames: make(map[string]string),
+	}
+
+	queryLanguage := queryLanguageJSONPath
+	if v, ok := m["QueryLanguage"]; ok {
+		switch v {
+		case queryLanguageJSONata, queryLanguageJSONPath:
+			queryLanguage = v.(string)
+		default:
+			l.errorf("", "QueryLanguage must be %q or %q", queryLanguageJSONPath, queryLanguageJSONata)
+		}
+	}
+
+	l.machine(m, "", queryLanguage)
+
+	return l.diags
+}
+
+type linter struct {
+	diags Diagnostics
+	// stateNames maps each state name to its location. State names are unique across the whole state machine.
+	stateNames map[string]string
+}
+
+func (l *linter) errorf(location, format string, a ...any) {
+	l.diags = append(l.diags, Diagnostic{Severity: SeverityError, Location: location, Message: fmt.Sprintf(format, a...)})
+}
+
+func (l *linter) warnf(location, format string, a ...any) {
+	l.diags = append(l.diags, Diagnostic{Severity: SeverityWarning, Location: location, Message: fmt.Sprintf(format, a...)})
+}
+
+// machine lints a top-level state machine, a Parallel branch or a Map item processor.
+func (l *linter) machine(m map[string]any, location, queryLanguage string) {
+	states, ok := m["States"].(map[string]any)
+	if !ok || len(states) == 0 {
+		l.errorf(location, "States must be a non-empty object")
+		return
+	}
+
+	startAt, ok := m["StartAt"].(string)
+	if !ok || startAt == "" {
+		l.errorf(location, "StartAt is required")
+	} else if _, ok := states[startAt]; !ok {
+		l.errorf(location, "StartAt %q does not match any state", startAt)
+	}
+
+	names := make([]string, 0, len(states))
+	for name := range states {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	transitions := make(map[string][]string, len(states))
+	for _, name := range names {
+		stateLocation := joinLocation(location, fmt.Sprintf("state %q", name))
+
+		if other, ok := l.stateNames[name]; ok {
+			l.errorf(stateLocation, "state name is already used by %s", other)
+		} else {
+			l.stateNames[name] = stateLocation
+		}
+
+		if len(name) > stateNameLengthMax {
+			l.errorf(stateLocation, "state name must be at most %d characters", stateNameLengthMax)
+		}
+
+		state, ok := states[name].(map[string]any)
+		if !ok {
+			l.errorf(stateLocation, "state must be an object")
+			continue
+		}
+
+		transitions[name] = l.state(state, stateLocation, queryLanguage)
+
+		for _, next := range transitions[name] {
+			if _, ok := states[next]; !ok {
+				l.errorf(stateLocation, "transition target %q does not match any state in this scope", next)
+			}
+		}
+	}
+
+	// Every state must be reachable from StartAt.
+	if _, ok := states[startAt]; ok {
+		reachable := map[string]bool{startAt: true}
+		queue := []string{startAt}
+		for len(queue) > 0 {
+			name := queue[0]
+			queue = queue[1:]
+			for _, next := range transitions[name] {
+				if _, ok := states[next]; ok && !reachable[next] {
+					reachable[next] = true
+					queue = append(queue, next)
+				}
+			}
+		}
+
+		for _, name := range names {
+			if !reachable[name] {
+				l.errorf(joinLocation(location, fmt.Sprintf("state %q", name)), "state is not reachable from StartAt %q", startAt)
+			}
+		}
+	}
+}
+
+// state lints a single state and returns the names of the states it can transition to.
+func (l *linter) state(state map[string]any, location, queryLanguage string) []string {
+	var transitions []string
+
+	if v, ok := state["QueryLanguage"]; ok {
+		switch v {
+		case queryLanguageJSONata:
+			queryLanguage = queryLanguageJSONata
+		case queryLanguageJSONPath:
+			if queryLanguage == queryLanguageJSONata {
+				l.errorf(location, "QueryLanguage %q cannot be used when the state machine's QueryLanguage is %q", queryLanguageJSONPath, queryLanguageJSONata)
+			}
+		default:
+			l.errorf(location, "QueryLanguage must be %q or %q", queryLanguageJSONPath, queryLanguageJSONata)
+		}
+	}
+
+	l.queryLanguageFields(state, location, queryLanguage)
+
+	stateType, _ := state["Type"].(string)
+	switch stateType {
+	case stateTypeChoice:
+		l.forbidFields(state, location, stateType, "End", "Next")
+		transitions = append(transitions, l.choice(state, location, queryLanguage)...)
+	case stateTypeFail, stateTypeSucceed:
+		l.forbidFields(state, location, stateType, "End", "Next")
+	case stateTypeMap, stateTypeParallel, stateTypePass, stateTypeTask, stateTypeWait:
+		next, hasNext := state["Next"].(string)
+		end, _ := state["End"].(bool)
+
+		switch {
+		case hasNext && end:
+			l.errorf(location, "Next and End cannot both be set")
+		case !hasNext && !end:
+			l.errorf(location, "either Next or End must be set")
+		case hasNext:
+			transitions = append(transitions, next)
+		}
+	case "":
+		l.errorf(location, "Type is required")
+		return nil
+	default:
+		l.errorf(location, "unsupported Type %q", stateType)
+		return nil
+	}
+
+	switch stateType {
+	case stateTypeMap:
+		l.mapState(state, location, queryLanguage)
+	case stateTypeParallel:
+		branches, ok := state["Branches"].([]any)
+		if !ok || len(branches) == 0 {
+			l.errorf(location, "Branches must be a non-empty array")
+		}
+		for i, v := range branches {
+			branchLocation := joinLocation(location, fmt.Sprintf("branch %d", i))
+			branch, ok := v.(map[string]any)
+			if !ok {
+				l.errorf(branchLocation, "branch must be an object")
+				continue
+			}
+			l.machine(branch, branchLocation, queryLanguage)
+		}
+	case stateTypeTask:
+		if _, ok := state["Resource"].(string); !ok {
+			l.errorf(location, "Resource is required")
+		}
+		if _, ok := state["Catch"]; !ok {
+			l.warnf(location, "Task state has no Catch, so any error that is not retried fails the execution")
+		}
+	case stateTypeWait:
+		var n int
+		for _, k := range []string{"Seconds", "SecondsPath", "Timestamp", "TimestampPath"} {
+			if _, ok := state[k]; ok {
+				n++
+			}
+		}
+		if n != 1 {
+			l.errorf(location, "exactly one of Seconds, SecondsPath, Timestamp or TimestampPath must be set")
+		}
+	}
+
+	if slices.Contains([]string{stateTypeMap, stateTypeParallel, stateTypeTask}, stateType) {
+		l.retriers(state, location)
+		transitions = append(transitions, l.catchers(state, location)...)
+	} else {
+		l.forbidFields(state, location, stateType, "Catch", "Retry")
+	}
+
+	return transitions
+}
+
+func (l *linter) mapState(state map[string]any, location, queryLanguage string) {
+	processor, ok := state["ItemProcessor"].(map[string]any)
+	if !ok {
+		// Iterator is the deprecated name of ItemProcessor.
+		processor, ok = state["Iterator"].(map[string]any)
+	}
+	if !ok {
+		l.errorf(location, "ItemProcessor is required")
+	} else {
+		l.machine(processor, joinLocation(location, "item processor"), queryLanguage)
+	}
+
+	_, hasMaxConcurrency := state["MaxConcurrency"]
+	_, hasMaxConcurrencyPath := state["MaxConcurrencyPath"]
+	if v, _ := state["MaxConcurrency"].(float64); (!hasMaxConcurrency && !hasMaxConcurrencyPath) || (hasMaxConcurrency && v == 0) {
+		l.warnf(location, "Map state has no MaxConcurrency, so iterations run with unlimited concurrency")
+	}
+}
+
+// choice lints the rules of a Choice state and returns its transitions.
+func (l *linter) choice(state map[string]any, location, queryLanguage string) []string {
+	var transitions []string
+
+	choices, ok := state["Choices"].([]any)
+	if !ok || len(choices) == 0 {
+		l.errorf(location, "Choices must be a non-empty array")
+	}
+
+	for i, v := range choices {
+		ruleLocation := joinLocation(location, fmt.Sprintf("choice %d", i))
+		rule, ok := v.(map[string]any)
+		if !ok {
+			l.errorf(ruleLocation, "choice rule must be an object")
+			continue
+		}
+
+		if next, ok := rule["Next"].(string); ok {
+			transitions = append(transitions, next)
+		} else {
+			l.errorf(ruleLocation, "Next is required")
+		}
+
+		if queryLanguage == queryLanguageJSONata {
+			if _, ok := rule["Condition"]; !ok {
+				l.errorf(ruleLocation, "Condition is required when QueryLanguage is %q", queryLanguageJSONata)
+			}
+			if _, ok := rule["Variable"]; ok {
+				l.errorf(ruleLocation, "Variable cannot be used when QueryLanguage is %q", queryLanguageJSONata)
+			}
+		} else {
+			if _, ok := rule["Condition"]; ok {
+				l.errorf(ruleLocation, "Condition cannot be used when QueryLanguage is %q", queryLanguageJSONPath)
+			}
+			l.choiceRule(rule, ruleLocation)
+		}
+	}
+
+	if v, ok := state["Default"]; ok {
+		if next, ok := v.(string); ok {
+			transitions = append(transitions, next)
+		} else {
+			l.errorf(location, "Default must be a string")
+		}
+	}
+
+	return transitions
+}
+
+// choiceRule lints a JSONPath choice rule, which is either a Boolean expression or a comparison.
+func (l *linter) choiceRule(rule map[string]any, location string) {
+	switch {
+	case rule["And"] != nil, rule["Or"] != nil:
+		for _, k := range []string{"And", "Or"} {
+			v, ok := rule[k]
+			if !ok {
+				continue
+			}
+			rules, ok := v.([]any)
+			if !ok || len(rules) == 0 {
+				l.errorf(location, "%s must be a non-empty array", k)
+				continue
+			}
+			for _, v := range rules {
+				if nested, ok := v.(map[string]any); ok {
+					l.choiceRule(nested, location)
+				} else {
+					l.errorf(location, "%s elements must be objects", k)
+				}
+			}
+		}
+	case rule["Not"] != nil:
+		if nested, ok := rule["Not"].(map[string]any); ok {
+			l.choiceRule(nested, location)
+		} else {
+			l.errorf(location, "Not must be an object")
+		}
+	default:
+		if _, ok := rule["Variable"].(string); !ok {
+			l.errorf(location, "Variable is required in a comparison")
+		}
+	}
+}
+
+func (l *linter) retriers(state map[string]any, location string) {
+	v, ok := state["Retry"]
+	if !ok {
+		return
+	}
+
+	retriers, ok := v.([]any)
+	if !ok {
+		l.errorf(location, "Retry must be an array")
+		return
+	}
+
+	for i, v := range retriers {
+		retrierLocation := joinLocation(location, fmt.Sprintf("retrier %d", i))
+		retrier, ok := v.(map[string]any)
+		if !ok {
+			l.errorf(retrierLocation, "retrier must be an object")
+			continue
+		}
+
+		l.errorEquals(retrier, retrierLocation, i == len(retriers)-1)
+
+		if v, ok := retrier["IntervalSeconds"].(float64); ok && v < 1 {
+			l.errorf(retrierLocation, "IntervalSeconds must be a positive integer")
+		}
+		if v, ok := retrier["MaxAttempts"].(float64); ok && v < 0 {
+			l.errorf(retrierLocation, "MaxAttempts must be a non-negative integer")
+		}
+		if v, ok := retrier["BackoffRate"].(float64); ok && v < 1 {
+			l.errorf(retrierLocation, "BackoffRate must be greater than or equal to 1.0")
+		}
+	}
+}
+
+// catchers lints a state's Catch field and returns the catchers' transitions.
+func (l *linter) catchers(state map[string]any, location string) []string {
+	v, ok := state["Catch"]
+	if !ok {
+		return nil
+	}
+
+	catchers, ok := v.([]any)
+	if !ok {
+		l.errorf(location, "Catch must be an array")
+		return nil
+	}
+
+	var transitions []string
+	for i, v := range catchers {
+		catcherLocation := joinLocation(location, fmt.Sprintf("catcher %d", i))
+		catcher, ok := v.(map[string]any)
+		if !ok {
+			l.errorf(catcherLocation, "catcher must be an object")
+			continue
+		}
+
+		l.errorEquals(catcher, catcherLocation, i == len(catchers)-1)
+
+		if next, ok := catcher["Next"].(string); ok {
+			transitions = append(transitions, next)
+		} else {
+			l.errorf(catcherLocation, "Next is required")
+		}
+	}
+
+	return transitions
+}
+
+// errorEquals lints the ErrorEquals field of a retrier or catcher.
+func (l *linter) errorEquals(m map[string]any, location string, last bool) {
+	errorNames, ok := m["ErrorEquals"].([]any)
+	if !ok || len(errorNames) == 0 {
+		l.errorf(location, "ErrorEquals must be a non-empty array")
+		return
+	}
+
+	for _, v := range errorNames {
+		name, ok := v.(string)
+		if !ok {
+			l.errorf(location, "ErrorEquals elements must be strings")
+			continue
+		}
+
+		if strings.HasPrefix(name, "States.") && !slices.Contains(predefinedErrorNames, name) {
+			l.errorf(location, "unknown error name %q", name)
+		}
+
+		if name == errorNameAll {
+			if len(errorNames) != 1 {
+				l.errorf(location, "%q must be the only error name in ErrorEquals", errorNameAll)
+			}
+			if !last {
+				l.errorf(location, "%q can only be used in the last retrier or catcher", errorNameAll)
+			}
+		}
+	}
+}
+
+// queryLanguageFields reports fields that cannot be used with the state's query language.
+func (l *linter) queryLanguageFields(state map[string]any, location, queryLanguage string) {
+	forbidden := jsonataFields
+	if queryLanguage == queryLanguageJSONata {
+		forbidden = jsonPathFields
+	}
+
+	for _, k := range forbidden {
+		if _, ok := state[k]; ok {
+			l.errorf(location, "%s cannot be used when QueryLanguage is %q", k, queryLanguage)
+		}
+	}
+}
+
+func (l *linter) forbidFields(state map[string]any, location, stateType string, fields ...string) {
+	for _, k := range fields {
+		if _, ok := state[k]; ok {
+			l.errorf(location, "%s cannot be used in a %s state", k, stateType)
+		}
+	}
+}
+
+func joinLocation(location, element string) string {
+	if location == "" {
+		return element
+	}
+	return location + ", " + element
+}
@@ -0,0 +1,231 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package asl
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLint(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		definition   string
+		wantErrors   []string
+		wantWarnings []string
+	}{
+		"valid": {
+			definition: `{
+				"StartAt": "Invoke",
+				"States": {
+					"Invoke": {
+						"Type": "Task",
+						"Resource": "arn:aws:states:::lambda:invoke",
+						"Retry": [
+							{"ErrorEquals": ["States.Timeout"], "IntervalSeconds": 2, "MaxAttempts": 3, "BackoffRate": 2.0},
+							{"ErrorEquals": ["States.ALL"]}
+						],
+						"Catch": [{"ErrorEquals": ["States.ALL"], "Next": "Failed"}],
+						"Next": "Check"
+					},
+					"Check": {
+						"Type": "Choice",
+						"Choices": [{"Variable": "$.ok", "BooleanEquals": true, "Next": "Done"}],
+						"Default": "Failed"
+					},
+					"Done": {"Type": "Succeed"},
+					"Failed": {"Type": "Fail"}
+				}
+			}`,
+		},
+		"invalid JSON": {
+			definition: `{"StartAt": `,
+			wantErrors: []string{"definition is not valid JSON"},
+		},
+		"missing StartAt": {
+			definition: `{"States": {"A": {"Type": "Pass", "End": true}}}`,
+			wantErrors: []string{"StartAt is required"},
+		},
+		"unknown StartAt": {
+			definition: `{"StartAt": "B", "States": {"A": {"Type": "Pass", "End": true}}}`,
+			wantErrors: []string{`StartAt "B" does not match any state`},
+		},
+		"missing Next target": {
+			definition: `{"StartAt": "A", "States": {"A": {"Type": "Pass", "Next": "B"}}}`,
+			wantErrors: []string{`state "A": transition target "B" does not match any state in this scope`},
+		},
+		"neither Next nor End": {
+			definition: `{"StartAt": "A", "States": {"A": {"Type": "Pass"}}}`,
+			wantErrors: []string{`state "A": either Next or End must be set`},
+		},
+		"Next and End": {
+			definition: `{"StartAt": "A", "States": {"A": {"Type": "Pass", "Next": "B", "End": true}, "B": {"Type": "Succeed"}}}`,
+			wantErrors: []string{`state "A": Next and End cannot both be set`},
+		},
+		"unreachable state": {
+			definition: `{"StartAt": "A", "States": {"A": {"Type": "Pass", "End": true}, "B": {"Type": "Pass", "End": true}}}`,
+			wantErrors: []string{`state "B": state is not reachable from StartAt "A"`},
+		},
+		"reachable through catcher": {
+			definition: `{"StartAt": "A", "States": {
+				"A": {"Type": "Task", "Resource": "arn:aws:states:::sns:publish", "Catch": [{"ErrorEquals": ["States.ALL"], "Next": "B"}], "End": true},
+				"B": {"Type": "Fail"}
+			}}`,
+		},
+		"unsupported type": {
+			definition: `{"StartAt": "A", "States": {"A": {"Type": "Sleep", "End": true}}}`,
+			wantErrors: []string{`state "A": unsupported Type "Sleep"`},
+		},
+		"terminal state with Next": {
+			definition: `{"StartAt": "A", "States": {"A": {"Type": "Succeed", "Next": "A"}}}`,
+			wantErrors: []string{`state "A": Next cannot be used in a Succeed state`},
+		},
+		"unknown error name": {
+			definition: `{"StartAt": "A", "States": {"A": {
+				"Type": "Task", "Resource": "arn:aws:states:::sns:publish", "End": true,
+				"Retry": [{"ErrorEquals": ["States.TimeOut"]}],
+				"Catch": [{"ErrorEquals": ["States.ALL"], "Next": "A"}]
+			}}}`,
+			wantErrors: []string{`state "A", retrier 0: unknown error name "States.TimeOut"`},
+		},
+		"States.ALL not alone": {
+			definition: `{"StartAt": "A", "States": {"A": {
+				"Type": "Task", "Resource": "arn:aws:states:::sns:publish", "End": true,
+				"Retry": [{"ErrorEquals": ["States.ALL", "States.Timeout"]}],
+				"Catch": [{"ErrorEquals": ["States.ALL"], "Next": "A"}]
+			}}}`,
+			wantErrors: []string{`"States.ALL" must be the only error name in ErrorEquals`},
+		},
+		"States.ALL not last": {
+			definition: `{"StartAt": "A", "States": {"A": {
+				"Type": "Task", "Resource": "arn:aws:states:::sns:publish", "End": true,
+				"Retry": [{"ErrorEquals": ["States.ALL"]}, {"ErrorEquals": ["States.Timeout"]}],
+				"Catch": [{"ErrorEquals": ["States.ALL"], "Next": "A"}]
+			}}}`,
+			wantErrors: []string{`state "A", retrier 0: "States.ALL" can only be used in the last retrier or catcher`},
+		},
+		"invalid backoff rate": {
+			definition: `{"StartAt": "A", "States": {"A": {
+				"Type": "Task", "Resource": "arn:aws:states:::sns:publish", "End": true,
+				"Retry": [{"ErrorEquals": ["States.Timeout"], "BackoffRate": 0.5}],
+				"Catch": [{"ErrorEquals": ["States.ALL"], "Next": "A"}]
+			}}}`,
+			wantErrors: []string{"BackoffRate must be greater than or equal to 1.0"},
+		},
+		"Retry on Pass state": {
+			definition: `{"StartAt": "A", "States": {"A": {"Type": "Pass", "End": true, "Retry": [{"ErrorEquals": ["States.ALL"]}]}}}`,
+			wantErrors: []string{`state "A": Retry cannot be used in a Pass state`},
+		},
+		"choice without Next": {
+			definition: `{"StartAt": "A", "States": {
+				"A": {"Type": "Choice", "Choices": [{"Variable": "$.x", "IsPresent": true}], "Default": "B"},
+				"B": {"Type": "Succeed"}
+			}}`,
+			wantErrors: []string{`state "A", choice 0: Next is required`},
+		},
+		"wait without duration": {
+			definition: `{"StartAt": "A", "States": {"A": {"Type": "Wait", "End": true}}}`,
+			wantErrors: []string{"exactly one of Seconds, SecondsPath, Timestamp or TimestampPath must be set"},
+		},
+		"nested branch": {
+			definition: `{"StartAt": "P", "States": {"P": {
+				"Type": "Parallel", "End": true,
+				"Branches": [{"StartAt": "Inner", "States": {"Inner": {"Type": "Pass", "Next": "P"}}}]
+			}}}`,
+			wantErrors: []string{`state "P", branch 0, state "Inner": transition target "P" does not match any state in this scope`},
+		},
+		"duplicate state name": {
+			definition: `{"StartAt": "P", "States": {"P": {
+				"Type": "Parallel", "End": true,
+				"Branches": [
+					{"StartAt": "Inner", "States": {"Inner": {"Type": "Pass", "End": true}}},
+					{"StartAt": "Inner", "States": {"Inner": {"Type": "Pass", "End": true}}}
+				]
+			}}}`,
+			wantErrors: []string{`state "P", branch 1, state "Inner": state name is already used by state "P", branch 0, state "Inner"`},
+		},
+		"JSONata state using JSONPath fields": {
+			definition: `{"QueryLanguage": "JSONata", "StartAt": "A", "States": {"A": {"Type": "Pass", "InputPath": "$.x", "End": true}}}`,
+			wantErrors: []string{`state "A": InputPath cannot be used when QueryLanguage is "JSONata"`},
+		},
+		"JSONPath state using JSONata fields": {
+			definition: `{"StartAt": "A", "States": {"A": {"Type": "Pass", "Output": "{% $states.input %}", "End": true}}}`,
+			wantErrors: []string{`state "A": Output cannot be used when QueryLanguage is "JSONPath"`},
+		},
+		"JSONPath state in JSONata machine": {
+			definition: `{"QueryLanguage": "JSONata", "StartAt": "A", "States": {"A": {"Type": "Pass", "QueryLanguage": "JSONPath", "End": true}}}`,
+			wantErrors: []string{`QueryLanguage "JSONPath" cannot be used when the state machine's QueryLanguage is "JSONata"`},
+		},
+		"JSONata state in JSONPath machine": {
+			definition: `{"StartAt": "A", "States": {
+				"A": {"Type": "Choice", "QueryLanguage": "JSONata", "Choices": [{"Condition": "{% $states.input.ok %}", "Next": "B"}], "Default": "B"},
+				"B": {"Type": "Pass", "Parameters": {"x.$": "$.x"}, "End": true}
+			}}`,
+		},
+		"JSONata choice with Variable": {
+			definition: `{"QueryLanguage": "JSONata", "StartAt": "A", "States": {
+				"A": {"Type": "Choice", "Choices": [{"Variable": "$.x", "IsPresent": true, "Next": "B"}]},
+				"B": {"Type": "Succeed"}
+			}}`,
+			wantErrors: []string{"Condition is required", "Variable cannot be used"},
+		},
+		"task without catch": {
+			definition:   `{"StartAt": "A", "States": {"A": {"Type": "Task", "Resource": "arn:aws:states:::sns:publish", "End": true}}}`,
+			wantWarnings: []string{`state "A": Task state has no Catch`},
+		},
+		"map without max concurrency": {
+			definition: `{"StartAt": "M", "States": {"M": {
+				"Type": "Map", "End": true,
+				"ItemProcessor": {"StartAt": "Item", "States": {"Item": {"Type": "Pass", "End": true}}}
+			}}}`,
+			wantWarnings: []string{`state "M": Map state has no MaxConcurrency`},
+		},
+		"map with max concurrency": {
+			definition: `{"StartAt": "M", "States": {"M": {
+				"Type": "Map", "MaxConcurrency": 10, "End": true,
+				"ItemProcessor": {"StartAt": "Item", "States": {"Item": {"Type": "Pass", "End": true}}}
+			}}}`,
+		},
+		"map without item processor": {
+			definition: `{"StartAt": "M", "States": {"M": {"Type": "Map", "MaxConcurrency": 1, "End": true}}}`,
+			wantErrors: []string{`state "M": ItemProcessor is required`},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := Lint(testCase.definition)
+
+			checkDiagnostics(t, "error", diags.Errors(), testCase.wantErrors)
+			checkDiagnostics(t, "warning", diags.Warnings(), testCase.wantWarnings)
+		})
+	}
+}
+
+func checkDiagnostics(t *testing.T, kind string, got Diagnostics, want []string) {
+	t.Helper()
+
+	if len(want) == 0 {
+		if len(got) > 0 {
+			t.Errorf("unexpected %ss: %s", kind, got.Err())
+		}
+		return
+	}
+
+	for _, w := range want {
+		var found bool
+		for _, d := range got {
+			if strings.Contains(d.Error(), w) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Errorf("expected %s containing %q, got: %v", kind, w, got.Err())
+		}
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/sfn"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/sfn/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
@@ -26,6 +27,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/service/sfn/asl"
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
@@ -194,6 +196,7 @@ func resourceStateMachine() *schema.Resource {
 		},
 
 		CustomizeDiff: customdiff.Sequence(
+			stateMachineDefinitionLint,
 			stateMachineDefinitionValidate,
 			stateMachineUpdateComputedAttributesOnPublish,
 			verify.SetTagsDiff,
@@ -561,6 +564,41 @@ func stateMachineNeedsConfigUpdate(d sdkv2.ResourceDiffer) bool {
 	return false
 }
 
+// stateMachineDefinitionLint statically analyzes the state machine definition.
+// Structural errors fail the plan. Warnings are logged unless the provider is configured to treat them as errors.
+func stateMachineDefinitionLint(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.HasChange("definition") || !d.NewValueKnown("definition") {
+		return nil
+	}
+
+	definition := d.Get("definition").(string)
+	if definition == "" {
+		return nil
+	}
+
+	diags := asl.Lint(definition)
+
+	if err := diags.Errors().Err(); err != nil {
+		return fmt.Errorf("invalid Step Functions State Machine definition: %w", err)
+	}
+
+	warnings := diags.Warnings()
+	if meta.(*conns.AWSClient).SFNDefinitionWarningsAsErrors(ctx) {
+		if err := warnings.Err(); err != nil {
+			return fmt.Errorf("Step Functions State Machine definition warnings are treated as errors: %w", err)
+		}
+	}
+
+	for _, warning := range warnings {
+		tflog.Warn(ctx, "Step Functions State Machine definition", map[string]any{
+			"location": warning.Location,
+			"message":  warning.Message,
+		})
+	}
+
+	return nil
+}
+
 func stateMachineDefinitionValidate(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).SFNClient(ctx)
 
@@ -612,6 +612,28 @@ func TestAccSFNStateMachine_definitionValidation(t *testing.T) {
 	})
 }
 
+func TestAccSFNStateMachine_definitionLint(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SFNServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStateMachineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccStateMachineConfig_lintErrors(rName),
+				ExpectError: regexache.MustCompile(`state "Orphan": state is not reachable from StartAt "Start"`),
+			},
+			{
+				Config:      testAccStateMachineConfig_lintErrors(rName),
+				ExpectError: regexache.MustCompile(`state "Start", retrier 0: unknown error name "States.TimeOut"`),
+			},
+		},
+	})
+}
+
 func testAccCheckExists(ctx context.Context, n string, v *sfn.DescribeStateMachineOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -1279,3 +1301,29 @@ EOF
 }
 `, rName))
 }
+
+func testAccStateMachineConfig_lintErrors(rName string) string {
+	return acctest.ConfigCompose(testAccStateMachineConfig_base(rName), fmt.Sprintf(`
+resource "aws_sfn_state_machine" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.for_sfn.arn
+
+  definition = jsonencode({
+    StartAt = "Start"
+    States = {
+      Start = {
+        Type     = "Task"
+        Resource = "arn:${data.aws_partition.current.partition}:states:::sns:publish"
+        Retry = [{
+          ErrorEquals = ["States.TimeOut"]
+        }]
+        End = true
+      }
+      Orphan = {
+        Type = "Succeed"
+      }
+    }
+  })
+}
+`, rName))
+}
@@ -0,0 +1,381 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wafv2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wafv2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wafv2/types"
+)
+
+// WAF capacity unit (WCU) costs.
+// See https://docs.aws.amazon.com/waf/latest/developerguide/aws-waf-capacity-units.html.
+const (
+	capacityByteMatchExact             = 2
+	capacityByteMatchContains          = 10
+	capacityGeoMatch                   = 1
+	capacityIPSetReference             = 1
+	capacityLabelMatch                 = 1
+	capacityRateBased                  = 2
+	capacityRateBasedCustomKey         = 30
+	capacityRegexMatch                 = 3
+	capacityRegexPatternSetReference   = 25
+	capacitySizeConstraint             = 1
+	capacitySQLiMatchLowSensitivity    = 20
+	capacitySQLiMatchHighSensitivity   = 30
+	capacityXSSMatch                   = 40
+	capacityTextTransformation         = 10
+	capacityAllQueryArgumentsSurcharge = 10
+
+	// webACLCapacityMax is the maximum number of WCUs in a web ACL.
+	webACLCapacityMax = 5000
+
+	rateBasedStatementCustomKeysMax = 5
+)
+
+// errCapacityUnknown is returned when a rule's capacity can't be determined,
+// e.g. because a referenced rule group's ARN is not yet known.
+var errCapacityUnknown = errors.New("capacity unknown")
+
+// capacityEstimator computes the WCUs used by a set of rules.
+// The capacity of referenced and managed rule groups is looked up via the optional functions.
+type capacityEstimator struct {
+	managedRuleGroupCapacity func(context.Context, *awstypes.ManagedRuleGroupStatement) (int64, error)
+	ruleGroupCapacity        func(context.Context, string) (int64, error)
+}
+
+func newCapacityEstimator(conn *wafv2.Client, scope awstypes.Scope) *capacityEstimator {
+	return &capacityEstimator{
+		managedRuleGroupCapacity: func(ctx context.Context, statement *awstypes.ManagedRuleGroupStatement) (int64, error) {
+			input := &wafv2.DescribeManagedRuleGroupInput{
+				Name:        statement.Name,
+				Scope:       scope,
+				VendorName:  statement.VendorName,
+				VersionName: statement.Version,
+			}
+
+			output, err := conn.DescribeManagedRuleGroup(ctx, input)
+
+			if err != nil {
+				return 0, err
+			}
+
+			if output == nil || output.Capacity == nil {
+				return 0, errCapacityUnknown
+			}
+
+			return aws.ToInt64(output.Capacity), nil
+		},
+		ruleGroupCapacity: func(ctx context.Context, arn string) (int64, error) {
+			output, err := findRuleGroupByARN(ctx, conn, arn)
+
+			if err != nil {
+				return 0, err
+			}
+
+			if output.RuleGroup.Capacity == nil {
+				return 0, errCapacityUnknown
+			}
+
+			return aws.ToInt64(output.RuleGroup.Capacity), nil
+		},
+	}
+}
+
+func (e *capacityEstimator) rulesCapacity(ctx context.Context, rules []awstypes.Rule) (int64, error) {
+	var total int64
+
+	for _, rule := range rules {
+		n, err := e.statementCapacity(ctx, rule.Statement)
+		if err != nil {
+			return 0, err
+		}
+
+		total += n
+	}
+
+	return total, nil
+}
+
+func (e *capacityEstimator) statementCapacity(ctx context.Context, statement *awstypes.Statement) (int64, error) {
+	if statement == nil {
+		return 0, nil
+	}
+
+	switch {
+	case statement.AndStatement != nil:
+		return e.statementsCapacity(ctx, statement.AndStatement.Statements)
+	case statement.ByteMatchStatement != nil:
+		v := statement.ByteMatchStatement
+		var base int64 = capacityByteMatchExact
+		switch v.PositionalConstraint {
+		case awstypes.PositionalConstraintContains, awstypes.PositionalConstraintContainsWord:
+			base = capacityByteMatchContains
+		}
+		return matchCapacity(base, v.FieldToMatch, v.TextTransformations), nil
+	case statement.GeoMatchStatement != nil:
+		return capacityGeoMatch, nil
+	case statement.IPSetReferenceStatement != nil:
+		return capacityIPSetReference, nil
+	case statement.LabelMatchStatement != nil:
+		return capacityLabelMatch, nil
+	case statement.ManagedRuleGroupStatement != nil:
+		v := statement.ManagedRuleGroupStatement
+		if e.managedRuleGroupCapacity == nil {
+			return 0, errCapacityUnknown
+		}
+		n, err := e.managedRuleGroupCapacity(ctx, v)
+		if err != nil {
+			return 0, err
+		}
+		scopeDown, err := e.statementCapacity(ctx, v.ScopeDownStatement)
+		if err != nil {
+			return 0, err
+		}
+		return n + scopeDown, nil
+	case statement.NotStatement != nil:
+		return e.statementCapacity(ctx, statement.NotStatement.Statement)
+	case statement.OrStatement != nil:
+		return e.statementsCapacity(ctx, statement.OrStatement.Statements)
+	case statement.RateBasedStatement != nil:
+		v := statement.RateBasedStatement
+		var n int64 = capacityRateBased
+		for _, key := range v.CustomKeys {
+			n += capacityRateBasedCustomKey + rateBasedStatementCustomKeyTextTransformationsCapacity(key)
+		}
+		scopeDown, err := e.statementCapacity(ctx, v.ScopeDownStatement)
+		if err != nil {
+			return 0, err
+		}
+		return n + scopeDown, nil
+	case statement.RegexMatchStatement != nil:
+		v := statement.RegexMatchStatement
+		return matchCapacity(capacityRegexMatch, v.FieldToMatch, v.TextTransformations), nil
+	case statement.RegexPatternSetReferenceStatement != nil:
+		v := statement.RegexPatternSetReferenceStatement
+		return matchCapacity(capacityRegexPatternSetReference, v.FieldToMatch, v.TextTransformations), nil
+	case statement.RuleGroupReferenceStatement != nil:
+		arn := aws.ToString(statement.RuleGroupReferenceStatement.ARN)
+		if arn == "" || e.ruleGroupCapacity == nil {
+			return 0, errCapacityUnknown
+		}
+		return e.ruleGroupCapacity(ctx, arn)
+	case statement.SizeConstraintStatement != nil:
+		v := statement.SizeConstraintStatement
+		return matchCapacity(capacitySizeConstraint, v.FieldToMatch, v.TextTransformations), nil
+	case statement.SqliMatchStatement != nil:
+		v := statement.SqliMatchStatement
+		var base int64 = capacitySQLiMatchLowSensitivity
+		if v.SensitivityLevel == awstypes.SensitivityLevelHigh {
+			base = capacitySQLiMatchHighSensitivity
+		}
+		return matchCapacity(base, v.FieldToMatch, v.TextTransformations), nil
+	case statement.XssMatchStatement != nil:
+		v := statement.XssMatchStatement
+		return matchCapacity(capacityXSSMatch, v.FieldToMatch, v.TextTransformations), nil
+	}
+
+	return 0, nil
+}
+
+func (e *capacityEstimator) statementsCapacity(ctx context.Context, statements []awstypes.Statement) (int64, error) {
+	var total int64
+
+	for _, statement := range statements {
+		n, err := e.statementCapacity(ctx, &statement)
+		if err != nil {
+			return 0, err
+		}
+
+		total += n
+	}
+
+	return total, nil
+}
+
+// matchCapacity returns the cost of a match statement with the specified base cost.
+// Inspecting the JSON body doubles the base cost, inspecting all query arguments adds a surcharge
+// and each text transformation other than NONE adds to the cost.
+func matchCapacity(base int64, fieldToMatch *awstypes.FieldToMatch, textTransformations []awstypes.TextTransformation) int64 {
+	n := base
+
+	if fieldToMatch != nil {
+		if fieldToMatch.JsonBody != nil {
+			n *= 2
+		}
+		if fieldToMatch.AllQueryArguments != nil {
+			n += capacityAllQueryArgumentsSurcharge
+		}
+	}
+
+	return n + textTransformationsCapacity(textTransformations)
+}
+
+func textTransformationsCapacity(textTransformations []awstypes.TextTransformation) int64 {
+	var n int64
+
+	for _, v := range textTransformations {
+		if v.Type != awstypes.TextTransformationTypeNone {
+			n += capacityTextTransformation
+		}
+	}
+
+	return n
+}
+
+func rateBasedStatementCustomKeyTextTransformationsCapacity(key awstypes.RateBasedStatementCustomKey) int64 {
+	switch {
+	case key.Cookie != nil:
+		return textTransformationsCapacity(key.Cookie.TextTransformations)
+	case key.Header != nil:
+		return textTransformationsCapacity(key.Header.TextTransformations)
+	case key.QueryArgument != nil:
+		return textTransformationsCapacity(key.QueryArgument.TextTransformations)
+	case key.QueryString != nil:
+		return textTransformationsCapacity(key.QueryString.TextTransformations)
+	case key.UriPath != nil:
+		return textTransformationsCapacity(key.UriPath.TextTransformations)
+	}
+
+	return 0
+}
+
+// validateRules checks rule statement nesting and field combinations that AWS would otherwise only reject on apply.
+// Rule group and managed rule group references are only valid in web ACLs.
+func validateRules(rules []awstypes.Rule, webACL bool) error {
+	var errs []error
+	ruleNames := make(map[string]struct{})
+	priorities := make(map[int32]string)
+
+	for _, rule := range rules {
+		name := aws.ToString(rule.Name)
+
+		if _, ok := ruleNames[name]; ok {
+			errs = append(errs, fmt.Errorf("rule %q: duplicate rule name", name))
+		}
+		ruleNames[name] = struct{}{}
+
+		if other, ok := priorities[rule.Priority]; ok {
+			errs = append(errs, fmt.Errorf("rule %q: priority %d is already used by rule %q", name, rule.Priority, other))
+		} else {
+			priorities[rule.Priority] = name
+		}
+
+		if rule.Statement == nil {
+			errs = append(errs, fmt.Errorf("rule %q: statement is required", name))
+			continue
+		}
+
+		if webACL {
+			isRuleGroup := rule.Statement.ManagedRuleGroupStatement != nil || rule.Statement.RuleGroupReferenceStatement != nil
+			switch {
+			case isRuleGroup && rule.Action != nil:
+				errs = append(errs, fmt.Errorf("rule %q: action cannot be used with a rule group statement, use override_action", name))
+			case isRuleGroup && rule.OverrideAction == nil:
+				errs = append(errs, fmt.Errorf("rule %q: override_action is required with a rule group statement", name))
+			case !isRuleGroup && rule.OverrideAction != nil:
+				errs = append(errs, fmt.Errorf("rule %q: override_action can only be used with a rule group statement, use action", name))
+			case !isRuleGroup && rule.Action == nil:
+				errs = append(errs, fmt.Errorf("rule %q: action is required", name))
+			}
+		}
+
+		if err := validateStatement(rule.Statement, true, webACL); err != nil {
+			errs = append(errs, fmt.Errorf("rule %q: %w", name, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func validateStatement(statement *awstypes.Statement, topLevel, webACL bool) error {
+	if n := countStatementTypes(statement); n != 1 {
+		return fmt.Errorf("a statement must contain exactly one statement type, found %d", n)
+	}
+
+	switch {
+	case statement.AndStatement != nil:
+		return validateLogicalStatements("and_statement", statement.AndStatement.Statements, webACL)
+	case statement.ManagedRuleGroupStatement != nil:
+		if !webACL {
+			return errors.New("managed_rule_group_statement can only be used in a web ACL")
+		}
+		if !topLevel {
+			return errors.New("managed_rule_group_statement can only be used as the top-level statement of a rule")
+		}
+		if v := statement.ManagedRuleGroupStatement.ScopeDownStatement; v != nil {
+			return validateStatement(v, false, webACL)
+		}
+	case statement.NotStatement != nil:
+		if statement.NotStatement.Statement == nil {
+			return errors.New("not_statement requires a statement")
+		}
+		return validateStatement(statement.NotStatement.Statement, false, webACL)
+	case statement.OrStatement != nil:
+		return validateLogicalStatements("or_statement", statement.OrStatement.Statements, webACL)
+	case statement.RateBasedStatement != nil:
+		if !topLevel {
+			return errors.New("rate_based_statement can only be used as the top-level statement of a rule")
+		}
+		v := statement.RateBasedStatement
+		switch v.AggregateKeyType {
+		case awstypes.RateBasedStatementAggregateKeyTypeCustomKeys:
+			if n := len(v.CustomKeys); n == 0 || n > rateBasedStatementCustomKeysMax {
+				return fmt.Errorf("rate_based_statement with aggregate_key_type %s requires between 1 and %d custom_key blocks", v.AggregateKeyType, rateBasedStatementCustomKeysMax)
+			}
+		case awstypes.RateBasedStatementAggregateKeyTypeForwardedIp:
+			if v.ForwardedIPConfig == nil {
+				return fmt.Errorf("rate_based_statement with aggregate_key_type %s requires forwarded_ip_config", v.AggregateKeyType)
+			}
+		}
+		if v.AggregateKeyType != awstypes.RateBasedStatementAggregateKeyTypeCustomKeys && len(v.CustomKeys) > 0 {
+			return fmt.Errorf("rate_based_statement custom_key can only be used with aggregate_key_type %s", awstypes.RateBasedStatementAggregateKeyTypeCustomKeys)
+		}
+		if v.ScopeDownStatement != nil {
+			return validateStatement(v.ScopeDownStatement, false, webACL)
+		}
+	case statement.RuleGroupReferenceStatement != nil:
+		if !webACL {
+			return errors.New("rule_group_reference_statement can only be used in a web ACL")
+		}
+		if !topLevel {
+			return errors.New("rule_group_reference_statement can only be used as the top-level statement of a rule")
+		}
+	}
+
+	return nil
+}
+
+func validateLogicalStatements(statementType string, statements []awstypes.Statement, webACL bool) error {
+	if len(statements) < 2 {
+		return fmt.Errorf("%s requires at least 2 statements", statementType)
+	}
+
+	for _, v := range statements {
+		if err := validateStatement(&v, false, webACL); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// countStatementTypes returns the number of statement types set in the specified statement.
+func countStatementTypes(statement *awstypes.Statement) int {
+	var n int
+
+	v := reflect.ValueOf(statement).Elem()
+	for i := range v.NumField() {
+		if f := v.Field(i); f.Kind() == reflect.Pointer && !f.IsNil() {
+			n++
+		}
+	}
+
+	return n
+}
@@ -0,0 +1,320 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wafv2
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wafv2/types"
+)
+
+func TestCapacityEstimatorRulesCapacity(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	estimator := &capacityEstimator{
+		managedRuleGroupCapacity: func(_ context.Context, statement *awstypes.ManagedRuleGroupStatement) (int64, error) {
+			if aws.ToString(statement.Name) == "AWSManagedRulesCommonRuleSet" {
+				return 700, nil
+			}
+			return 0, errors.New("not found")
+		},
+		ruleGroupCapacity: func(_ context.Context, arn string) (int64, error) {
+			return 50, nil
+		},
+	}
+
+	testCases := map[string]struct {
+		rules   []awstypes.Rule
+		want    int64
+		wantErr bool
+	}{
+		"no rules": {
+			want: 0,
+		},
+		"byte match": {
+			rules: []awstypes.Rule{{
+				Statement: &awstypes.Statement{
+					ByteMatchStatement: &awstypes.ByteMatchStatement{
+						FieldToMatch:         &awstypes.FieldToMatch{UriPath: &awstypes.UriPath{}},
+						PositionalConstraint: awstypes.PositionalConstraintStartsWith,
+						TextTransformations: []awstypes.TextTransformation{
+							{Priority: 0, Type: awstypes.TextTransformationTypeNone},
+						},
+					},
+				},
+			}},
+			want: 2,
+		},
+		"byte match contains with text transformations": {
+			rules: []awstypes.Rule{{
+				Statement: &awstypes.Statement{
+					ByteMatchStatement: &awstypes.ByteMatchStatement{
+						FieldToMatch:         &awstypes.FieldToMatch{AllQueryArguments: &awstypes.AllQueryArguments{}},
+						PositionalConstraint: awstypes.PositionalConstraintContains,
+						TextTransformations: []awstypes.TextTransformation{
+							{Priority: 0, Type: awstypes.TextTransformationTypeLowercase},
+							{Priority: 1, Type: awstypes.TextTransformationTypeUrlDecode},
+						},
+					},
+				},
+			}},
+			want: 10 + 10 + 2*10,
+		},
+		"json body doubles base cost": {
+			rules: []awstypes.Rule{{
+				Statement: &awstypes.Statement{
+					XssMatchStatement: &awstypes.XssMatchStatement{
+						FieldToMatch: &awstypes.FieldToMatch{JsonBody: &awstypes.JsonBody{}},
+					},
+				},
+			}},
+			want: 80,
+		},
+		"sqli high sensitivity": {
+			rules: []awstypes.Rule{{
+				Statement: &awstypes.Statement{
+					SqliMatchStatement: &awstypes.SqliMatchStatement{
+						FieldToMatch:     &awstypes.FieldToMatch{Body: &awstypes.Body{}},
+						SensitivityLevel: awstypes.SensitivityLevelHigh,
+					},
+				},
+			}},
+			want: 30,
+		},
+		"nested logical statements": {
+			rules: []awstypes.Rule{{
+				Statement: &awstypes.Statement{
+					AndStatement: &awstypes.AndStatement{
+						Statements: []awstypes.Statement{
+							{GeoMatchStatement: &awstypes.GeoMatchStatement{}},
+							{NotStatement: &awstypes.NotStatement{
+								Statement: &awstypes.Statement{
+									OrStatement: &awstypes.OrStatement{
+										Statements: []awstypes.Statement{
+											{IPSetReferenceStatement: &awstypes.IPSetReferenceStatement{}},
+											{RegexPatternSetReferenceStatement: &awstypes.RegexPatternSetReferenceStatement{}},
+										},
+									},
+								},
+							}},
+						},
+					},
+				},
+			}},
+			want: 1 + 1 + 25,
+		},
+		"rate based with custom keys and scope down": {
+			rules: []awstypes.Rule{{
+				Statement: &awstypes.Statement{
+					RateBasedStatement: &awstypes.RateBasedStatement{
+						AggregateKeyType: awstypes.RateBasedStatementAggregateKeyTypeCustomKeys,
+						CustomKeys: []awstypes.RateBasedStatementCustomKey{
+							{IP: &awstypes.RateLimitIP{}},
+							{Header: &awstypes.RateLimitHeader{
+								Name: aws.String("x-api-key"),
+								TextTransformations: []awstypes.TextTransformation{
+									{Priority: 0, Type: awstypes.TextTransformationTypeLowercase},
+								},
+							}},
+						},
+						ScopeDownStatement: &awstypes.Statement{
+							LabelMatchStatement: &awstypes.LabelMatchStatement{},
+						},
+					},
+				},
+			}},
+			want: 2 + 30 + 30 + 10 + 1,
+		},
+		"managed and referenced rule groups": {
+			rules: []awstypes.Rule{
+				{
+					Statement: &awstypes.Statement{
+						ManagedRuleGroupStatement: &awstypes.ManagedRuleGroupStatement{
+							Name:       aws.String("AWSManagedRulesCommonRuleSet"),
+							VendorName: aws.String("AWS"),
+							ScopeDownStatement: &awstypes.Statement{
+								SizeConstraintStatement: &awstypes.SizeConstraintStatement{},
+							},
+						},
+					},
+				},
+				{
+					Statement: &awstypes.Statement{
+						RuleGroupReferenceStatement: &awstypes.RuleGroupReferenceStatement{
+							ARN: aws.String("arn:aws:wafv2:us-west-2:123456789012:regional/rulegroup/example/a1b2c3d4"), //lintignore:AWSAT003,AWSAT005
+						},
+					},
+				},
+			},
+			want: 700 + 1 + 50,
+		},
+		"unknown rule group reference": {
+			rules: []awstypes.Rule{{
+				Statement: &awstypes.Statement{
+					RuleGroupReferenceStatement: &awstypes.RuleGroupReferenceStatement{},
+				},
+			}},
+			wantErr: true,
+		},
+		"managed rule group lookup error": {
+			rules: []awstypes.Rule{{
+				Statement: &awstypes.Statement{
+					ManagedRuleGroupStatement: &awstypes.ManagedRuleGroupStatement{
+						Name:       aws.String("Unknown"),
+						VendorName: aws.String("AWS"),
+					},
+				},
+			}},
+			wantErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := estimator.rulesCapacity(ctx, testCase.rules)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("rulesCapacity() err %t, want %t (%v)", got, want, err)
+			}
+
+			if err == nil && got != testCase.want {
+				t.Errorf("rulesCapacity() = %d, want %d", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestValidateRules(t *testing.T) {
+	t.Parallel()
+
+	geoMatch := &awstypes.Statement{GeoMatchStatement: &awstypes.GeoMatchStatement{}}
+	block := &awstypes.RuleAction{Block: &awstypes.BlockAction{}}
+	none := &awstypes.OverrideAction{None: &awstypes.NoneAction{}}
+
+	testCases := map[string]struct {
+		rules   []awstypes.Rule
+		webACL  bool
+		wantErr string
+	}{
+		"valid": {
+			rules: []awstypes.Rule{
+				{Name: aws.String("rule-1"), Priority: 1, Action: block, Statement: geoMatch},
+				{Name: aws.String("rule-2"), Priority: 2, OverrideAction: none, Statement: &awstypes.Statement{
+					ManagedRuleGroupStatement: &awstypes.ManagedRuleGroupStatement{
+						ScopeDownStatement: geoMatch,
+					},
+				}},
+			},
+			webACL: true,
+		},
+		"duplicate name": {
+			rules: []awstypes.Rule{
+				{Name: aws.String("rule-1"), Priority: 1, Action: block, Statement: geoMatch},
+				{Name: aws.String("rule-1"), Priority: 2, Action: block, Statement: geoMatch},
+			},
+			wantErr: `rule "rule-1": duplicate rule name`,
+		},
+		"duplicate priority": {
+			rules: []awstypes.Rule{
+				{Name: aws.String("rule-1"), Priority: 1, Action: block, Statement: geoMatch},
+				{Name: aws.String("rule-2"), Priority: 1, Action: block, Statement: geoMatch},
+			},
+			wantErr: `rule "rule-2": priority 1 is already used by rule "rule-1"`,
+		},
+		"no statement type": {
+			rules: []awstypes.Rule{
+				{Name: aws.String("rule-1"), Priority: 1, Action: block, Statement: &awstypes.Statement{}},
+			},
+			wantErr: "exactly one statement type, found 0",
+		},
+		"multiple statement types": {
+			rules: []awstypes.Rule{
+				{Name: aws.String("rule-1"), Priority: 1, Action: block, Statement: &awstypes.Statement{
+					GeoMatchStatement:   &awstypes.GeoMatchStatement{},
+					LabelMatchStatement: &awstypes.LabelMatchStatement{},
+				}},
+			},
+			wantErr: "exactly one statement type, found 2",
+		},
+		"single and operand": {
+			rules: []awstypes.Rule{
+				{Name: aws.String("rule-1"), Priority: 1, Action: block, Statement: &awstypes.Statement{
+					AndStatement: &awstypes.AndStatement{Statements: []awstypes.Statement{*geoMatch}},
+				}},
+			},
+			wantErr: "and_statement requires at least 2 statements",
+		},
+		"nested rate based": {
+			rules: []awstypes.Rule{
+				{Name: aws.String("rule-1"), Priority: 1, Action: block, Statement: &awstypes.Statement{
+					NotStatement: &awstypes.NotStatement{Statement: &awstypes.Statement{
+						RateBasedStatement: &awstypes.RateBasedStatement{},
+					}},
+				}},
+			},
+			wantErr: "rate_based_statement can only be used as the top-level statement of a rule",
+		},
+		"custom keys without aggregate key type": {
+			rules: []awstypes.Rule{
+				{Name: aws.String("rule-1"), Priority: 1, Action: block, Statement: &awstypes.Statement{
+					RateBasedStatement: &awstypes.RateBasedStatement{
+						AggregateKeyType: awstypes.RateBasedStatementAggregateKeyTypeIp,
+						CustomKeys:       []awstypes.RateBasedStatementCustomKey{{IP: &awstypes.RateLimitIP{}}},
+					},
+				}},
+			},
+			wantErr: "custom_key can only be used with aggregate_key_type CUSTOM_KEYS",
+		},
+		"rule group reference in rule group": {
+			rules: []awstypes.Rule{
+				{Name: aws.String("rule-1"), Priority: 1, Action: block, Statement: &awstypes.Statement{
+					RuleGroupReferenceStatement: &awstypes.RuleGroupReferenceStatement{},
+				}},
+			},
+			wantErr: "rule_group_reference_statement can only be used in a web ACL",
+		},
+		"action with managed rule group": {
+			rules: []awstypes.Rule{
+				{Name: aws.String("rule-1"), Priority: 1, Action: block, Statement: &awstypes.Statement{
+					ManagedRuleGroupStatement: &awstypes.ManagedRuleGroupStatement{},
+				}},
+			},
+			webACL:  true,
+			wantErr: "action cannot be used with a rule group statement",
+		},
+		"override action without rule group": {
+			rules: []awstypes.Rule{
+				{Name: aws.String("rule-1"), Priority: 1, OverrideAction: none, Statement: geoMatch},
+			},
+			webACL:  true,
+			wantErr: "override_action can only be used with a rule group statement",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateRules(testCase.rules, testCase.webACL)
+
+			if testCase.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), testCase.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", testCase.wantErr, err)
+			}
+		})
+	}
+}
@@ -4,9 +4,11 @@
 package wafv2
 
 import (
+	"cmp"
 	"errors"
 	"fmt"
 	"reflect"
+	"slices"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -1066,6 +1068,141 @@ func walkWebACLJSON(v reflect.Value) {
 	}
 }
 
+// webACLRuleJSONDefaults are the values that AWS assumes for omitted fields, keyed by parent object name.
+var webACLRuleJSONDefaults = map[string]map[string]any{
+	"Body": {
+		"OversizeHandling": string(awstypes.OversizeHandlingContinue),
+	},
+	"JsonBody": {
+		"OversizeHandling": string(awstypes.OversizeHandlingContinue),
+	},
+	"RateBasedStatement": {
+		"EvaluationWindowSec": float64(300),
+	},
+	"SqliMatchStatement": {
+		"SensitivityLevel": string(awstypes.SensitivityLevelLow),
+	},
+}
+
+// normalizeWebACLRulesJSON returns the canonical form of a `rule_json` value.
+func normalizeWebACLRulesJSON(rawRules string) (string, error) {
+	rules, err := expandWebACLRulesJSON(rawRules)
+	if err != nil {
+		return "", err
+	}
+
+	return flattenWebACLRulesJSON(rules)
+}
+
+// flattenWebACLRulesJSON returns the canonical JSON representation of the specified rules.
+// Object keys are sorted, zero and default values are removed, `and`/`or` operands are sorted
+// and rules are ordered by priority so that semantically equivalent rules compare equal.
+func flattenWebACLRulesJSON(rules []awstypes.Rule) (string, error) {
+	b, err := tfjson.EncodeToBytes(rules)
+	if err != nil {
+		return "", err
+	}
+
+	var v []any
+	if err := tfjson.DecodeFromBytes(b, &v); err != nil {
+		return "", err
+	}
+
+	for i := range v {
+		v[i] = normalizeWebACLJSON(v[i], "")
+	}
+
+	slices.SortStableFunc(v, func(a, b any) int {
+		priority := func(v any) float64 {
+			if m, ok := v.(map[string]any); ok {
+				if p, ok := m["Priority"].(float64); ok {
+					return p
+				}
+			}
+			return 0
+		}
+		return cmp.Compare(priority(a), priority(b))
+	})
+
+	if v == nil {
+		v = []any{}
+	}
+
+	out, err := tfjson.EncodeToString(v)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(out), nil
+}
+
+func normalizeWebACLJSON(v any, parent string) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, val := range v {
+			val = normalizeWebACLJSON(val, k)
+
+			// SearchString is a blob; rule_json values contain the raw string.
+			if parent == "ByteMatchStatement" && k == "SearchString" {
+				if s, ok := val.(string); ok {
+					if b, err := itypes.Base64Decode(s); err == nil {
+						val = string(b)
+					}
+				}
+			}
+
+			if (parent == "AndStatement" || parent == "OrStatement") && k == "Statements" {
+				if l, ok := val.([]any); ok {
+					slices.SortFunc(l, func(a, b any) int {
+						x, _ := tfjson.EncodeToString(a)
+						y, _ := tfjson.EncodeToString(b)
+						return strings.Compare(x, y)
+					})
+				}
+			}
+
+			if isZeroWebACLJSONValue(val) {
+				delete(v, k)
+				continue
+			}
+
+			if d, ok := webACLRuleJSONDefaults[parent][k]; ok && d == val {
+				delete(v, k)
+				continue
+			}
+
+			v[k] = val
+		}
+
+		return v
+	case []any:
+		for i := range v {
+			v[i] = normalizeWebACLJSON(v[i], parent)
+		}
+
+		return v
+	}
+
+	return v
+}
+
+func isZeroWebACLJSONValue(v any) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case bool:
+		return !v
+	case float64:
+		return v == 0
+	case string:
+		return v == ""
+	case []any:
+		return len(v) == 0
+	}
+
+	return false
+}
+
 func expandWebACLRules(l []interface{}) []awstypes.Rule {
 	if len(l) == 0 || l[0] == nil {
 		return nil
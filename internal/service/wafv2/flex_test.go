@@ -157,3 +157,79 @@ func Test_expandWebACLRulesJSON(t *testing.T) {
 		})
 	}
 }
+
+func Test_normalizeWebACLRulesJSON(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		rawRules1 string
+		rawRules2 string
+		equal     bool
+	}{
+		"key order": {
+			rawRules1: `[{"Name":"rule-1","Priority":1,"Action":{"Block":{}},"Statement":{"GeoMatchStatement":{"CountryCodes":["US"]}},"VisibilityConfig":{"CloudWatchMetricsEnabled":false,"MetricName":"rule-1","SampledRequestsEnabled":false}}]`,
+			rawRules2: `[{"VisibilityConfig":{"SampledRequestsEnabled":false,"MetricName":"rule-1","CloudWatchMetricsEnabled":false},"Statement":{"GeoMatchStatement":{"CountryCodes":["US"]}},"Action":{"Block":{}},"Priority":1,"Name":"rule-1"}]`,
+			equal:     true,
+		},
+		"default values": {
+			rawRules1: `[{"Name":"rule-1","Priority":0,"Action":{"Block":{}},"Statement":{"RateBasedStatement":{"AggregateKeyType":"IP","Limit":100,"EvaluationWindowSec":300}},"VisibilityConfig":{"MetricName":"rule-1"}}]`,
+			rawRules2: `[{"Name":"rule-1","Action":{"Block":{}},"Statement":{"RateBasedStatement":{"AggregateKeyType":"IP","Limit":100}},"VisibilityConfig":{"CloudWatchMetricsEnabled":false,"MetricName":"rule-1"}}]`,
+			equal:     true,
+		},
+		"non-default value": {
+			rawRules1: `[{"Name":"rule-1","Action":{"Block":{}},"Statement":{"RateBasedStatement":{"AggregateKeyType":"IP","Limit":100,"EvaluationWindowSec":600}},"VisibilityConfig":{"MetricName":"rule-1"}}]`,
+			rawRules2: `[{"Name":"rule-1","Action":{"Block":{}},"Statement":{"RateBasedStatement":{"AggregateKeyType":"IP","Limit":100}},"VisibilityConfig":{"MetricName":"rule-1"}}]`,
+			equal:     false,
+		},
+		"operand order": {
+			rawRules1: `[{"Name":"rule-1","Action":{"Block":{}},"Statement":{"OrStatement":{"Statements":[{"GeoMatchStatement":{"CountryCodes":["US"]}},{"ByteMatchStatement":{"SearchString":"test","FieldToMatch":{"UriPath":{}},"PositionalConstraint":"EXACTLY","TextTransformations":[{"Priority":0,"Type":"NONE"}]}}]}},"VisibilityConfig":{"MetricName":"rule-1"}}]`,
+			rawRules2: `[{"Name":"rule-1","Action":{"Block":{}},"Statement":{"OrStatement":{"Statements":[{"ByteMatchStatement":{"SearchString":"test","FieldToMatch":{"UriPath":{}},"PositionalConstraint":"EXACTLY","TextTransformations":[{"Priority":0,"Type":"NONE"}]}},{"GeoMatchStatement":{"CountryCodes":["US"]}}]}},"VisibilityConfig":{"MetricName":"rule-1"}}]`,
+			equal:     true,
+		},
+		"rule order": {
+			rawRules1: `[{"Name":"rule-1","Priority":1,"Action":{"Block":{}},"Statement":{"GeoMatchStatement":{"CountryCodes":["US"]}},"VisibilityConfig":{"MetricName":"rule-1"}},{"Name":"rule-2","Priority":2,"Action":{"Count":{}},"Statement":{"GeoMatchStatement":{"CountryCodes":["NL"]}},"VisibilityConfig":{"MetricName":"rule-2"}}]`,
+			rawRules2: `[{"Name":"rule-2","Priority":2,"Action":{"Count":{}},"Statement":{"GeoMatchStatement":{"CountryCodes":["NL"]}},"VisibilityConfig":{"MetricName":"rule-2"}},{"Name":"rule-1","Priority":1,"Action":{"Block":{}},"Statement":{"GeoMatchStatement":{"CountryCodes":["US"]}},"VisibilityConfig":{"MetricName":"rule-1"}}]`,
+			equal:     true,
+		},
+		"changed action": {
+			rawRules1: `[{"Name":"rule-1","Action":{"Block":{}},"Statement":{"GeoMatchStatement":{"CountryCodes":["US"]}},"VisibilityConfig":{"MetricName":"rule-1"}}]`,
+			rawRules2: `[{"Name":"rule-1","Action":{"Count":{}},"Statement":{"GeoMatchStatement":{"CountryCodes":["US"]}},"VisibilityConfig":{"MetricName":"rule-1"}}]`,
+			equal:     false,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got1, err := normalizeWebACLRulesJSON(tc.rawRules1)
+			if err != nil {
+				t.Fatalf("normalizeWebACLRulesJSON(%q) error = %v", tc.rawRules1, err)
+			}
+
+			got2, err := normalizeWebACLRulesJSON(tc.rawRules2)
+			if err != nil {
+				t.Fatalf("normalizeWebACLRulesJSON(%q) error = %v", tc.rawRules2, err)
+			}
+
+			if equal := got1 == got2; equal != tc.equal {
+				t.Errorf("normalizeWebACLRulesJSON equal = %t, want %t\n%s\n%s", equal, tc.equal, got1, got2)
+			}
+		})
+	}
+}
+
+func Test_flattenWebACLRulesJSON_roundTrip(t *testing.T) {
+	t.Parallel()
+
+	rawRules := `[{"Action":{"Block":{}},"Name":"rule-1","Priority":1,"Statement":{"ByteMatchStatement":{"FieldToMatch":{"SingleHeader":{"Name":"host"}},"PositionalConstraint":"EXACTLY","SearchString":"example.com","TextTransformations":[{"Type":"NONE"}]}},"VisibilityConfig":{"MetricName":"rule-1"}}]`
+
+	got, err := normalizeWebACLRulesJSON(rawRules)
+	if err != nil {
+		t.Fatalf("normalizeWebACLRulesJSON() error = %v", err)
+	}
+
+	if got != rawRules {
+		t.Errorf("normalizeWebACLRulesJSON() = %s, want %s", got, rawRules)
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/wafv2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/wafv2/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -73,6 +74,10 @@ func resourceRuleGroup() *schema.Resource {
 					Optional:     true,
 					ValidateFunc: validation.StringLenBetween(1, 256),
 				},
+				"estimated_capacity": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
 				"lock_token": {
 					Type:     schema.TypeString,
 					Computed: true,
@@ -146,7 +151,10 @@ func resourceRuleGroup() *schema.Resource {
 			}
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourceRuleGroupCustomizeDiff,
+		),
 	}
 }
 
@@ -212,6 +220,9 @@ func resourceRuleGroupRead(ctx context.Context, d *schema.ResourceData, meta int
 		return sdkdiag.AppendErrorf(diags, "setting custom_response_body: %s", err)
 	}
 	d.Set(names.AttrDescription, ruleGroup.Description)
+	if capacity, err := (&capacityEstimator{}).rulesCapacity(ctx, ruleGroup.Rules); err == nil {
+		d.Set("estimated_capacity", capacity)
+	}
 	d.Set("lock_token", output.LockToken)
 	d.Set(names.AttrName, ruleGroup.Name)
 	d.Set(names.AttrNamePrefix, create.NamePrefixFromName(aws.ToString(ruleGroup.Name)))
@@ -229,7 +240,7 @@ func resourceRuleGroupUpdate(ctx context.Context, d *schema.ResourceData, meta i
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).WAFV2Client(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, "estimated_capacity") {
 		input := &wafv2.UpdateRuleGroupInput{
 			Id:               aws.String(d.Id()),
 			LockToken:        aws.String(d.Get("lock_token").(string)),
@@ -292,6 +303,34 @@ func resourceRuleGroupDelete(ctx context.Context, d *schema.ResourceData, meta i
 	return diags
 }
 
+func resourceRuleGroupCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && !d.HasChanges("capacity", names.AttrRule) {
+		return nil
+	}
+
+	if !d.GetRawPlan().GetAttr(names.AttrRule).IsWhollyKnown() {
+		return d.SetNewComputed("estimated_capacity")
+	}
+
+	rules := expandRules(d.Get(names.AttrRule).(*schema.Set).List())
+
+	if err := validateRules(rules, false); err != nil {
+		return err
+	}
+
+	capacity, err := (&capacityEstimator{}).rulesCapacity(ctx, rules)
+
+	if err != nil {
+		return err
+	}
+
+	if v := d.Get("capacity").(int); d.NewValueKnown("capacity") && capacity > int64(v) {
+		return fmt.Errorf("estimated capacity (%d WCUs) exceeds the rule group capacity (%d WCUs)", capacity, v)
+	}
+
+	return d.SetNew("estimated_capacity", capacity)
+}
+
 func findRuleGroupByThreePartKey(ctx context.Context, conn *wafv2.Client, id, name, scope string) (*wafv2.GetRuleGroupOutput, error) {
 	input := &wafv2.GetRuleGroupInput{
 		Id:    aws.String(id),
@@ -299,6 +338,18 @@ func findRuleGroupByThreePartKey(ctx context.Context, conn *wafv2.Client, id, na
 		Scope: awstypes.Scope(scope),
 	}
 
+	return findRuleGroup(ctx, conn, input)
+}
+
+func findRuleGroupByARN(ctx context.Context, conn *wafv2.Client, arn string) (*wafv2.GetRuleGroupOutput, error) {
+	input := &wafv2.GetRuleGroupInput{
+		ARN: aws.String(arn),
+	}
+
+	return findRuleGroup(ctx, conn, input)
+}
+
+func findRuleGroup(ctx context.Context, conn *wafv2.Client, input *wafv2.GetRuleGroupInput) (*wafv2.GetRuleGroupOutput, error) {
 	output, err := conn.GetRuleGroup(ctx, input)
 
 	if errs.IsA[*awstypes.WAFNonexistentItemException](err) {
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
 	"strings"
 	"time"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/wafv2"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/wafv2/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
@@ -24,6 +26,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
@@ -89,6 +92,10 @@ func resourceWebACL() *schema.Resource {
 					Optional:     true,
 					ValidateFunc: validation.StringLenBetween(1, 256),
 				},
+				"estimated_capacity": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
 				"lock_token": {
 					Type:     schema.TypeString,
 					Computed: true,
@@ -105,18 +112,16 @@ func resourceWebACL() *schema.Resource {
 				"rule_json": {
 					Type:             schema.TypeString,
 					Optional:         true,
-					ConflictsWith:    []string{names.AttrRule},
 					ValidateFunc:     validation.StringIsJSON,
-					DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+					DiffSuppressFunc: suppressEquivalentWebACLRulesJSON,
 					StateFunc: func(v interface{}) string {
 						json, _ := structure.NormalizeJsonString(v)
 						return json
 					},
 				},
 				names.AttrRule: {
-					Type:          schema.TypeSet,
-					Optional:      true,
-					ConflictsWith: []string{"rule_json"},
+					Type:     schema.TypeSet,
+					Optional: true,
 					Elem: &schema.Resource{
 						Schema: map[string]*schema.Schema{
 							names.AttrAction: {
@@ -183,7 +188,10 @@ func resourceWebACL() *schema.Resource {
 			}
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			resourceWebACLCustomizeDiff,
+		),
 	}
 }
 
@@ -204,17 +212,11 @@ func resourceWebACLCreate(ctx context.Context, d *schema.ResourceData, meta inte
 		VisibilityConfig:  expandVisibilityConfig(d.Get("visibility_config").([]interface{})),
 	}
 
-	if v, ok := d.GetOk(names.AttrRule); ok {
-		input.Rules = expandWebACLRules(v.(*schema.Set).List())
-	}
-
-	if v, ok := d.GetOk("rule_json"); ok {
-		rules, err := expandWebACLRulesJSON(v.(string))
-		if err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting rule: %s", err)
-		}
-		input.Rules = rules
+	rules, err := expandWebACLConfigRules(d)
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting rule: %s", err)
 	}
+	input.Rules = rules
 
 	if v, ok := d.GetOk("custom_response_body"); ok && v.(*schema.Set).Len() > 0 {
 		input.CustomResponseBodies = expandCustomResponseBodies(v.(*schema.Set).List())
@@ -243,6 +245,8 @@ func resourceWebACLCreate(ctx context.Context, d *schema.ResourceData, meta inte
 
 	d.SetId(aws.ToString(output.Summary.Id))
 
+	setWebACLEstimatedCapacity(ctx, d, conn, input.Scope, input.Rules)
+
 	return append(diags, resourceWebACLRead(ctx, d, meta)...)
 }
 
@@ -285,14 +289,36 @@ func resourceWebACLRead(ctx context.Context, d *schema.ResourceData, meta interf
 	d.Set("lock_token", output.LockToken)
 	d.Set(names.AttrName, webACL.Name)
 
-	if _, ok := d.GetOk("rule_json"); !ok {
-		rules := filterWebACLRules(webACL.Rules, expandWebACLRules(d.Get(names.AttrRule).(*schema.Set).List()))
-		if err := d.Set(names.AttrRule, flattenWebACLRules(rules)); err != nil {
-			return sdkdiag.AppendErrorf(diags, "setting rule: %s", err)
+	// Rules named in `rule_json` are read back into it, all others into `rule`.
+	var jsonRuleNames []string
+	if v, ok := d.GetOk("rule_json"); ok {
+		if jsonRules, err := expandWebACLRulesJSON(v.(string)); err == nil {
+			for _, r := range jsonRules {
+				jsonRuleNames = append(jsonRuleNames, aws.ToString(r.Name))
+			}
+		}
+	}
+
+	var rules, jsonRules []awstypes.Rule
+	for _, r := range webACL.Rules {
+		if slices.Contains(jsonRuleNames, aws.ToString(r.Name)) {
+			jsonRules = append(jsonRules, r)
+		} else {
+			rules = append(rules, r)
 		}
-	} else {
-		d.Set("rule_json", d.Get("rule_json"))
-		d.Set(names.AttrRule, nil)
+	}
+
+	rules = filterWebACLRules(rules, expandWebACLRules(d.Get(names.AttrRule).(*schema.Set).List()))
+	if err := d.Set(names.AttrRule, flattenWebACLRules(rules)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting rule: %s", err)
+	}
+
+	if len(jsonRuleNames) > 0 {
+		ruleJSON, err := flattenWebACLRulesJSON(jsonRules)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting rule_json: %s", err)
+		}
+		d.Set("rule_json", ruleJSON)
 	}
 
 	d.Set("token_domains", aws.StringSlice(webACL.TokenDomains))
@@ -307,15 +333,17 @@ func resourceWebACLUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).WAFV2Client(ctx)
 
-	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll, "estimated_capacity") {
 		aclName := d.Get(names.AttrName).(string)
 		aclScope := d.Get(names.AttrScope).(string)
 		aclLockToken := d.Get("lock_token").(string)
+		rules, err := expandWebACLConfigRules(d)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "expanding WAFv2 WebACL JSON rule (%s): %s", d.Id(), err)
+		}
+
 		// Find the AWS managed ShieldMitigationRuleGroup group rule if existent and add it into the set of rules to update
 		// so that the provider will not remove the Shield rule when changes are applied to the WebACL.
-		var rules []awstypes.Rule
-
-		rules = expandWebACLRules(d.Get(names.AttrRule).(*schema.Set).List())
 		if sr := findShieldRule(rules); len(sr) == 0 {
 			output, err := findWebACLByThreePartKey(ctx, conn, d.Id(), aclName, aclScope)
 
@@ -326,23 +354,6 @@ func resourceWebACLUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 			rules = append(rules, findShieldRule(output.WebACL.Rules)...)
 		}
 
-		if v, ok := d.GetOk("rule_json"); ok {
-			r, err := expandWebACLRulesJSON(v.(string))
-			if err != nil {
-				return sdkdiag.AppendErrorf(diags, "expanding WAFv2 WebACL JSON rule (%s): %s", d.Id(), err)
-			}
-			if sr := findShieldRule(rules); len(sr) == 0 {
-				output, err := findWebACLByThreePartKey(ctx, conn, d.Id(), aclName, aclScope)
-
-				if err != nil {
-					return sdkdiag.AppendErrorf(diags, "reading WAFv2 WebACL (%s): %s", d.Id(), err)
-				}
-
-				r = append(r, findShieldRule(output.WebACL.Rules)...)
-			}
-			rules = r
-		}
-
 		input := &wafv2.UpdateWebACLInput{
 			AssociationConfig: expandAssociationConfig(d.Get("association_config").([]interface{})),
 			CaptchaConfig:     expandCaptchaConfig(d.Get("captcha_config").([]interface{})),
@@ -371,7 +382,7 @@ func resourceWebACLUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		const (
 			timeout = 5 * time.Minute
 		)
-		_, err := tfresource.RetryWhenIsA[*awstypes.WAFUnavailableEntityException](ctx, timeout, func() (interface{}, error) {
+		_, err = tfresource.RetryWhenIsA[*awstypes.WAFUnavailableEntityException](ctx, timeout, func() (interface{}, error) {
 			return conn.UpdateWebACL(ctx, input)
 		})
 
@@ -401,6 +412,12 @@ func resourceWebACLUpdate(ctx context.Context, d *schema.ResourceData, meta inte
 		}
 	}
 
+	if d.HasChanges(names.AttrRule, "rule_json") {
+		if rules, err := expandWebACLConfigRules(d); err == nil {
+			setWebACLEstimatedCapacity(ctx, d, conn, awstypes.Scope(d.Get(names.AttrScope).(string)), rules)
+		}
+	}
+
 	return append(diags, resourceWebACLRead(ctx, d, meta)...)
 }
 
@@ -458,6 +475,96 @@ func resourceWebACLDelete(ctx context.Context, d *schema.ResourceData, meta inte
 	return diags
 }
 
+func resourceWebACLCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	rulesChanged := d.Id() == "" || d.HasChanges(names.AttrRule, "rule_json")
+
+	if !rulesChanged {
+		if _, ok := d.GetOk("estimated_capacity"); ok {
+			return nil
+		}
+	}
+
+	if plan := d.GetRawPlan(); !plan.GetAttr(names.AttrRule).IsWhollyKnown() || !plan.GetAttr("rule_json").IsWhollyKnown() {
+		return d.SetNewComputed("estimated_capacity")
+	}
+
+	rules, err := expandWebACLConfigRules(d)
+	if err != nil {
+		return err
+	}
+
+	if rulesChanged {
+		if err := validateRules(rules, true); err != nil {
+			return err
+		}
+	}
+
+	conn := meta.(*conns.AWSClient).WAFV2Client(ctx)
+	capacity, err := newCapacityEstimator(conn, awstypes.Scope(d.Get(names.AttrScope).(string))).rulesCapacity(ctx, rules)
+
+	if err != nil {
+		// Referenced rule groups may not exist yet or may not be readable.
+		log.Printf("[WARN] estimating WAFv2 WebACL capacity: %s", err)
+		if !rulesChanged {
+			return nil
+		}
+		return d.SetNewComputed("estimated_capacity")
+	}
+
+	if rulesChanged && capacity > webACLCapacityMax {
+		return fmt.Errorf("estimated capacity (%d WCUs) exceeds the web ACL maximum of %d WCUs", capacity, webACLCapacityMax)
+	}
+
+	return d.SetNew("estimated_capacity", capacity)
+}
+
+// expandWebACLConfigRules returns the rules configured in `rule` and `rule_json`.
+func expandWebACLConfigRules(d sdkv2.ResourceDiffer) ([]awstypes.Rule, error) {
+	rules := expandWebACLRules(d.Get(names.AttrRule).(*schema.Set).List())
+
+	if v, ok := d.GetOk("rule_json"); ok {
+		jsonRules, err := expandWebACLRulesJSON(v.(string))
+		if err != nil {
+			return nil, fmt.Errorf("expanding rule_json: %w", err)
+		}
+
+		rules = append(rules, jsonRules...)
+	}
+
+	return rules, nil
+}
+
+// suppressEquivalentWebACLRulesJSON suppresses `rule_json` differences that don't change the rules' meaning.
+func suppressEquivalentWebACLRulesJSON(k, old, new string, d *schema.ResourceData) bool {
+	if verify.SuppressEquivalentJSONDiffs(k, old, new, d) {
+		return true
+	}
+
+	o, err := normalizeWebACLRulesJSON(old)
+	if err != nil {
+		return false
+	}
+
+	n, err := normalizeWebACLRulesJSON(new)
+	if err != nil {
+		return false
+	}
+
+	return o == n
+}
+
+// setWebACLEstimatedCapacity sets `estimated_capacity` once any referenced rule groups exist.
+func setWebACLEstimatedCapacity(ctx context.Context, d *schema.ResourceData, conn *wafv2.Client, scope awstypes.Scope, rules []awstypes.Rule) {
+	capacity, err := newCapacityEstimator(conn, scope).rulesCapacity(ctx, rules)
+
+	if err != nil {
+		log.Printf("[WARN] estimating WAFv2 WebACL (%s) capacity: %s", d.Id(), err)
+		return
+	}
+
+	d.Set("estimated_capacity", capacity)
+}
+
 func findWebACLByThreePartKey(ctx context.Context, conn *wafv2.Client, id, name, scope string) (*wafv2.GetWebACLOutput, error) {
 	input := &wafv2.GetWebACLInput{
 		Id:    aws.String(id),
@@ -3058,6 +3058,40 @@ func TestAccWAFV2WebACL_ruleJSON(t *testing.T) {
 	})
 }
 
+func TestAccWAFV2WebACL_ruleJSONMixed(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.WebACL
+	webACLName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_wafv2_web_acl.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckScopeRegional(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WAFV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWebACLDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebACLConfig_jsonRuleMixed(webACLName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckWebACLExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtRulePound, "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "rule.*", map[string]string{
+						names.AttrName:     "rule-typed",
+						names.AttrPriority: "1",
+					}),
+					resource.TestCheckResourceAttrSet(resourceName, "rule_json"),
+					resource.TestCheckResourceAttr(resourceName, "estimated_capacity", "3"),
+				),
+			},
+			{
+				// Operand and key order changes are not real changes.
+				Config:   testAccWebACLConfig_jsonRuleMixedReordered(webACLName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func TestAccWAFV2WebACL_ruleJSONToRule(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v awstypes.WebACL
@@ -6321,3 +6355,154 @@ resource "aws_wafv2_web_acl" "test" {
 }
 `, rName)
 }
+
+func testAccWebACLConfig_jsonRuleMixed(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_wafv2_web_acl" "test" {
+  name  = %[1]q
+  scope = "REGIONAL"
+
+  default_action {
+    allow {}
+  }
+
+  rule {
+    name     = "rule-typed"
+    priority = 1
+
+    action {
+      block {}
+    }
+
+    statement {
+      geo_match_statement {
+        country_codes = ["US"]
+      }
+    }
+
+    visibility_config {
+      cloudwatch_metrics_enabled = false
+      metric_name                = "rule-typed"
+      sampled_requests_enabled   = false
+    }
+  }
+
+  rule_json = jsonencode([{
+    Name     = "rule-json"
+    Priority = 2
+    Action = {
+      Count = {}
+    }
+    Statement = {
+      OrStatement = {
+        Statements = [
+          {
+            GeoMatchStatement = {
+              CountryCodes = ["NL"]
+            }
+          },
+          {
+            SizeConstraintStatement = {
+              ComparisonOperator = "GT"
+              Size               = 100
+              FieldToMatch = {
+                UriPath = {}
+              }
+              TextTransformations = [{
+                Priority = 0
+                Type     = "NONE"
+              }]
+            }
+          },
+        ]
+      }
+    }
+    VisibilityConfig = {
+      CloudWatchMetricsEnabled = false
+      MetricName               = "rule-json"
+      SampledRequestsEnabled   = false
+    }
+  }])
+
+  visibility_config {
+    cloudwatch_metrics_enabled = false
+    metric_name                = "friendly-metric-name"
+    sampled_requests_enabled   = false
+  }
+}
+`, rName)
+}
+
+func testAccWebACLConfig_jsonRuleMixedReordered(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_wafv2_web_acl" "test" {
+  name  = %[1]q
+  scope = "REGIONAL"
+
+  default_action {
+    allow {}
+  }
+
+  rule {
+    name     = "rule-typed"
+    priority = 1
+
+    action {
+      block {}
+    }
+
+    statement {
+      geo_match_statement {
+        country_codes = ["US"]
+      }
+    }
+
+    visibility_config {
+      cloudwatch_metrics_enabled = false
+      metric_name                = "rule-typed"
+      sampled_requests_enabled   = false
+    }
+  }
+
+  rule_json = jsonencode([{
+    VisibilityConfig = {
+      MetricName = "rule-json"
+    }
+    Statement = {
+      OrStatement = {
+        Statements = [
+          {
+            SizeConstraintStatement = {
+              TextTransformations = [{
+                Type = "NONE"
+              }]
+              FieldToMatch = {
+                UriPath = {}
+              }
+              Size               = 100
+              ComparisonOperator = "GT"
+            }
+          },
+          {
+            GeoMatchStatement = {
+              CountryCodes = ["NL"]
+            }
+          },
+        ]
+      }
+    }
+    Action = {
+      Count = {}
+    }
+    Priority = 2
+    Name     = "rule-json"
+  }])
+
+  visibility_config {
+    cloudwatch_metrics_enabled = false
+    metric_name                = "friendly-metric-name"
+    sampled_requests_enabled   = false
+  }
+}
+`, rName)
+}
@@ -25,6 +25,7 @@ import (
 // @SDKResource("aws_xray_group", name="Group")
 // @Tags(identifierAttribute="arn")
 // @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/xray/types;types.Group")
+// @Testing(basicTest=true)
 func resourceGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceGroupCreate,
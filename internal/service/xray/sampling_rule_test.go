@@ -20,46 +20,6 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-func TestAccXRaySamplingRule_basic(t *testing.T) {
-	ctx := acctest.Context(t)
-	var v types.SamplingRule
-	resourceName := "aws_xray_sampling_rule.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.XRayServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckSamplingRuleDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccSamplingRuleConfig_basic(rName),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckSamplingRuleExists(ctx, resourceName, &v),
-					acctest.CheckResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "xray", fmt.Sprintf("sampling-rule/%s", rName)),
-					resource.TestCheckResourceAttr(resourceName, names.AttrPriority, "5"),
-					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, "1"),
-					resource.TestCheckResourceAttr(resourceName, "reservoir_size", "10"),
-					resource.TestCheckResourceAttr(resourceName, "url_path", "*"),
-					resource.TestCheckResourceAttr(resourceName, "host", "*"),
-					resource.TestCheckResourceAttr(resourceName, "http_method", "GET"),
-					resource.TestCheckResourceAttr(resourceName, "fixed_rate", "0.3"),
-					resource.TestCheckResourceAttr(resourceName, names.AttrResourceARN, "*"),
-					resource.TestCheckResourceAttr(resourceName, names.AttrServiceName, "*"),
-					resource.TestCheckResourceAttr(resourceName, "service_type", "*"),
-					resource.TestCheckResourceAttr(resourceName, "attributes.%", "1"),
-					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
-				),
-			},
-			{
-				ResourceName:      resourceName,
-				ImportState:       true,
-				ImportStateVerify: true,
-			},
-		},
-	})
-}
-
 func TestAccXRaySamplingRule_update(t *testing.T) {
 	ctx := acctest.Context(t)
 	var v types.SamplingRule
@@ -119,30 +79,6 @@ func TestAccXRaySamplingRule_update(t *testing.T) {
 	})
 }
 
-func TestAccXRaySamplingRule_disappears(t *testing.T) {
-	ctx := acctest.Context(t)
-	var v types.SamplingRule
-	resourceName := "aws_xray_sampling_rule.test"
-	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
-
-	resource.ParallelTest(t, resource.TestCase{
-		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
-		ErrorCheck:               acctest.ErrorCheck(t, names.XRayServiceID),
-		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
-		CheckDestroy:             testAccCheckSamplingRuleDestroy(ctx),
-		Steps: []resource.TestStep{
-			{
-				Config: testAccSamplingRuleConfig_basic(rName),
-				Check: resource.ComposeAggregateTestCheckFunc(
-					testAccCheckSamplingRuleExists(ctx, resourceName, &v),
-					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfxray.ResourceSamplingRule(), resourceName),
-				),
-				ExpectNonEmptyPlan: true,
-			},
-		},
-	})
-}
-
 func testAccCheckSamplingRuleExists(ctx context.Context, n string, v *types.SamplingRule) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -194,28 +130,6 @@ func testAccCheckSamplingRuleDestroy(ctx context.Context) resource.TestCheckFunc
 	}
 }
 
-func testAccSamplingRuleConfig_basic(rName string) string {
-	return fmt.Sprintf(`
-resource "aws_xray_sampling_rule" "test" {
-  rule_name      = %[1]q
-  priority       = 5
-  reservoir_size = 10
-  url_path       = "*"
-  host           = "*"
-  http_method    = "GET"
-  service_type   = "*"
-  service_name   = "*"
-  fixed_rate     = 0.3
-  resource_arn   = "*"
-  version        = 1
-
-  attributes = {
-    Hello = "World"
-  }
-}
-`, rName)
-}
-
 func testAccSamplingRuleConfig_update(rName string, priority, reservoirSize int) string {
 	return fmt.Sprintf(`
 resource "aws_xray_sampling_rule" "test" {
@@ -113,3 +113,19 @@ func (d Duration) equal(o Duration) bool {
 func Sub(t time.Time, d Duration) time.Time {
 	return t.AddDate(-d.years, -d.months, -d.days)
 }
+
+func Add(t time.Time, d Duration) time.Time {
+	return t.AddDate(d.years, d.months, d.days)
+}
+
+func (d Duration) Years() int {
+	return d.years
+}
+
+func (d Duration) Months() int {
+	return d.months
+}
+
+func (d Duration) Days() int {
+	return d.days
+}
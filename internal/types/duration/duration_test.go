@@ -213,3 +213,46 @@ func TestSub(t *testing.T) {
 		})
 	}
 }
+
+func TestAdd(t *testing.T) {
+	t.Parallel()
+
+	tz, err := time.LoadLocation("America/Vancouver")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testcases := map[string]struct {
+		startTime time.Time
+		duration  Duration
+		expected  time.Time
+	}{
+		"zero": {
+			startTime: time.Date(2022, 3, 1, 0, 0, 0, 0, tz),
+			duration:  Duration{},
+			expected:  time.Date(2022, 3, 1, 0, 0, 0, 0, tz),
+		},
+		"regular": {
+			startTime: time.Date(2022, 3, 1, 0, 0, 0, 0, tz),
+			duration:  Duration{years: 1, months: 2, days: 3},
+			expected:  time.Date(2023, 5, 4, 0, 0, 0, 0, tz),
+		},
+		"leap year": {
+			startTime: time.Date(2020, 2, 28, 0, 0, 0, 0, tz),
+			duration:  Duration{days: 1},
+			expected:  time.Date(2020, 2, 29, 0, 0, 0, 0, tz),
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			actual := Add(tc.startTime, tc.duration)
+
+			if !actual.Equal(tc.expected) {
+				t.Fatalf("expected %s, got %s", tc.expected, actual)
+			}
+		})
+	}
+}
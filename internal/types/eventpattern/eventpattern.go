@@ -0,0 +1,713 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package eventpattern parses EventBridge event patterns and matches them against events
+// using EventBridge content filtering semantics.
+// See https://docs.aws.amazon.com/eventbridge/latest/userguide/eb-event-patterns.html.
+package eventpattern
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"slices"
+	"strings"
+)
+
+const (
+	keyOr = "$or"
+
+	operatorAnythingBut      = "anything-but"
+	operatorCIDR             = "cidr"
+	operatorEqualsIgnoreCase = "equals-ignore-case"
+	operatorExists           = "exists"
+	operatorNumeric          = "numeric"
+	operatorPrefix           = "prefix"
+	operatorSuffix           = "suffix"
+	operatorWildcard         = "wildcard"
+
+	// Numeric matching is supported for values in this range.
+	numericMax = 5.0e9
+	numericMin = -5.0e9
+)
+
+// PathError is returned when the value at a pattern path is invalid.
+type PathError struct {
+	Path string
+	Err  error
+}
+
+func (e *PathError) Error() string {
+	if e.Path == "" {
+		return e.Err.Error()
+	}
+	return fmt.Sprintf("%s: %s", e.Path, e.Err)
+}
+
+func (e *PathError) Unwrap() error {
+	return e.Err
+}
+
+// Pattern is a parsed event pattern.
+type Pattern struct {
+	root *object
+}
+
+// object matches a JSON object: all fields and one of each `$or` alternative must match.
+type object struct {
+	fields map[string]field
+	ors    [][]*object
+}
+
+// field is either a nested object or a list of leaf matchers.
+type field struct {
+	object   *object
+	matchers []matcher
+}
+
+type matcher interface {
+	// match returns whether the present, scalar event value matches.
+	match(v any) bool
+}
+
+// Parse parses an event pattern.
+func Parse(s string) (*Pattern, error) {
+	v, err := decode(s)
+	if err != nil {
+		return nil, err
+	}
+
+	m, ok := v.(map[string]any)
+	if !ok {
+		return nil, errors.New("event pattern must be a JSON object")
+	}
+
+	if len(m) == 0 {
+		return nil, errors.New("event pattern must not be empty")
+	}
+
+	root, err := parseObject(m, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return &Pattern{root: root}, nil
+}
+
+// Validate returns an error if the event pattern is invalid.
+func Validate(s string) error {
+	_, err := Parse(s)
+	return err
+}
+
+// Matches returns whether the event, a JSON object, matches the pattern.
+func (p *Pattern) Matches(event string) (bool, error) {
+	v, err := decode(event)
+	if err != nil {
+		return false, err
+	}
+
+	m, ok := v.(map[string]any)
+	if !ok {
+		return false, errors.New("event must be a JSON object")
+	}
+
+	return p.root.match(m), nil
+}
+
+func decode(s string) (any, error) {
+	var v any
+
+	dec := json.NewDecoder(strings.NewReader(s))
+	dec.UseNumber()
+
+	if err := dec.Decode(&v); err != nil {
+		return nil, fmt.Errorf("decoding JSON: %w", err)
+	}
+
+	if dec.More() {
+		return nil, errors.New("decoding JSON: unexpected data after top-level value")
+	}
+
+	return normalizeNumbers(v), nil
+}
+
+// normalizeNumbers converts json.Number values to float64.
+func normalizeNumbers(v any) any {
+	switch v := v.(type) {
+	case json.Number:
+		f, err := v.Float64()
+		if err != nil {
+			return v.String()
+		}
+		return f
+	case map[string]any:
+		for k, e := range v {
+			v[k] = normalizeNumbers(e)
+		}
+	case []any:
+		for i, e := range v {
+			v[i] = normalizeNumbers(e)
+		}
+	}
+
+	return v
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
+
+func parseObject(m map[string]any, path string) (*object, error) {
+	o := &object{
+		fields: make(map[string]field),
+	}
+
+	for _, k := range sortedKeys(m) {
+		v := m[k]
+		p := joinPath(path, k)
+
+		if k == keyOr {
+			alternatives, err := parseOr(v, p)
+			if err != nil {
+				return nil, err
+			}
+			o.ors = append(o.ors, alternatives)
+			continue
+		}
+
+		switch v := v.(type) {
+		case map[string]any:
+			if len(v) == 0 {
+				return nil, &PathError{Path: p, Err: errors.New("object must not be empty")}
+			}
+			nested, err := parseObject(v, p)
+			if err != nil {
+				return nil, err
+			}
+			o.fields[k] = field{object: nested}
+		case []any:
+			matchers, err := parseMatchers(v, p)
+			if err != nil {
+				return nil, err
+			}
+			o.fields[k] = field{matchers: matchers}
+		default:
+			return nil, &PathError{Path: p, Err: errors.New("value must be an object or an array")}
+		}
+	}
+
+	return o, nil
+}
+
+func parseOr(v any, path string) ([]*object, error) {
+	l, ok := v.([]any)
+	if !ok || len(l) < 2 {
+		return nil, &PathError{Path: path, Err: errors.New("must be an array of at least 2 objects")}
+	}
+
+	var alternatives []*object
+	for i, e := range l {
+		p := fmt.Sprintf("%s[%d]", path, i)
+		m, ok := e.(map[string]any)
+		if !ok || len(m) == 0 {
+			return nil, &PathError{Path: p, Err: errors.New("must be a non-empty object")}
+		}
+		o, err := parseObject(m, p)
+		if err != nil {
+			return nil, err
+		}
+		alternatives = append(alternatives, o)
+	}
+
+	return alternatives, nil
+}
+
+func parseMatchers(l []any, path string) ([]matcher, error) {
+	if len(l) == 0 {
+		return nil, &PathError{Path: path, Err: errors.New("array must not be empty")}
+	}
+
+	var matchers []matcher
+	for i, e := range l {
+		p := fmt.Sprintf("%s[%d]", path, i)
+
+		switch e := e.(type) {
+		case nil, bool, float64, string:
+			matchers = append(matchers, exactMatcher{value: e})
+		case map[string]any:
+			m, err := parseOperator(e, p)
+			if err != nil {
+				return nil, err
+			}
+			matchers = append(matchers, m)
+		default:
+			return nil, &PathError{Path: p, Err: errors.New("nested arrays are not supported")}
+		}
+	}
+
+	return matchers, nil
+}
+
+func parseOperator(m map[string]any, path string) (matcher, error) {
+	if len(m) != 1 {
+		return nil, &PathError{Path: path, Err: errors.New("object must contain exactly one operator")}
+	}
+
+	var operator string
+	var v any
+	for operator, v = range m {
+	}
+
+	p := joinPath(path, operator)
+
+	switch operator {
+	case operatorAnythingBut:
+		return parseAnythingBut(v, p)
+	case operatorCIDR:
+		s, ok := v.(string)
+		if !ok {
+			return nil, &PathError{Path: p, Err: errors.New("must be a string")}
+		}
+		_, ipNet, err := net.ParseCIDR(s)
+		if err != nil {
+			ip := net.ParseIP(s)
+			if ip == nil {
+				return nil, &PathError{Path: p, Err: fmt.Errorf("invalid CIDR block or IP address: %q", s)}
+			}
+			bits := 8 * len(ip.To16())
+			if ip4 := ip.To4(); ip4 != nil {
+				ip, bits = ip4, 32
+			}
+			ipNet = &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}
+		}
+		return cidrMatcher{ipNet: ipNet}, nil
+	case operatorEqualsIgnoreCase:
+		s, ok := v.(string)
+		if !ok {
+			return nil, &PathError{Path: p, Err: errors.New("must be a string")}
+		}
+		return equalsIgnoreCaseMatcher{value: s}, nil
+	case operatorExists:
+		b, ok := v.(bool)
+		if !ok {
+			return nil, &PathError{Path: p, Err: errors.New("must be a boolean")}
+		}
+		return existsMatcher{exists: b}, nil
+	case operatorNumeric:
+		return parseNumeric(v, p)
+	case operatorPrefix, operatorSuffix:
+		s, ignoreCase, err := parseAffix(v, p)
+		if err != nil {
+			return nil, err
+		}
+		return affixMatcher{value: s, ignoreCase: ignoreCase, suffix: operator == operatorSuffix}, nil
+	case operatorWildcard:
+		s, ok := v.(string)
+		if !ok {
+			return nil, &PathError{Path: p, Err: errors.New("must be a string")}
+		}
+		return parseWildcard(s, p)
+	}
+
+	return nil, &PathError{Path: path, Err: fmt.Errorf("unsupported operator %q", operator)}
+}
+
+// parseAffix parses a `prefix` or `suffix` value: a string or an object with an `equals-ignore-case` string.
+func parseAffix(v any, path string) (string, bool, error) {
+	switch v := v.(type) {
+	case string:
+		return v, false, nil
+	case map[string]any:
+		if s, ok := v[operatorEqualsIgnoreCase].(string); ok && len(v) == 1 {
+			return s, true, nil
+		}
+	}
+
+	return "", false, &PathError{Path: path, Err: fmt.Errorf("must be a string or an object with an %q string", operatorEqualsIgnoreCase)}
+}
+
+func parseAnythingBut(v any, path string) (matcher, error) {
+	var inner []matcher
+
+	switch v := v.(type) {
+	case string, float64:
+		inner = append(inner, exactMatcher{value: v})
+	case []any:
+		if len(v) == 0 {
+			return nil, &PathError{Path: path, Err: errors.New("array must not be empty")}
+		}
+		for i, e := range v {
+			switch e.(type) {
+			case string, float64:
+				inner = append(inner, exactMatcher{value: e})
+			default:
+				return nil, &PathError{Path: fmt.Sprintf("%s[%d]", path, i), Err: errors.New("must be a string or a number")}
+			}
+		}
+	case map[string]any:
+		if len(v) != 1 {
+			return nil, &PathError{Path: path, Err: errors.New("object must contain exactly one operator")}
+		}
+		for operator, e := range v {
+			p := joinPath(path, operator)
+			values, ok := stringOrStrings(e)
+			if !ok {
+				return nil, &PathError{Path: p, Err: errors.New("must be a string or an array of strings")}
+			}
+
+			for _, s := range values {
+				switch operator {
+				case operatorEqualsIgnoreCase:
+					inner = append(inner, equalsIgnoreCaseMatcher{value: s})
+				case operatorPrefix, operatorSuffix:
+					if _, isString := e.(string); !isString {
+						return nil, &PathError{Path: p, Err: errors.New("must be a string")}
+					}
+					inner = append(inner, affixMatcher{value: s, suffix: operator == operatorSuffix})
+				case operatorWildcard:
+					m, err := parseWildcard(s, p)
+					if err != nil {
+						return nil, err
+					}
+					inner = append(inner, m)
+				default:
+					return nil, &PathError{Path: path, Err: fmt.Errorf("unsupported operator %q", operator)}
+				}
+			}
+		}
+	default:
+		return nil, &PathError{Path: path, Err: errors.New("must be a string, a number, an array or an object")}
+	}
+
+	return anythingButMatcher{matchers: inner}, nil
+}
+
+func stringOrStrings(v any) ([]string, bool) {
+	switch v := v.(type) {
+	case string:
+		return []string{v}, true
+	case []any:
+		if len(v) == 0 {
+			return nil, false
+		}
+		var values []string
+		for _, e := range v {
+			s, ok := e.(string)
+			if !ok {
+				return nil, false
+			}
+			values = append(values, s)
+		}
+		return values, true
+	}
+
+	return nil, false
+}
+
+func parseNumeric(v any, path string) (matcher, error) {
+	l, ok := v.([]any)
+	if !ok || len(l) == 0 || len(l)%2 != 0 || len(l) > 4 {
+		return nil, &PathError{Path: path, Err: errors.New(`must be an array of 1 or 2 operator and number pairs, e.g. [">", 0, "<=", 5]`)}
+	}
+
+	m := numericMatcher{lower: math.Inf(-1), upper: math.Inf(1)}
+	var hasLower, hasUpper bool
+
+	for i := 0; i < len(l); i += 2 {
+		op, ok := l[i].(string)
+		if !ok {
+			return nil, &PathError{Path: fmt.Sprintf("%s[%d]", path, i), Err: errors.New("must be a string")}
+		}
+		n, ok := l[i+1].(float64)
+		if !ok {
+			return nil, &PathError{Path: fmt.Sprintf("%s[%d]", path, i+1), Err: errors.New("must be a number")}
+		}
+		if n < numericMin || n > numericMax {
+			return nil, &PathError{Path: fmt.Sprintf("%s[%d]", path, i+1), Err: fmt.Errorf("must be between %g and %g", numericMin, numericMax)}
+		}
+
+		switch op {
+		case "=":
+			if len(l) != 2 {
+				return nil, &PathError{Path: path, Err: errors.New(`"=" cannot be combined with other operators`)}
+			}
+			m.lower, m.upper, m.lowerInclusive, m.upperInclusive = n, n, true, true
+		case ">", ">=":
+			if hasLower {
+				return nil, &PathError{Path: path, Err: errors.New("only one lower bound is allowed")}
+			}
+			hasLower = true
+			m.lower, m.lowerInclusive = n, op == ">="
+		case "<", "<=":
+			if hasUpper {
+				return nil, &PathError{Path: path, Err: errors.New("only one upper bound is allowed")}
+			}
+			hasUpper = true
+			m.upper, m.upperInclusive = n, op == "<="
+		default:
+			return nil, &PathError{Path: fmt.Sprintf("%s[%d]", path, i), Err: fmt.Errorf("unsupported operator %q", op)}
+		}
+	}
+
+	if m.lower > m.upper || (m.lower == m.upper && !(m.lowerInclusive && m.upperInclusive)) {
+		return nil, &PathError{Path: path, Err: errors.New("range is empty")}
+	}
+
+	return m, nil
+}
+
+func parseWildcard(s, path string) (matcher, error) {
+	var tokens []wildcardToken
+	var literal strings.Builder
+
+	for i := 0; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '\\':
+			if i+1 >= len(s) || (s[i+1] != '*' && s[i+1] != '\\') {
+				return nil, &PathError{Path: path, Err: errors.New(`"\" must escape "*" or "\"`)}
+			}
+			i++
+			literal.WriteByte(s[i])
+		case '*':
+			if n := len(tokens); literal.Len() == 0 && n > 0 && tokens[n-1].star {
+				return nil, &PathError{Path: path, Err: errors.New("consecutive wildcard characters are not allowed")}
+			}
+			if literal.Len() > 0 {
+				tokens = append(tokens, wildcardToken{literal: literal.String()})
+				literal.Reset()
+			}
+			tokens = append(tokens, wildcardToken{star: true})
+		default:
+			literal.WriteByte(c)
+		}
+	}
+
+	if literal.Len() > 0 {
+		tokens = append(tokens, wildcardToken{literal: literal.String()})
+	}
+
+	return wildcardMatcher{tokens: tokens}, nil
+}
+
+func (o *object) match(event map[string]any) bool {
+	for k, f := range o.fields {
+		v, present := event[k]
+		if !f.match(v, present) {
+			return false
+		}
+	}
+
+	for _, alternatives := range o.ors {
+		if !slices.ContainsFunc(alternatives, func(alternative *object) bool {
+			return alternative.match(event)
+		}) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (f field) match(v any, present bool) bool {
+	if f.object != nil {
+		switch v := v.(type) {
+		case map[string]any:
+			return f.object.match(v)
+		case []any:
+			// Match if any object in an array matches.
+			return slices.ContainsFunc(v, func(e any) bool {
+				m, ok := e.(map[string]any)
+				return ok && f.object.match(m)
+			})
+		}
+
+		return f.object.matchAbsent()
+	}
+
+	if !present {
+		return slices.ContainsFunc(f.matchers, func(m matcher) bool {
+			e, ok := m.(existsMatcher)
+			return ok && !e.exists
+		})
+	}
+
+	var values []any
+	switch v := v.(type) {
+	case map[string]any:
+		// Leaf matchers don't match objects.
+		return false
+	case []any:
+		values = v
+	default:
+		values = []any{v}
+	}
+
+	for _, m := range f.matchers {
+		if e, ok := m.(existsMatcher); ok {
+			if e.exists && len(values) > 0 {
+				return true
+			}
+			continue
+		}
+
+		if slices.ContainsFunc(values, m.match) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// matchAbsent returns whether the object matches an event in which it is absent,
+// which is only the case if all its leaves require their fields not to exist.
+func (o *object) matchAbsent() bool {
+	return o.match(map[string]any{})
+}
+
+type exactMatcher struct {
+	value any
+}
+
+func (m exactMatcher) match(v any) bool {
+	return m.value == v
+}
+
+type affixMatcher struct {
+	value      string
+	ignoreCase bool
+	suffix     bool
+}
+
+func (m affixMatcher) match(v any) bool {
+	s, ok := v.(string)
+	if !ok {
+		return false
+	}
+
+	value := m.value
+	if m.ignoreCase {
+		s, value = strings.ToLower(s), strings.ToLower(value)
+	}
+
+	if m.suffix {
+		return strings.HasSuffix(s, value)
+	}
+	return strings.HasPrefix(s, value)
+}
+
+type equalsIgnoreCaseMatcher struct {
+	value string
+}
+
+func (m equalsIgnoreCaseMatcher) match(v any) bool {
+	s, ok := v.(string)
+	return ok && strings.EqualFold(s, m.value)
+}
+
+type existsMatcher struct {
+	exists bool
+}
+
+func (m existsMatcher) match(any) bool {
+	return m.exists
+}
+
+type anythingButMatcher struct {
+	matchers []matcher
+}
+
+func (m anythingButMatcher) match(v any) bool {
+	return !slices.ContainsFunc(m.matchers, func(m matcher) bool {
+		return m.match(v)
+	})
+}
+
+type numericMatcher struct {
+	lower, upper                   float64
+	lowerInclusive, upperInclusive bool
+}
+
+func (m numericMatcher) match(v any) bool {
+	n, ok := v.(float64)
+	if !ok {
+		return false
+	}
+
+	if n < m.lower || (n == m.lower && !m.lowerInclusive) {
+		return false
+	}
+	if n > m.upper || (n == m.upper && !m.upperInclusive) {
+		return false
+	}
+
+	return true
+}
+
+type cidrMatcher struct {
+	ipNet *net.IPNet
+}
+
+func (m cidrMatcher) match(v any) bool {
+	s, ok := v.(string)
+	if !ok {
+		return false
+	}
+
+	ip := net.ParseIP(s)
+	return ip != nil && m.ipNet.Contains(ip)
+}
+
+type wildcardToken struct {
+	literal string
+	star    bool
+}
+
+type wildcardMatcher struct {
+	tokens []wildcardToken
+}
+
+func (m wildcardMatcher) match(v any) bool {
+	s, ok := v.(string)
+	if !ok {
+		return false
+	}
+
+	return matchWildcard(m.tokens, s)
+}
+
+func matchWildcard(tokens []wildcardToken, s string) bool {
+	if len(tokens) == 0 {
+		return s == ""
+	}
+
+	if t := tokens[0]; !t.star {
+		if !strings.HasPrefix(s, t.literal) {
+			return false
+		}
+		return matchWildcard(tokens[1:], s[len(t.literal):])
+	}
+
+	for i := 0; i <= len(s); i++ {
+		if matchWildcard(tokens[1:], s[i:]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	return keys
+}
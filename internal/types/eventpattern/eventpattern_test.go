@@ -0,0 +1,251 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventpattern
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		pattern string
+		wantErr string
+	}{
+		"exact values": {
+			pattern: `{"source": ["aws.ec2"], "detail": {"count": [5], "enabled": [true], "value": [null]}}`,
+		},
+		"all operators": {
+			pattern: `{"detail": {
+				"a": [{"prefix": "x"}, {"prefix": {"equals-ignore-case": "X"}}],
+				"b": [{"suffix": ".png"}],
+				"c": [{"anything-but": ["x", 1]}, {"anything-but": {"prefix": "y"}}, {"anything-but": {"wildcard": ["*.a", "*.b"]}}],
+				"d": [{"numeric": [">", 0, "<=", 5]}],
+				"e": [{"exists": false}],
+				"f": [{"equals-ignore-case": "x"}],
+				"g": [{"wildcard": "a*b\\*"}],
+				"h": [{"cidr": "10.0.0.0/24"}]
+			}}`,
+		},
+		"or": {
+			pattern: `{"$or": [{"source": ["a"]}, {"detail-type": ["b"]}]}`,
+		},
+		"invalid JSON": {
+			pattern: `{"source": `,
+			wantErr: "decoding JSON",
+		},
+		"trailing data": {
+			pattern: `{} {}`,
+			wantErr: "unexpected data after top-level value",
+		},
+		"not an object": {
+			pattern: `["aws.ec2"]`,
+			wantErr: "must be a JSON object",
+		},
+		"empty": {
+			pattern: `{}`,
+			wantErr: "must not be empty",
+		},
+		"scalar leaf": {
+			pattern: `{"source": "aws.ec2"}`,
+			wantErr: "source: value must be an object or an array",
+		},
+		"empty array": {
+			pattern: `{"detail": {"state": []}}`,
+			wantErr: "detail.state: array must not be empty",
+		},
+		"empty nested object": {
+			pattern: `{"detail": {}}`,
+			wantErr: "detail: object must not be empty",
+		},
+		"unsupported operator": {
+			pattern: `{"source": [{"contains": "x"}]}`,
+			wantErr: `source[0]: unsupported operator "contains"`,
+		},
+		"multiple operators": {
+			pattern: `{"source": [{"prefix": "x", "suffix": "y"}]}`,
+			wantErr: "exactly one operator",
+		},
+		"nested array": {
+			pattern: `{"source": [["x"]]}`,
+			wantErr: "nested arrays are not supported",
+		},
+		"exists not boolean": {
+			pattern: `{"source": [{"exists": "true"}]}`,
+			wantErr: "source[0].exists: must be a boolean",
+		},
+		"numeric odd length": {
+			pattern: `{"n": [{"numeric": [">", 0, "<"]}]}`,
+			wantErr: "operator and number pairs",
+		},
+		"numeric equals combined": {
+			pattern: `{"n": [{"numeric": ["=", 0, "<", 5]}]}`,
+			wantErr: `"=" cannot be combined`,
+		},
+		"numeric empty range": {
+			pattern: `{"n": [{"numeric": [">", 5, "<", 5]}]}`,
+			wantErr: "range is empty",
+		},
+		"numeric out of range": {
+			pattern: `{"n": [{"numeric": [">", 6e9]}]}`,
+			wantErr: "must be between",
+		},
+		"numeric duplicate bound": {
+			pattern: `{"n": [{"numeric": [">", 1, ">=", 2]}]}`,
+			wantErr: "only one lower bound",
+		},
+		"wildcard consecutive": {
+			pattern: `{"s": [{"wildcard": "a**b"}]}`,
+			wantErr: "consecutive wildcard characters",
+		},
+		"wildcard invalid escape": {
+			pattern: `{"s": [{"wildcard": "a\\b"}]}`,
+			wantErr: `must escape`,
+		},
+		"invalid CIDR": {
+			pattern: `{"ip": [{"cidr": "10.0.0.0/33"}]}`,
+			wantErr: "invalid CIDR block or IP address",
+		},
+		"anything-but prefix list": {
+			pattern: `{"s": [{"anything-but": {"prefix": ["a", "b"]}}]}`,
+			wantErr: "must be a string",
+		},
+		"anything-but empty list": {
+			pattern: `{"s": [{"anything-but": []}]}`,
+			wantErr: "array must not be empty",
+		},
+		"or single alternative": {
+			pattern: `{"$or": [{"source": ["a"]}]}`,
+			wantErr: "$or: must be an array of at least 2 objects",
+		},
+		"or empty alternative": {
+			pattern: `{"$or": [{"source": ["a"]}, {}]}`,
+			wantErr: "$or[1]: must be a non-empty object",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Parse(testCase.pattern)
+
+			if testCase.wantErr == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+				return
+			}
+
+			if err == nil || !strings.Contains(err.Error(), testCase.wantErr) {
+				t.Fatalf("expected error containing %q, got %v", testCase.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestPatternMatches(t *testing.T) {
+	t.Parallel()
+
+	const event = `{
+		"source": "aws.ec2",
+		"detail-type": "EC2 Instance State-change Notification",
+		"resources": ["arn:aws:ec2:us-west-2:123456789012:instance/i-1234567890abcdef0"],
+		"detail": {
+			"instance-id": "i-1234567890abcdef0",
+			"state": "running",
+			"count": 5,
+			"enabled": true,
+			"empty": null,
+			"source-ip": "10.0.0.15",
+			"file": "image.PNG",
+			"tags": [{"key": "env", "value": "prod"}, {"key": "team", "value": "web"}]
+		}
+	}` //lintignore:AWSAT003,AWSAT005
+
+	testCases := map[string]struct {
+		pattern string
+		want    bool
+	}{
+		"exact match":                        {pattern: `{"source": ["aws.ec2"]}`, want: true},
+		"exact mismatch":                     {pattern: `{"source": ["aws.s3"]}`, want: false},
+		"any of values":                      {pattern: `{"detail": {"state": ["stopped", "running"]}}`, want: true},
+		"number":                             {pattern: `{"detail": {"count": [5.0]}}`, want: true},
+		"number is not string":               {pattern: `{"detail": {"count": ["5"]}}`, want: false},
+		"boolean":                            {pattern: `{"detail": {"enabled": [true]}}`, want: true},
+		"null":                               {pattern: `{"detail": {"empty": [null]}}`, want: true},
+		"event array":                        {pattern: `{"resources": [{"prefix": "arn:aws:ec2:"}]}`, want: true},
+		"prefix":                             {pattern: `{"detail": {"instance-id": [{"prefix": "i-"}]}}`, want: true},
+		"prefix ignore case":                 {pattern: `{"detail": {"state": [{"prefix": {"equals-ignore-case": "RUN"}}]}}`, want: true},
+		"suffix case sensitive":              {pattern: `{"detail": {"file": [{"suffix": ".png"}]}}`, want: false},
+		"suffix ignore case":                 {pattern: `{"detail": {"file": [{"suffix": {"equals-ignore-case": ".png"}}]}}`, want: true},
+		"equals ignore case":                 {pattern: `{"detail": {"state": [{"equals-ignore-case": "RUNNING"}]}}`, want: true},
+		"anything-but value":                 {pattern: `{"detail": {"state": [{"anything-but": "stopped"}]}}`, want: true},
+		"anything-but list":                  {pattern: `{"detail": {"state": [{"anything-but": ["stopped", "running"]}]}}`, want: false},
+		"anything-but prefix":                {pattern: `{"detail": {"state": [{"anything-but": {"prefix": "run"}}]}}`, want: false},
+		"anything-but wildcard":              {pattern: `{"detail": {"file": [{"anything-but": {"wildcard": "*.jpg"}}]}}`, want: true},
+		"anything-but missing field":         {pattern: `{"detail": {"missing": [{"anything-but": "x"}]}}`, want: false},
+		"numeric range":                      {pattern: `{"detail": {"count": [{"numeric": [">", 0, "<=", 5]}]}}`, want: true},
+		"numeric exclusive":                  {pattern: `{"detail": {"count": [{"numeric": ["<", 5]}]}}`, want: false},
+		"numeric equals":                     {pattern: `{"detail": {"count": [{"numeric": ["=", 5]}]}}`, want: true},
+		"numeric string value":               {pattern: `{"detail": {"state": [{"numeric": [">", 0]}]}}`, want: false},
+		"exists true":                        {pattern: `{"detail": {"state": [{"exists": true}]}}`, want: true},
+		"exists true missing":                {pattern: `{"detail": {"missing": [{"exists": true}]}}`, want: false},
+		"exists false":                       {pattern: `{"detail": {"missing": [{"exists": false}]}}`, want: true},
+		"exists false present":               {pattern: `{"detail": {"state": [{"exists": false}]}}`, want: false},
+		"exists false missing parent":        {pattern: `{"missing": {"field": [{"exists": false}]}}`, want: true},
+		"exists true on object":              {pattern: `{"detail": [{"exists": true}]}`, want: false},
+		"wildcard":                           {pattern: `{"detail": {"instance-id": [{"wildcard": "i-*def0"}]}}`, want: true},
+		"wildcard mismatch":                  {pattern: `{"detail": {"instance-id": [{"wildcard": "i-*abc"}]}}`, want: false},
+		"wildcard escaped star":              {pattern: `{"detail": {"instance-id": [{"wildcard": "i-\\*"}]}}`, want: false},
+		"cidr":                               {pattern: `{"detail": {"source-ip": [{"cidr": "10.0.0.0/24"}]}}`, want: true},
+		"cidr mismatch":                      {pattern: `{"detail": {"source-ip": [{"cidr": "10.0.1.0/24"}]}}`, want: false},
+		"cidr single address":                {pattern: `{"detail": {"source-ip": [{"cidr": "10.0.0.15"}]}}`, want: true},
+		"nested object in array":             {pattern: `{"detail": {"tags": {"key": ["team"], "value": ["web"]}}}`, want: true},
+		"nested object in array mismatch":    {pattern: `{"detail": {"tags": {"key": ["owner"]}}}`, want: false},
+		"all fields must match":              {pattern: `{"source": ["aws.ec2"], "detail": {"state": ["stopped"]}}`, want: false},
+		"or":                                 {pattern: `{"$or": [{"source": ["aws.s3"]}, {"detail": {"state": ["running"]}}]}`, want: true},
+		"or no alternative matches":          {pattern: `{"$or": [{"source": ["aws.s3"]}, {"detail": {"state": ["stopped"]}}]}`, want: false},
+		"nested or":                          {pattern: `{"detail": {"$or": [{"count": [{"numeric": [">", 10]}]}, {"enabled": [true]}]}}`, want: true},
+		"or combined with other fields":      {pattern: `{"source": ["aws.s3"], "$or": [{"detail": {"state": ["running"]}}, {"detail": {"count": [5]}}]}`, want: false},
+		"leaf matcher does not match object": {pattern: `{"detail": ["running"]}`, want: false},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			pattern, err := Parse(testCase.pattern)
+			if err != nil {
+				t.Fatalf("parsing pattern: %s", err)
+			}
+
+			got, err := pattern.Matches(event)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if got != testCase.want {
+				t.Errorf("Matches() = %t, want %t", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestPatternMatchesInvalidEvent(t *testing.T) {
+	t.Parallel()
+
+	pattern, err := Parse(`{"source": ["aws.ec2"]}`)
+	if err != nil {
+		t.Fatalf("parsing pattern: %s", err)
+	}
+
+	for _, event := range []string{`{"source": `, `"aws.ec2"`} {
+		if _, err := pattern.Matches(event); err == nil {
+			t.Errorf("expected error for event %s", event)
+		}
+	}
+}
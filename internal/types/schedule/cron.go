@@ -0,0 +1,516 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schedule
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	minYear = 1970
+	maxYear = 2199
+)
+
+var (
+	monthNames = map[string]int{
+		"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+		"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+	}
+	dayOfWeekNames = map[string]int{
+		"SUN": 1, "MON": 2, "TUE": 3, "WED": 4, "THU": 5, "FRI": 6, "SAT": 7,
+	}
+	unixDayOfWeekNames = map[string]int{
+		"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+	}
+)
+
+// field describes the range and syntax of a single cron field.
+type field struct {
+	name  string
+	min   int
+	max   int
+	names map[string]int
+}
+
+var (
+	fieldMinutes    = field{name: "minutes", min: 0, max: 59}
+	fieldHours      = field{name: "hours", min: 0, max: 23}
+	fieldDayOfMonth = field{name: "day-of-month", min: 1, max: 31}
+	fieldMonth      = field{name: "month", min: 1, max: 12, names: monthNames}
+	fieldDayOfWeek  = field{name: "day-of-week", min: 1, max: 7, names: dayOfWeekNames}
+	fieldYear       = field{name: "year", min: minYear, max: maxYear}
+
+	// Unix cron numbers the days of the week from 0 (Sunday) to 6 (Saturday), with 7 also meaning Sunday.
+	fieldUnixDayOfWeek = field{name: "day-of-week", min: 0, max: 7, names: unixDayOfWeekNames}
+)
+
+// bitset is a set of small non-negative integers.
+type bitset [4]uint64
+
+func (b *bitset) set(i int) {
+	b[i/64] |= 1 << (i % 64)
+}
+
+func (b bitset) has(i int) bool {
+	return b[i/64]&(1<<(i%64)) != 0
+}
+
+// dayOfMonthSpec is the parsed day-of-month field.
+type dayOfMonthSpec struct {
+	any         bool   // "?"
+	days        bitset // Explicit days.
+	last        bool   // "L" or "L-n".
+	lastOffset  int    // n in "L-n".
+	lastWeekday bool   // "LW".
+	weekdays    []int  // n in "nW".
+}
+
+// dayOfWeekSpec is the parsed day-of-week field.
+type dayOfWeekSpec struct {
+	any  bool     // "?"
+	all  bool     // "*"
+	days bitset   // Explicit days of the week, 1 (Sunday) to 7 (Saturday).
+	last []int    // n in "nL".
+	nth  [][2]int // n and k in "n#k".
+}
+
+// Cron is a `cron(minutes hours day-of-month month day-of-week year)` expression.
+type Cron struct {
+	expr       string
+	minutes    bitset
+	hours      bitset
+	dayOfMonth dayOfMonthSpec
+	months     bitset
+	dayOfWeek  dayOfWeekSpec
+	years      bitset // Offset by minYear.
+
+	// Unix cron expressions match either day field when both are restricted.
+	unix bool
+}
+
+// ParseCron parses a `cron(minutes hours day-of-month month day-of-week year)` expression
+// in the six-field dialect used by EventBridge, EventBridge Scheduler, AWS Backup and Systems Manager.
+func ParseCron(s string) (*Cron, error) {
+	body, err := unwrap(s, "cron")
+	if err != nil {
+		return nil, err
+	}
+
+	parts := strings.Fields(body)
+	if len(parts) != 6 {
+		return nil, fmt.Errorf("%q: %w, expected 6 fields (minutes hours day-of-month month day-of-week year), got %d", s, ErrSyntax, len(parts))
+	}
+
+	c := &Cron{
+		expr: s,
+	}
+
+	if c.minutes, err = fieldMinutes.parse(parts[0]); err != nil {
+		return nil, err
+	}
+	if c.hours, err = fieldHours.parse(parts[1]); err != nil {
+		return nil, err
+	}
+	if c.dayOfMonth, err = parseDayOfMonth(parts[2]); err != nil {
+		return nil, err
+	}
+	if c.months, err = fieldMonth.parse(parts[3]); err != nil {
+		return nil, err
+	}
+	if c.dayOfWeek, err = parseDayOfWeek(parts[4]); err != nil {
+		return nil, err
+	}
+	if c.years, err = fieldYear.parse(parts[5]); err != nil {
+		return nil, err
+	}
+
+	// You can't specify the day-of-month and day-of-week fields in the same cron expression.
+	// If you specify a value or a * in one of the fields, you must use a ? in the other.
+	switch {
+	case c.dayOfMonth.any && c.dayOfWeek.any:
+		return nil, &FieldError{Field: fieldDayOfWeek.name, Value: parts[4], Err: errors.New("? can't be used in both the day-of-month and day-of-week fields")}
+	case !c.dayOfMonth.any && !c.dayOfWeek.any:
+		return nil, &FieldError{Field: fieldDayOfWeek.name, Value: parts[4], Err: errors.New("one of the day-of-month or day-of-week fields must be ?")}
+	}
+
+	return c, nil
+}
+
+// ParseUnixCron parses a five-field Unix `minutes hours day-of-month month day-of-week` expression,
+// as used by Auto Scaling scheduled actions.
+// As in Unix cron, if both the day-of-month and day-of-week fields are restricted, a day matching either field matches.
+func ParseUnixCron(s string) (*Cron, error) {
+	parts := strings.Fields(s)
+	if len(parts) != 5 {
+		return nil, fmt.Errorf("%q: %w, expected 5 fields (minutes hours day-of-month month day-of-week), got %d", s, ErrSyntax, len(parts))
+	}
+
+	c := &Cron{
+		expr: s,
+		unix: true,
+	}
+
+	var err error
+	if c.minutes, err = fieldMinutes.parse(parts[0]); err != nil {
+		return nil, err
+	}
+	if c.hours, err = fieldHours.parse(parts[1]); err != nil {
+		return nil, err
+	}
+	if c.dayOfMonth.days, err = fieldDayOfMonth.parse(parts[2]); err != nil {
+		return nil, err
+	}
+	if c.months, err = fieldMonth.parse(parts[3]); err != nil {
+		return nil, err
+	}
+	days, err := fieldUnixDayOfWeek.parse(parts[4])
+	if err != nil {
+		return nil, err
+	}
+	for i := fieldUnixDayOfWeek.min; i <= fieldUnixDayOfWeek.max; i++ {
+		if days.has(i) {
+			c.dayOfWeek.days.set(i%7 + 1)
+		}
+	}
+	for i := fieldYear.min; i <= fieldYear.max; i++ {
+		c.years.set(i - minYear)
+	}
+
+	// As in Vixie cron, a day field starting with "*" (including "*/n") is considered unrestricted.
+	c.dayOfMonth.any = strings.HasPrefix(parts[2], "*")
+	c.dayOfWeek.any = strings.HasPrefix(parts[4], "*")
+
+	return c, nil
+}
+
+func (c *Cron) String() string {
+	return c.expr
+}
+
+// Next returns the first time strictly after t at which the expression fires.
+// The expression is evaluated in t's location.
+func (c *Cron) Next(t time.Time) (time.Time, bool) {
+	loc := t.Location()
+	t = t.Truncate(time.Minute).Add(time.Minute)
+
+	for t.Year() <= maxYear {
+		year, month, day := t.Date()
+
+		if year < minYear || !c.years.has(year-minYear) {
+			t = time.Date(year+1, time.January, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !c.months.has(int(month)) {
+			t = time.Date(year, month+1, 1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !c.dayMatches(year, month, day) {
+			t = time.Date(year, month, day+1, 0, 0, 0, 0, loc)
+			continue
+		}
+		if !c.hours.has(t.Hour()) {
+			t = time.Date(year, month, day, t.Hour()+1, 0, 0, 0, loc)
+			continue
+		}
+		if !c.minutes.has(t.Minute()) {
+			t = t.Add(time.Minute)
+			continue
+		}
+
+		return t, true
+	}
+
+	return time.Time{}, false
+}
+
+func (c *Cron) dayMatches(year int, month time.Month, day int) bool {
+	if c.unix {
+		if c.dayOfMonth.any || c.dayOfWeek.any {
+			return c.dayOfMonth.matches(year, month, day) && c.dayOfWeek.matches(year, month, day)
+		}
+
+		return c.dayOfMonth.matches(year, month, day) || c.dayOfWeek.matches(year, month, day)
+	}
+
+	if !c.dayOfMonth.any {
+		return c.dayOfMonth.matches(year, month, day)
+	}
+
+	return c.dayOfWeek.matches(year, month, day)
+}
+
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func weekday(year int, month time.Month, day int) time.Weekday {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Weekday()
+}
+
+func isWeekday(year int, month time.Month, day int) bool {
+	wd := weekday(year, month, day)
+	return wd != time.Saturday && wd != time.Sunday
+}
+
+// nearestWeekday returns the weekday nearest to the specified day in the same month.
+func nearestWeekday(year int, month time.Month, day int) int {
+	last := daysIn(year, month)
+	if day > last {
+		day = last
+	}
+
+	switch weekday(year, month, day) {
+	case time.Saturday:
+		if day == 1 {
+			return day + 2
+		}
+		return day - 1
+	case time.Sunday:
+		if day == last {
+			return day - 2
+		}
+		return day + 1
+	}
+
+	return day
+}
+
+func (s dayOfMonthSpec) matches(year int, month time.Month, day int) bool {
+	last := daysIn(year, month)
+
+	if s.days.has(day) {
+		return true
+	}
+	if s.last && day == last-s.lastOffset {
+		return true
+	}
+	if s.lastWeekday {
+		d := last
+		for !isWeekday(year, month, d) {
+			d--
+		}
+		if day == d {
+			return true
+		}
+	}
+	for _, v := range s.weekdays {
+		if day == nearestWeekday(year, month, v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s dayOfWeekSpec) matches(year int, month time.Month, day int) bool {
+	wd := int(weekday(year, month, day)) + 1 // 1 (Sunday) to 7 (Saturday).
+
+	if s.all || s.days.has(wd) {
+		return true
+	}
+	for _, v := range s.last {
+		if wd == v && day+7 > daysIn(year, month) {
+			return true
+		}
+	}
+	for _, v := range s.nth {
+		if wd == v[0] && (day-1)/7+1 == v[1] {
+			return true
+		}
+	}
+
+	return false
+}
+
+func parseDayOfMonth(s string) (dayOfMonthSpec, error) {
+	var spec dayOfMonthSpec
+
+	if s == "?" {
+		spec.any = true
+		return spec, nil
+	}
+
+	for _, item := range strings.Split(s, ",") {
+		switch {
+		case item == "L":
+			spec.last = true
+		case item == "LW":
+			spec.lastWeekday = true
+		case strings.HasPrefix(item, "L-"):
+			n, err := strconv.Atoi(item[2:])
+			if err != nil || n < 0 || n > 30 {
+				return spec, &FieldError{Field: fieldDayOfMonth.name, Value: s, Err: fmt.Errorf("invalid last day offset %q", item)}
+			}
+			spec.last = true
+			spec.lastOffset = n
+		case strings.HasSuffix(item, "W"):
+			n, err := fieldDayOfMonth.value(item[:len(item)-1])
+			if err != nil {
+				return spec, &FieldError{Field: fieldDayOfMonth.name, Value: s, Err: err}
+			}
+			spec.weekdays = append(spec.weekdays, n)
+		default:
+			days, err := fieldDayOfMonth.parseItem(item)
+			if err != nil {
+				return spec, &FieldError{Field: fieldDayOfMonth.name, Value: s, Err: err}
+			}
+			for i := range spec.days {
+				spec.days[i] |= days[i]
+			}
+		}
+	}
+
+	return spec, nil
+}
+
+func parseDayOfWeek(s string) (dayOfWeekSpec, error) {
+	var spec dayOfWeekSpec
+
+	switch s {
+	case "?":
+		spec.any = true
+		return spec, nil
+	case "*":
+		spec.all = true
+		return spec, nil
+	}
+
+	for _, item := range strings.Split(s, ",") {
+		switch {
+		case item == "L":
+			spec.days.set(7)
+		case strings.HasSuffix(item, "L"):
+			n, err := fieldDayOfWeek.value(item[:len(item)-1])
+			if err != nil {
+				return spec, &FieldError{Field: fieldDayOfWeek.name, Value: s, Err: err}
+			}
+			spec.last = append(spec.last, n)
+		case strings.Contains(item, "#"):
+			day, nth, _ := strings.Cut(item, "#")
+			n, err := fieldDayOfWeek.value(day)
+			if err != nil {
+				return spec, &FieldError{Field: fieldDayOfWeek.name, Value: s, Err: err}
+			}
+			k, err := strconv.Atoi(nth)
+			if err != nil || k < 1 || k > 5 {
+				return spec, &FieldError{Field: fieldDayOfWeek.name, Value: s, Err: fmt.Errorf("invalid occurrence %q, must be between 1 and 5", nth)}
+			}
+			spec.nth = append(spec.nth, [2]int{n, k})
+		default:
+			days, err := fieldDayOfWeek.parseItem(item)
+			if err != nil {
+				return spec, &FieldError{Field: fieldDayOfWeek.name, Value: s, Err: err}
+			}
+			for i := range spec.days {
+				spec.days[i] |= days[i]
+			}
+		}
+	}
+
+	return spec, nil
+}
+
+// parse parses a comma-separated list of values, ranges and increments.
+func (f field) parse(s string) (bitset, error) {
+	var result bitset
+
+	for _, item := range strings.Split(s, ",") {
+		v, err := f.parseItem(item)
+		if err != nil {
+			return result, &FieldError{Field: f.name, Value: s, Err: err}
+		}
+		for i := range result {
+			result[i] |= v[i]
+		}
+	}
+
+	return result, nil
+}
+
+// parseItem parses a single `*`, value, range (`a-b`) or increment (`a/n`, `a-b/n`, `*/n`).
+// Years are offset by minYear so that they fit in a bitset.
+func (f field) parseItem(item string) (bitset, error) {
+	var result bitset
+
+	if item == "" {
+		return result, errors.New("empty value")
+	}
+
+	rangeExpr, stepExpr, hasStep := strings.Cut(item, "/")
+
+	step := 1
+	if hasStep {
+		n, err := strconv.Atoi(stepExpr)
+		if err != nil || n <= 0 {
+			return result, fmt.Errorf("invalid increment %q", stepExpr)
+		}
+		step = n
+	}
+
+	var start, end int
+	switch {
+	case rangeExpr == "*":
+		start, end = f.min, f.max
+	case strings.Contains(rangeExpr, "-"):
+		from, to, _ := strings.Cut(rangeExpr, "-")
+		var err error
+		if start, err = f.value(from); err != nil {
+			return result, err
+		}
+		if end, err = f.value(to); err != nil {
+			return result, err
+		}
+	default:
+		var err error
+		if start, err = f.value(rangeExpr); err != nil {
+			return result, err
+		}
+		end = start
+		if hasStep {
+			end = f.max
+		}
+	}
+
+	offset := 0
+	if f.min >= minYear {
+		offset = minYear
+	}
+
+	// Ranges may wrap around, e.g. FRI-MON.
+	n := end - start
+	if n < 0 {
+		n += f.max - f.min + 1
+	}
+	for i := 0; i <= n; i += step {
+		v := start + i
+		if v > f.max {
+			v -= f.max - f.min + 1
+		}
+		result.set(v - offset)
+	}
+
+	return result, nil
+}
+
+// value parses a single numeric or named value.
+func (f field) value(s string) (int, error) {
+	if v, ok := f.names[strings.ToUpper(s)]; ok {
+		return v, nil
+	}
+
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %q", s)
+	}
+
+	if v < f.min || v > f.max {
+		return 0, fmt.Errorf("value %d out of range %d-%d", v, f.min, f.max)
+	}
+
+	return v, nil
+}
@@ -0,0 +1,195 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package schedule parses and evaluates AWS schedule expressions:
+// `cron()`, `rate()` and `at()` as used by EventBridge, EventBridge Scheduler, AWS Backup and Systems Manager.
+package schedule
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrSyntax = errors.New("invalid syntax")
+
+// Expression is a parsed schedule expression.
+type Expression interface {
+	// Next returns the first time strictly after t at which the schedule fires.
+	// The second return value is false if the schedule never fires after t.
+	Next(t time.Time) (time.Time, bool)
+	String() string
+}
+
+// FieldError is returned when a single field of a schedule expression is invalid.
+type FieldError struct {
+	Field string
+	Value string
+	Err   error
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%s field (%q): %s", e.Field, e.Value, e.Err)
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// Type is a schedule expression type.
+type Type string
+
+const (
+	TypeCron Type = "cron"
+	TypeRate Type = "rate"
+	TypeAt   Type = "at"
+)
+
+// Parse parses a `cron()`, `rate()` or `at()` schedule expression.
+func Parse(s string) (Expression, error) {
+	return ParseAllowed(s, TypeCron, TypeRate, TypeAt)
+}
+
+// ParseAllowed parses a schedule expression of one of the allowed types.
+func ParseAllowed(s string, allowed ...Type) (Expression, error) {
+	for _, t := range allowed {
+		if !strings.HasPrefix(s, string(t)+"(") {
+			continue
+		}
+
+		switch t {
+		case TypeCron:
+			return ParseCron(s)
+		case TypeRate:
+			return ParseRate(s)
+		case TypeAt:
+			return ParseAt(s)
+		}
+	}
+
+	expected := make([]string, len(allowed))
+	for i, t := range allowed {
+		expected[i] = string(t) + "()"
+	}
+
+	return nil, fmt.Errorf("%q: %w, expected %s expression", s, ErrSyntax, strings.Join(expected, " or "))
+}
+
+func unwrap(s, name string) (string, error) {
+	prefix := name + "("
+	if !strings.HasPrefix(s, prefix) || !strings.HasSuffix(s, ")") {
+		return "", fmt.Errorf("%q: %w, expected %s(...)", s, ErrSyntax, name)
+	}
+
+	return s[len(prefix) : len(s)-1], nil
+}
+
+// Rate is a `rate(value unit)` expression.
+type Rate struct {
+	value    int
+	unit     string
+	interval time.Duration
+}
+
+// ParseRate parses a `rate(value unit)` expression.
+func ParseRate(s string) (*Rate, error) {
+	body, err := unwrap(s, "rate")
+	if err != nil {
+		return nil, err
+	}
+
+	parts := strings.Fields(body)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("%q: %w, expected rate(value unit)", s, ErrSyntax)
+	}
+
+	value, err := strconv.Atoi(parts[0])
+	if err != nil || value <= 0 {
+		return nil, &FieldError{Field: "value", Value: parts[0], Err: errors.New("must be a positive integer")}
+	}
+
+	var interval time.Duration
+	unit := parts[1]
+	switch strings.TrimSuffix(unit, "s") {
+	case "minute":
+		interval = time.Minute
+	case "hour":
+		interval = time.Hour
+	case "day":
+		interval = 24 * time.Hour
+	default:
+		return nil, &FieldError{Field: "unit", Value: unit, Err: errors.New("must be one of minute(s), hour(s) or day(s)")}
+	}
+
+	return &Rate{
+		value:    value,
+		unit:     unit,
+		interval: time.Duration(value) * interval,
+	}, nil
+}
+
+// ValidateUnit returns an error if the rate's unit does not agree in number with its value,
+// e.g. `rate(1 hours)` or `rate(5 minute)`.
+// EventBridge rules reject such expressions; EventBridge Scheduler and Systems Manager accept them.
+func (r *Rate) ValidateUnit() error {
+	if plural := strings.HasSuffix(r.unit, "s"); r.value == 1 && plural {
+		return &FieldError{Field: "unit", Value: r.unit, Err: errors.New("must be singular when value is 1")}
+	} else if r.value > 1 && !plural {
+		return &FieldError{Field: "unit", Value: r.unit, Err: errors.New("must be plural when value is greater than 1")}
+	}
+
+	return nil
+}
+
+// Interval returns the time between invocations.
+func (r *Rate) Interval() time.Duration {
+	return r.interval
+}
+
+// Next returns t plus the rate's interval.
+// Rate-based schedules start when they are created, so t should be the schedule's creation time or a previous invocation.
+func (r *Rate) Next(t time.Time) (time.Time, bool) {
+	return t.Add(r.interval), true
+}
+
+func (r *Rate) String() string {
+	return fmt.Sprintf("rate(%d %s)", r.value, r.unit)
+}
+
+const atLayout = "2006-01-02T15:04:05"
+
+// At is a one-time `at(yyyy-mm-ddThh:mm:ss)` expression.
+type At struct {
+	time time.Time
+}
+
+// ParseAt parses an `at(yyyy-mm-ddThh:mm:ss)` expression.
+func ParseAt(s string) (*At, error) {
+	body, err := unwrap(s, "at")
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := time.Parse(atLayout, body)
+	if err != nil {
+		return nil, &FieldError{Field: "timestamp", Value: body, Err: fmt.Errorf("must be in the format yyyy-mm-ddThh:mm:ss: %w", err)}
+	}
+
+	return &At{time: t}, nil
+}
+
+// Next returns the expression's time if it is after t.
+func (a *At) Next(t time.Time) (time.Time, bool) {
+	v := time.Date(a.time.Year(), a.time.Month(), a.time.Day(), a.time.Hour(), a.time.Minute(), a.time.Second(), 0, t.Location())
+	if v.After(t) {
+		return v, true
+	}
+
+	return time.Time{}, false
+}
+
+func (a *At) String() string {
+	return fmt.Sprintf("at(%s)", a.time.Format(atLayout))
+}
@@ -0,0 +1,479 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schedule
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input         string
+		expectedField string
+		expectedErr   bool
+	}{
+		"empty": {
+			input:       "",
+			expectedErr: true,
+		},
+		"unknown function": {
+			input:       "every(5 minutes)",
+			expectedErr: true,
+		},
+		"cron": {
+			input: "cron(0 12 * * ? *)",
+		},
+		"cron names": {
+			input: "cron(0 18 ? JAN-MAR MON-FRI *)",
+		},
+		"cron increments": {
+			input: "cron(0/15 */2 1-15/3 * ? 2025-2030)",
+		},
+		"cron last day of month": {
+			input: "cron(0 0 L * ? *)",
+		},
+		"cron last weekday of month": {
+			input: "cron(0 0 LW * ? *)",
+		},
+		"cron nearest weekday": {
+			input: "cron(0 0 3W * ? *)",
+		},
+		"cron nth day of week": {
+			input: "cron(0 0 ? * 3#2 *)",
+		},
+		"cron last day of week": {
+			input: "cron(0 0 ? * 6L *)",
+		},
+		"cron too few fields": {
+			input:       "cron(0 12 * * ?)",
+			expectedErr: true,
+		},
+		"cron minutes out of range": {
+			input:         "cron(60 12 * * ? *)",
+			expectedField: "minutes",
+			expectedErr:   true,
+		},
+		"cron hours out of range": {
+			input:         "cron(0 24 * * ? *)",
+			expectedField: "hours",
+			expectedErr:   true,
+		},
+		"cron day of month out of range": {
+			input:         "cron(0 12 32 * ? *)",
+			expectedField: "day-of-month",
+			expectedErr:   true,
+		},
+		"cron invalid month name": {
+			input:         "cron(0 12 * FOO ? *)",
+			expectedField: "month",
+			expectedErr:   true,
+		},
+		"cron day of week out of range": {
+			input:         "cron(0 12 ? * 8 *)",
+			expectedField: "day-of-week",
+			expectedErr:   true,
+		},
+		"cron invalid occurrence": {
+			input:         "cron(0 12 ? * MON#6 *)",
+			expectedField: "day-of-week",
+			expectedErr:   true,
+		},
+		"cron year out of range": {
+			input:         "cron(0 12 * * ? 2200)",
+			expectedField: "year",
+			expectedErr:   true,
+		},
+		"cron invalid increment": {
+			input:         "cron(0/0 12 * * ? *)",
+			expectedField: "minutes",
+			expectedErr:   true,
+		},
+		"cron day of month and day of week": {
+			input:         "cron(0 12 * * MON *)",
+			expectedField: "day-of-week",
+			expectedErr:   true,
+		},
+		"cron neither day of month nor day of week": {
+			input:         "cron(0 12 ? * ? *)",
+			expectedField: "day-of-week",
+			expectedErr:   true,
+		},
+		"rate": {
+			input: "rate(5 minutes)",
+		},
+		"rate singular": {
+			input: "rate(1 hour)",
+		},
+		"rate zero": {
+			input:         "rate(0 minutes)",
+			expectedField: "value",
+			expectedErr:   true,
+		},
+		"rate invalid unit": {
+			input:         "rate(5 weeks)",
+			expectedField: "unit",
+			expectedErr:   true,
+		},
+		"rate plural with one": {
+			input: "rate(1 hours)",
+		},
+		"rate singular with many": {
+			input: "rate(2 day)",
+		},
+		"at": {
+			input: "at(2025-11-20T13:00:00)",
+		},
+		"at invalid": {
+			input:         "at(2025-11-20 13:00)",
+			expectedField: "timestamp",
+			expectedErr:   true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := Parse(testCase.input)
+
+			if got, want := err != nil, testCase.expectedErr; got != want {
+				t.Fatalf("Parse(%q) err %t, want %t (%v)", testCase.input, got, want, err)
+			}
+
+			if testCase.expectedField != "" {
+				var fieldErr *FieldError
+				if !errors.As(err, &fieldErr) {
+					t.Fatalf("Parse(%q) err = %v, want FieldError", testCase.input, err)
+				}
+				if got, want := fieldErr.Field, testCase.expectedField; got != want {
+					t.Errorf("Parse(%q) field = %q, want %q", testCase.input, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestRateValidateUnit(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input       string
+		expectedErr bool
+	}{
+		"singular with one": {
+			input: "rate(1 hour)",
+		},
+		"plural with many": {
+			input: "rate(5 minutes)",
+		},
+		"plural with one": {
+			input:       "rate(1 hours)",
+			expectedErr: true,
+		},
+		"singular with many": {
+			input:       "rate(2 day)",
+			expectedErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r, err := ParseRate(testCase.input)
+			if err != nil {
+				t.Fatalf("ParseRate(%q) err = %v", testCase.input, err)
+			}
+
+			err = r.ValidateUnit()
+
+			if got, want := err != nil, testCase.expectedErr; got != want {
+				t.Fatalf("ValidateUnit(%q) err %t, want %t (%v)", testCase.input, got, want, err)
+			}
+		})
+	}
+}
+
+func TestNext(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2025, time.January, 15, 10, 30, 0, 0, time.UTC) // A Wednesday.
+
+	testCases := map[string]struct {
+		input    string
+		expected []time.Time
+	}{
+		"daily at noon": {
+			input: "cron(0 12 * * ? *)",
+			expected: []time.Time{
+				time.Date(2025, time.January, 15, 12, 0, 0, 0, time.UTC),
+				time.Date(2025, time.January, 16, 12, 0, 0, 0, time.UTC),
+			},
+		},
+		"every 15 minutes": {
+			input: "cron(0/15 * * * ? *)",
+			expected: []time.Time{
+				time.Date(2025, time.January, 15, 10, 45, 0, 0, time.UTC),
+				time.Date(2025, time.January, 15, 11, 0, 0, 0, time.UTC),
+			},
+		},
+		"weekdays": {
+			input: "cron(0 9 ? * MON-FRI *)",
+			expected: []time.Time{
+				time.Date(2025, time.January, 16, 9, 0, 0, 0, time.UTC),
+				time.Date(2025, time.January, 17, 9, 0, 0, 0, time.UTC),
+				time.Date(2025, time.January, 20, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		"weekend wrap": {
+			input: "cron(0 9 ? * SAT-SUN *)",
+			expected: []time.Time{
+				time.Date(2025, time.January, 18, 9, 0, 0, 0, time.UTC),
+				time.Date(2025, time.January, 19, 9, 0, 0, 0, time.UTC),
+				time.Date(2025, time.January, 25, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		"last day of month": {
+			input: "cron(0 0 L * ? *)",
+			expected: []time.Time{
+				time.Date(2025, time.January, 31, 0, 0, 0, 0, time.UTC),
+				time.Date(2025, time.February, 28, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		"last weekday of month": {
+			input: "cron(0 0 LW * ? *)",
+			expected: []time.Time{
+				time.Date(2025, time.January, 31, 0, 0, 0, 0, time.UTC),
+				time.Date(2025, time.February, 28, 0, 0, 0, 0, time.UTC),
+				time.Date(2025, time.March, 31, 0, 0, 0, 0, time.UTC),
+				time.Date(2025, time.April, 30, 0, 0, 0, 0, time.UTC),
+				time.Date(2025, time.May, 30, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		"nearest weekday": {
+			input: "cron(0 0 1W * ? *)",
+			expected: []time.Time{
+				time.Date(2025, time.February, 3, 0, 0, 0, 0, time.UTC),
+				time.Date(2025, time.March, 3, 0, 0, 0, 0, time.UTC),
+				time.Date(2025, time.April, 1, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		"second tuesday": {
+			input: "cron(0 0 ? * TUE#2 *)",
+			expected: []time.Time{
+				time.Date(2025, time.February, 11, 0, 0, 0, 0, time.UTC),
+				time.Date(2025, time.March, 11, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		"last friday": {
+			input: "cron(0 0 ? * 6L *)",
+			expected: []time.Time{
+				time.Date(2025, time.January, 31, 0, 0, 0, 0, time.UTC),
+				time.Date(2025, time.February, 28, 0, 0, 0, 0, time.UTC),
+				time.Date(2025, time.March, 28, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		"leap day": {
+			input: "cron(0 0 29 2 ? *)",
+			expected: []time.Time{
+				time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC),
+				time.Date(2032, time.February, 29, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		"never": {
+			input: "cron(0 0 30 2 ? *)",
+		},
+		"past year": {
+			input: "cron(0 0 * * ? 2020)",
+		},
+		"rate": {
+			input: "rate(2 hours)",
+			expected: []time.Time{
+				time.Date(2025, time.January, 15, 12, 30, 0, 0, time.UTC),
+				time.Date(2025, time.January, 15, 14, 30, 0, 0, time.UTC),
+			},
+		},
+		"at future": {
+			input: "at(2025-02-01T08:00:00)",
+			expected: []time.Time{
+				time.Date(2025, time.February, 1, 8, 0, 0, 0, time.UTC),
+			},
+		},
+		"at past": {
+			input: "at(2024-02-01T08:00:00)",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			expr, err := Parse(testCase.input)
+			if err != nil {
+				t.Fatalf("Parse(%q) err = %v", testCase.input, err)
+			}
+
+			var got []time.Time
+			for v, ok := expr.Next(start); ok && len(got) < len(testCase.expected); v, ok = expr.Next(v) {
+				got = append(got, v)
+			}
+			if v, ok := expr.Next(start); len(testCase.expected) == 0 && ok {
+				t.Fatalf("Next(%q) = %s, want none", testCase.input, v)
+			}
+
+			if len(got) != len(testCase.expected) {
+				t.Fatalf("Next(%q) = %v, want %v", testCase.input, got, testCase.expected)
+			}
+			for i := range got {
+				if !got[i].Equal(testCase.expected[i]) {
+					t.Errorf("Next(%q)[%d] = %s, want %s", testCase.input, i, got[i], testCase.expected[i])
+				}
+			}
+		})
+	}
+}
+
+func TestParseUnixCron(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2025, time.January, 15, 10, 30, 0, 0, time.UTC) // A Wednesday.
+
+	testCases := map[string]struct {
+		input         string
+		expected      []time.Time
+		expectedField string
+		expectedErr   bool
+	}{
+		"too few fields": {
+			input:       "0 12 * *",
+			expectedErr: true,
+		},
+		"aws dialect": {
+			input:       "cron(0 12 * * ? *)",
+			expectedErr: true,
+		},
+		"question mark": {
+			input:         "0 12 ? * *",
+			expectedField: "day-of-month",
+			expectedErr:   true,
+		},
+		"hour out of range": {
+			input:         "0 24 * * *",
+			expectedField: "hours",
+			expectedErr:   true,
+		},
+		"day of week out of range": {
+			input:         "0 12 * * 8",
+			expectedField: "day-of-week",
+			expectedErr:   true,
+		},
+		"daily": {
+			input: "0 12 * * *",
+			expected: []time.Time{
+				time.Date(2025, time.January, 15, 12, 0, 0, 0, time.UTC),
+				time.Date(2025, time.January, 16, 12, 0, 0, 0, time.UTC),
+			},
+		},
+		"weekdays": {
+			input: "0 9 * * 1-5",
+			expected: []time.Time{
+				time.Date(2025, time.January, 16, 9, 0, 0, 0, time.UTC),
+				time.Date(2025, time.January, 17, 9, 0, 0, 0, time.UTC),
+				time.Date(2025, time.January, 20, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		"sunday as 7": {
+			input: "0 9 * * 7",
+			expected: []time.Time{
+				time.Date(2025, time.January, 19, 9, 0, 0, 0, time.UTC),
+			},
+		},
+		"day of month or day of week": {
+			input: "0 0 20 * FRI",
+			expected: []time.Time{
+				time.Date(2025, time.January, 17, 0, 0, 0, 0, time.UTC),
+				time.Date(2025, time.January, 20, 0, 0, 0, 0, time.UTC),
+				time.Date(2025, time.January, 24, 0, 0, 0, 0, time.UTC),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			expr, err := ParseUnixCron(testCase.input)
+
+			if got, want := err != nil, testCase.expectedErr; got != want {
+				t.Fatalf("ParseUnixCron(%q) err %t, want %t (%v)", testCase.input, got, want, err)
+			}
+			if err != nil {
+				if testCase.expectedField != "" {
+					var fieldErr *FieldError
+					if !errors.As(err, &fieldErr) {
+						t.Fatalf("ParseUnixCron(%q) err = %v, want FieldError", testCase.input, err)
+					}
+					if got, want := fieldErr.Field, testCase.expectedField; got != want {
+						t.Errorf("ParseUnixCron(%q) field = %q, want %q", testCase.input, got, want)
+					}
+				}
+				return
+			}
+
+			var got []time.Time
+			for v, ok := expr.Next(start); ok && len(got) < len(testCase.expected); v, ok = expr.Next(v) {
+				got = append(got, v)
+			}
+
+			if len(got) != len(testCase.expected) {
+				t.Fatalf("Next(%q) = %v, want %v", testCase.input, got, testCase.expected)
+			}
+			for i := range got {
+				if !got[i].Equal(testCase.expected[i]) {
+					t.Errorf("Next(%q)[%d] = %s, want %s", testCase.input, i, got[i], testCase.expected[i])
+				}
+			}
+		})
+	}
+}
+
+func TestParseAllowed(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input       string
+		allowed     []Type
+		expectedErr bool
+	}{
+		"cron allowed": {
+			input:   "cron(0 12 * * ? *)",
+			allowed: []Type{TypeCron},
+		},
+		"rate allowed": {
+			input:   "rate(5 minutes)",
+			allowed: []Type{TypeCron, TypeRate},
+		},
+		"at not allowed": {
+			input:       "at(2025-11-20T13:00:00)",
+			allowed:     []Type{TypeCron, TypeRate},
+			expectedErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseAllowed(testCase.input, testCase.allowed...)
+
+			if got, want := err != nil, testCase.expectedErr; got != want {
+				t.Fatalf("ParseAllowed(%q) err %t, want %t (%v)", testCase.input, got, want, err)
+			}
+		})
+	}
+}
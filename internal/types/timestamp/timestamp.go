@@ -7,8 +7,6 @@ import (
 	"fmt"
 	"strings"
 	"time"
-
-	"github.com/YakDriver/regexache"
 )
 
 // Timestamp is a timestamp string type
@@ -29,10 +27,11 @@ func (t Timestamp) String() string {
 // ValidateOnceADayWindowFormat validates once a day window format
 func (t Timestamp) ValidateOnceADayWindowFormat() error {
 	// valid time format is "hh24:mi"
-	validTimeFormat := "([0-1][0-9]|2[0-3]):([0-5][0-9])"
-	validTimeFormatConsolidated := "^(" + validTimeFormat + "-" + validTimeFormat + "|)$"
+	if t == "" {
+		return nil
+	}
 
-	if !regexache.MustCompile(validTimeFormatConsolidated).MatchString(t.String()) {
+	if _, err := ParseOnceADayWindow(t.String()); err != nil {
 		return fmt.Errorf("(%s) must satisfy the format of \"hh24:mi-hh24:mi\"", t.String())
 	}
 
@@ -42,11 +41,12 @@ func (t Timestamp) ValidateOnceADayWindowFormat() error {
 // ValidateOnceAWeekWindowFormat validates once a week window date format
 func (t Timestamp) ValidateOnceAWeekWindowFormat() error {
 	// valid time format is "ddd:hh24:mi"
-	validTimeFormat := "(sun|mon|tue|wed|thu|fri|sat):([0-1][0-9]|2[0-3]):([0-5][0-9])"
-	validTimeFormatConsolidated := "^(" + validTimeFormat + "-" + validTimeFormat + "|)$"
+	if t == "" {
+		return nil
+	}
 
 	val := strings.ToLower(t.String())
-	if !regexache.MustCompile(validTimeFormatConsolidated).MatchString(val) {
+	if _, err := ParseOnceAWeekWindow(val); err != nil {
 		return fmt.Errorf("(%s) must satisfy the format of \"ddd:hh24:mi-ddd:hh24:mi\"", val)
 	}
 
@@ -0,0 +1,252 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timestamp
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/YakDriver/regexache"
+)
+
+const (
+	minutesPerDay  = 24 * 60
+	minutesPerWeek = 7 * minutesPerDay
+)
+
+var (
+	days = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+
+	dayTimeRegexp = regexache.MustCompile(`^\d{2}:\d{2}$`)
+)
+
+// Window is a recurring weekly or daily window, e.g. an RDS maintenance or backup window.
+type Window interface {
+	fmt.Stringer
+	// Duration returns the length of the window.
+	Duration() time.Duration
+	// intervals returns the window's occurrences as half-open minute ranges within a week.
+	intervals() []interval
+}
+
+var (
+	_ Window = OnceAWeekWindow{}
+	_ Window = OnceADayWindow{}
+)
+
+type interval struct {
+	start, end int
+}
+
+// ParseWindow parses either a once a week ("ddd:hh24:mi-ddd:hh24:mi") or once a day ("hh24:mi-hh24:mi") window.
+func ParseWindow(s string) (Window, error) {
+	if len(s) > 0 && s[0] >= '0' && s[0] <= '9' {
+		return ParseOnceADayWindow(s)
+	}
+
+	return ParseOnceAWeekWindow(s)
+}
+
+// ShiftWindow moves a once a week or once a day window by the specified duration.
+func ShiftWindow(w Window, d time.Duration) (Window, error) {
+	switch w := w.(type) {
+	case OnceAWeekWindow:
+		return w.Shift(d)
+	case OnceADayWindow:
+		return w.Shift(d)
+	}
+
+	return nil, fmt.Errorf("unsupported window type %T", w)
+}
+
+// WindowsOverlap returns whether two windows are ever open at the same time.
+// A once a day window is treated as open on every day of the week.
+func WindowsOverlap(a, b Window) bool {
+	for _, x := range a.intervals() {
+		for _, y := range b.intervals() {
+			if x.start < y.end && y.start < x.end {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// OnceAWeekWindow is a window in the format "ddd:hh24:mi-ddd:hh24:mi".
+type OnceAWeekWindow struct {
+	start, end int // Minutes since Sunday 00:00.
+}
+
+// ParseOnceAWeekWindow parses a window in the format "ddd:hh24:mi-ddd:hh24:mi".
+// Day names are case insensitive.
+func ParseOnceAWeekWindow(s string) (OnceAWeekWindow, error) {
+	from, to, ok := strings.Cut(strings.ToLower(s), "-")
+	if !ok {
+		return OnceAWeekWindow{}, fmt.Errorf("(%s) must satisfy the format of \"ddd:hh24:mi-ddd:hh24:mi\"", s)
+	}
+
+	start, err := parseWeekTime(from)
+	if err != nil {
+		return OnceAWeekWindow{}, fmt.Errorf("(%s) %w", s, err)
+	}
+	end, err := parseWeekTime(to)
+	if err != nil {
+		return OnceAWeekWindow{}, fmt.Errorf("(%s) %w", s, err)
+	}
+
+	return OnceAWeekWindow{start: start, end: end}, nil
+}
+
+// Shift moves the window by the specified duration, wrapping around the week.
+// The duration must be a whole number of minutes.
+func (w OnceAWeekWindow) Shift(d time.Duration) (OnceAWeekWindow, error) {
+	n, err := wholeMinutes(d)
+	if err != nil {
+		return OnceAWeekWindow{}, err
+	}
+
+	return OnceAWeekWindow{
+		start: mod(w.start+n, minutesPerWeek),
+		end:   mod(w.end+n, minutesPerWeek),
+	}, nil
+}
+
+// Duration returns the length of the window.
+func (w OnceAWeekWindow) Duration() time.Duration {
+	return time.Duration(mod(w.end-w.start, minutesPerWeek)) * time.Minute
+}
+
+func (w OnceAWeekWindow) intervals() []interval {
+	return split(w.start, mod(w.end-w.start, minutesPerWeek), minutesPerWeek)
+}
+
+func (w OnceAWeekWindow) String() string {
+	return formatWeekTime(w.start) + "-" + formatWeekTime(w.end)
+}
+
+// OnceADayWindow is a window in the format "hh24:mi-hh24:mi".
+type OnceADayWindow struct {
+	start, end int // Minutes since 00:00.
+}
+
+// ParseOnceADayWindow parses a window in the format "hh24:mi-hh24:mi".
+func ParseOnceADayWindow(s string) (OnceADayWindow, error) {
+	from, to, ok := strings.Cut(s, "-")
+	if !ok {
+		return OnceADayWindow{}, fmt.Errorf("(%s) must satisfy the format of \"hh24:mi-hh24:mi\"", s)
+	}
+
+	start, err := parseDayTime(from)
+	if err != nil {
+		return OnceADayWindow{}, fmt.Errorf("(%s) %w", s, err)
+	}
+	end, err := parseDayTime(to)
+	if err != nil {
+		return OnceADayWindow{}, fmt.Errorf("(%s) %w", s, err)
+	}
+
+	return OnceADayWindow{start: start, end: end}, nil
+}
+
+// Shift moves the window by the specified duration, wrapping around the day.
+// The duration must be a whole number of minutes.
+func (w OnceADayWindow) Shift(d time.Duration) (OnceADayWindow, error) {
+	n, err := wholeMinutes(d)
+	if err != nil {
+		return OnceADayWindow{}, err
+	}
+
+	return OnceADayWindow{
+		start: mod(w.start+n, minutesPerDay),
+		end:   mod(w.end+n, minutesPerDay),
+	}, nil
+}
+
+// Duration returns the length of the window.
+func (w OnceADayWindow) Duration() time.Duration {
+	return time.Duration(mod(w.end-w.start, minutesPerDay)) * time.Minute
+}
+
+func (w OnceADayWindow) intervals() []interval {
+	var result []interval
+	for day := range 7 {
+		result = append(result, split(day*minutesPerDay+w.start, mod(w.end-w.start, minutesPerDay), minutesPerWeek)...)
+	}
+
+	return result
+}
+
+func (w OnceADayWindow) String() string {
+	return formatDayTime(w.start) + "-" + formatDayTime(w.end)
+}
+
+// parseWeekTime parses a time in the format "ddd:hh24:mi" and returns the minutes since Sunday 00:00.
+func parseWeekTime(s string) (int, error) {
+	day, rest, ok := strings.Cut(s, ":")
+	if !ok {
+		return 0, errors.New("must satisfy the format of \"ddd:hh24:mi-ddd:hh24:mi\"")
+	}
+
+	for i, v := range days {
+		if v == day {
+			t, err := parseDayTime(rest)
+			if err != nil {
+				return 0, err
+			}
+			return i*minutesPerDay + t, nil
+		}
+	}
+
+	return 0, fmt.Errorf("invalid day %q", day)
+}
+
+// parseDayTime parses a time in the format "hh24:mi" and returns the minutes since 00:00.
+func parseDayTime(s string) (int, error) {
+	if !dayTimeRegexp.MatchString(s) {
+		return 0, fmt.Errorf("time %q must satisfy the format of \"hh24:mi\"", s)
+	}
+
+	hour, _ := strconv.Atoi(s[:2])
+	minute, _ := strconv.Atoi(s[3:])
+
+	if hour > 23 || minute > 59 {
+		return 0, fmt.Errorf("time %q out of range", s)
+	}
+
+	return hour*60 + minute, nil
+}
+
+func formatWeekTime(v int) string {
+	return days[v/minutesPerDay] + ":" + formatDayTime(v%minutesPerDay)
+}
+
+func formatDayTime(v int) string {
+	return fmt.Sprintf("%02d:%02d", v/60, v%60)
+}
+
+// split returns the half-open ranges covering [start, start+length) modulo period.
+func split(start, length, period int) []interval {
+	end := start + length
+	if end > period {
+		return []interval{{start, period}, {0, end - period}}
+	}
+
+	return []interval{{start, end}}
+}
+
+func wholeMinutes(d time.Duration) (int, error) {
+	if d%time.Minute != 0 {
+		return 0, fmt.Errorf("duration %s is not a whole number of minutes", d)
+	}
+
+	return int(d / time.Minute), nil
+}
+
+func mod(a, b int) int {
+	return ((a % b) + b) % b
+}
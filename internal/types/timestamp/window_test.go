@@ -0,0 +1,199 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timestamp
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOnceAWeekWindowShift(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input       string
+		shift       time.Duration
+		expected    string
+		expectedErr bool
+	}{
+		"invalid": {
+			input:       "sun:05:00",
+			expectedErr: true,
+		},
+		"invalid day": {
+			input:       "abc:05:00-sun:06:00",
+			expectedErr: true,
+		},
+		"invalid hour": {
+			input:       "sun:24:00-mon:01:00",
+			expectedErr: true,
+		},
+		"no shift": {
+			input:    "Sun:05:00-Sun:06:00",
+			expected: "sun:05:00-sun:06:00",
+		},
+		"forward": {
+			input:    "mon:23:30-tue:00:30",
+			shift:    90 * time.Minute,
+			expected: "tue:01:00-tue:02:00",
+		},
+		"backward wrap": {
+			input:    "sun:00:30-sun:01:30",
+			shift:    -time.Hour,
+			expected: "sat:23:30-sun:00:30",
+		},
+		"forward wrap": {
+			input:    "sat:23:00-sun:00:30",
+			shift:    2 * time.Hour,
+			expected: "sun:01:00-sun:02:30",
+		},
+		"seconds": {
+			input:       "sun:05:00-sun:06:00",
+			shift:       30 * time.Second,
+			expectedErr: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			w, err := ParseOnceAWeekWindow(testCase.input)
+			if err == nil {
+				w, err = w.Shift(testCase.shift)
+			}
+
+			if got, want := err != nil, testCase.expectedErr; got != want {
+				t.Fatalf("err %t, want %t (%v)", got, want, err)
+			}
+			if err != nil {
+				return
+			}
+
+			if got, want := w.String(), testCase.expected; got != want {
+				t.Errorf("got %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestOnceADayWindowShift(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		input       string
+		shift       time.Duration
+		expected    string
+		expectedErr bool
+	}{
+		"invalid": {
+			input:       "5:00-6:00",
+			expectedErr: true,
+		},
+		"signed hour": {
+			input:       "+5:00-06:00",
+			expectedErr: true,
+		},
+		"space padded hour": {
+			input:       " 5:00-06:00",
+			expectedErr: true,
+		},
+		"forward wrap": {
+			input:    "23:00-23:30",
+			shift:    90 * time.Minute,
+			expected: "00:30-01:00",
+		},
+		"backward wrap": {
+			input:    "00:15-00:45",
+			shift:    -30 * time.Minute,
+			expected: "23:45-00:15",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			w, err := ParseOnceADayWindow(testCase.input)
+			if err == nil {
+				w, err = w.Shift(testCase.shift)
+			}
+
+			if got, want := err != nil, testCase.expectedErr; got != want {
+				t.Fatalf("err %t, want %t (%v)", got, want, err)
+			}
+			if err != nil {
+				return
+			}
+
+			if got, want := w.String(), testCase.expected; got != want {
+				t.Errorf("got %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestWindowsOverlap(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		a, b     string
+		expected bool
+	}{
+		"weekly disjoint": {
+			a: "sun:05:00-sun:06:00",
+			b: "sun:06:00-sun:07:00",
+		},
+		"weekly overlap": {
+			a:        "sun:05:00-sun:06:00",
+			b:        "sun:05:30-sun:07:00",
+			expected: true,
+		},
+		"weekly wrap overlap": {
+			a:        "sat:23:00-sun:01:00",
+			b:        "sun:00:30-sun:02:00",
+			expected: true,
+		},
+		"daily disjoint": {
+			a: "01:00-02:00",
+			b: "03:00-04:00",
+		},
+		"daily wrap overlap": {
+			a:        "23:30-00:30",
+			b:        "00:00-00:15",
+			expected: true,
+		},
+		"weekly and daily disjoint": {
+			a: "wed:03:00-wed:04:00",
+			b: "01:00-02:00",
+		},
+		"weekly and daily overlap": {
+			a:        "wed:03:00-wed:04:00",
+			b:        "03:30-04:30",
+			expected: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			a, err := ParseWindow(testCase.a)
+			if err != nil {
+				t.Fatal(err)
+			}
+			b, err := ParseWindow(testCase.b)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got, want := WindowsOverlap(a, b), testCase.expected; got != want {
+				t.Errorf("got %t, want %t", got, want)
+			}
+			if got, want := WindowsOverlap(b, a), testCase.expected; got != want {
+				t.Errorf("reversed: got %t, want %t", got, want)
+			}
+		})
+	}
+}
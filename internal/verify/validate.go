@@ -24,6 +24,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	tfmaps "github.com/hashicorp/terraform-provider-aws/internal/maps"
 	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/schedule"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/timestamp"
 )
 
@@ -397,6 +398,76 @@ func ValidOnceAWeekWindowFormat(v interface{}, k string) (ws []string, errors []
 	return
 }
 
+// ValidScheduleExpression returns a SchemaValidateFunc which tests if the provided value
+// is a valid schedule expression of one of the allowed types, e.g. `cron(0 12 * * ? *)` or `rate(5 minutes)`.
+// Empty values are not validated.
+func ValidScheduleExpression(allowed ...schedule.Type) schema.SchemaValidateFunc {
+	return func(v interface{}, k string) (ws []string, errors []error) {
+		value, ok := v.(string)
+		if !ok {
+			errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+			return
+		}
+
+		if value == "" {
+			return
+		}
+
+		if _, err := schedule.ParseAllowed(value, allowed...); err != nil {
+			errors = append(errors, fmt.Errorf("%q (%q) is an invalid schedule expression: %w", k, value, err))
+		}
+
+		return
+	}
+}
+
+// ValidRateScheduleExpressionUnit validates that the unit of a `rate()` schedule expression agrees in number with its value,
+// e.g. `rate(1 hour)` or `rate(5 hours)`, as required by EventBridge rules.
+// Empty values and other expression types are not validated.
+func ValidRateScheduleExpressionUnit(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if !strings.HasPrefix(value, string(schedule.TypeRate)+"(") {
+		return
+	}
+
+	r, err := schedule.ParseRate(value)
+	if err != nil {
+		// Reported by ValidScheduleExpression.
+		return
+	}
+
+	if err := r.ValidateUnit(); err != nil {
+		errors = append(errors, fmt.Errorf("%q (%q) is an invalid schedule expression: %w", k, value, err))
+	}
+
+	return
+}
+
+// ValidUnixCronExpression validates a five-field Unix cron expression, e.g. `0 12 * * MON-FRI`.
+// Empty values are not validated.
+func ValidUnixCronExpression(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if value == "" {
+		return
+	}
+
+	if _, err := schedule.ParseUnixCron(value); err != nil {
+		errors = append(errors, fmt.Errorf("%q (%q) is an invalid cron expression: %w", k, value, err))
+	}
+
+	return
+}
+
 var (
 	ValidRegionName = validation.StringMatch(regionRegexp, "must be a valid AWS Region Code")
 )
@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/schedule"
 )
 
 func TestValidAmazonSideASN(t *testing.T) {
@@ -534,6 +535,143 @@ func TestValidOnceAWeekWindowFormat(t *testing.T) {
 	}
 }
 
+func TestValidScheduleExpression(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Value    string
+		ErrCount int
+		ErrMsg   string
+	}{
+		{
+			Value:    "cron(0 12 * * ? *)",
+			ErrCount: 0,
+		},
+		{
+			Value:    "rate(5 minutes)",
+			ErrCount: 0,
+		},
+		{
+			Value:    "",
+			ErrCount: 0,
+		},
+		{
+			// at() not allowed
+			Value:    "at(2025-11-20T13:00:00)",
+			ErrCount: 1,
+			ErrMsg:   "expected cron() or rate() expression",
+		},
+		{
+			// both day-of-month and day-of-week
+			Value:    "cron(0 12 * * MON *)",
+			ErrCount: 1,
+			ErrMsg:   "day-of-week field",
+		},
+		{
+			// hour out of range
+			Value:    "cron(0 25 * * ? *)",
+			ErrCount: 1,
+			ErrMsg:   "hours field",
+		},
+		{
+			// invalid unit
+			Value:    "rate(5 weeks)",
+			ErrCount: 1,
+			ErrMsg:   "unit field",
+		},
+	}
+
+	f := ValidScheduleExpression(schedule.TypeCron, schedule.TypeRate)
+	for _, tc := range cases {
+		_, errors := f(tc.Value, "schedule_expression")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d validation errors, But got %d errors for \"%s\"", tc.ErrCount, len(errors), tc.Value)
+		}
+		if tc.ErrMsg != "" && !strings.Contains(errors[0].Error(), tc.ErrMsg) {
+			t.Errorf("Expected error for \"%s\" to contain %q, got %q", tc.Value, tc.ErrMsg, errors[0])
+		}
+	}
+}
+
+func TestValidRateScheduleExpressionUnit(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "rate(1 hour)",
+			ErrCount: 0,
+		},
+		{
+			Value:    "rate(5 minutes)",
+			ErrCount: 0,
+		},
+		{
+			Value:    "cron(0 12 * * ? *)",
+			ErrCount: 0,
+		},
+		{
+			Value:    "",
+			ErrCount: 0,
+		},
+		{
+			Value:    "rate(1 hours)",
+			ErrCount: 1,
+		},
+		{
+			Value:    "rate(5 minute)",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := ValidRateScheduleExpressionUnit(tc.Value, "schedule_expression")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d validation errors, But got %d errors for \"%s\"", tc.ErrCount, len(errors), tc.Value)
+		}
+	}
+}
+
+func TestValidUnixCronExpression(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		Value    string
+		ErrCount int
+	}{
+		{
+			Value:    "0 12 * * MON-FRI",
+			ErrCount: 0,
+		},
+		{
+			Value:    "",
+			ErrCount: 0,
+		},
+		{
+			// AWS dialect
+			Value:    "cron(0 12 * * ? *)",
+			ErrCount: 1,
+		},
+		{
+			// minute out of range
+			Value:    "60 12 * * *",
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := ValidUnixCronExpression(tc.Value, "recurrence")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d validation errors, But got %d errors for \"%s\"", tc.ErrCount, len(errors), tc.Value)
+		}
+	}
+}
+
 func TestValidOnceADayWindowFormat(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package data
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// APILineage maps resource type to CRUD phase to AWS API operation to the IAM actions required by that operation.
+type APILineage map[string]map[string]map[string][]string
+
+var (
+	apiLineage     APILineage
+	apiLineageErr  error
+	apiLineageOnce sync.Once
+)
+
+// ReadAPILineage returns the API lineage generated by internal/generate/apilineage.
+func ReadAPILineage() (APILineage, error) {
+	apiLineageOnce.Do(func() {
+		if err := json.Unmarshal(apiLineageJSON, &apiLineage); err != nil {
+			apiLineageErr = fmt.Errorf("decoding API lineage: %w", err)
+		}
+	})
+
+	return apiLineage, apiLineageErr
+}
+
+// IAMActions returns the sorted, de-duplicated IAM actions required by the specified resource type in the specified CRUD phase.
+// The second return value is false if the resource type is not known.
+func (l APILineage) IAMActions(typeName, phase string) ([]string, bool) {
+	phases, ok := l[typeName]
+	if !ok {
+		return nil, false
+	}
+
+	var actions []string
+	for _, v := range phases[phase] {
+		actions = append(actions, v...)
+	}
+	slices.Sort(actions)
+
+	return slices.Compact(actions), true
+}
+
+//go:embed api_lineage.json
+var apiLineageJSON []byte